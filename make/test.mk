@@ -388,3 +388,8 @@ display-eval:
 ## Run the unit tests in the 'testsupport/...' packages
 test:
 	@go test github.com/codeready-toolchain/toolchain-e2e/testsupport/... -failfast
+
+.PHONY: test-race
+## Run the unit tests in the 'testsupport/...' packages with the race detector, to verify that the helpers are safe for concurrent use
+test-race:
+	@go test github.com/codeready-toolchain/toolchain-e2e/testsupport/... -failfast -race
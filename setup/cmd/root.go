@@ -70,6 +70,8 @@ func Execute() {
 
 	cmd.Flags().StringVar(&usernamePrefix, "username", usernamePrefix, "the prefix used for usersignup names")
 	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "absolute path to the kubeconfig file")
+	cmd.Flags().Float32Var(&cfg.ClientQPS, "qps", cfg.ClientQPS, "the max number of queries per second to the API server (lower it on a shared cluster)")
+	cmd.Flags().IntVar(&cfg.ClientBurst, "burst", cfg.ClientBurst, "the max burst of queries to the API server")
 	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "if 'debug' traces should be displayed in the console")
 	cmd.Flags().IntVarP(&numberOfUsers, "users", "u", 2000, "the number of user accounts to provision")
 	cmd.Flags().StringVar(&cfg.HostOperatorNamespace, "host-ns", cfg.DefaultHostNS, "the namespace of Host operator")
@@ -2,6 +2,7 @@ package e2e
 
 import (
 	"context"
+	"os"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	userv1 "github.com/openshift/api/user/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -43,6 +45,15 @@ func TestE2EFlow(t *testing.T) {
 		t.Run("verify overall toolchain status", func(t *testing.T) {
 			VerifyToolchainStatus(t, hostAwait, memberAwait)
 		})
+
+		t.Run("verify registration service deployment", func(t *testing.T) {
+			fixture, err := os.ReadFile("testdata/registration-service-deployment.yaml")
+			require.NoError(t, err)
+			_, err = wait.WaitForObjectMatchingYAML[*appsv1.Deployment](t, hostAwait.Awaitility,
+				types.NamespacedName{Namespace: hostAwait.RegistrationServiceNs, Name: "registration-service"}, fixture,
+				".status.conditions[*].lastTransitionTime", ".status.conditions[*].lastUpdateTime")
+			require.NoError(t, err)
+		})
 	})
 
 	t.Run("verify MemberOperatorConfigs synced from ToolchainConfig to member clusters", func(t *testing.T) {
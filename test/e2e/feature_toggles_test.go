@@ -0,0 +1,21 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/codeready-toolchain/toolchain-e2e/testsupport"
+)
+
+func TestFeatureToggles(t *testing.T) {
+	// given
+	awaitilities := WaitForDeployments(t)
+	hostAwait := awaitilities.Host()
+	SetFeatureToggles(t, hostAwait, FeatureToggle{Name: "test-feature-toggle", Weight: 50})
+
+	// when & then
+	userSignups := VerifyFeatureToggleDistribution(t, hostAwait, "test-feature-toggle", 50, 30)
+
+	// deprovision all the users at once, to keep track of the deprovisioning performance
+	BulkDeleteUserSignups(t, hostAwait, userSignups, 5*time.Minute)
+}
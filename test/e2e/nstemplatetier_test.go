@@ -77,6 +77,28 @@ func TestNSTemplateTiers(t *testing.T) {
 	}
 }
 
+func TestTierFootprints(t *testing.T) {
+	// given
+	awaitilities := WaitForDeployments(t)
+	hostAwait := awaitilities.Host()
+	allTiers := &toolchainv1alpha1.NSTemplateTierList{}
+	err := hostAwait.Client.List(context.TODO(), allTiers, client.InNamespace(hostAwait.Namespace))
+	require.NoError(t, err)
+	tierNames := make([]string, 0, len(allTiers.Items))
+	for _, tier := range allTiers.Items {
+		tierNames = append(tierNames, tier.Name)
+	}
+
+	// when
+	footprints := CompareTierFootprints(t, awaitilities, tierNames...)
+
+	// then
+	require.Len(t, footprints, len(tierNames))
+	for _, footprint := range footprints {
+		assert.NotEmpty(t, footprint.Namespaces, "no namespace provisioned for the '%s' tier", footprint.Tier)
+	}
+}
+
 func TestSetDefaultTier(t *testing.T) {
 	// given
 	awaitilities := WaitForDeployments(t)
@@ -0,0 +1,77 @@
+package parallel
+
+import (
+	"context"
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	. "github.com/codeready-toolchain/toolchain-e2e/testsupport"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/backup"
+	. "github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestRestoreUserFromBackup(t *testing.T) {
+	// given
+	t.Parallel()
+	awaitilities := WaitForDeployments(t)
+	hostAwait := awaitilities.Host()
+
+	userSignup, mur := NewSignupRequest(awaitilities).
+		ManuallyApprove().
+		TargetCluster(awaitilities.Member1()).
+		EnsureMUR().
+		RequireConditions(ConditionSet(Default(), ApprovedByAdmin())...).
+		Execute(t).Resources()
+	space, err := hostAwait.WaitForSpace(t, mur.Name, UntilSpaceHasConditions(Provisioned()))
+	require.NoError(t, err)
+
+	// only keep the resources of this user, since other tests are running in parallel in the same namespace
+	snapshot := backup.TakeSnapshot(t, hostAwait.Client, hostAwait.Namespace, backup.HostResources()...).
+		Filter(func(obj client.Object) bool {
+			switch obj := obj.(type) {
+			case *toolchainv1alpha1.SpaceBinding:
+				return obj.Spec.MasterUserRecord == mur.Name
+			case *toolchainv1alpha1.UserSignup:
+				return obj.Name == userSignup.Name
+			case *toolchainv1alpha1.MasterUserRecord, *toolchainv1alpha1.Space:
+				return obj.GetName() == mur.Name
+			}
+			return false
+		})
+	require.Len(t, snapshot.Objects, 4) // UserSignup, MUR, Space and SpaceBinding
+
+	t.Run("restore into a fresh namespace", func(t *testing.T) {
+		// given
+		namespace := "restore-" + mur.Name
+		hostAwait.CreateNamespace(t, namespace)
+
+		// when
+		restored := snapshot.Restore(t, hostAwait.Client, namespace)
+
+		// then
+		require.Len(t, restored, len(snapshot.Objects))
+		snapshot.RequireRestored(t, hostAwait.Client, namespace)
+	})
+
+	t.Run("restore into the host namespace once the user is lost", func(t *testing.T) {
+		// given
+		err = hostAwait.Client.Delete(context.TODO(), userSignup)
+		require.NoError(t, err)
+		err = hostAwait.WaitUntilObjectDeleted(t, mur)
+		require.NoError(t, err)
+		err = hostAwait.WaitUntilObjectDeleted(t, space)
+		require.NoError(t, err)
+
+		// when
+		restored := snapshot.Restore(t, hostAwait.Client, hostAwait.Namespace)
+
+		// then
+		// the resources which were already re-created by the operators from the restored UserSignup are also returned
+		require.Len(t, restored, len(snapshot.Objects))
+		snapshot.WaitUntilReconciled(t, hostAwait.Awaitility, restored)
+		VerifyResourcesProvisionedForSignup(t, awaitilities, userSignup, "deactivate30", "base")
+	})
+}
@@ -13,14 +13,13 @@ import (
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 	"github.com/codeready-toolchain/toolchain-common/pkg/cluster"
-	commonsocialevent "github.com/codeready-toolchain/toolchain-common/pkg/socialevent"
 	"github.com/codeready-toolchain/toolchain-common/pkg/states"
 	commonauth "github.com/codeready-toolchain/toolchain-common/pkg/test/auth"
-	testsocialevent "github.com/codeready-toolchain/toolchain-common/pkg/test/socialevent"
 	commonsignup "github.com/codeready-toolchain/toolchain-common/pkg/usersignup"
 	. "github.com/codeready-toolchain/toolchain-e2e/testsupport"
 	authsupport "github.com/codeready-toolchain/toolchain-e2e/testsupport/auth"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/cleanup"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/factories"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/gofrs/uuid"
@@ -342,7 +341,7 @@ func TestSignupOK(t *testing.T) {
 		assert.Equal(t, email, emailAnnotation)
 
 		// Call get signup endpoint with a valid token and make sure it's pending approval
-		assertGetSignupStatusPendingApproval(t, await, identity.Username, token)
+		pendingETag := assertGetSignupStatusPendingApproval(t, await, identity.Username, token)
 
 		// Attempt to create same usersignup by calling post signup with same token should return an error
 		mp := NewHTTPRequest(t).
@@ -366,6 +365,12 @@ func TestSignupOK(t *testing.T) {
 		// Call signup endpoint with same valid token to check if status changed to Provisioned now
 		assertGetSignupStatusProvisioned(t, await, identity.Username, token)
 
+		// Make sure that the status before the approval can't be served from a cache anymore
+		provisioned := NewHTTPRequest(t).
+			InvokeEndpoint("GET", route+"/api/v1/signup", token, "", http.StatusOK).
+			RequireNotCacheable()
+		AssertETagChanged(t, pendingETag, provisioned.ETag())
+
 		return userSignup
 	}
 
@@ -634,11 +639,9 @@ func TestActivationCodeVerification(t *testing.T) {
 
 	t.Run("verification successful", func(t *testing.T) {
 		// given
-		event := testsocialevent.NewSocialEvent(hostAwait.Namespace, commonsocialevent.NewName(),
-			testsocialevent.WithUserTier("deactivate80"),
-			testsocialevent.WithSpaceTier("base1ns6didler"))
-		err := hostAwait.CreateWithCleanup(t, event)
-		require.NoError(t, err)
+		event := factories.CreateSocialEvent(t, hostAwait,
+			factories.SocialEventUserTier("deactivate80"),
+			factories.SocialEventSpaceTier("base1ns6didler"))
 		userSignup, token := signup(t, hostAwait)
 
 		// when call verification endpoint with a valid activation code
@@ -648,7 +651,7 @@ func TestActivationCodeVerification(t *testing.T) {
 		// then
 		// ensure the UserSignup is in "pending approval" condition,
 		// because in these series of parallel tests, automatic approval is disabled ¯\_(ツ)_/¯
-		_, err = hostAwait.WaitForUserSignup(t, userSignup.Name,
+		_, err := hostAwait.WaitForUserSignup(t, userSignup.Name,
 			wait.UntilUserSignupHasLabel(toolchainv1alpha1.SocialEventUserSignupLabelKey, event.Name),
 			wait.UntilUserSignupHasConditions(wait.ConditionSet(wait.Default(), wait.PendingApproval())...))
 		require.NoError(t, err)
@@ -676,9 +679,8 @@ func TestActivationCodeVerification(t *testing.T) {
 		)
 		require.NoError(t, err)
 
-		// also check that the SocialEvent status was updated accordingly
-		_, err = hostAwait.WaitForSocialEvent(t, event.Name, wait.UntilSocialEventHasActivationCount(1))
-		require.NoError(t, err)
+		// also check that the SocialEvent status was updated accordingly (ie, the signup was counted exactly once)
+		VerifySocialEventActivationCount(t, hostAwait, event.Name, 1)
 	})
 
 	t.Run("verification failed", func(t *testing.T) {
@@ -701,12 +703,10 @@ func TestActivationCodeVerification(t *testing.T) {
 
 		t.Run("over capacity", func(t *testing.T) {
 			// given
-			event := testsocialevent.NewSocialEvent(hostAwait.Namespace, commonsocialevent.NewName(),
-				testsocialevent.WithUserTier("deactivate80"),
-				testsocialevent.WithSpaceTier("base1ns6didler"))
-			err := hostAwait.CreateWithCleanup(t, event)
-			require.NoError(t, err)
-			event, err = hostAwait.WaitForSocialEvent(t, event.Name) // need to reload event
+			event := factories.CreateSocialEvent(t, hostAwait,
+				factories.SocialEventUserTier("deactivate80"),
+				factories.SocialEventSpaceTier("base1ns6didler"))
+			event, err := hostAwait.WaitForSocialEvent(t, event.Name) // need to reload event
 			require.NoError(t, err)
 			event.Status.ActivationCount = event.Spec.MaxAttendees // activation count identical to `MaxAttendees`
 			err = hostAwait.Client.Status().Update(context.TODO(), event)
@@ -728,9 +728,7 @@ func TestActivationCodeVerification(t *testing.T) {
 
 		t.Run("not opened yet", func(t *testing.T) {
 			// given
-			event := testsocialevent.NewSocialEvent(hostAwait.Namespace, commonsocialevent.NewName(), testsocialevent.WithStartTime(time.Now().Add(time.Hour))) // not open yet
-			err := hostAwait.CreateWithCleanup(t, event)
-			require.NoError(t, err)
+			event := factories.CreateSocialEvent(t, hostAwait, factories.SocialEventStartTime(time.Now().Add(time.Hour))) // not open yet
 			userSignup, token := signup(t, hostAwait)
 
 			// when call verification endpoint with a valid activation code
@@ -739,7 +737,7 @@ func TestActivationCodeVerification(t *testing.T) {
 
 			// then
 			// ensure the UserSignup is not approved yet
-			userSignup, err = hostAwait.WaitForUserSignup(t, userSignup.Name,
+			userSignup, err := hostAwait.WaitForUserSignup(t, userSignup.Name,
 				wait.UntilUserSignupHasConditions(wait.ConditionSet(wait.Default(), wait.VerificationRequired())...))
 			require.NoError(t, err)
 			assert.Equal(t, userSignup.Annotations[toolchainv1alpha1.UserVerificationAttemptsAnnotationKey], "1")
@@ -747,9 +745,7 @@ func TestActivationCodeVerification(t *testing.T) {
 
 		t.Run("already closed", func(t *testing.T) {
 			// given
-			event := testsocialevent.NewSocialEvent(hostAwait.Namespace, commonsocialevent.NewName(), testsocialevent.WithEndTime(time.Now().Add(-time.Hour))) // already closd
-			err := hostAwait.CreateWithCleanup(t, event)
-			require.NoError(t, err)
+			event := factories.CreateSocialEvent(t, hostAwait, factories.SocialEventEndTime(time.Now().Add(-time.Hour))) // already closed
 			userSignup, token := signup(t, hostAwait)
 
 			// when call verification endpoint with a valid activation code
@@ -758,7 +754,7 @@ func TestActivationCodeVerification(t *testing.T) {
 
 			// then
 			// ensure the UserSignup is approved
-			userSignup, err = hostAwait.WaitForUserSignup(t, userSignup.Name,
+			userSignup, err := hostAwait.WaitForUserSignup(t, userSignup.Name,
 				wait.UntilUserSignupHasConditions(wait.ConditionSet(wait.Default(), wait.VerificationRequired())...))
 			require.NoError(t, err)
 			assert.Equal(t, userSignup.Annotations[toolchainv1alpha1.UserVerificationAttemptsAnnotationKey], "1")
@@ -872,15 +868,21 @@ func assertGetSignupStatusProvisioned(t *testing.T, await wait.Awaitilities, use
 	assertRHODSClusterURL(t, memberAwait, mp)
 }
 
-func assertGetSignupStatusPendingApproval(t *testing.T, await wait.Awaitilities, username, bearerToken string) {
+// assertGetSignupStatusPendingApproval verifies that the signup is pending approval according to registration service, and returns
+// the ETag of the response, if any
+func assertGetSignupStatusPendingApproval(t *testing.T, await wait.Awaitilities, username, bearerToken string) string {
 	route := await.Host().RegistrationServiceURL
-	mp, mpStatus := ParseSignupResponse(t, NewHTTPRequest(t).InvokeEndpoint("GET", route+"/api/v1/signup", bearerToken, "", http.StatusOK).UnmarshalMap())
+	resp := NewHTTPRequest(t).
+		InvokeEndpoint("GET", route+"/api/v1/signup", bearerToken, "", http.StatusOK).
+		RequireNotCacheable()
+	mp, mpStatus := ParseSignupResponse(t, resp.UnmarshalMap())
 	assert.Equal(t, username, mp["username"])
 	assert.Empty(t, mp["defaultUserNamespace"])
 	assert.Empty(t, mp["rhodsMemberURL"])
 	require.IsType(t, false, mpStatus["ready"])
 	assert.False(t, mpStatus["ready"].(bool))
 	assert.Equal(t, "PendingApproval", mpStatus["reason"])
+	return resp.ETag()
 }
 
 func assertGetSignupReturnsNotFound(t *testing.T, await wait.Awaitilities, bearerToken string) {
@@ -900,7 +902,7 @@ func waitForUserSignupReadyInRegistrationService(t *testing.T, registrationServi
 	t.Logf("waiting and verifying that UserSignup '%s' is ready according to registration service", name)
 	var mp, mpStatus map[string]interface{}
 	err := k8swait.Poll(time.Second, time.Second*60, func() (done bool, err error) {
-		mp, mpStatus = ParseSignupResponse(t, NewHTTPRequest(t).InvokeEndpoint("GET", registrationServiceURL+"/api/v1/signup", bearerToken, "", http.StatusOK).UnmarshalMap())
+		mp, mpStatus = ParseSignupResponse(t, NewHTTPRequest(t).BustCache().InvokeEndpoint("GET", registrationServiceURL+"/api/v1/signup", bearerToken, "", http.StatusOK).UnmarshalMap())
 		// check if `ready` field is set
 		if _, ok := mpStatus["ready"]; !ok {
 			t.Logf("usersignup response for %s is missing `ready` field ", name)
@@ -5,8 +5,8 @@ import (
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 	. "github.com/codeready-toolchain/toolchain-e2e/testsupport"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/factories"
 	. "github.com/codeready-toolchain/toolchain-e2e/testsupport/space"
-	"github.com/codeready-toolchain/toolchain-e2e/testsupport/spacebinding"
 	. "github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
 
 	"github.com/stretchr/testify/require"
@@ -47,7 +47,9 @@ func TestRetargetUserByChangingSpaceTargetClusterWhenSpaceIsNotShared(t *testing
 	_, err = member2Await.WaitForNSTmplSet(t, space.Name)
 	require.NoError(t, err)
 	VerifyResourcesProvisionedForSpace(t, awaitilities, space.Name, UntilSpaceHasStatusTargetCluster(member2Await.ClusterName))
-	VerifyUserRelatedResources(t, awaitilities, userSignup, mur.Spec.TierName, ExpectUserAccountIn(member2Await))
+	userSignup, mur = VerifyUserRelatedResources(t, awaitilities, userSignup, mur.Spec.TierName, ExpectUserAccountIn(member2Await))
+	// and the UserAccount only exists on member-2
+	VerifyUserAccountsInMurTargetClusters(t, awaitilities, userSignup, mur)
 }
 
 func TestRetargetUserByChangingSpaceTargetClusterWhenSpaceIsShared(t *testing.T) {
@@ -83,8 +85,8 @@ func TestRetargetUserByChangingSpaceTargetClusterWhenSpaceIsShared(t *testing.T)
 		UntilSpaceHasAnyTierNameSet())
 	require.NoError(t, err)
 
-	spacebinding.CreateSpaceBinding(t, hostAwait, murToShareWith1, spaceToMove, "admin")
-	spacebinding.CreateSpaceBinding(t, hostAwait, murToShareWith2, spaceToMove, "admin")
+	factories.CreateSpaceBinding(t, hostAwait, murToShareWith1, spaceToMove)
+	factories.CreateSpaceBinding(t, hostAwait, murToShareWith2, spaceToMove)
 
 	tier, err := hostAwait.WaitForNSTemplateTier(t, spaceToMove.Spec.TierName)
 	require.NoError(t, err)
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -88,9 +89,18 @@ func TestDoNotOverrideServiceAccount(t *testing.T) {
 
 		// then
 		VerifyResourcesProvisionedForSpace(t, awaitilities, mur.Name)
+		// the space label is restored, and the annotation added above is kept
+		err = member.WaitForClusterResources(t, &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   nsName,
+				Name:        "namespace-manager",
+				Labels:      map[string]string{v1alpha1.SpaceLabelKey: mur.Name},
+				Annotations: map[string]string{"should": "stay"},
+			},
+		})
+		require.NoError(t, err)
 		sa, err := member.WaitForServiceAccount(t, nsName, "namespace-manager")
 		require.NoError(t, err)
-		assert.Equal(t, "stay", sa.Annotations["should"])
 
 		// verify that the expected secret refs are present
 		assert.NotEmpty(t, sa.Secrets)
@@ -1,7 +1,6 @@
 package parallel
 
 import (
-	"context"
 	"testing"
 	"time"
 
@@ -14,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestCreateSocialEvent(t *testing.T) {
@@ -41,15 +41,17 @@ func TestCreateSocialEvent(t *testing.T) {
 
 		// then
 		require.NoError(t, err)
-		event, err = hostAwait.WaitForSocialEvent(t, event.Name, UntilSocialEventHasConditions(toolchainv1alpha1.Condition{
-			Type:   toolchainv1alpha1.ConditionReady,
-			Status: corev1.ConditionTrue,
-		}))
+		event, err = hostAwait.WaitForSocialEvent(t, event.Name,
+			UntilSocialEventHasConditions(toolchainv1alpha1.Condition{
+				Type:   toolchainv1alpha1.ConditionReady,
+				Status: corev1.ConditionTrue,
+			}),
+			UntilSocialEventHasStartTime(start),
+			UntilSocialEventHasEndTime(end),
+			UntilSocialEventHasActivationCount(0))
 		require.NoError(t, err)
 		assert.Equal(t, "deactivate30", event.Spec.UserTier)
 		assert.Equal(t, "base1ns", event.Spec.SpaceTier)
-		assert.Equal(t, start, event.Spec.StartTime.Time)
-		assert.Equal(t, end, event.Spec.EndTime.Time)
 		assert.Equal(t, 5, event.Spec.MaxAttendees)
 	})
 
@@ -73,11 +75,10 @@ func TestCreateSocialEvent(t *testing.T) {
 		require.NoError(t, err)
 
 		t.Run("update with valid tier name", func(t *testing.T) {
-			// given
-			event.Spec.UserTier = "deactivate30"
-
 			// when
-			err := hostAwait.Client.Update(context.TODO(), event)
+			_, err := Update(t, hostAwait.Awaitility, client.ObjectKeyFromObject(event), func(e *toolchainv1alpha1.SocialEvent) {
+				e.Spec.UserTier = "deactivate30"
+			})
 
 			// then
 			require.NoError(t, err)
@@ -109,11 +110,10 @@ func TestCreateSocialEvent(t *testing.T) {
 		require.NoError(t, err)
 
 		t.Run("update with valid tier name", func(t *testing.T) {
-			// given
-			event.Spec.SpaceTier = "base"
-
 			// when
-			err := hostAwait.Client.Update(context.TODO(), event)
+			_, err := Update(t, hostAwait.Awaitility, client.ObjectKeyFromObject(event), func(e *toolchainv1alpha1.SocialEvent) {
+				e.Spec.SpaceTier = "base"
+			})
 
 			// then
 			require.NoError(t, err)
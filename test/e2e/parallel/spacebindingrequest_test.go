@@ -92,6 +92,13 @@ func TestCreateSpaceBindingRequest(t *testing.T) {
 						require.NoError(t, err)
 						err = hostAwait.WaitUntilSpaceBindingDeleted(spaceBinding.Name)
 						require.NoError(t, err)
+						// and the user no longer has any role in the namespaces of the space, while the owner of the space keeps its roles
+						spaceBindings, err := hostAwait.ListSpaceBindings(space.Name)
+						require.NoError(t, err)
+						require.Len(t, spaceBindings, 1) // only the SpaceBinding of the owner remains
+						testsupportspace.VerifySpaceRoleBindings(t, awaitilities, space.Name,
+							[]UserRoleRefs{ExpectRoleRefs(spaceBindings[0].Spec.MasterUserRecord, "appstudio-admin-user-actions", "view")},
+							spaceBindingRequest.Spec.MasterUserRecord)
 					})
 				})
 			})
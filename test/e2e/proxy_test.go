@@ -2,10 +2,7 @@ package e2e
 
 import (
 	"context"
-	"crypto/tls"
-	"encoding/base64"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -24,6 +21,7 @@ import (
 	testspace "github.com/codeready-toolchain/toolchain-common/pkg/test/space"
 	. "github.com/codeready-toolchain/toolchain-e2e/testsupport"
 	appstudiov1 "github.com/codeready-toolchain/toolchain-e2e/testsupport/appstudio/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/httpclient"
 	testsupportspace "github.com/codeready-toolchain/toolchain-e2e/testsupport/space"
 	. "github.com/codeready-toolchain/toolchain-e2e/testsupport/spacebinding"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
@@ -48,6 +46,7 @@ type proxyUser struct {
 	token                 string
 	identityID            uuid.UUID
 	signup                *toolchainv1alpha1.UserSignup
+	signupRequest         *SignupRequest
 	compliantUsername     string
 }
 
@@ -146,6 +145,7 @@ func TestProxyFlow(t *testing.T) {
 	setStoneSoupConfig(t, hostAwait, memberAwait)
 
 	t.Logf("Proxy URL: %s", hostAwait.APIProxyURL)
+	VerifyHostRoutesReachable(t, hostAwait)
 
 	waitForWatcher := runWatcher(t, awaitilities)
 	defer func() {
@@ -181,7 +181,7 @@ func TestProxyFlow(t *testing.T) {
 	for index, user := range users {
 		t.Run(user.username, func(t *testing.T) {
 			// Start a new websocket watcher
-			w := newWsWatcher(t, *user, user.compliantUsername, hostAwait.APIProxyURL)
+			w := newWsWatcher(t, hostAwait.Awaitility, *user, user.compliantUsername, hostAwait.APIProxyURL)
 			closeConnection := w.Start()
 			defer closeConnection()
 			proxyCl := user.createProxyClient(t, hostAwait)
@@ -354,12 +354,14 @@ func TestProxyFlow(t *testing.T) {
 				// that fail because in this case the api discovery calls go through the proxyWorkspaceURL which is invalid. If using oc or kubectl and you
 				// enable verbose logging you would see Response Body: invalid workspace request: access to workspace 'proxymember2' is forbidden
 				require.EqualError(t, err, `no matches for kind "Application" in version "appstudio.redhat.com/v1alpha1"`)
+				// and the raw response of the proxy tells that the access to the workspace is forbidden
+				InvokeProxyEndpoint(t, "GET", proxyWorkspaceURL+"/api", user.token).RequireForbiddenWorkspace(t, workspaceName)
 			})
 
 			t.Run("successful workspace context request", func(t *testing.T) {
 				proxyWorkspaceURL := hostAwait.ProxyURLWithWorkspaceContext(user.compliantUsername)
 				// Start a new websocket watcher which watches for Application CRs in the user's namespace
-				w := newWsWatcher(t, *user, user.compliantUsername, proxyWorkspaceURL)
+				w := newWsWatcher(t, hostAwait.Awaitility, *user, user.compliantUsername, proxyWorkspaceURL)
 				closeConnection := w.Start()
 				defer closeConnection()
 				workspaceCl, err := hostAwait.CreateAPIProxyClient(t, user.token, proxyWorkspaceURL) // proxy client with workspace context
@@ -395,12 +397,7 @@ func TestProxyFlow(t *testing.T) {
 				CreateProxyPluginWithCleanup(t, hostAwait, "openshift-console", "openshift-console", "console")
 				VerifyProxyPlugin(t, hostAwait, "openshift-console")
 				proxyPluginWorkspaceURL := hostAwait.PluginProxyURLWithWorkspaceContext("openshift-console", user.compliantUsername)
-				client := http.Client{
-					Timeout: 30 * time.Second,
-					Transport: &http.Transport{
-						TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
-					},
-				}
+				client := httpclient.New(httpclient.WithTimeout(30*time.Second), httpclient.WithInsecureSkipVerify())
 				request, err := http.NewRequest("GET", proxyPluginWorkspaceURL, nil)
 				require.NoError(t, err)
 
@@ -426,6 +423,17 @@ func TestProxyFlow(t *testing.T) {
 				hostAwaitWithShorterTimeout := hostAwait.WithRetryOptions(wait.TimeoutOption(time.Second * 3)) // we expect an error so we can use a shorter timeout
 				_, err := hostAwaitWithShorterTimeout.CreateAPIProxyClient(t, user.token, proxyWorkspaceURL)
 				require.EqualError(t, err, `an error on the server ("unable to get target cluster: the requested space is not available") has prevented the request from succeeding`)
+				// and the raw response of the proxy tells that the workspace is not available
+				InvokeProxyEndpoint(t, "GET", proxyWorkspaceURL+"/api", user.token).RequireWorkspaceNotFound(t)
+			})
+
+			t.Run("request without token", func(t *testing.T) {
+				proxyWorkspaceURL := hostAwait.ProxyURLWithWorkspaceContext(user.compliantUsername)
+				InvokeProxyEndpoint(t, "GET", proxyWorkspaceURL+"/api", "").RequireUnauthorized(t, "")
+			})
+
+			t.Run("expired and refreshed tokens", func(t *testing.T) {
+				VerifyProxyTokenLifecycle(t, hostAwait.ProxyURLWithWorkspaceContext(user.compliantUsername), user.signupRequest)
 			})
 
 			t.Run("invalid request headers", func(t *testing.T) {
@@ -435,14 +443,7 @@ func TestProxyFlow(t *testing.T) {
 					{"Impersonate-Group", "system:cluster-admins"},
 					{"Impersonate-Group", "system:node-admins"},
 				}
-				client := http.Client{
-					Timeout: time.Duration(5 * time.Second), // because sometimes the network connection may be a bit slow
-				}
-				client.Transport = &http.Transport{
-					TLSClientConfig: &tls.Config{
-						InsecureSkipVerify: true, // nolint:gosec
-					},
-				}
+				client := httpclient.New(httpclient.WithTimeout(5*time.Second), httpclient.WithInsecureSkipVerify()) // because sometimes the network connection may be a bit slow
 				t.Logf("proxyWorkspaceURL: %s", proxyWorkspaceURL)
 				nodesURL := fmt.Sprintf("%s/api/v1/nodes", proxyWorkspaceURL)
 				t.Logf("nodesURL: %s", nodesURL)
@@ -518,7 +519,7 @@ func TestProxyFlow(t *testing.T) {
 			VerifySpaceRelatedResources(t, awaitilities, primaryUser.signup, "appstudio")
 
 			// Start a new websocket watcher which watches for Application CRs in the user's namespace
-			w := newWsWatcher(t, *guestUser, primaryUser.compliantUsername, primaryUserWorkspaceURL)
+			w := newWsWatcher(t, hostAwait.Awaitility, *guestUser, primaryUser.compliantUsername, primaryUserWorkspaceURL)
 			closeConnection := w.Start()
 			defer closeConnection()
 			guestUserPrimaryWsCl, err := hostAwait.CreateAPIProxyClient(t, guestUser.token, primaryUserWorkspaceURL)
@@ -562,6 +563,9 @@ func TestProxyFlow(t *testing.T) {
 
 				// then
 				require.EqualError(t, err, fmt.Sprintf(`invalid workspace request: access to namespace '%s' in workspace '%s' is forbidden (get applications.appstudio.redhat.com %s)`, primaryUserNamespace, workspaceName, applicationName))
+				// and the raw response tells the user which namespace and workspace are forbidden
+				InvokeProxyEndpoint(t, "GET", fmt.Sprintf("%s/apis/appstudio.redhat.com/v1alpha1/namespaces/%s/applications/%s", guestUserWorkspaceURL, primaryUserNamespace, applicationName), guestUser.token).
+					RequireForbiddenNamespace(t, primaryUserNamespace, workspaceName)
 			})
 		})
 
@@ -646,6 +650,37 @@ func runWatcher(t *testing.T, awaitilities wait.Awaitilities) *sync.WaitGroup {
 	return &waitForWatcher
 }
 
+func TestProxyRequestsMetrics(t *testing.T) {
+	// given
+	awaitilities := WaitForDeployments(t)
+	hostAwait := awaitilities.Host()
+	memberAwait := awaitilities.Member1()
+
+	setStoneSoupConfig(t, hostAwait, memberAwait)
+
+	user := &proxyUser{
+		expectedMemberCluster: memberAwait,
+		username:              "proxymetrics",
+		identityID:            uuid.Must(uuid.NewV4()),
+	}
+	createAppStudioUser(t, awaitilities, user)
+	proxyWorkspaceURL := hostAwait.ProxyURLWithWorkspaceContext(user.compliantUsername)
+
+	t.Run("proxied request is counted once", func(t *testing.T) {
+		// given
+		counter := hostAwait.CountProxyRequests(t)
+
+		// when
+		resp := InvokeProxyEndpoint(t, "GET", proxyWorkspaceURL+"/api", user.token)
+
+		// then
+		require.Equal(t, http.StatusOK, resp.StatusCode, "unexpected response status with body: %s", resp.Body)
+		resp.RequireNotCacheable(t)
+		err := hostAwait.WaitForProxyRequests(t, counter, wait.ProxyRequests{Count: 1})
+		require.NoError(t, err)
+	})
+}
+
 func TestSpaceLister(t *testing.T) {
 	// given
 	awaitilities := WaitForDeployments(t)
@@ -778,11 +813,12 @@ func TestSpaceLister(t *testing.T) {
 
 	t.Run("bus lists workspaces", func(t *testing.T) {
 		// when
-		workspaces := users["bus"].listWorkspaces(t, hostAwait)
-
-		// then
-		// bus should see both its own and car's workspace
-		require.Len(t, workspaces, 2)
+		workspaces, err := hostAwait.WaitForWorkspaces(t, users["bus"].token,
+			// then
+			// bus should see both its own and car's workspace
+			wait.UntilWorkspacesHaveNames("bus", "car"),
+			wait.UntilWorkspaceHasRole("car", "admin"))
+		require.NoError(t, err)
 		verifyHasExpectedWorkspace(t, expectedWorkspaceFor(t, awaitilities.Host(), "bus", commonproxy.WithType("home")), workspaces...)
 		verifyHasExpectedWorkspace(t, expectedWorkspaceFor(t, awaitilities.Host(), "car"), workspaces...)
 	})
@@ -933,6 +969,24 @@ func TestSpaceLister(t *testing.T) {
 			require.EqualError(t, err, fmt.Sprintf("workspaces.toolchain.dev.openshift.com \"%[1]s\" is forbidden: User \"%[1]s\" cannot update resource \"workspaces\" in API group \"toolchain.dev.openshift.com\" at the cluster scope", users["bicycle"].compliantUsername))
 		})
 	})
+
+	t.Run("bicycle no longer lists bus workspace when the binding is removed", func(t *testing.T) {
+		// given
+		busSpace, err := hostAwait.WaitForSpace(t, users["bus"].compliantUsername, wait.UntilSpaceHasAnyTargetClusterSet())
+		require.NoError(t, err)
+		memberAwait, err := awaitilities.Member(busSpace.Spec.TargetCluster)
+		require.NoError(t, err)
+
+		// when
+		err = memberAwait.Client.Delete(context.TODO(), bicycleSBROnBusSpace)
+		require.NoError(t, err)
+
+		// then
+		_, err = hostAwait.WaitForWorkspaces(t, users["bicycle"].token,
+			wait.UntilWorkspacesHaveNames("road-bicycle", "car"),
+			wait.UntilWorkspacesDoNotContain("bus"))
+		require.NoError(t, err)
+	})
 }
 
 func tenantNsName(username string) string {
@@ -950,6 +1004,7 @@ func createAppStudioUser(t *testing.T, awaitilities wait.Awaitilities, user *pro
 		RequireConditions(wait.ConditionSet(wait.Default(), wait.ApprovedByAdmin())...).
 		Execute(t)
 	user.signup, _ = req.Resources()
+	user.signupRequest = req
 	user.token = req.GetToken()
 	VerifyResourcesProvisionedForSignup(t, awaitilities, user.signup, "deactivate30", "appstudio")
 	user.compliantUsername = user.signup.Status.CompliantUsername
@@ -983,11 +1038,12 @@ func createPreexistingUserAndIdentity(t *testing.T, user proxyUser) (*userv1.Use
 	return preexistingUser, preexistingIdentity
 }
 
-func newWsWatcher(t *testing.T, user proxyUser, namespace, proxyURL string) *wsWatcher {
+func newWsWatcher(t *testing.T, await *wait.Awaitility, user proxyUser, namespace, proxyURL string) *wsWatcher {
 	_, err := url.Parse(proxyURL)
 	require.NoError(t, err)
 	return &wsWatcher{
 		t:            t,
+		await:        await,
 		namespace:    namespace,
 		user:         user,
 		proxyBaseURL: proxyURL,
@@ -1000,6 +1056,7 @@ type wsWatcher struct {
 	done         chan interface{}
 	interrupt    chan os.Signal
 	t            *testing.T
+	await        *wait.Awaitility
 	user         proxyUser
 	namespace    string
 	connection   *websocket.Conn
@@ -1016,28 +1073,12 @@ func (w *wsWatcher) Start() func() {
 
 	signal.Notify(w.interrupt, os.Interrupt) // Notify the interrupt channel for SIGINT
 
-	encodedToken := base64.RawURLEncoding.EncodeToString([]byte(w.user.token))
-	protocol := fmt.Sprintf("base64url.bearer.authorization.k8s.io.%s", encodedToken)
-
 	trimmedProxyURL := strings.TrimPrefix(w.proxyBaseURL, "https://")
 	socketURL := fmt.Sprintf("wss://%s/apis/appstudio.redhat.com/v1alpha1/namespaces/%s/applications?watch=true", trimmedProxyURL, tenantNsName(w.namespace))
-	w.t.Logf("opening connection to '%s'", socketURL)
-	dialer := &websocket.Dialer{
-		Subprotocols: []string{protocol, "base64.binary.k8s.io"},
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: true, // nolint:gosec
-		},
-	}
-
-	extraHeaders := make(http.Header, 1)
-	extraHeaders.Add("Origin", "http://localhost")
-
-	conn, resp, err := dialer.Dial(socketURL, extraHeaders) // nolint:bodyclose // see `return func() {...}`
-	if errors.Is(err, websocket.ErrBadHandshake) {
-		r, _ := io.ReadAll(resp.Body)
-		defer resp.Body.Close()
-		w.t.Logf("handshake failed with status %d / response %s", resp.StatusCode, string(r))
-	}
+	conn, err := w.await.WaitForWebSocket(w.t, socketURL,
+		wait.WithBearerTokenSubprotocol(w.user.token),
+		wait.WithSubprotocols("base64.binary.k8s.io"),
+		wait.WithWebSocketHeader("Origin", "http://localhost"))
 	require.NoError(w.t, err)
 	w.connection = conn
 	w.receivedApps = make(map[string]*appstudiov1.Application)
@@ -1047,10 +1088,6 @@ func (w *wsWatcher) Start() func() {
 
 	return func() {
 		_ = w.connection.Close()
-		if resp != nil {
-			_, _ = io.Copy(io.Discard, resp.Body)
-			_ = resp.Body.Close()
-		}
 	}
 }
 
@@ -22,6 +22,12 @@ func TestToolchainClusterE2E(t *testing.T) {
 	hostAwait := awaitilities.Host()
 	memberAwait := awaitilities.Member1()
 
+	t.Run("host and members are paired in both directions", func(t *testing.T) {
+		for _, memberAwait := range awaitilities.AllMembers() {
+			VerifyClusterPairing(t, hostAwait, memberAwait)
+		}
+	})
+
 	verifyToolchainCluster(t, hostAwait.Awaitility, memberAwait.Awaitility)
 	verifyToolchainCluster(t, memberAwait.Awaitility, hostAwait.Awaitility)
 }
@@ -8,6 +8,7 @@ import (
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 	testconfig "github.com/codeready-toolchain/toolchain-common/pkg/test/config"
 	. "github.com/codeready-toolchain/toolchain-e2e/testsupport"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/scenario"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/types"
@@ -34,10 +35,9 @@ func TestForceMetricsSynchronization(t *testing.T) {
 	err := hostAwait.DeleteToolchainStatus(t, "toolchain-status")
 	require.NoError(t, err)
 	// restarting the pod after the `toolchain-status` resource was deleted will trigger a recount based on resources
-	err = hostAwait.DeletePods(client.InNamespace(hostAwait.Namespace), client.MatchingLabels{"name": "controller-manager"})
-	require.NoError(t, err)
+	RestartDeployment(t, hostAwait.Awaitility, "host-operator-controller-manager")
 
-	hostAwait.InitMetrics(t, awaitilities.Member1().ClusterName, awaitilities.Member2().ClusterName)
+	hostAwait.InitMetrics(t)
 
 	t.Run("tampering activation-counter annotations", func(t *testing.T) {
 
@@ -63,10 +63,9 @@ func TestForceMetricsSynchronization(t *testing.T) {
 			hostAwait.UpdateToolchainConfig(t, testconfig.Metrics().ForceSynchronization(false))
 
 			// when restarting the pod
-			err := hostAwait.DeletePods(client.InNamespace(hostAwait.Namespace), client.MatchingLabels{"name": "controller-manager"})
+			RestartDeployment(t, hostAwait.Awaitility, "host-operator-controller-manager")
 
 			// then
-			require.NoError(t, err)
 			// metrics have not changed yet
 			hostAwait.WaitForMetricDelta(t, wait.MasterUserRecordsPerDomainMetric, 0, "domain", "external")                       // value was increased by 1
 			hostAwait.WaitForMetricDelta(t, wait.UsersPerActivationsAndDomainMetric, 0, "activations", "1", "domain", "external") // value was increased by 1
@@ -78,10 +77,9 @@ func TestForceMetricsSynchronization(t *testing.T) {
 
 			// when restarting the pod
 			// TODO: unneeded once the ToolchainConfig controller will be in place ?
-			err := hostAwait.DeletePods(client.InNamespace(hostAwait.Namespace), client.MatchingLabels{"name": "controller-manager"})
+			RestartDeployment(t, hostAwait.Awaitility, "host-operator-controller-manager")
 
 			// then
-			require.NoError(t, err)
 			// metrics have been updated
 			hostAwait.WaitForMetricDelta(t, wait.MasterUserRecordsPerDomainMetric, 0, "domain", "external")                        // unchanged
 			hostAwait.WaitForMetricDelta(t, wait.UsersPerActivationsAndDomainMetric, 2, "activations", "10", "domain", "external") // updated
@@ -90,3 +88,27 @@ func TestForceMetricsSynchronization(t *testing.T) {
 	})
 
 }
+
+func TestMemberStatusIsRecreated(t *testing.T) {
+	// given
+	awaitilities := WaitForDeployments(t)
+	// the member operator should not fight with the test over the MemberStatus
+	memberAwait := awaitilities.Member1().WithAPIErrorBudget(t, wait.APIErrors{Conflicts: 1})
+	key := types.NamespacedName{Namespace: memberAwait.Namespace, Name: "toolchain-member-status"}
+	var uid types.UID
+
+	sc := scenario.New(t)
+	sc.Step("delete the MemberStatus", func(t *testing.T) {
+		var err error
+		uid, err = wait.DeleteSingleton[*toolchainv1alpha1.MemberStatus](t, memberAwait.Awaitility, key)
+		require.NoError(t, err)
+		sc.Record("deleted MemberStatus UID", uid)
+	}).Step("restart the member operator", func(t *testing.T) {
+		// the MemberStatus is created when the member operator starts
+		RestartDeployment(t, memberAwait.Awaitility, "member-operator-controller-manager")
+	}).Step("wait for the recreated MemberStatus to be ready", func(t *testing.T) {
+		_, err := wait.WaitForRecreatedObject(t, memberAwait.Awaitility, key, uid,
+			wait.UntilHasConditions[*toolchainv1alpha1.MemberStatus](wait.Ready()))
+		require.NoError(t, err)
+	}).Run()
+}
@@ -16,6 +16,7 @@ import (
 	testconfig "github.com/codeready-toolchain/toolchain-common/pkg/test/config"
 	. "github.com/codeready-toolchain/toolchain-e2e/testsupport"
 	authsupport "github.com/codeready-toolchain/toolchain-e2e/testsupport/auth"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/factories"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/tiers"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
 
@@ -530,7 +531,7 @@ func (s *userManagementTestSuite) TestUserBanning() {
 			Execute(t).Resources()
 
 		// Create the BannedUser
-		CreateBannedUser(t, s.Host(), userSignup.Annotations[toolchainv1alpha1.UserSignupUserEmailAnnotationKey])
+		factories.CreateBannedUser(t, s.Host(), factories.BannedUserEmail(userSignup.Annotations[toolchainv1alpha1.UserSignupUserEmailAnnotationKey]))
 
 		// Confirm the user is banned
 		_, err := hostAwait.WithRetryOptions(wait.TimeoutOption(time.Second*15)).WaitForUserSignup(t, userSignup.Name,
@@ -554,20 +555,17 @@ func (s *userManagementTestSuite) TestUserBanning() {
 
 		id := uuid.Must(uuid.NewV4()).String()
 		email := "testuser" + id + "@test.com"
-		CreateBannedUser(t, s.Host(), email)
+		factories.CreateBannedUser(t, s.Host(), factories.BannedUserEmail(email))
 
 		// For this test, we don't want to create the UserSignup via the registration service (the next test does this)
 		// Instead, we want to confirm the behaviour when a UserSignup with a banned email address is created manually
-		userSignup := NewUserSignup(hostAwait.Namespace, "testuser"+id, email)
-		userSignup.Spec.TargetCluster = memberAwait.ClusterName
-
-		// Create the UserSignup via the Kubernetes API
-		err := hostAwait.CreateWithCleanup(t, userSignup)
-		require.NoError(t, err)
-		t.Logf("user signup '%s' created", userSignup.Name)
+		userSignup := factories.CreateUserSignup(t, hostAwait,
+			factories.UserSignupUsername("testuser"+id),
+			factories.UserSignupEmail(email),
+			factories.UserSignupTargetCluster(memberAwait.ClusterName))
 
 		// Check the UserSignup is created
-		userSignup, err = hostAwait.WaitForUserSignup(t, userSignup.Name)
+		userSignup, err := hostAwait.WaitForUserSignup(t, userSignup.Name)
 		require.NoError(t, err)
 
 		// Confirm that the user is banned
@@ -585,7 +583,7 @@ func (s *userManagementTestSuite) TestUserBanning() {
 
 		id := uuid.Must(uuid.NewV4()).String()
 		email := "testuser" + id + "@test.com"
-		CreateBannedUser(t, s.Host(), email)
+		factories.CreateBannedUser(t, s.Host(), factories.BannedUserEmail(email))
 
 		// Get valid generated token for e2e tests. IAT claim is overridden
 		// to avoid token used before issued error.
@@ -632,7 +630,7 @@ func (s *userManagementTestSuite) TestUserBanning() {
 			Execute(t).Resources()
 
 		// Create the BannedUser
-		bannedUser := CreateBannedUser(t, s.Host(), userSignup.Annotations[toolchainv1alpha1.UserSignupUserEmailAnnotationKey])
+		bannedUser := factories.CreateBannedUser(t, s.Host(), factories.BannedUserEmail(userSignup.Annotations[toolchainv1alpha1.UserSignupUserEmailAnnotationKey]))
 
 		// Confirm the user is banned
 		_, err := hostAwait.WaitForUserSignup(t, userSignup.Name,
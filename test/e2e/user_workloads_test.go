@@ -80,6 +80,7 @@ func (s *userWorkloadsTestSuite) TestIdlerAndPriorityClass() {
 	// make sure that "noise" pods are still there, and notification is not created for stage namespace
 	_, err = memberAwait.WaitForPods(s.T(), idlerNoise.Name, len(podsNoise), wait.PodRunning(), wait.WithPodLabel("idler", "idler"), wait.WithSandboxPriorityClass())
 	require.NoError(s.T(), err)
+	memberAwait.ForNamespace(idlerNoise.Name).WaitForDeploymentToGetReady(s.T(), "idler-test-deployment", 3)
 	_, err = memberAwait.WaitForPods(s.T(), "workloads-noise", len(externalNsPodsNoise), wait.PodRunning(), wait.WithPodLabel("idler", "idler"), wait.WithOriginalPriorityClass())
 	require.NoError(s.T(), err)
 	_, err = hostAwait.WithRetryOptions(wait.TimeoutOption(10*time.Second)).WaitForNotificationWithName(s.T(), "test-idler-stage-idled", toolchainv1alpha1.NotificationTypeIdled, wait.UntilNotificationHasConditions(wait.Sent()))
@@ -105,6 +106,33 @@ func (s *userWorkloadsTestSuite) TestIdlerAndPriorityClass() {
 	// There should not be any pods left in the namespace
 	err = memberAwait.WaitUntilPodsDeleted(s.T(), idler.Name, wait.WithPodLabel("idler", "idler"))
 	require.NoError(s.T(), err)
+
+	// Deleting the Deployment of the "noise" workloads also deletes its ReplicaSet and their Pods
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "idler-test-deployment", Namespace: "workloads-noise"}}
+	err = memberAwait.DeleteAndWaitForCascadingDeletion(s.T(), deployment, &appsv1.ReplicaSetList{}, &corev1.PodList{})
+	require.NoError(s.T(), err)
+}
+
+func (s *userWorkloadsTestSuite) TestWebhookCertsRotation() {
+	// given
+	memberAwait := s.Member1()
+	NewSignupRequest(s.Awaitilities).
+		Username("test-webhook-certs").
+		Email("test-webhook-certs@redhat.com").
+		ManuallyApprove().
+		EnsureMUR().
+		TargetCluster(memberAwait).
+		RequireConditions(wait.ConditionSet(wait.Default(), wait.ApprovedByAdmin())...).
+		Execute(s.T())
+	VerifyWebhookAdmission(s.T(), memberAwait, "test-webhook-certs-dev")
+
+	// when
+	RotateMemberWebhookCerts(s.T(), memberAwait, "test-webhook-certs-dev")
+
+	// then the configuration of the webhook is still the expected one, with the CA of the new certificate
+	webhookImage := memberAwait.GetContainerEnv(s.T(), "MEMBER_OPERATOR_WEBHOOK_IMAGE")
+	require.NotEmpty(s.T(), webhookImage)
+	memberAwait.WaitForMemberWebhooks(s.T(), webhookImage)
 }
 
 func (s *userWorkloadsTestSuite) prepareWorkloads(namespace string, additionalPodCriteria ...wait.PodWaitCriterion) []corev1.Pod {
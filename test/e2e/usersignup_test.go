@@ -12,10 +12,12 @@ import (
 	testconfig "github.com/codeready-toolchain/toolchain-common/pkg/test/config"
 	testcommonspace "github.com/codeready-toolchain/toolchain-common/pkg/test/space"
 	. "github.com/codeready-toolchain/toolchain-e2e/testsupport"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/factories"
 	. "github.com/codeready-toolchain/toolchain-e2e/testsupport/space"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
 	"github.com/redhat-cop/operator-utils/pkg/util"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/assert"
@@ -460,6 +462,29 @@ func (s *userSignupIntegrationTest) TestManualApproval() {
 
 }
 
+func (s *userSignupIntegrationTest) TestUserSignupApprovedWhileHostOperatorIsPaused() {
+	// given
+	hostAwait := s.Host()
+	hostAwait.UpdateToolchainConfig(s.T(), testconfig.AutomaticApproval().Enabled(false))
+	resume := hostAwait.PauseReconciliation(s.T())
+	// the UserSignup is created and approved while the host operator is not running
+	userSignup, _ := NewSignupRequest(s.Awaitilities).
+		Username("paused1").
+		Email("paused1@redhat.com").
+		ManuallyApprove().
+		Execute(s.T()).Resources()
+
+	// when
+	resume()
+
+	// then
+	userSignup, err := hostAwait.WaitForUserSignup(s.T(), userSignup.Name,
+		wait.UntilUserSignupHasConditions(wait.ConditionSet(wait.Default(), wait.ApprovedByAdmin())...),
+		wait.UntilUserSignupHasStateLabel(toolchainv1alpha1.UserSignupStateLabelValueApproved))
+	require.NoError(s.T(), err)
+	VerifyResourcesProvisionedForSignup(s.T(), s.Awaitilities, userSignup, "deactivate30", "base")
+}
+
 func (s *userSignupIntegrationTest) TestCapacityManagementWithManualApproval() {
 	hostAwait := s.Host()
 	memberAwait1 := s.Member1()
@@ -603,6 +628,26 @@ func (s *userSignupIntegrationTest) TestUserSignupVerificationRequired() {
 	})
 }
 
+func (s *userSignupIntegrationTest) TestVerificationExcludedEmailDomains() {
+	// given
+	hostAwait := s.Host()
+	hostAwait.UpdateToolchainConfig(s.T(), testconfig.AutomaticApproval().Enabled(true))
+	SetVerificationExcludedEmailDomains(s.T(), hostAwait, "allowed.com", "also-allowed.com")
+
+	s.T().Run("users of the excluded domains are approved", func(t *testing.T) {
+		for _, domain := range []string{"allowed.com", "also-allowed.com"} {
+			SignupWithEmailDomain(t, s.Awaitilities, domain, false)
+		}
+	})
+
+	s.T().Run("users of the other domains require verification", func(t *testing.T) {
+		// including the domains which were excluded by the default config of the e2e tests
+		for _, domain := range []string{"redhat.com", "example.com"} {
+			SignupWithEmailDomain(t, s.Awaitilities, domain, true)
+		}
+	})
+}
+
 func (s *userSignupIntegrationTest) TestTargetClusterSelectedAutomatically() {
 	hostAwait := s.Host()
 	memberAwait := s.Member1()
@@ -615,13 +660,12 @@ func (s *userSignupIntegrationTest) TestTargetClusterSelectedAutomatically() {
 			).
 			ResourceCapacityThreshold(80))
 
-	userSignup := NewUserSignup(hostAwait.Namespace, "reginald@alpha.com", "reginald@alpha.com")
-	err := hostAwait.CreateWithCleanup(s.T(), userSignup)
-	require.NoError(s.T(), err)
-	s.T().Logf("user signup '%s' created", userSignup.Name)
+	userSignup := factories.CreateUserSignup(s.T(), hostAwait,
+		factories.UserSignupUsername("reginald@alpha.com"),
+		factories.UserSignupEmail("reginald@alpha.com"))
 
 	// Check the UserSignup is approved now
-	userSignup, err = hostAwait.WaitForUserSignup(s.T(), userSignup.Name, wait.UntilUserSignupHasConditions(wait.ConditionSet(wait.Default(), wait.ApprovedAutomatically())...))
+	userSignup, err := hostAwait.WaitForUserSignup(s.T(), userSignup.Name, wait.UntilUserSignupHasConditions(wait.ConditionSet(wait.Default(), wait.ApprovedAutomatically())...))
 	require.NoError(s.T(), err)
 
 	// Confirm the MUR was created and target cluster was set
@@ -651,18 +695,18 @@ func (s *userSignupIntegrationTest) TestTransformUsernameWithSpaceConflict() {
 		space, err := s.Host().WaitForSpace(t, compliantUsername, wait.UntilSpaceHasAnyProvisionedNamespaces())
 		require.NoError(t, err)
 		namespaceName := space.Status.ProvisionedNamespaces[0].Name
-		// and add a dummy finalizer there so it will get stuck
-		_, err = s.Member1().UpdateNamespace(t, namespaceName, func(ns *v1.Namespace) {
+		// and add a dummy finalizer there so it will get stuck (the finalizer is applied by the test as its own field manager,
+		// so that it does not conflict with the updates of the namespace by the member operator)
+		_, err = wait.Update(t, s.Member1().Awaitility, types.NamespacedName{Name: namespaceName}, func(ns *v1.Namespace) {
 			util.AddFinalizer(ns, "test/finalizer.toolchain.e2e.tests")
-		})
+		}, wait.ServerSideApply("e2e-tests"))
 		require.NoError(t, err)
 
 		// don't forget to clean the finalizer up
 		defer func() {
 			t.Log("cleaning up the finalizer")
-			_, err = s.Member1().UpdateNamespace(t, namespaceName, func(ns *v1.Namespace) {
-				util.RemoveFinalizer(ns, "test/finalizer.toolchain.e2e.tests")
-			})
+			// applying the namespace without the finalizer removes it, since it is owned by the test
+			_, err = wait.Update(t, s.Member1().Awaitility, types.NamespacedName{Name: namespaceName}, func(ns *v1.Namespace) {}, wait.ServerSideApply("e2e-tests"))
 			require.NoError(t, err)
 		}()
 
@@ -830,21 +874,15 @@ func (s *userSignupIntegrationTest) createUserSignupVerificationRequiredAndAsser
 	// Create a new UserSignup
 	username := "testuser" + uuid.Must(uuid.NewV4()).String()
 	email := username + "@test.com"
-	userSignup := NewUserSignup(hostAwait.Namespace, username, email)
-	userSignup.Spec.TargetCluster = memberAwait.ClusterName
-
-	// Set approved to true
-	states.SetApprovedManually(userSignup, true)
-
-	// Set verification required
-	states.SetVerificationRequired(userSignup, true)
-
-	err := hostAwait.CreateWithCleanup(s.T(), userSignup)
-	require.NoError(s.T(), err)
-	s.T().Logf("user signup '%s' created", userSignup.Name)
+	userSignup := factories.CreateUserSignup(s.T(), hostAwait,
+		factories.UserSignupUsername(username),
+		factories.UserSignupEmail(email),
+		factories.UserSignupTargetCluster(memberAwait.ClusterName),
+		factories.UserSignupApproved(),
+		factories.UserSignupVerificationRequired())
 
 	// Check the UserSignup is pending approval now
-	userSignup, err = hostAwait.WaitForUserSignup(s.T(), userSignup.Name,
+	userSignup, err := hostAwait.WaitForUserSignup(s.T(), userSignup.Name,
 		wait.UntilUserSignupHasConditions(wait.ConditionSet(wait.Default(), wait.VerificationRequired())...),
 		wait.UntilUserSignupHasStateLabel(toolchainv1alpha1.UserSignupStateLabelValueNotReady))
 	require.NoError(s.T(), err)
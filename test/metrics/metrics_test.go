@@ -20,7 +20,6 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestOperatorVersionMetrics(t *testing.T) {
@@ -32,7 +31,7 @@ func TestOperatorVersionMetrics(t *testing.T) {
 		// given
 		hostAwait := awaitilities.Host()
 		// host metrics should be available at this point
-		hostAwait.InitMetrics(t, awaitilities.Member1().ClusterName, awaitilities.Member2().ClusterName)
+		hostAwait.InitMetrics(t)
 
 		// when
 		labels := hostAwait.GetMetricLabels(t, wait.HostOperatorVersionMetric)
@@ -47,7 +46,7 @@ func TestOperatorVersionMetrics(t *testing.T) {
 	t.Run("member-operators", func(t *testing.T) {
 		// given
 		member1Await := awaitilities.Member1()
-		member2Await := awaitilities.Member1()
+		member2Await := awaitilities.Member2()
 		// member metrics should be available at this point
 		member1Await.InitMetrics(t)
 		member2Await.InitMetrics(t)
@@ -86,11 +85,10 @@ func TestMetricsWhenUsersManuallyApprovedAndThenDeactivated(t *testing.T) {
 	memberAwait := awaitilities.Member1()
 	memberAwait2 := awaitilities.Member2()
 	hostAwait.UpdateToolchainConfig(t, testconfig.AutomaticApproval().Enabled(false))
-	hostAwait.InitMetrics(t, awaitilities.Member1().ClusterName, awaitilities.Member2().ClusterName)
+	hostAwait.InitMetrics(t)
 	t.Cleanup(func() {
 		// wait until metrics are back to their respective baselines
-		hostAwait.WaitForMetricBaseline(t, wait.SpacesMetric, "cluster_name", memberAwait.ClusterName)
-		hostAwait.WaitForMetricBaseline(t, wait.SpacesMetric, "cluster_name", memberAwait2.ClusterName)
+		hostAwait.AssertMetricsBackToBaseline(t, wait.SpacesMetric)
 	})
 
 	usersignups := map[string]*toolchainv1alpha1.UserSignup{}
@@ -152,15 +150,12 @@ func TestMetricsWhenUsersAutomaticallyApprovedAndThenDeactivated(t *testing.T) {
 	// given
 	awaitilities := WaitForDeployments(t)
 	hostAwait := awaitilities.Host()
-	memberAwait := awaitilities.Member1()
-	memberAwait2 := awaitilities.Member2()
 	hostAwait.UpdateToolchainConfig(t, testconfig.AutomaticApproval().Enabled(true))
 	// host metrics should be available at this point
-	hostAwait.InitMetrics(t, awaitilities.Member1().ClusterName, awaitilities.Member2().ClusterName)
+	hostAwait.InitMetrics(t)
 	t.Cleanup(func() {
 		// wait until metrics are back to their respective baselines
-		hostAwait.WaitForMetricBaseline(t, wait.SpacesMetric, "cluster_name", memberAwait.ClusterName)
-		hostAwait.WaitForMetricBaseline(t, wait.SpacesMetric, "cluster_name", memberAwait2.ClusterName)
+		hostAwait.AssertMetricsBackToBaseline(t, wait.SpacesMetric)
 	})
 
 	usersignups := map[string]*toolchainv1alpha1.UserSignup{}
@@ -216,16 +211,13 @@ func TestVerificationRequiredMetric(t *testing.T) {
 	// given
 	awaitilities := WaitForDeployments(t)
 	hostAwait := awaitilities.Host()
-	memberAwait := awaitilities.Member1()
-	memberAwait2 := awaitilities.Member2()
 	route := hostAwait.RegistrationServiceURL
 	hostAwait.UpdateToolchainConfig(t, testconfig.AutomaticApproval().Enabled(false)) // disable automatic approval so that users are created with verification required
 	// host metrics should be available at this point
-	hostAwait.InitMetrics(t, awaitilities.Member1().ClusterName, awaitilities.Member2().ClusterName)
+	hostAwait.InitMetrics(t)
 	t.Cleanup(func() {
 		// wait until metrics are back to their respective baselines
-		hostAwait.WaitForMetricBaseline(t, wait.SpacesMetric, "cluster_name", memberAwait.ClusterName)
-		hostAwait.WaitForMetricBaseline(t, wait.SpacesMetric, "cluster_name", memberAwait2.ClusterName)
+		hostAwait.AssertMetricsBackToBaseline(t, wait.SpacesMetric)
 	})
 
 	var userSignup *toolchainv1alpha1.UserSignup
@@ -316,7 +308,7 @@ func TestMetricsWhenUsersDeactivatedAndReactivated(t *testing.T) {
 	memberAwait := awaitilities.Member1()
 	hostAwait.UpdateToolchainConfig(t, testconfig.AutomaticApproval().Enabled(false))
 	// host metrics should be available at this point
-	hostAwait.InitMetrics(t, awaitilities.Member1().ClusterName, awaitilities.Member2().ClusterName)
+	hostAwait.InitMetrics(t)
 	t.Cleanup(func() {
 		hostAwait.WaitForMetricBaseline(t, wait.SpacesMetric, "cluster_name", memberAwait.ClusterName) // wait until counter is back to 0
 	})
@@ -373,12 +365,11 @@ func TestMetricsWhenUsersDeactivatedAndReactivated(t *testing.T) {
 
 	t.Run("restart host-operator pod and verify that metrics are still available", func(t *testing.T) {
 		// when deleting the host-operator pod to emulate an operator restart during redeployment.
-		err := hostAwait.DeletePods(client.InNamespace(hostAwait.Namespace), client.MatchingLabels{"name": "controller-manager"})
+		RestartDeployment(t, hostAwait.Awaitility, "host-operator-controller-manager")
 
 		// then check how much time it takes to restart and process all existing resources
-		require.NoError(t, err)
 		// host metrics should become available again at this point
-		_, err = hostAwait.WaitForRouteToBeAvailable(t, hostAwait.Namespace, "host-operator-metrics-service", "/metrics")
+		_, err := hostAwait.WaitForRouteToBeAvailable(t, hostAwait.Namespace, "host-operator-metrics-service", "/metrics")
 		require.NoError(t, err, "failed while setting up or waiting for the route to the 'host-operator-metrics-service' service to be available")
 		// also verify that the metric values "survived" the restart
 		hostAwait.WaitForMetricDelta(t, wait.UsersPerActivationsAndDomainMetric, 1, "activations", "1", "domain", "external") // user-0001 was 1 time (unchanged after pod restarted)
@@ -398,7 +389,7 @@ func TestMetricsWhenUsersDeleted(t *testing.T) {
 	memberAwait := awaitilities.Member1()
 	hostAwait.UpdateToolchainConfig(t, testconfig.AutomaticApproval().Enabled(false))
 	// host metrics should be available at this point
-	hostAwait.InitMetrics(t, awaitilities.Member1().ClusterName, awaitilities.Member2().ClusterName)
+	hostAwait.InitMetrics(t)
 	t.Cleanup(func() {
 		hostAwait.WaitForMetricBaseline(t, wait.SpacesMetric, "cluster_name", memberAwait.ClusterName) // wait until counter is back to 0
 	})
@@ -456,11 +447,10 @@ func TestMetricsWhenUsersBanned(t *testing.T) {
 	memberAwait2 := awaitilities.Member2()
 
 	// given
-	hostAwait.InitMetrics(t, awaitilities.Member1().ClusterName, awaitilities.Member2().ClusterName)
+	hostAwait.InitMetrics(t)
 	t.Cleanup(func() {
 		t.Log("waiting for metrics to get back to their baseline values...")
-		hostAwait.WaitForMetricBaseline(t, wait.SpacesMetric, "cluster_name", memberAwait.ClusterName)  // wait until counter is back to 0
-		hostAwait.WaitForMetricBaseline(t, wait.SpacesMetric, "cluster_name", memberAwait2.ClusterName) // wait until counter is back to 0
+		hostAwait.AssertMetricsBackToBaseline(t, wait.SpacesMetric)
 	})
 
 	hostAwait.UpdateToolchainConfig(t, testconfig.AutomaticApproval().Enabled(false))
@@ -529,11 +519,10 @@ func TestMetricsWhenUserDisabled(t *testing.T) {
 	memberAwait2 := awaitilities.Member2()
 	hostAwait.UpdateToolchainConfig(t, testconfig.AutomaticApproval().Enabled(false))
 	// host metrics should be available at this point
-	hostAwait.InitMetrics(t, awaitilities.Member1().ClusterName, awaitilities.Member2().ClusterName)
+	hostAwait.InitMetrics(t)
 	t.Cleanup(func() {
 		t.Log("waiting for metrics to get back to their baseline values...")
-		hostAwait.WaitForMetricBaseline(t, wait.SpacesMetric, "cluster_name", memberAwait.ClusterName)  // wait until counter is back to 0
-		hostAwait.WaitForMetricBaseline(t, wait.SpacesMetric, "cluster_name", memberAwait2.ClusterName) // wait until counter is back to 0
+		hostAwait.AssertMetricsBackToBaseline(t, wait.SpacesMetric)
 	})
 
 	// Create UserSignup
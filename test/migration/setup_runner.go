@@ -15,6 +15,7 @@ import (
 	testspace "github.com/codeready-toolchain/toolchain-common/pkg/test/space"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/cleanup"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/factories"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/space"
 	tsspace "github.com/codeready-toolchain/toolchain-e2e/testsupport/space"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/tiers"
@@ -171,7 +172,7 @@ func (r *SetupMigrationRunner) prepareBannedUser(t *testing.T) {
 	hostAwait := r.Awaitilities.Host()
 
 	// Create the BannedUser
-	bannedUser := testsupport.NewBannedUser(hostAwait, userSignup.Annotations[toolchainv1alpha1.UserSignupUserEmailAnnotationKey])
+	bannedUser := factories.NewBannedUser(t, hostAwait.Namespace, factories.BannedUserEmail(userSignup.Annotations[toolchainv1alpha1.UserSignupUserEmailAnnotationKey]))
 	err := hostAwait.Client.Create(context.TODO(), bannedUser)
 	require.NoError(t, err)
 
@@ -14,6 +14,7 @@ import (
 	"github.com/codeready-toolchain/toolchain-e2e/test/migration"
 	. "github.com/codeready-toolchain/toolchain-e2e/testsupport"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/cleanup"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/factories"
 	. "github.com/codeready-toolchain/toolchain-e2e/testsupport/space"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
 	"k8s.io/apimachinery/pkg/types"
@@ -30,6 +31,7 @@ func TestAfterMigration(t *testing.T) {
 		awaitilities.Host().WithRetryOptions(wait.TimeoutOption(wait.DefaultTimeout*2)),
 		awaitilities.Member1().WithRetryOptions(wait.TimeoutOption(wait.DefaultTimeout*2)),
 		awaitilities.Member2().WithRetryOptions(wait.TimeoutOption(wait.DefaultTimeout*2)))
+	waitForUpgradedOperators(t, awaitilities)
 
 	// run all the verify functions concurrently
 	// to ensure that the objects provisioned with the "old" operator versions are not altered in an unexpected way by the new operator version (the changes are backward compatible)
@@ -67,6 +69,22 @@ func TestAfterMigration(t *testing.T) {
 	})
 }
 
+// waitForUpgradedOperators waits until the operators run the images of the new versions (when they are specified), regardless of whether
+// the operators were installed by OLM or by applying their manifests directly
+func waitForUpgradedOperators(t *testing.T, awaitilities wait.Awaitilities) {
+	env := wait.CurrentEnvironment()
+	if image := env.HostOperatorImage; image != "" {
+		_, err := awaitilities.Host().WaitForOperatorImage(t, image)
+		require.NoError(t, err)
+	}
+	if image := env.MemberOperatorImage; image != "" {
+		for _, memberAwait := range awaitilities.AllMembers() {
+			_, err := memberAwait.WaitForOperatorImage(t, image)
+			require.NoError(t, err)
+		}
+	}
+}
+
 func runVerifyFunctions(t *testing.T, awaitilities wait.Awaitilities) {
 	// check MUR migrations and get Signups for the users provisioned in the setup part
 	t.Log("checking MUR Migrations")
@@ -168,7 +186,7 @@ func verifyProvisionedSignup(t *testing.T, awaitilities wait.Awaitilities, signu
 func verifySecondMemberProvisionedSignup(t *testing.T, awaitilities wait.Awaitilities, signup *toolchainv1alpha1.UserSignup) {
 	cleanup.AddCleanTasks(t, awaitilities.Host().Client, signup)
 	VerifyResourcesProvisionedForSignup(t, awaitilities, signup, "deactivate30", "base")
-	CreateBannedUser(t, awaitilities.Host(), signup.Annotations[toolchainv1alpha1.UserSignupUserEmailAnnotationKey])
+	factories.CreateBannedUser(t, awaitilities.Host(), factories.BannedUserEmail(signup.Annotations[toolchainv1alpha1.UserSignupUserEmailAnnotationKey]))
 }
 
 func verifyAppStudioProvisionedSignup(t *testing.T, awaitilities wait.Awaitilities, signup *toolchainv1alpha1.UserSignup) {
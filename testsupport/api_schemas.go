@@ -0,0 +1,28 @@
+package testsupport
+
+import (
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+)
+
+// VerifyAPISchemas compares the toolchain API types which the tests are compiled against with the CRDs installed in the
+// cluster of the given awaitility. Fields which are only declared in the CRDs are reported as warnings, but the verification fails
+// if some fields of the vendored types are missing in the CRDs, since the values set by the tests on these fields would be silently dropped
+// (eg: when the API dependency was bumped in the e2e tests but not in the operators).
+func VerifyAPISchemas(t *testing.T, a *wait.Awaitility) {
+	drifts, err := a.SchemaDrifts(toolchainv1alpha1.GroupVersion)
+	require.NoError(t, err)
+	var missingInCluster []string
+	for _, drift := range drifts {
+		if drift.MissingInCluster {
+			missingInCluster = append(missingInCluster, drift.String())
+			continue
+		}
+		t.Logf("WARNING: API schema drift in the %s cluster: %s", a.Type, drift)
+	}
+	require.Empty(t, missingInCluster, "the vendored API types don't match the CRDs installed in the %s cluster", a.Type)
+}
@@ -0,0 +1,194 @@
+package backup
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/cleanup"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HostResources returns the lists of toolchain resources of the host namespace which are part of a backup.
+// The order matters: the tiers need to be restored before the resources which refer to them.
+func HostResources() []client.ObjectList {
+	return []client.ObjectList{
+		&toolchainv1alpha1.NSTemplateTierList{},
+		&toolchainv1alpha1.UserTierList{},
+		&toolchainv1alpha1.BannedUserList{},
+		&toolchainv1alpha1.SocialEventList{},
+		&toolchainv1alpha1.UserSignupList{},
+		&toolchainv1alpha1.MasterUserRecordList{},
+		&toolchainv1alpha1.SpaceList{},
+		&toolchainv1alpha1.SpaceBindingList{},
+	}
+}
+
+// Snapshot holds a copy of the resources which existed in a namespace at the time the snapshot was taken
+type Snapshot struct {
+	Namespace string
+	Objects   []client.Object
+}
+
+// TakeSnapshot lists all resources of the given kinds in the given namespace and keeps a copy of them
+func TakeSnapshot(t *testing.T, cl client.Client, namespace string, lists ...client.ObjectList) *Snapshot {
+	snapshot := &Snapshot{
+		Namespace: namespace,
+	}
+	for _, list := range lists {
+		require.NoError(t, cl.List(context.TODO(), list, client.InNamespace(namespace)))
+		items, err := meta.ExtractList(list)
+		require.NoError(t, err)
+		for _, item := range items {
+			obj, ok := item.DeepCopyObject().(client.Object)
+			require.True(t, ok, "unexpected type of item in list: %T", item)
+			snapshot.Objects = append(snapshot.Objects, obj)
+		}
+	}
+	t.Logf("took a snapshot of %d resources in namespace '%s'", len(snapshot.Objects), namespace)
+	return snapshot
+}
+
+// Restore simulates a restore of the snapshot into the given namespace: all resources are re-applied without their
+// status and without the metadata which is set by the cluster (UID, resource version, owner references, finalizers...).
+// The resources which already exist in the namespace (eg: a MasterUserRecord re-created by the host operator from a UserSignup
+// restored before it) are considered as restored if their spec is the same as in the snapshot, and the test fails otherwise.
+// Only the spec is compared: the labels and annotations of the existing resources may differ from the ones of the snapshot,
+// since they are also set by the operators (eg: the state of a UserSignup). Returns the restored resources. Only the ones created by the restore are deleted at the end of the test.
+func (s *Snapshot) Restore(t *testing.T, cl client.Client, namespace string) []client.Object {
+	t.Logf("restoring %d resources from namespace '%s' into namespace '%s'", len(s.Objects), s.Namespace, namespace)
+	restored := make([]client.Object, 0, len(s.Objects))
+	for _, original := range s.Objects {
+		obj := clearedCopy(t, original, namespace)
+		if err := cl.Create(context.TODO(), obj); err != nil {
+			require.True(t, apierrors.IsAlreadyExists(err), "failed to restore %T '%s': %s", obj, obj.GetName(), err)
+			t.Logf("%T '%s' already exists in namespace '%s', comparing it with the snapshot", obj, obj.GetName(), namespace)
+			existing := newObjectOf(t, original)
+			require.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: obj.GetName()}, existing))
+			requireSameSpec(t, original, existing)
+			restored = append(restored, existing)
+			continue
+		}
+		cleanup.AddCleanTasks(t, cl, obj)
+		restored = append(restored, obj)
+	}
+	return restored
+}
+
+// RequireRestored verifies that all the resources of the snapshot exist in the given namespace, with the same spec as in the snapshot
+// (eg: after a restore into a fresh namespace, in which no operator updates the restored resources). As in Restore, the labels,
+// annotations and status of the resources are not compared.
+func (s *Snapshot) RequireRestored(t *testing.T, cl client.Client, namespace string) {
+	for _, original := range s.Objects {
+		obj := newObjectOf(t, original)
+		err := cl.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: original.GetName()}, obj)
+		require.NoError(t, err, "%T '%s' was not restored in namespace '%s'", original, original.GetName(), namespace)
+		requireSameSpec(t, original, obj)
+	}
+}
+
+// Filter returns a new snapshot with the resources for which the given func returns `true`, eg: to restore only the resources
+// of a given user when other tests run in parallel in the same namespace
+func (s *Snapshot) Filter(keep func(client.Object) bool) *Snapshot {
+	filtered := &Snapshot{
+		Namespace: s.Namespace,
+	}
+	for _, obj := range s.Objects {
+		if keep(obj) {
+			filtered.Objects = append(filtered.Objects, obj)
+		}
+	}
+	return filtered
+}
+
+// WaitUntilReconciled waits until the given restored resources (as returned by Restore) which had a `Ready=True` status condition
+// at the time the snapshot was taken, have been reconciled back to `Ready=True`
+func (s *Snapshot) WaitUntilReconciled(t *testing.T, await *wait.Awaitility, restored []client.Object) {
+	for _, r := range restored {
+		original := s.originalOf(r)
+		if original == nil || !isReady(t, original) {
+			continue
+		}
+		t.Logf("waiting for restored %T '%s' in namespace '%s' to be ready", r, r.GetName(), r.GetNamespace())
+		obj, ok := r.DeepCopyObject().(client.Object)
+		require.True(t, ok)
+		err := await.Poll(t, func() (done bool, err error) {
+			if err := await.Client.Get(context.TODO(), types.NamespacedName{Namespace: r.GetNamespace(), Name: r.GetName()}, obj); err != nil {
+				if apierrors.IsNotFound(err) {
+					return false, nil
+				}
+				return false, err
+			}
+			return isReady(t, obj), nil
+		})
+		if err != nil {
+			y, _ := wait.StringifyObject(obj)
+			t.Logf("restored resource is not ready:\n%s", y)
+		}
+		require.NoError(t, err)
+	}
+}
+
+// originalOf returns the resource of the snapshot from which the given restored resource was created, or nil if there is none
+func (s *Snapshot) originalOf(restored client.Object) client.Object {
+	for _, obj := range s.Objects {
+		if reflect.TypeOf(obj) == reflect.TypeOf(restored) && obj.GetName() == restored.GetName() {
+			return obj
+		}
+	}
+	return nil
+}
+
+func clearedCopy(t *testing.T, original client.Object, namespace string) client.Object {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(original)
+	require.NoError(t, err)
+	unstructured.RemoveNestedField(u, "status")
+	for _, field := range []string{"uid", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp",
+		"deletionGracePeriodSeconds", "ownerReferences", "finalizers", "managedFields"} {
+		unstructured.RemoveNestedField(u, "metadata", field)
+	}
+	// convert back into a new (empty) object of the same type, so the removed fields are not retained
+	obj := newObjectOf(t, original)
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u, obj))
+	obj.SetNamespace(namespace)
+	return obj
+}
+
+// newObjectOf returns a new (empty) object of the same type as the given object
+func newObjectOf(t *testing.T, obj client.Object) client.Object {
+	newObj, ok := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(client.Object)
+	require.True(t, ok)
+	return newObj
+}
+
+// requireSameSpec verifies that the given actual resource has the same spec as the given resource of the snapshot. The metadata
+// (including the labels and annotations) and the status are not compared.
+func requireSameSpec(t *testing.T, original, actual client.Object) {
+	expected, err := runtime.DefaultUnstructuredConverter.ToUnstructured(original)
+	require.NoError(t, err)
+	obtained, err := runtime.DefaultUnstructuredConverter.ToUnstructured(actual)
+	require.NoError(t, err)
+	require.Equal(t, expected["spec"], obtained["spec"], "%T '%s' in namespace '%s' differs from the snapshot", actual, actual.GetName(), actual.GetNamespace())
+}
+
+func isReady(t *testing.T, obj client.Object) bool {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	conditions, _, _ := unstructured.NestedSlice(u, "status", "conditions")
+	for _, c := range conditions {
+		if condition, ok := c.(map[string]interface{}); ok &&
+			condition["type"] == string(toolchainv1alpha1.ConditionReady) && condition["status"] == "True" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,258 @@
+package testsupport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DeletionReportFile the name of the file in which the report of a bulk deletion is written, in the `ARTIFACT_DIR`
+	// (the placeholder is replaced by the name of the test)
+	DeletionReportFile = "deletion-%s.txt"
+	// deletionProgressInterval the interval between two log lines with the progress of a bulk deletion
+	deletionProgressInterval = 10 * time.Second
+	// deletionPollInterval the interval between two checks of the resources which are not cleaned yet
+	deletionPollInterval = time.Second
+)
+
+// DeletionStats the time it took to clean the resources of a given kind during a bulk deletion, measured from the deletion
+// of the resource which owns them (eg: the UserSignup for its MasterUserRecord and its Space)
+type DeletionStats struct {
+	Kind string
+	// TimesToClean the time it took to clean each resource, sorted
+	TimesToClean []time.Duration
+	// Elapsed the time between the start of the bulk deletion and the cleanup of the last resource
+	Elapsed time.Duration
+	// Pending the number of resources which were not cleaned before the end of the bulk deletion
+	Pending int
+}
+
+// Throughput returns the number of resources cleaned per second
+func (s DeletionStats) Throughput() float64 {
+	if s.Elapsed <= 0 {
+		return 0
+	}
+	return float64(len(s.TimesToClean)) / s.Elapsed.Seconds()
+}
+
+// Percentile returns the time to clean below which the given percentage of the resources were cleaned (or 0 if none was cleaned)
+func (s DeletionStats) Percentile(p int) time.Duration {
+	if len(s.TimesToClean) == 0 {
+		return 0
+	}
+	i := (len(s.TimesToClean)*p+99)/100 - 1
+	if i < 0 {
+		i = 0
+	}
+	return s.TimesToClean[i]
+}
+
+// DeletionReport the outcome of a bulk deletion, with the stats of each kind of resource
+type DeletionReport struct {
+	Count   int
+	Elapsed time.Duration
+	Stats   []DeletionStats
+}
+
+// Write writes a table with the throughput and the time to clean of each kind of resource
+func (r DeletionReport) Write(out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "deletion of %d resource(s) in %s\n", r.Count, r.Elapsed.Round(time.Millisecond))
+	fmt.Fprintln(w, "KIND\tCLEANED\tPENDING\tTHROUGHPUT (/s)\tP50\tP95\tMAX")
+	for _, s := range r.Stats {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%.2f\t%s\t%s\t%s\n", s.Kind, len(s.TimesToClean), s.Pending, s.Throughput(),
+			s.Percentile(50).Round(time.Millisecond), s.Percentile(95).Round(time.Millisecond), s.Percentile(100).Round(time.Millisecond))
+	}
+	return w.Flush()
+}
+
+// deletionKind a kind of resources which are cleaned during a bulk deletion, along with the list type to retrieve them
+type deletionKind struct {
+	name    string
+	newList func() client.ObjectList
+}
+
+var (
+	userSignupDeletionKind       = deletionKind{name: "UserSignup", newList: func() client.ObjectList { return &toolchainv1alpha1.UserSignupList{} }}
+	masterUserRecordDeletionKind = deletionKind{name: "MasterUserRecord", newList: func() client.ObjectList { return &toolchainv1alpha1.MasterUserRecordList{} }}
+	spaceDeletionKind            = deletionKind{name: "Space", newList: func() client.ObjectList { return &toolchainv1alpha1.SpaceList{} }}
+)
+
+// deletionTarget a resource to delete, along with the names of the resources (by kind, including the resource itself) which must be cleaned
+type deletionTarget struct {
+	obj   client.Object
+	names map[string]string
+}
+
+// BulkDeleteUserSignups deletes the given UserSignups, and waits until their MasterUserRecords and their Spaces are cleaned too,
+// within the given duration. The progress is logged periodically, and the report of the throughput and the time to clean of each kind
+// of resource is logged and written in the `ARTIFACT_DIR` (see DeletionReportFile), so that regressions in the deprovisioning
+// performance can be detected. The test fails if some resources are not cleaned in time.
+func BulkDeleteUserSignups(t *testing.T, hostAwait *wait.HostAwaitility, userSignups []*toolchainv1alpha1.UserSignup, within time.Duration) DeletionReport {
+	targets := make([]deletionTarget, 0, len(userSignups))
+	for _, userSignup := range userSignups {
+		// the compliant username is only known once the UserSignup was approved
+		actual := &toolchainv1alpha1.UserSignup{}
+		err := hostAwait.Client.Get(context.TODO(), types.NamespacedName{Namespace: hostAwait.Namespace, Name: userSignup.Name}, actual)
+		require.NoError(t, err)
+		names := map[string]string{userSignupDeletionKind.name: actual.Name}
+		if actual.Status.CompliantUsername != "" {
+			names[masterUserRecordDeletionKind.name] = actual.Status.CompliantUsername
+			names[spaceDeletionKind.name] = actual.Status.CompliantUsername
+		}
+		targets = append(targets, deletionTarget{
+			obj:   actual,
+			names: names,
+		})
+	}
+	return bulkDelete(t, hostAwait, targets, []deletionKind{userSignupDeletionKind, masterUserRecordDeletionKind, spaceDeletionKind}, within)
+}
+
+// BulkDeleteSpaces deletes the given Spaces and waits until they are cleaned (ie: until their namespaces were deleted on the member clusters)
+// within the given duration, with the same progress and report as BulkDeleteUserSignups
+func BulkDeleteSpaces(t *testing.T, hostAwait *wait.HostAwaitility, spaces []*toolchainv1alpha1.Space, within time.Duration) DeletionReport {
+	targets := make([]deletionTarget, 0, len(spaces))
+	for _, space := range spaces {
+		targets = append(targets, deletionTarget{
+			obj:   space,
+			names: map[string]string{spaceDeletionKind.name: space.Name},
+		})
+	}
+	return bulkDelete(t, hostAwait, targets, []deletionKind{spaceDeletionKind}, within)
+}
+
+func bulkDelete(t *testing.T, hostAwait *wait.HostAwaitility, targets []deletionTarget, kinds []deletionKind, within time.Duration) DeletionReport {
+	t.Logf("deleting %d resource(s) within %s", len(targets), within)
+	start := time.Now()
+	deletedAt := make([]time.Time, len(targets))
+	var errs []string
+	for i, target := range targets {
+		deletedAt[i] = time.Now()
+		if err := hostAwait.Client.Delete(context.TODO(), target.obj, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Sprintf("unable to delete '%s': %s", target.obj.GetName(), err.Error()))
+		}
+	}
+	require.Empty(t, errs, "failed to delete %d resource(s) out of %d:\n%s", len(errs), len(targets), strings.Join(errs, "\n"))
+
+	// the time it took to clean each resource (by kind and target), along with the time when the last resource of each kind was cleaned
+	cleaned := map[string]map[int]time.Duration{}
+	lastCleanedAt := map[string]time.Time{}
+	pending := func() int {
+		count := 0
+		for _, kind := range kinds {
+			for i, target := range targets {
+				if _, found := target.names[kind.name]; found {
+					if _, done := cleaned[kind.name][i]; !done {
+						count++
+					}
+				}
+			}
+		}
+		return count
+	}
+	deadline := start.Add(within)
+	lastProgress := start
+	for {
+		for _, kind := range kinds {
+			existing, err := existingNames(hostAwait, kind)
+			if err != nil {
+				t.Logf("unable to list the %s resources: %s", kind.name, err.Error())
+				continue
+			}
+			if cleaned[kind.name] == nil {
+				cleaned[kind.name] = map[int]time.Duration{}
+			}
+			now := time.Now()
+			for i, target := range targets {
+				name, found := target.names[kind.name]
+				if _, done := cleaned[kind.name][i]; !found || done || existing[name] {
+					continue
+				}
+				cleaned[kind.name][i] = now.Sub(deletedAt[i])
+				lastCleanedAt[kind.name] = now
+			}
+		}
+		remaining := pending()
+		if remaining == 0 || time.Now().After(deadline) {
+			break
+		}
+		if time.Since(lastProgress) >= deletionProgressInterval {
+			lastProgress = time.Now()
+			progress := make([]string, 0, len(kinds))
+			for _, kind := range kinds {
+				progress = append(progress, fmt.Sprintf("%d %s(s)", len(cleaned[kind.name]), kind.name))
+			}
+			t.Logf("cleaned %s after %s (%d resource(s) pending)", strings.Join(progress, ", "), time.Since(start).Round(time.Second), remaining)
+		}
+		time.Sleep(deletionPollInterval)
+	}
+
+	report := DeletionReport{
+		Count:   len(targets),
+		Elapsed: time.Since(start),
+	}
+	var stuck []string
+	for _, kind := range kinds {
+		stats := DeletionStats{
+			Kind: kind.name,
+		}
+		if last, found := lastCleanedAt[kind.name]; found {
+			stats.Elapsed = last.Sub(start)
+		}
+		for i, target := range targets {
+			name, found := target.names[kind.name]
+			if !found {
+				continue
+			}
+			if d, done := cleaned[kind.name][i]; done {
+				stats.TimesToClean = append(stats.TimesToClean, d)
+			} else {
+				stats.Pending++
+				stuck = append(stuck, fmt.Sprintf("  %s '%s'", kind.name, name))
+			}
+		}
+		sort.Slice(stats.TimesToClean, func(i, j int) bool { return stats.TimesToClean[i] < stats.TimesToClean[j] })
+		report.Stats = append(report.Stats, stats)
+	}
+	out := &strings.Builder{}
+	require.NoError(t, report.Write(out))
+	t.Log(out.String())
+	writeReport(fmt.Sprintf(DeletionReportFile, strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())), out.String())
+	require.Empty(t, stuck, "%d resource(s) were not cleaned after %s:\n%s", len(stuck), within, strings.Join(stuck, "\n"))
+	return report
+}
+
+// existingNames returns the names of the resources of the given kind in the host namespace
+func existingNames(hostAwait *wait.HostAwaitility, kind deletionKind) (map[string]bool, error) {
+	list := kind.newList()
+	if err := hostAwait.Client.List(context.TODO(), list, client.InNamespace(hostAwait.Namespace)); err != nil {
+		return nil, err
+	}
+	objs, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool, len(objs))
+	for _, obj := range objs {
+		if o, ok := obj.(client.Object); ok {
+			names[o.GetName()] = true
+		}
+	}
+	return names, nil
+}
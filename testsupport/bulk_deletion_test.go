@@ -0,0 +1,55 @@
+package testsupport_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeletionStats(t *testing.T) {
+	// given
+	stats := testsupport.DeletionStats{
+		Kind:         "Space",
+		TimesToClean: []time.Duration{1 * time.Second, 2 * time.Second, 3 * time.Second, 10 * time.Second},
+		Elapsed:      8 * time.Second,
+		Pending:      1,
+	}
+
+	t.Run("throughput", func(t *testing.T) {
+		assert.InDelta(t, 0.5, stats.Throughput(), 0.001)
+		assert.Zero(t, testsupport.DeletionStats{}.Throughput())
+	})
+
+	t.Run("percentiles", func(t *testing.T) {
+		assert.Equal(t, 2*time.Second, stats.Percentile(50))
+		assert.Equal(t, 10*time.Second, stats.Percentile(95))
+		assert.Equal(t, 10*time.Second, stats.Percentile(100))
+		assert.Equal(t, 1*time.Second, stats.Percentile(0))
+		assert.Zero(t, testsupport.DeletionStats{}.Percentile(50))
+	})
+
+	t.Run("report", func(t *testing.T) {
+		// given
+		report := testsupport.DeletionReport{
+			Count:   5,
+			Elapsed: 12 * time.Second,
+			Stats:   []testsupport.DeletionStats{stats},
+		}
+		out := &strings.Builder{}
+
+		// when
+		err := report.Write(out)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, `deletion of 5 resource(s) in 12s
+KIND   CLEANED  PENDING  THROUGHPUT (/s)  P50  P95  MAX
+Space  4        1        0.50             2s   10s  10s
+`, out.String())
+	})
+}
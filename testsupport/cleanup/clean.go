@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
-	"testing"
 	"time"
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
@@ -30,13 +29,22 @@ var (
 	})
 )
 
+// T the methods of `*testing.T` used by the clean tasks, which allows them to be added by the Awaitilities
+// used outside of the Go tests (see `wait.T`)
+type T interface {
+	Logf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+	FailNow()
+	Cleanup(f func())
+}
+
 type cleanManager struct {
 	sync.RWMutex
-	cleanTasks map[*testing.T][]*cleanTask
+	cleanTasks map[T][]*cleanTask
 }
 
 var cleaning = &cleanManager{
-	cleanTasks: map[*testing.T][]*cleanTask{},
+	cleanTasks: map[T][]*cleanTask{},
 }
 
 type AwaitilityInt interface {
@@ -44,11 +52,11 @@ type AwaitilityInt interface {
 }
 
 // AddCleanTasks adds cleaning tasks for the given objects that will be automatically performed at the end of the test execution
-func AddCleanTasks(t *testing.T, cl client.Client, objects ...client.Object) {
+func AddCleanTasks(t T, cl client.Client, objects ...client.Object) {
 	cleaning.addCleanTasks(t, cl, objects...)
 }
 
-func (c *cleanManager) addCleanTasks(t *testing.T, cl client.Client, objects ...client.Object) {
+func (c *cleanManager) addCleanTasks(t T, cl client.Client, objects ...client.Object) {
 	c.Lock()
 	defer c.Unlock()
 	for _, obj := range objects {
@@ -60,11 +68,11 @@ func (c *cleanManager) addCleanTasks(t *testing.T, cl client.Client, objects ...
 }
 
 // ExecuteAllCleanTasks triggers cleanup of all resources that were marked to be cleaned before that
-func ExecuteAllCleanTasks(t *testing.T) {
+func ExecuteAllCleanTasks(t T) {
 	cleaning.clean(t)()
 }
 
-func (c *cleanManager) clean(t *testing.T) func() {
+func (c *cleanManager) clean(t T) func() {
 	return func() {
 		c.Lock()
 		defer c.Unlock()
@@ -85,13 +93,13 @@ type cleanTask struct {
 	sync.Once
 	objToClean client.Object
 	client     client.Client
-	t          *testing.T
+	t          T
 }
 
 func (c *cleanTask) clean() {
 	c.Do(c.cleanObject)
 }
-func newCleanTask(t *testing.T, cl client.Client, obj client.Object) *cleanTask {
+func newCleanTask(t T, cl client.Client, obj client.Object) *cleanTask {
 	return &cleanTask{
 		t:          t,
 		client:     cl,
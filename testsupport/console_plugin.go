@@ -0,0 +1,231 @@
+package testsupport
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	consolePluginName         = "member-operator-console-plugin"
+	consolePluginManifestName = "toolchain-member-web-console-plugin"
+)
+
+// VerifyConsolePluginResources verifies the ServiceAccount, Role, RoleBinding, Deployment and Service of the web console plugin
+// deployed by the member operator
+func VerifyConsolePluginResources(t *testing.T, memberAwait *wait.MemberAwaitility) {
+	image := memberAwait.GetContainerEnv(t, "MEMBER_OPERATOR_WEBCONSOLEPLUGIN_IMAGE")
+	require.NotEmpty(t, image, "The value of the env var MEMBER_OPERATOR_WEBCONSOLEPLUGIN_IMAGE wasn't found in the deployment of the member operator.")
+
+	_, err := memberAwait.WaitForServiceAccount(t, memberAwait.Namespace, consolePluginName)
+	require.NoError(t, err)
+	verifyConsolePluginRole(t, memberAwait)
+	verifyConsolePluginRoleBinding(t, memberAwait)
+	verifyConsolePluginDeployment(t, memberAwait, image)
+	verifyConsolePluginService(t, memberAwait)
+}
+
+func verifyConsolePluginRole(t *testing.T, await *wait.MemberAwaitility) {
+	ns := &corev1.Namespace{}
+	ns.Name = await.Namespace
+	role, err := await.WaitForRole(t, ns, consolePluginName)
+	require.NoError(t, err)
+	assert.Len(t, role.Rules, 2)
+	expected := &rbacv1.Role{
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{"toolchain.dev.openshift.com"},
+				Resources: []string{"memberoperatorconfigs"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"secrets"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+		},
+	}
+
+	assert.Equal(t, expected.Rules, role.Rules)
+	assert.Equal(t, "codeready-toolchain", role.ObjectMeta.Labels["toolchain.dev.openshift.com/provider"])
+}
+
+func verifyConsolePluginRoleBinding(t *testing.T, await *wait.MemberAwaitility) {
+	ns := &corev1.Namespace{}
+	ns.Name = await.Namespace
+	rb, err := await.WaitForRoleBinding(t, ns, consolePluginName)
+	require.NoError(t, err)
+	assert.Len(t, rb.Subjects, 1)
+	assert.Equal(t, "ServiceAccount", rb.Subjects[0].Kind)
+	assert.Equal(t, consolePluginName, rb.Subjects[0].Name)
+	assert.Equal(t, consolePluginName, rb.RoleRef.Name)
+	assert.Equal(t, "Role", rb.RoleRef.Kind)
+	assert.Equal(t, "rbac.authorization.k8s.io", rb.RoleRef.APIGroup)
+	assert.Equal(t, "codeready-toolchain", rb.ObjectMeta.Labels["toolchain.dev.openshift.com/provider"])
+}
+
+func verifyConsolePluginDeployment(t *testing.T, await *wait.MemberAwaitility, image string) {
+	t.Logf("checking Deployment '%s' in namespace '%s' and with image '%s'", consolePluginName, await.Namespace, image)
+	actualDeployment := await.WaitForDeploymentToGetReady(t, consolePluginName, 3,
+		wait.DeploymentHasContainerWithImage(consolePluginName, image))
+
+	assert.Equal(t, "codeready-toolchain", actualDeployment.ObjectMeta.Labels["toolchain.dev.openshift.com/provider"])
+	assert.Equal(t, int32(3), *actualDeployment.Spec.Replicas)
+	assert.Equal(t, map[string]string{
+		"name": consolePluginName,
+	}, actualDeployment.Spec.Selector.MatchLabels)
+
+	template := actualDeployment.Spec.Template
+	assert.Equal(t, map[string]string{
+		"name": consolePluginName,
+		"run":  consolePluginName,
+	}, template.ObjectMeta.Labels)
+	assert.Equal(t, consolePluginName, template.Spec.ServiceAccountName)
+	require.Len(t, template.Spec.Volumes, 1)
+	assert.Equal(t, "consoleplugin-certs", template.Spec.Volumes[0].Name)
+	assert.Equal(t, consolePluginName, template.Spec.Volumes[0].Secret.SecretName)
+	require.Len(t, template.Spec.Containers, 1)
+
+	container := template.Spec.Containers[0]
+	assert.Equal(t, consolePluginName, container.Name)
+	assert.NotEmpty(t, container.Image)
+	assert.Equal(t, []string{consolePluginName}, container.Command)
+	assert.Equal(t, corev1.PullIfNotPresent, container.ImagePullPolicy)
+	assert.NotEmpty(t, container.LivenessProbe)
+	assert.NotEmpty(t, container.ReadinessProbe)
+	assert.NotEmpty(t, container.StartupProbe)
+	assert.Len(t, container.Env, 1)
+	assert.Equal(t, "WATCH_NAMESPACE", container.Env[0].Name)
+	assert.Equal(t, await.Namespace, container.Env[0].Value)
+
+	assert.Len(t, container.VolumeMounts, 1)
+	assert.Equal(t, "consoleplugin-certs", container.VolumeMounts[0].Name)
+	assert.Equal(t, "/etc/consoleplugin/certs", container.VolumeMounts[0].MountPath)
+	assert.True(t, container.VolumeMounts[0].ReadOnly)
+}
+
+func verifyConsolePluginService(t *testing.T, await *wait.MemberAwaitility) {
+	t.Logf("waiting for Service '%s' in namespace '%s'", consolePluginName, await.Namespace)
+	service, err := await.WaitForService(t, consolePluginName)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"run":                                  consolePluginName,
+		"toolchain.dev.openshift.com/provider": "codeready-toolchain",
+	}, service.Labels)
+	require.Len(t, service.Spec.Ports, 1)
+	assert.Equal(t, int32(9443), service.Spec.Ports[0].Port)
+	assert.Equal(t, "9443", service.Spec.Ports[0].Name)
+	assert.Equal(t, intstr.IntOrString{
+		IntVal: 9443,
+	}, service.Spec.Ports[0].TargetPort)
+	assert.Equal(t, map[string]string{
+		"run": consolePluginName,
+	}, service.Spec.Selector)
+}
+
+// ExposeConsolePlugin creates a route to the web console plugin service in the member cluster (since the web console API resources
+// can't easily be accessed directly, due to complex security requirements), waits until it is available and returns its base URL
+func ExposeConsolePlugin(t *testing.T, memberAwait *wait.MemberAwaitility) string {
+	// the route uses the certificate and private key of the console plugin
+	secret, err := memberAwait.WaitForSecret(t, consolePluginName)
+	require.NoError(t, err)
+
+	route := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "consolepluginroute",
+			Namespace: memberAwait.Namespace,
+			Annotations: map[string]string{
+				"openshift.io/host.generated": "true",
+			},
+		},
+		Spec: routev1.RouteSpec{
+			To: routev1.RouteTargetReference{
+				Kind: "Service",
+				Name: consolePluginName,
+			},
+			Port: &routev1.RoutePort{
+				TargetPort: intstr.FromInt(9443),
+			},
+			TLS: &routev1.TLSConfig{
+				Termination: "reencrypt",
+				Certificate: string(secret.Data["tls.crt"]),
+				Key:         string(secret.Data["tls.key"]),
+			},
+			WildcardPolicy: "None",
+		},
+	}
+	err = memberAwait.CreateWithCleanup(t, route)
+	require.NoError(t, err)
+
+	reloaded, err := memberAwait.WaitForRouteToBeAvailable(t, route.Namespace, route.Name, "/status")
+	require.NoError(t, err, "route not available", route)
+	return fmt.Sprintf("https://%s", reloaded.Spec.Host)
+}
+
+// ConsolePluginResponse the raw response of a request sent to the web console plugin
+type ConsolePluginResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// InvokeConsolePluginEndpoint sends a `GET` request to the given path of the web console plugin (see ExposeConsolePlugin)
+// with the given token, and returns the raw response
+func InvokeConsolePluginEndpoint(t *testing.T, baseURL, path, token string) *ConsolePluginResponse {
+	req, err := http.NewRequest("GET", baseURL+path, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", token)
+	resp, err := httpClient.Do(req) // nolint:bodyclose // see `defer Close(t, resp)`
+	require.NoError(t, err)
+	defer Close(t, resp)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return &ConsolePluginResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+	}
+}
+
+// VerifyConsolePluginEndpoints verifies that the health check and the manifest endpoints of the web console plugin
+// respond correctly, and returns the response of the manifest endpoint for further verifications (eg: the CSP headers)
+func VerifyConsolePluginEndpoints(t *testing.T, baseURL, token string) *ConsolePluginResponse {
+	healthCheck := InvokeConsolePluginEndpoint(t, baseURL, "/status", token)
+	require.Equal(t, http.StatusOK, healthCheck.StatusCode, "error calling health check endpoint: %s", string(healthCheck.Body))
+
+	manifest := InvokeConsolePluginEndpoint(t, baseURL, "/plugin-manifest.json", token)
+	require.Equal(t, http.StatusOK, manifest.StatusCode, "error calling console plugin manifest: %s", string(manifest.Body))
+	assert.Contains(t, manifest.Header.Get("Content-Type"), "application/json")
+	content := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(manifest.Body, &content), "invalid console plugin manifest: %s", string(manifest.Body))
+	assert.Equal(t, consolePluginManifestName, content["name"])
+	return manifest
+}
+
+// RequireContentSecurityPolicy verifies that the response has a `Content-Security-Policy` header which contains all the given directives
+// (eg: `default-src 'self'`)
+func (r *ConsolePluginResponse) RequireContentSecurityPolicy(t *testing.T, directives ...string) {
+	csp := r.Header.Get("Content-Security-Policy")
+	require.NotEmpty(t, csp, "missing 'Content-Security-Policy' header")
+	actual := map[string]bool{}
+	for _, d := range strings.Split(csp, ";") {
+		actual[strings.Join(strings.Fields(d), " ")] = true
+	}
+	for _, d := range directives {
+		assert.True(t, actual[d], "missing directive '%s' in 'Content-Security-Policy' header: %s", d, csp)
+	}
+}
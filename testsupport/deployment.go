@@ -0,0 +1,17 @@
+package testsupport
+
+import (
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+)
+
+// RestartDeployment restarts the deployment with the given name in the namespace of the given Awaitility:
+// it scales the deployment down to zero, waits until all its pods are terminated, scales it back to the original
+// number of replicas and then waits until the deployment is fully ready again. If the deployment was holding
+// a leader election Lease before the restart, then it also waits until one of the new pods is elected as leader.
+func RestartDeployment(t *testing.T, await *wait.Awaitility, name string) {
+	t.Logf("restarting deployment '%s' in namespace '%s'", name, await.Namespace)
+	resume := await.PauseDeployment(t, name)
+	resume()
+}
@@ -0,0 +1,74 @@
+package testsupport
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// SetVerificationExcludedEmailDomains configures the given email domains (eg: `redhat.com`) as the only ones whose users are not
+// required to verify their phone number when they sign up, and restores the original domains at the end of the test.
+// Along with the automatic approval, this is how the ToolchainConfig lets the users of some domains in (ie, they are approved
+// right away) while the others are held until they are verified: there are no other allow/block lists of email domains.
+func SetVerificationExcludedEmailDomains(t *testing.T, hostAwait *wait.HostAwaitility, domains ...string) {
+	var original *string
+	if config := hostAwait.GetToolchainConfig(t); config != nil {
+		original = config.Spec.Host.RegistrationService.Verification.ExcludedEmailDomains
+	}
+	setVerificationExcludedEmailDomains(t, hostAwait, strings.Join(domains, ","))
+	t.Cleanup(func() {
+		if original == nil {
+			hostAwait.PatchToolchainConfig(t, `{"spec":{"host":{"registrationService":{"verification":{"excludedEmailDomains":null}}}}}`)
+			return
+		}
+		setVerificationExcludedEmailDomains(t, hostAwait, *original)
+	})
+}
+
+func setVerificationExcludedEmailDomains(t *testing.T, hostAwait *wait.HostAwaitility, domains string) {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"host": map[string]interface{}{
+				"registrationService": map[string]interface{}{
+					"verification": map[string]interface{}{
+						"excludedEmailDomains": domains,
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	hostAwait.PatchToolchainConfig(t, string(patch))
+}
+
+// SignupWithEmailDomain signs up a new user with an email address in the given domain, and waits until the UserSignup is
+// approved automatically if the domain is excluded from the phone verification (see SetVerificationExcludedEmailDomains),
+// or until it requires the verification (and hence is not approved) otherwise.
+// The automatic approval must be enabled in the ToolchainConfig.
+func SignupWithEmailDomain(t *testing.T, awaitilities wait.Awaitilities, domain string, verificationRequired bool) *toolchainv1alpha1.UserSignup {
+	username := fmt.Sprintf("emaildomain-%s", uuid.Must(uuid.NewV4()).String())
+	conditions := wait.ConditionSet(wait.Default(), wait.ApprovedAutomatically())
+	if verificationRequired {
+		conditions = wait.ConditionSet(wait.Default(), wait.VerificationRequired())
+	}
+	userSignup, _ := NewSignupRequest(awaitilities).
+		Username(username).
+		Email(fmt.Sprintf("%s@%s", username, domain)).
+		VerificationFromConfig().
+		RequireConditions(conditions...).
+		Execute(t).
+		Resources()
+	if verificationRequired {
+		_, err := awaitilities.Host().WaitForUserSignup(t, userSignup.Name,
+			wait.UntilUserSignupHasStateLabel(toolchainv1alpha1.UserSignupStateLabelValueNotReady))
+		require.NoError(t, err)
+	}
+	return userSignup
+}
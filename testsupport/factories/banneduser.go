@@ -0,0 +1,56 @@
+package factories
+
+import (
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-common/pkg/hash"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BannedUserOption an option to configure the BannedUser created by NewBannedUser
+type BannedUserOption func(*toolchainv1alpha1.BannedUser)
+
+// BannedUserEmail sets the email of the banned user, along with the corresponding hash label
+func BannedUserEmail(email string) BannedUserOption {
+	return func(bannedUser *toolchainv1alpha1.BannedUser) {
+		bannedUser.Spec.Email = email
+		bannedUser.Labels[toolchainv1alpha1.BannedUserEmailHashLabelKey] = hash.EncodeString(email)
+	}
+}
+
+// BannedUserPhoneNumberHash sets the phone number hash label of the banned user
+func BannedUserPhoneNumberHash(phoneNumberHash string) BannedUserOption {
+	return func(bannedUser *toolchainv1alpha1.BannedUser) {
+		bannedUser.Labels[toolchainv1alpha1.BannedUserPhoneNumberHashLabelKey] = phoneNumberHash
+	}
+}
+
+// NewBannedUser returns a new BannedUser with a unique name, and an email derived from this name
+func NewBannedUser(t *testing.T, namespace string, opts ...BannedUserOption) *toolchainv1alpha1.BannedUser {
+	name := uniqueName(t)
+	bannedUser := &toolchainv1alpha1.BannedUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels:    map[string]string{},
+		},
+	}
+	BannedUserEmail(name + "@acme.com")(bannedUser)
+	for _, apply := range opts {
+		apply(bannedUser)
+	}
+	return bannedUser
+}
+
+// CreateBannedUser creates a new BannedUser (see NewBannedUser) in the host namespace, and registers it for cleanup
+func CreateBannedUser(t *testing.T, hostAwait *wait.HostAwaitility, opts ...BannedUserOption) *toolchainv1alpha1.BannedUser {
+	bannedUser := NewBannedUser(t, hostAwait.Namespace, opts...)
+	err := hostAwait.CreateWithCleanup(t, bannedUser)
+	require.NoError(t, err)
+	t.Logf("BannedUser '%s' created", bannedUser.Spec.Email)
+	return bannedUser
+}
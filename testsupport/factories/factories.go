@@ -0,0 +1,18 @@
+// Package factories provides builders with functional options for the toolchain resources used in the e2e tests.
+// All builders set sane defaults and a unique name, so that the tests only need to specify the fields they care about,
+// and the `Create*` functions register the created resources for cleanup at the end of the test.
+package factories
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
+
+	"github.com/gofrs/uuid"
+)
+
+// uniqueName returns a name based on the name of the given test, with a random suffix
+func uniqueName(t *testing.T) string {
+	return fmt.Sprintf("%s-%s", util.NewObjectNamePrefix(t), uuid.Must(uuid.NewV4()).String()[:8])
+}
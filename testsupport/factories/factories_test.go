@@ -0,0 +1,274 @@
+package factories_test
+
+import (
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-common/pkg/cluster"
+	"github.com/codeready-toolchain/toolchain-common/pkg/hash"
+	"github.com/codeready-toolchain/toolchain-common/pkg/states"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/factories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUserSignup(t *testing.T) {
+
+	t.Run("with defaults", func(t *testing.T) {
+		// when
+		signup := factories.NewUserSignup(t, "host")
+
+		// then
+		require.NotEmpty(t, signup.Name)
+		assert.Equal(t, "host", signup.Namespace)
+		assert.Equal(t, signup.Name, signup.Spec.Username)
+		assert.Equal(t, signup.Name+"@acme.com", signup.Spec.IdentityClaims.Email)
+		assert.Equal(t, hash.EncodeString(signup.Name+"@acme.com"), signup.Labels[toolchainv1alpha1.UserSignupUserEmailHashLabelKey])
+		assert.False(t, states.ApprovedManually(signup))
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// when
+		signup := factories.NewUserSignup(t, "host",
+			factories.UserSignupUsername("johnsmith"),
+			factories.UserSignupEmail("john@example.com"),
+			factories.UserSignupApproved())
+
+		// then
+		assert.Equal(t, "johnsmith", signup.Spec.Username)
+		assert.Equal(t, "john@example.com", signup.Annotations[toolchainv1alpha1.UserSignupUserEmailAnnotationKey])
+		assert.Equal(t, hash.EncodeString("john@example.com"), signup.Labels[toolchainv1alpha1.UserSignupUserEmailHashLabelKey])
+		assert.True(t, states.ApprovedManually(signup))
+	})
+
+	t.Run("unique names", func(t *testing.T) {
+		assert.NotEqual(t, factories.NewUserSignup(t, "host").Name, factories.NewUserSignup(t, "host").Name)
+	})
+}
+
+func TestNewSocialEvent(t *testing.T) {
+	// when
+	event := factories.NewSocialEvent("host",
+		factories.SocialEventUserTier("deactivate80"),
+		factories.SocialEventMaxAttendees(5))
+
+	// then
+	require.NotEmpty(t, event.Name)
+	assert.Equal(t, "deactivate80", event.Spec.UserTier)
+	assert.Equal(t, "base", event.Spec.SpaceTier)
+	assert.Equal(t, 5, event.Spec.MaxAttendees)
+	assert.True(t, event.Spec.StartTime.Before(&event.Spec.EndTime))
+}
+
+func TestNewSpace(t *testing.T) {
+	// when
+	space := factories.NewSpace(t, "host",
+		factories.SpaceTargetCluster("member-1"),
+		factories.SpaceCreator("johnsmith"))
+
+	// then
+	require.NotEmpty(t, space.Name)
+	assert.Equal(t, "base", space.Spec.TierName)
+	assert.Equal(t, "member-1", space.Spec.TargetCluster)
+	assert.Equal(t, "johnsmith", space.Labels[toolchainv1alpha1.SpaceCreatorLabelKey])
+}
+
+func TestNewMasterUserRecord(t *testing.T) {
+	// given
+	signup := factories.NewUserSignup(t, "host")
+
+	// when
+	mur := factories.NewMasterUserRecord(t, "host",
+		factories.MasterUserRecordOwner(signup),
+		factories.MasterUserRecordTargetCluster("member-1"))
+
+	// then
+	require.NotEmpty(t, mur.Name)
+	assert.Equal(t, "deactivate30", mur.Spec.TierName)
+	assert.Equal(t, signup.Name, mur.Labels[toolchainv1alpha1.MasterUserRecordOwnerLabelKey])
+	assert.Equal(t, signup.Spec.IdentityClaims.PropagatedClaims, mur.Spec.PropagatedClaims)
+	require.Len(t, mur.Spec.UserAccounts, 1)
+	assert.Equal(t, "member-1", mur.Spec.UserAccounts[0].TargetCluster)
+}
+
+func TestNewSpaceBinding(t *testing.T) {
+	// given
+	mur := factories.NewMasterUserRecord(t, "host")
+	space := factories.NewSpace(t, "host")
+
+	// when
+	binding := factories.NewSpaceBinding(t, mur, space, factories.SpaceBindingRole("viewer"))
+
+	// then
+	require.NotEmpty(t, binding.Name)
+	assert.Equal(t, "host", binding.Namespace)
+	assert.Equal(t, mur.Name, binding.Spec.MasterUserRecord)
+	assert.Equal(t, space.Name, binding.Spec.Space)
+	assert.Equal(t, "viewer", binding.Spec.SpaceRole)
+	assert.Equal(t, mur.Name, binding.Labels[toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey])
+	assert.Equal(t, space.Name, binding.Labels[toolchainv1alpha1.SpaceBindingSpaceLabelKey])
+}
+
+func TestNewBannedUser(t *testing.T) {
+	// when
+	bannedUser := factories.NewBannedUser(t, "host", factories.BannedUserEmail("john@example.com"))
+
+	// then
+	require.NotEmpty(t, bannedUser.Name)
+	assert.Equal(t, "john@example.com", bannedUser.Spec.Email)
+	assert.Equal(t, hash.EncodeString("john@example.com"), bannedUser.Labels[toolchainv1alpha1.BannedUserEmailHashLabelKey])
+}
+
+func TestNewNSTemplateTier(t *testing.T) {
+
+	t.Run("with defaults", func(t *testing.T) {
+		// when
+		tier := factories.NewNSTemplateTier(t, "host")
+
+		// then
+		require.NotEmpty(t, tier.Name)
+		assert.Equal(t, "host", tier.Namespace)
+		assert.Empty(t, tier.Spec.Namespaces)
+		assert.Nil(t, tier.Spec.ClusterResources)
+		assert.Empty(t, tier.Spec.SpaceRoles)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// when
+		tier := factories.NewNSTemplateTier(t, "host",
+			factories.NSTemplateTierNamespaces("base-dev-abcd123", "base-stage-abcd123"),
+			factories.NSTemplateTierClusterResources("base-clusterresources-abcd123"),
+			factories.NSTemplateTierSpaceRole("admin", "base-admin-abcd123"),
+			factories.NSTemplateTierSpaceRole("viewer", "base-viewer-abcd123"))
+
+		// then
+		assert.Equal(t, []toolchainv1alpha1.NSTemplateTierNamespace{
+			{TemplateRef: "base-dev-abcd123"},
+			{TemplateRef: "base-stage-abcd123"},
+		}, tier.Spec.Namespaces)
+		require.NotNil(t, tier.Spec.ClusterResources)
+		assert.Equal(t, "base-clusterresources-abcd123", tier.Spec.ClusterResources.TemplateRef)
+		assert.Equal(t, map[string]toolchainv1alpha1.NSTemplateTierSpaceRole{
+			"admin":  {TemplateRef: "base-admin-abcd123"},
+			"viewer": {TemplateRef: "base-viewer-abcd123"},
+		}, tier.Spec.SpaceRoles)
+	})
+}
+
+func TestNewUserTier(t *testing.T) {
+
+	t.Run("with defaults", func(t *testing.T) {
+		// when
+		tier := factories.NewUserTier(t, "host")
+
+		// then
+		require.NotEmpty(t, tier.Name)
+		assert.Equal(t, "host", tier.Namespace)
+		assert.Equal(t, 30, tier.Spec.DeactivationTimeoutDays)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// when
+		tier := factories.NewUserTier(t, "host", factories.UserTierDeactivationTimeoutDays(90))
+
+		// then
+		assert.Equal(t, 90, tier.Spec.DeactivationTimeoutDays)
+	})
+}
+
+func TestNewSpaceRequest(t *testing.T) {
+
+	t.Run("with defaults", func(t *testing.T) {
+		// when
+		request := factories.NewSpaceRequest(t, "john-tenant")
+
+		// then
+		require.NotEmpty(t, request.Name)
+		assert.Equal(t, "john-tenant", request.Namespace)
+		assert.Equal(t, "appstudio-env", request.Spec.TierName)
+		assert.Equal(t, []string{cluster.RoleLabel(cluster.Tenant)}, request.Spec.TargetClusterRoles)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// when
+		request := factories.NewSpaceRequest(t, "john-tenant",
+			factories.SpaceRequestTier("base"),
+			factories.SpaceRequestTargetClusterRoles(cluster.RoleLabel("workspace")))
+
+		// then
+		assert.Equal(t, "base", request.Spec.TierName)
+		assert.Equal(t, []string{cluster.RoleLabel("workspace")}, request.Spec.TargetClusterRoles)
+	})
+}
+
+func TestNewSpaceBindingRequest(t *testing.T) {
+
+	t.Run("with defaults", func(t *testing.T) {
+		// when
+		request := factories.NewSpaceBindingRequest(t, "john-tenant", "jane")
+
+		// then
+		require.NotEmpty(t, request.Name)
+		assert.Equal(t, "john-tenant", request.Namespace)
+		assert.Equal(t, "jane", request.Spec.MasterUserRecord)
+		assert.Equal(t, "admin", request.Spec.SpaceRole)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// when
+		request := factories.NewSpaceBindingRequest(t, "john-tenant", "jane", factories.SpaceBindingRequestRole("viewer"))
+
+		// then
+		assert.Equal(t, "viewer", request.Spec.SpaceRole)
+	})
+}
+
+func TestNewProxyPlugin(t *testing.T) {
+
+	t.Run("with defaults", func(t *testing.T) {
+		// when
+		plugin := factories.NewProxyPlugin(t, "host", "tekton-results", "tekton-results")
+
+		// then
+		require.NotEmpty(t, plugin.Name)
+		assert.Equal(t, "host", plugin.Namespace)
+		require.NotNil(t, plugin.Spec.OpenShiftRouteTargetEndpoint)
+		assert.Equal(t, toolchainv1alpha1.OpenShiftRouteTarget{
+			Namespace: "tekton-results",
+			Name:      "tekton-results",
+		}, *plugin.Spec.OpenShiftRouteTargetEndpoint)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// when
+		plugin := factories.NewProxyPlugin(t, "host", "tekton-results", "tekton-results", factories.ProxyPluginName("tekton"))
+
+		// then
+		assert.Equal(t, "tekton", plugin.Name)
+	})
+}
+
+func TestNewIdler(t *testing.T) {
+
+	t.Run("with defaults", func(t *testing.T) {
+		// when
+		idler := factories.NewIdler("john-dev")
+
+		// then
+		assert.Equal(t, "john-dev", idler.Name)
+		assert.Empty(t, idler.Namespace)
+		assert.Equal(t, int32(12*60*60), idler.Spec.TimeoutSeconds)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// when
+		idler := factories.NewIdler("john-dev",
+			factories.IdlerTimeoutSeconds(30),
+			factories.IdlerOwner("john"))
+
+		// then
+		assert.Equal(t, int32(30), idler.Spec.TimeoutSeconds)
+		assert.Equal(t, "john", idler.Labels[toolchainv1alpha1.OwnerLabelKey])
+	})
+}
@@ -0,0 +1,54 @@
+package factories
+
+import (
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IdlerOption an option to configure the Idler created by NewIdler
+type IdlerOption func(*toolchainv1alpha1.Idler)
+
+// IdlerTimeoutSeconds sets the number of seconds after which the pods are idled
+func IdlerTimeoutSeconds(timeoutSeconds int32) IdlerOption {
+	return func(idler *toolchainv1alpha1.Idler) {
+		idler.Spec.TimeoutSeconds = timeoutSeconds
+	}
+}
+
+// IdlerOwner sets the owner label with the given name (eg: of a Space)
+func IdlerOwner(owner string) IdlerOption {
+	return func(idler *toolchainv1alpha1.Idler) {
+		idler.Labels[toolchainv1alpha1.OwnerLabelKey] = owner
+	}
+}
+
+// NewIdler returns a new (cluster-scoped) Idler for the namespace with the given name, with a timeout of 12 hours
+func NewIdler(namespace string, opts ...IdlerOption) *toolchainv1alpha1.Idler {
+	idler := &toolchainv1alpha1.Idler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   namespace,
+			Labels: map[string]string{},
+		},
+		Spec: toolchainv1alpha1.IdlerSpec{
+			TimeoutSeconds: 12 * 60 * 60,
+		},
+	}
+	for _, apply := range opts {
+		apply(idler)
+	}
+	return idler
+}
+
+// CreateIdler creates a new Idler (see NewIdler) in the member cluster, and registers it for cleanup
+func CreateIdler(t *testing.T, memberAwait *wait.MemberAwaitility, namespace string, opts ...IdlerOption) *toolchainv1alpha1.Idler {
+	idler := NewIdler(namespace, opts...)
+	err := memberAwait.CreateWithCleanup(t, idler)
+	require.NoError(t, err)
+	t.Logf("Idler '%s' created", idler.Name)
+	return idler
+}
@@ -0,0 +1,94 @@
+package factories
+
+import (
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MasterUserRecordOption an option to configure the MasterUserRecord created by NewMasterUserRecord
+type MasterUserRecordOption func(*toolchainv1alpha1.MasterUserRecord)
+
+// MasterUserRecordOwner sets the owner label with the name of the given UserSignup, and uses its identity claims
+func MasterUserRecordOwner(signup *toolchainv1alpha1.UserSignup) MasterUserRecordOption {
+	return func(mur *toolchainv1alpha1.MasterUserRecord) {
+		mur.Labels[toolchainv1alpha1.MasterUserRecordOwnerLabelKey] = signup.Name
+		mur.Spec.PropagatedClaims = signup.Spec.IdentityClaims.PropagatedClaims
+	}
+}
+
+// MasterUserRecordTargetCluster sets a single UserAccount in the given target cluster
+func MasterUserRecordTargetCluster(targetCluster string) MasterUserRecordOption {
+	return func(mur *toolchainv1alpha1.MasterUserRecord) {
+		mur.Spec.UserAccounts = []toolchainv1alpha1.UserAccountEmbedded{
+			{
+				TargetCluster: targetCluster,
+			},
+		}
+	}
+}
+
+// MasterUserRecordTier sets the name of the UserTier
+func MasterUserRecordTier(tierName string) MasterUserRecordOption {
+	return func(mur *toolchainv1alpha1.MasterUserRecord) {
+		mur.Spec.TierName = tierName
+	}
+}
+
+// MasterUserRecordDisabled marks the MasterUserRecord as disabled
+func MasterUserRecordDisabled() MasterUserRecordOption {
+	return func(mur *toolchainv1alpha1.MasterUserRecord) {
+		mur.Spec.Disabled = true
+	}
+}
+
+// MasterUserRecordLabel sets the given label
+func MasterUserRecordLabel(key, value string) MasterUserRecordOption {
+	return func(mur *toolchainv1alpha1.MasterUserRecord) {
+		mur.Labels[key] = value
+	}
+}
+
+// NewMasterUserRecord returns a new MasterUserRecord with a unique name, the `deactivate30` tier and some identity claims
+// derived from this name. The MasterUserRecord has no UserAccount, unless specified otherwise with the options
+func NewMasterUserRecord(t *testing.T, namespace string, opts ...MasterUserRecordOption) *toolchainv1alpha1.MasterUserRecord {
+	name := uniqueName(t)
+	userID := uuid.Must(uuid.NewV4()).String()
+	mur := &toolchainv1alpha1.MasterUserRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels: map[string]string{
+				toolchainv1alpha1.MasterUserRecordOwnerLabelKey: name,
+			},
+		},
+		Spec: toolchainv1alpha1.MasterUserRecordSpec{
+			TierName: "deactivate30",
+			PropagatedClaims: toolchainv1alpha1.PropagatedClaims{
+				Sub:         userID,
+				UserID:      userID,
+				AccountID:   uuid.Must(uuid.NewV4()).String(),
+				OriginalSub: "original-sub-" + userID,
+				Email:       name + "@acme.com",
+			},
+		},
+	}
+	for _, apply := range opts {
+		apply(mur)
+	}
+	return mur
+}
+
+// CreateMasterUserRecord creates a new MasterUserRecord (see NewMasterUserRecord) in the host namespace, and registers it for cleanup
+func CreateMasterUserRecord(t *testing.T, hostAwait *wait.HostAwaitility, opts ...MasterUserRecordOption) *toolchainv1alpha1.MasterUserRecord {
+	mur := NewMasterUserRecord(t, hostAwait.Namespace, opts...)
+	err := hostAwait.CreateWithCleanup(t, mur)
+	require.NoError(t, err)
+	t.Logf("MasterUserRecord '%s' created", mur.Name)
+	return mur
+}
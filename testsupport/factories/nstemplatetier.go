@@ -0,0 +1,73 @@
+package factories
+
+import (
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NSTemplateTierOption an option to configure the NSTemplateTier created by NewNSTemplateTier
+type NSTemplateTierOption func(*toolchainv1alpha1.NSTemplateTier)
+
+// NSTemplateTierNamespaces sets the namespaces with the given TierTemplate refs
+func NSTemplateTierNamespaces(templateRefs ...string) NSTemplateTierOption {
+	return func(tier *toolchainv1alpha1.NSTemplateTier) {
+		tier.Spec.Namespaces = make([]toolchainv1alpha1.NSTemplateTierNamespace, len(templateRefs))
+		for i, ref := range templateRefs {
+			tier.Spec.Namespaces[i] = toolchainv1alpha1.NSTemplateTierNamespace{TemplateRef: ref}
+		}
+	}
+}
+
+// NSTemplateTierClusterResources sets the cluster resources with the given TierTemplate ref
+func NSTemplateTierClusterResources(templateRef string) NSTemplateTierOption {
+	return func(tier *toolchainv1alpha1.NSTemplateTier) {
+		tier.Spec.ClusterResources = &toolchainv1alpha1.NSTemplateTierClusterResources{
+			TemplateRef: templateRef,
+		}
+	}
+}
+
+// NSTemplateTierSpaceRole sets the space role with the given name and TierTemplate ref
+func NSTemplateTierSpaceRole(role, templateRef string) NSTemplateTierOption {
+	return func(tier *toolchainv1alpha1.NSTemplateTier) {
+		if tier.Spec.SpaceRoles == nil {
+			tier.Spec.SpaceRoles = map[string]toolchainv1alpha1.NSTemplateTierSpaceRole{}
+		}
+		tier.Spec.SpaceRoles[role] = toolchainv1alpha1.NSTemplateTierSpaceRole{
+			TemplateRef: templateRef,
+		}
+	}
+}
+
+// NewNSTemplateTier returns a new NSTemplateTier with a unique name and without any namespace, cluster resources
+// or space roles, unless specified otherwise with the options
+func NewNSTemplateTier(t *testing.T, namespace string, opts ...NSTemplateTierOption) *toolchainv1alpha1.NSTemplateTier {
+	tier := &toolchainv1alpha1.NSTemplateTier{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      uniqueName(t),
+		},
+		Spec: toolchainv1alpha1.NSTemplateTierSpec{
+			Namespaces: []toolchainv1alpha1.NSTemplateTierNamespace{},
+		},
+	}
+	for _, apply := range opts {
+		apply(tier)
+	}
+	return tier
+}
+
+// CreateNSTemplateTier creates a new NSTemplateTier (see NewNSTemplateTier) in the host namespace, and registers it for cleanup.
+// Note: the TierTemplates referred to by the options must exist in the host namespace
+func CreateNSTemplateTier(t *testing.T, hostAwait *wait.HostAwaitility, opts ...NSTemplateTierOption) *toolchainv1alpha1.NSTemplateTier {
+	tier := NewNSTemplateTier(t, hostAwait.Namespace, opts...)
+	err := hostAwait.CreateWithCleanup(t, tier)
+	require.NoError(t, err)
+	t.Logf("NSTemplateTier '%s' created", tier.Name)
+	return tier
+}
@@ -0,0 +1,50 @@
+package factories
+
+import (
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProxyPluginOption an option to configure the ProxyPlugin created by NewProxyPlugin
+type ProxyPluginOption func(*toolchainv1alpha1.ProxyPlugin)
+
+// ProxyPluginName sets the name, which is also the path segment of the plugin in the proxy URL
+func ProxyPluginName(name string) ProxyPluginOption {
+	return func(plugin *toolchainv1alpha1.ProxyPlugin) {
+		plugin.Name = name
+	}
+}
+
+// NewProxyPlugin returns a new ProxyPlugin with a unique name, which targets the route with the given namespace and name
+func NewProxyPlugin(t *testing.T, namespace, routeNamespace, routeName string, opts ...ProxyPluginOption) *toolchainv1alpha1.ProxyPlugin {
+	plugin := &toolchainv1alpha1.ProxyPlugin{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      uniqueName(t),
+		},
+		Spec: toolchainv1alpha1.ProxyPluginSpec{
+			OpenShiftRouteTargetEndpoint: &toolchainv1alpha1.OpenShiftRouteTarget{
+				Namespace: routeNamespace,
+				Name:      routeName,
+			},
+		},
+	}
+	for _, apply := range opts {
+		apply(plugin)
+	}
+	return plugin
+}
+
+// CreateProxyPlugin creates a new ProxyPlugin (see NewProxyPlugin) in the host namespace, and registers it for cleanup
+func CreateProxyPlugin(t *testing.T, hostAwait *wait.HostAwaitility, routeNamespace, routeName string, opts ...ProxyPluginOption) *toolchainv1alpha1.ProxyPlugin {
+	plugin := NewProxyPlugin(t, hostAwait.Namespace, routeNamespace, routeName, opts...)
+	err := hostAwait.CreateWithCleanup(t, plugin)
+	require.NoError(t, err)
+	t.Logf("ProxyPlugin '%s' created", plugin.Name)
+	return plugin
+}
@@ -0,0 +1,82 @@
+package factories
+
+import (
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	commonsocialevent "github.com/codeready-toolchain/toolchain-common/pkg/socialevent"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SocialEventOption an option to configure the SocialEvent created by NewSocialEvent
+type SocialEventOption func(*toolchainv1alpha1.SocialEvent)
+
+// SocialEventUserTier sets the name of the UserTier
+func SocialEventUserTier(tierName string) SocialEventOption {
+	return func(event *toolchainv1alpha1.SocialEvent) {
+		event.Spec.UserTier = tierName
+	}
+}
+
+// SocialEventSpaceTier sets the name of the NSTemplateTier
+func SocialEventSpaceTier(tierName string) SocialEventOption {
+	return func(event *toolchainv1alpha1.SocialEvent) {
+		event.Spec.SpaceTier = tierName
+	}
+}
+
+// SocialEventStartTime sets the start time
+func SocialEventStartTime(startTime time.Time) SocialEventOption {
+	return func(event *toolchainv1alpha1.SocialEvent) {
+		event.Spec.StartTime = metav1.NewTime(startTime)
+	}
+}
+
+// SocialEventEndTime sets the end time
+func SocialEventEndTime(endTime time.Time) SocialEventOption {
+	return func(event *toolchainv1alpha1.SocialEvent) {
+		event.Spec.EndTime = metav1.NewTime(endTime)
+	}
+}
+
+// SocialEventMaxAttendees sets the max number of attendees
+func SocialEventMaxAttendees(maxAttendees int) SocialEventOption {
+	return func(event *toolchainv1alpha1.SocialEvent) {
+		event.Spec.MaxAttendees = maxAttendees
+	}
+}
+
+// NewSocialEvent returns a new SocialEvent with a unique activation code as its name, the `deactivate30` user tier,
+// the `base` space tier and 10 attendees max, which started 1 hour ago and ends in 1 hour
+func NewSocialEvent(namespace string, opts ...SocialEventOption) *toolchainv1alpha1.SocialEvent {
+	event := &toolchainv1alpha1.SocialEvent{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      commonsocialevent.NewName(),
+		},
+		Spec: toolchainv1alpha1.SocialEventSpec{
+			UserTier:     "deactivate30",
+			SpaceTier:    "base",
+			MaxAttendees: 10,
+			StartTime:    metav1.NewTime(time.Now().Add(-1 * time.Hour)),
+			EndTime:      metav1.NewTime(time.Now().Add(1 * time.Hour)),
+		},
+	}
+	for _, apply := range opts {
+		apply(event)
+	}
+	return event
+}
+
+// CreateSocialEvent creates a new SocialEvent (see NewSocialEvent) in the host namespace, and registers it for cleanup
+func CreateSocialEvent(t *testing.T, hostAwait *wait.HostAwaitility, opts ...SocialEventOption) *toolchainv1alpha1.SocialEvent {
+	event := NewSocialEvent(hostAwait.Namespace, opts...)
+	err := hostAwait.CreateWithCleanup(t, event)
+	require.NoError(t, err)
+	t.Logf("SocialEvent '%s' created", event.Name)
+	return event
+}
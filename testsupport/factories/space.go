@@ -0,0 +1,78 @@
+package factories
+
+import (
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SpaceOption an option to configure the Space created by NewSpace
+type SpaceOption func(*toolchainv1alpha1.Space)
+
+// SpaceTargetCluster sets the target cluster in the spec
+func SpaceTargetCluster(targetCluster string) SpaceOption {
+	return func(space *toolchainv1alpha1.Space) {
+		space.Spec.TargetCluster = targetCluster
+	}
+}
+
+// SpaceTier sets the name of the NSTemplateTier
+func SpaceTier(tierName string) SpaceOption {
+	return func(space *toolchainv1alpha1.Space) {
+		space.Spec.TierName = tierName
+	}
+}
+
+// SpaceParent sets the name of the parent Space
+func SpaceParent(parentSpace string) SpaceOption {
+	return func(space *toolchainv1alpha1.Space) {
+		space.Spec.ParentSpace = parentSpace
+	}
+}
+
+// SpaceCreator sets the creator label with the given name (eg: of a UserSignup)
+func SpaceCreator(creator string) SpaceOption {
+	return func(space *toolchainv1alpha1.Space) {
+		space.Labels[toolchainv1alpha1.SpaceCreatorLabelKey] = creator
+	}
+}
+
+// SpaceLabel sets the given label
+func SpaceLabel(key, value string) SpaceOption {
+	return func(space *toolchainv1alpha1.Space) {
+		space.Labels[key] = value
+	}
+}
+
+// NewSpace returns a new Space with a unique name and the `base` tier. The Space has no target cluster,
+// unless specified otherwise with the options
+func NewSpace(t *testing.T, namespace string, opts ...SpaceOption) *toolchainv1alpha1.Space {
+	space := &toolchainv1alpha1.Space{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      uniqueName(t),
+			Labels:    map[string]string{},
+		},
+		Spec: toolchainv1alpha1.SpaceSpec{
+			TierName: "base",
+		},
+	}
+	for _, apply := range opts {
+		apply(space)
+	}
+	return space
+}
+
+// CreateSpace creates a new Space (see NewSpace) in the host namespace, and registers it for cleanup.
+// Note: a Space without any SpaceBinding is eventually deleted by the host operator, see CreateSpaceBinding
+func CreateSpace(t *testing.T, hostAwait *wait.HostAwaitility, opts ...SpaceOption) *toolchainv1alpha1.Space {
+	space := NewSpace(t, hostAwait.Namespace, opts...)
+	err := hostAwait.CreateWithCleanup(t, space)
+	require.NoError(t, err)
+	t.Logf("Space '%s' created", space.Name)
+	return space
+}
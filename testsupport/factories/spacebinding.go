@@ -0,0 +1,61 @@
+package factories
+
+import (
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SpaceBindingOption an option to configure the SpaceBinding created by NewSpaceBinding
+type SpaceBindingOption func(*toolchainv1alpha1.SpaceBinding)
+
+// SpaceBindingRole sets the space role
+func SpaceBindingRole(spaceRole string) SpaceBindingOption {
+	return func(binding *toolchainv1alpha1.SpaceBinding) {
+		binding.Spec.SpaceRole = spaceRole
+	}
+}
+
+// SpaceBindingLabel sets the given label
+func SpaceBindingLabel(key, value string) SpaceBindingOption {
+	return func(binding *toolchainv1alpha1.SpaceBinding) {
+		binding.Labels[key] = value
+	}
+}
+
+// NewSpaceBinding returns a new SpaceBinding with a unique name, which grants the `admin` role in the given Space
+// to the given MasterUserRecord
+func NewSpaceBinding(t *testing.T, mur *toolchainv1alpha1.MasterUserRecord, space *toolchainv1alpha1.Space, opts ...SpaceBindingOption) *toolchainv1alpha1.SpaceBinding {
+	binding := &toolchainv1alpha1.SpaceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: space.Namespace,
+			Name:      uniqueName(t),
+			Labels: map[string]string{
+				toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey: mur.Name,
+				toolchainv1alpha1.SpaceBindingSpaceLabelKey:            space.Name,
+			},
+		},
+		Spec: toolchainv1alpha1.SpaceBindingSpec{
+			MasterUserRecord: mur.Name,
+			Space:            space.Name,
+			SpaceRole:        "admin",
+		},
+	}
+	for _, apply := range opts {
+		apply(binding)
+	}
+	return binding
+}
+
+// CreateSpaceBinding creates a new SpaceBinding (see NewSpaceBinding) in the host namespace, and registers it for cleanup
+func CreateSpaceBinding(t *testing.T, hostAwait *wait.HostAwaitility, mur *toolchainv1alpha1.MasterUserRecord, space *toolchainv1alpha1.Space, opts ...SpaceBindingOption) *toolchainv1alpha1.SpaceBinding {
+	binding := NewSpaceBinding(t, mur, space, opts...)
+	err := hostAwait.CreateWithCleanup(t, binding)
+	require.NoError(t, err)
+	t.Logf("SpaceBinding '%s' created", binding.Name)
+	return binding
+}
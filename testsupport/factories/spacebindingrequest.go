@@ -0,0 +1,50 @@
+package factories
+
+import (
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SpaceBindingRequestOption an option to configure the SpaceBindingRequest created by NewSpaceBindingRequest
+type SpaceBindingRequestOption func(*toolchainv1alpha1.SpaceBindingRequest)
+
+// SpaceBindingRequestRole sets the space role
+func SpaceBindingRequestRole(spaceRole string) SpaceBindingRequestOption {
+	return func(request *toolchainv1alpha1.SpaceBindingRequest) {
+		request.Spec.SpaceRole = spaceRole
+	}
+}
+
+// NewSpaceBindingRequest returns a new SpaceBindingRequest with a unique name, which grants the `admin` role
+// to the given MasterUserRecord
+func NewSpaceBindingRequest(t *testing.T, namespace, mur string, opts ...SpaceBindingRequestOption) *toolchainv1alpha1.SpaceBindingRequest {
+	request := &toolchainv1alpha1.SpaceBindingRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      uniqueName(t),
+		},
+		Spec: toolchainv1alpha1.SpaceBindingRequestSpec{
+			MasterUserRecord: mur,
+			SpaceRole:        "admin",
+		},
+	}
+	for _, apply := range opts {
+		apply(request)
+	}
+	return request
+}
+
+// CreateSpaceBindingRequest creates a new SpaceBindingRequest (see NewSpaceBindingRequest) in the given namespace
+// of the member cluster, and registers it for cleanup. The namespace must be one of the namespaces provisioned for a Space
+func CreateSpaceBindingRequest(t *testing.T, memberAwait *wait.MemberAwaitility, namespace, mur string, opts ...SpaceBindingRequestOption) *toolchainv1alpha1.SpaceBindingRequest {
+	request := NewSpaceBindingRequest(t, namespace, mur, opts...)
+	err := memberAwait.CreateWithCleanup(t, request)
+	require.NoError(t, err)
+	t.Logf("SpaceBindingRequest '%s' created in namespace '%s'", request.Name, request.Namespace)
+	return request
+}
@@ -0,0 +1,57 @@
+package factories
+
+import (
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-common/pkg/cluster"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SpaceRequestOption an option to configure the SpaceRequest created by NewSpaceRequest
+type SpaceRequestOption func(*toolchainv1alpha1.SpaceRequest)
+
+// SpaceRequestTier sets the name of the NSTemplateTier
+func SpaceRequestTier(tierName string) SpaceRequestOption {
+	return func(request *toolchainv1alpha1.SpaceRequest) {
+		request.Spec.TierName = tierName
+	}
+}
+
+// SpaceRequestTargetClusterRoles sets the cluster roles used to select the target cluster
+func SpaceRequestTargetClusterRoles(clusterRoles ...string) SpaceRequestOption {
+	return func(request *toolchainv1alpha1.SpaceRequest) {
+		request.Spec.TargetClusterRoles = clusterRoles
+	}
+}
+
+// NewSpaceRequest returns a new SpaceRequest with a unique name and the `appstudio-env` tier, targeting the tenant clusters
+func NewSpaceRequest(t *testing.T, namespace string, opts ...SpaceRequestOption) *toolchainv1alpha1.SpaceRequest {
+	request := &toolchainv1alpha1.SpaceRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      uniqueName(t),
+		},
+		Spec: toolchainv1alpha1.SpaceRequestSpec{
+			TierName:           "appstudio-env",
+			TargetClusterRoles: []string{cluster.RoleLabel(cluster.Tenant)},
+		},
+	}
+	for _, apply := range opts {
+		apply(request)
+	}
+	return request
+}
+
+// CreateSpaceRequest creates a new SpaceRequest (see NewSpaceRequest) in the given namespace of the member cluster,
+// and registers it for cleanup. The namespace must be one of the namespaces provisioned for a Space
+func CreateSpaceRequest(t *testing.T, memberAwait *wait.MemberAwaitility, namespace string, opts ...SpaceRequestOption) *toolchainv1alpha1.SpaceRequest {
+	request := NewSpaceRequest(t, namespace, opts...)
+	err := memberAwait.CreateWithCleanup(t, request)
+	require.NoError(t, err)
+	t.Logf("SpaceRequest '%s' created in namespace '%s'", request.Name, request.Namespace)
+	return request
+}
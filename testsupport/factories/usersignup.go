@@ -0,0 +1,114 @@
+package factories
+
+import (
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-common/pkg/hash"
+	"github.com/codeready-toolchain/toolchain-common/pkg/states"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UserSignupOption an option to configure the UserSignup created by NewUserSignup
+type UserSignupOption func(*toolchainv1alpha1.UserSignup)
+
+// UserSignupUsername sets the username in the spec and in the identity claims
+func UserSignupUsername(username string) UserSignupOption {
+	return func(signup *toolchainv1alpha1.UserSignup) {
+		signup.Spec.Username = username
+		signup.Spec.IdentityClaims.PreferredUsername = username
+	}
+}
+
+// UserSignupEmail sets the email in the identity claims, along with the corresponding annotation and hash label
+func UserSignupEmail(email string) UserSignupOption {
+	return func(signup *toolchainv1alpha1.UserSignup) {
+		signup.Annotations[toolchainv1alpha1.UserSignupUserEmailAnnotationKey] = email
+		signup.Labels[toolchainv1alpha1.UserSignupUserEmailHashLabelKey] = hash.EncodeString(email)
+		signup.Spec.IdentityClaims.Email = email
+	}
+}
+
+// UserSignupApproved marks the UserSignup as manually approved
+func UserSignupApproved() UserSignupOption {
+	return func(signup *toolchainv1alpha1.UserSignup) {
+		states.SetApprovedManually(signup, true)
+	}
+}
+
+// UserSignupVerificationRequired marks the UserSignup as requiring the phone verification
+func UserSignupVerificationRequired() UserSignupOption {
+	return func(signup *toolchainv1alpha1.UserSignup) {
+		states.SetVerificationRequired(signup, true)
+	}
+}
+
+// UserSignupTargetCluster sets the target cluster in the spec
+func UserSignupTargetCluster(targetCluster string) UserSignupOption {
+	return func(signup *toolchainv1alpha1.UserSignup) {
+		signup.Spec.TargetCluster = targetCluster
+	}
+}
+
+// UserSignupLabel sets the given label
+func UserSignupLabel(key, value string) UserSignupOption {
+	return func(signup *toolchainv1alpha1.UserSignup) {
+		signup.Labels[key] = value
+	}
+}
+
+// UserSignupAnnotation sets the given annotation
+func UserSignupAnnotation(key, value string) UserSignupOption {
+	return func(signup *toolchainv1alpha1.UserSignup) {
+		signup.Annotations[key] = value
+	}
+}
+
+// NewUserSignup returns a new UserSignup with a unique name, and the username, email and identity claims derived from this name.
+// The UserSignup is neither approved nor requires verification, unless specified otherwise with the options
+func NewUserSignup(t *testing.T, namespace string, opts ...UserSignupOption) *toolchainv1alpha1.UserSignup {
+	name := uniqueName(t)
+	userID := uuid.Must(uuid.NewV4()).String()
+	signup := &toolchainv1alpha1.UserSignup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        name,
+			Annotations: map[string]string{},
+			Labels:      map[string]string{},
+		},
+		Spec: toolchainv1alpha1.UserSignupSpec{
+			Userid:      userID,
+			OriginalSub: "original-sub-" + userID,
+			IdentityClaims: toolchainv1alpha1.IdentityClaimsEmbedded{
+				PropagatedClaims: toolchainv1alpha1.PropagatedClaims{
+					Sub:         userID,
+					UserID:      userID,
+					AccountID:   uuid.Must(uuid.NewV4()).String(),
+					OriginalSub: "original-sub-" + userID,
+				},
+				GivenName:  "Reginald",
+				FamilyName: "Smith",
+				Company:    "Alpha Bravo",
+			},
+		},
+	}
+	UserSignupUsername(name)(signup)
+	UserSignupEmail(name + "@acme.com")(signup)
+	for _, apply := range opts {
+		apply(signup)
+	}
+	return signup
+}
+
+// CreateUserSignup creates a new UserSignup (see NewUserSignup) in the host namespace, and registers it for cleanup
+func CreateUserSignup(t *testing.T, hostAwait *wait.HostAwaitility, opts ...UserSignupOption) *toolchainv1alpha1.UserSignup {
+	signup := NewUserSignup(t, hostAwait.Namespace, opts...)
+	err := hostAwait.CreateWithCleanup(t, signup)
+	require.NoError(t, err)
+	t.Logf("UserSignup '%s' created", signup.Name)
+	return signup
+}
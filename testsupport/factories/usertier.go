@@ -0,0 +1,47 @@
+package factories
+
+import (
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UserTierOption an option to configure the UserTier created by NewUserTier
+type UserTierOption func(*toolchainv1alpha1.UserTier)
+
+// UserTierDeactivationTimeoutDays sets the number of days after which the users are deactivated
+func UserTierDeactivationTimeoutDays(days int) UserTierOption {
+	return func(tier *toolchainv1alpha1.UserTier) {
+		tier.Spec.DeactivationTimeoutDays = days
+	}
+}
+
+// NewUserTier returns a new UserTier with a unique name and a deactivation timeout of 30 days
+func NewUserTier(t *testing.T, namespace string, opts ...UserTierOption) *toolchainv1alpha1.UserTier {
+	tier := &toolchainv1alpha1.UserTier{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      uniqueName(t),
+		},
+		Spec: toolchainv1alpha1.UserTierSpec{
+			DeactivationTimeoutDays: 30,
+		},
+	}
+	for _, apply := range opts {
+		apply(tier)
+	}
+	return tier
+}
+
+// CreateUserTier creates a new UserTier (see NewUserTier) in the host namespace, and registers it for cleanup
+func CreateUserTier(t *testing.T, hostAwait *wait.HostAwaitility, opts ...UserTierOption) *toolchainv1alpha1.UserTier {
+	tier := NewUserTier(t, hostAwait.Namespace, opts...)
+	err := hostAwait.CreateWithCleanup(t, tier)
+	require.NoError(t, err)
+	t.Logf("UserTier '%s' created", tier.Name)
+	return tier
+}
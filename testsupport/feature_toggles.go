@@ -0,0 +1,82 @@
+package testsupport
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/factories"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// FeatureTogglesAnnotationKey the annotation set on the Spaces, which contains the comma-separated list of the features enabled for the Space
+const FeatureTogglesAnnotationKey = "toolchain.dev.openshift.com/feature-toggles"
+
+// FeatureToggle a feature which is enabled for the given percentage of the new Spaces
+type FeatureToggle struct {
+	Name   string `json:"name"`
+	Weight uint   `json:"weight"`
+}
+
+// SetFeatureToggles configures the given feature toggles in the ToolchainConfig, and removes them at the end of the test
+func SetFeatureToggles(t *testing.T, hostAwait *wait.HostAwaitility, toggles ...FeatureToggle) {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"host": map[string]interface{}{
+				"tiers": map[string]interface{}{
+					"featureToggles": toggles,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	hostAwait.PatchToolchainConfig(t, string(patch))
+	t.Cleanup(func() {
+		hostAwait.PatchToolchainConfig(t, `{"spec":{"host":{"tiers":{"featureToggles":null}}}}`)
+	})
+}
+
+// FeatureToggleBounds returns the min and max number of Spaces (out of `n`) which can be expected to have a feature enabled
+// with the given weight (in percent), ie, the mean of the binomial distribution +/- 3 standard deviations
+func FeatureToggleBounds(n int, weight uint) (int, int) {
+	p := math.Min(float64(weight), 100) / 100
+	mean := float64(n) * p
+	stddev := math.Sqrt(float64(n) * p * (1 - p))
+	min := int(math.Max(0, math.Floor(mean-3*stddev)))
+	max := int(math.Min(float64(n), math.Ceil(mean+3*stddev)))
+	return min, max
+}
+
+// VerifyFeatureToggleDistribution provisions `n` users (and their Spaces), and verifies that the number of Spaces which have
+// the given feature enabled is within the statistical bounds of the given weight (see FeatureToggleBounds).
+// Returns the UserSignups of the provisioned users.
+func VerifyFeatureToggleDistribution(t *testing.T, hostAwait *wait.HostAwaitility, feature string, weight uint, n int) []*toolchainv1alpha1.UserSignup {
+	batches := CreateSignupsInBatches(t, hostAwait, n, 10, time.Second, factories.UserSignupApproved())
+	WaitForAllSignupsReady(t, hostAwait, batches.All(), 5*time.Minute)
+
+	enabled := 0
+	for _, userSignup := range batches.All() {
+		signup := &toolchainv1alpha1.UserSignup{}
+		err := hostAwait.Client.Get(context.TODO(), types.NamespacedName{Namespace: hostAwait.Namespace, Name: userSignup.Name}, signup)
+		require.NoError(t, err)
+		space, err := hostAwait.WaitForSpace(t, signup.Status.CompliantUsername)
+		require.NoError(t, err)
+		for _, f := range strings.Split(space.Annotations[FeatureTogglesAnnotationKey], ",") {
+			if f == feature {
+				enabled++
+				break
+			}
+		}
+	}
+	min, max := FeatureToggleBounds(n, weight)
+	t.Logf("feature '%s' with weight %d%% enabled in %d Space(s) out of %d (expected between %d and %d)", feature, weight, enabled, n, min, max)
+	require.True(t, enabled >= min && enabled <= max, "feature '%s' with weight %d%% enabled in %d Space(s) out of %d, expected between %d and %d", feature, weight, enabled, n, min, max)
+	return batches.All()
+}
@@ -0,0 +1,53 @@
+package testsupport_test
+
+import (
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeatureToggleBounds(t *testing.T) {
+
+	for name, tc := range map[string]struct {
+		n           int
+		weight      uint
+		expectedMin int
+		expectedMax int
+	}{
+		"never enabled": {
+			n: 30, weight: 0,
+			expectedMin: 0, expectedMax: 0,
+		},
+		"always enabled": {
+			n: 30, weight: 100,
+			expectedMin: 30, expectedMax: 30,
+		},
+		"weight above 100": {
+			n: 30, weight: 150,
+			expectedMin: 30, expectedMax: 30,
+		},
+		"half enabled": {
+			n: 100, weight: 50, // mean=50, stddev=5
+			expectedMin: 35, expectedMax: 65,
+		},
+		"lower bound capped at 0": {
+			n: 30, weight: 10, // mean=3, stddev≈1.64
+			expectedMin: 0, expectedMax: 8,
+		},
+		"no space": {
+			n: 0, weight: 50,
+			expectedMin: 0, expectedMax: 0,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			min, max := testsupport.FeatureToggleBounds(tc.n, tc.weight)
+
+			// then
+			assert.Equal(t, tc.expectedMin, min)
+			assert.Equal(t, tc.expectedMax, max)
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package httpclient
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
+)
+
+// DefaultTimeout the default timeout of the requests, including the retries
+const DefaultTimeout = 10 * time.Second
+
+// Logger the logger of the requests and responses, eg: the `*testing.T` of the test (or a `wait.T`)
+type Logger interface {
+	Logf(format string, args ...interface{})
+}
+
+// TokenFunc returns the bearer token to set on the requests
+type TokenFunc func() (string, error)
+
+// Option an option to configure the client returned by New
+type Option func(*config)
+
+type config struct {
+	timeout    time.Duration
+	transport  http.RoundTripper
+	insecure   bool
+	tokens     TokenProvider
+	maxRetries int
+	retryDelay time.Duration
+	logger     Logger
+}
+
+// WithTimeout configures the timeout of the requests, including the retries (see DefaultTimeout)
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		c.timeout = timeout
+	}
+}
+
+// WithTLSConfig configures the TLS config of the transport of the client, eg: to verify the certificates against the CAs of the
+// cluster (see util.VerifyingTLSConfig). By default, the certificates are verified against the CAs of the system and the CA bundle
+// of the environment (see util.NewVerifyingTransport)
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(c *config) {
+		c.transport = util.NewTransport(tlsConfig)
+	}
+}
+
+// WithInsecureSkipVerify configures the client to not verify the certificates of the servers (see util.NewInsecureTransport),
+// eg: for the routes of a cluster whose ingress certificate is self-signed. It is ignored if a transport is configured with
+// WithTLSConfig or WithTransport.
+func WithInsecureSkipVerify() Option {
+	return func(c *config) {
+		c.insecure = true
+	}
+}
+
+// WithTransport configures the transport of the client, on top of which the token, retries and logging are applied
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *config) {
+		c.transport = transport
+	}
+}
+
+// WithToken configures the bearer token which is set on the requests which have no `Authorization` header yet
+func WithToken(token string) Option {
+	return WithTokenProvider(StaticToken(token))
+}
+
+// WithTokenFunc configures the func which returns the bearer token which is set on the requests which have no `Authorization`
+// header yet. The func is called for each request, so that it can refresh an expired token
+func WithTokenFunc(tokenFunc TokenFunc) Option {
+	return WithTokenProvider(TokenProviderFunc(func(_ context.Context) (string, error) {
+		return tokenFunc()
+	}))
+}
+
+// WithTokenProvider configures the provider of the bearer token which is set on the requests which have no `Authorization` header
+// yet (see TokenProvider). The provider is called for each request, so that it can refresh an expired token
+func WithTokenProvider(tokens TokenProvider) Option {
+	return func(c *config) {
+		c.tokens = tokens
+	}
+}
+
+// WithRetries configures the client to send the requests again (at most the given number of times) when the response has the
+// `502 Bad Gateway` or `503 Service Unavailable` status, eg: while the pods behind a route are restarting. The client waits for
+// the duration of the `Retry-After` header of the response, if any, or for the given delay otherwise (or DefaultRetryDelay if the given delay is not positive)
+func WithRetries(maxRetries int, delay time.Duration) Option {
+	return func(c *config) {
+		c.maxRetries = maxRetries
+		c.retryDelay = delay
+	}
+}
+
+// WithLogger configures the client to log the requests and the responses (but not their headers nor bodies, which may contain
+// credentials) with the given logger, eg: the `*testing.T` of the test
+func WithLogger(logger Logger) Option {
+	return func(c *config) {
+		c.logger = logger
+	}
+}
+
+// New returns a new client with the given options. By default, the client has the DefaultTimeout, verifies the certificates of the
+// servers (see util.NewVerifyingTransport and WithInsecureSkipVerify), does not set any token, does not retry the requests and does not log them.
+func New(opts ...Option) *http.Client {
+	c := &config{
+		timeout: DefaultTimeout,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	transport := c.transport
+	if transport == nil {
+		if c.insecure {
+			transport = util.NewInsecureTransport()
+		} else {
+			transport = util.NewVerifyingTransport()
+		}
+	}
+	if c.tokens != nil {
+		transport = NewTokenTransport(transport, c.tokens)
+	}
+	if c.logger != nil {
+		// log each attempt, including the retried ones
+		transport = &loggingTransport{
+			next:   transport,
+			logger: c.logger,
+		}
+	}
+	if c.maxRetries > 0 {
+		if c.retryDelay <= 0 {
+			c.retryDelay = DefaultRetryDelay
+		}
+		transport = &retryTransport{
+			next:       transport,
+			maxRetries: c.maxRetries,
+			delay:      c.retryDelay,
+			logger:     c.logger,
+		}
+	}
+	return &http.Client{
+		Timeout:   c.timeout,
+		Transport: transport,
+	}
+}
+
+// NewTokenTransport returns a transport which sets the bearer token of the given provider on the requests which have no `Authorization`
+// header, before sending them with the given transport, eg: as the `WrapTransport` of a `rest.Config`
+func NewTokenTransport(next http.RoundTripper, tokens TokenProvider) http.RoundTripper {
+	return &tokenTransport{
+		next:   next,
+		tokens: tokens,
+	}
+}
+
+// tokenTransport sets the bearer token on the requests which have no `Authorization` header
+type tokenTransport struct {
+	next   http.RoundTripper
+	tokens TokenProvider
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Authorization") != "" {
+		return t.next.RoundTrip(req)
+	}
+	token, err := t.tokens.Token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("unable to obtain a token for '%s': %w", req.URL, err)
+	}
+	if token == "" {
+		return t.next.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return t.next.RoundTrip(req)
+}
+
+// loggingTransport logs the method and URL of the requests, and the status and duration of their responses
+type loggingTransport struct {
+	next   http.RoundTripper
+	logger Logger
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.logger.Logf("sending request %s %s", req.Method, req.URL)
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		t.logger.Logf("request %s %s failed after %s: %s", req.Method, req.URL, time.Since(start).Round(time.Millisecond), err)
+		return nil, err
+	}
+	t.logger.Logf("received response %s from %s %s after %s", resp.Status, req.Method, req.URL, time.Since(start).Round(time.Millisecond))
+	return resp, nil
+}
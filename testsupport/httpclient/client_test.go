@@ -0,0 +1,214 @@
+package httpclient_test
+
+import (
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/httpclient"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type logger struct {
+	lines []string
+}
+
+func (l *logger) Logf(format string, args ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, args...))
+}
+
+func TestClient(t *testing.T) {
+	// given
+	var unavailable int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&unavailable, -1) >= 0 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		fmt.Fprintf(w, "%s %s", r.Header.Get("Authorization"), body)
+	}))
+	defer server.Close()
+	post := func(t *testing.T, client *http.Client, header string) (*http.Response, string) {
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/api/v1/signup", strings.NewReader("payload"))
+		require.NoError(t, err)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, string(body)
+	}
+
+	t.Run("token", func(t *testing.T) {
+		// given
+		atomic.StoreInt32(&unavailable, 0)
+		client := httpclient.New(httpclient.WithToken("token"), httpclient.WithInsecureSkipVerify())
+
+		t.Run("injected", func(t *testing.T) {
+			// when
+			_, body := post(t, client, "")
+
+			// then
+			assert.Equal(t, "Bearer token payload", body)
+		})
+
+		t.Run("not overridden", func(t *testing.T) {
+			// when
+			_, body := post(t, client, "Bearer other")
+
+			// then
+			assert.Equal(t, "Bearer other payload", body)
+		})
+
+		t.Run("token func error", func(t *testing.T) {
+			// given
+			client := httpclient.New(httpclient.WithTokenFunc(func() (string, error) {
+				return "", fmt.Errorf("expired")
+			}))
+
+			// when
+			_, err := client.Get(server.URL)
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "unable to obtain a token for '"+server.URL+"': expired")
+		})
+	})
+
+	t.Run("retries", func(t *testing.T) {
+		t.Run("until available", func(t *testing.T) {
+			// given
+			atomic.StoreInt32(&unavailable, 2)
+			l := &logger{}
+			client := httpclient.New(httpclient.WithToken("token"), httpclient.WithRetries(3, time.Millisecond), httpclient.WithLogger(l),
+				httpclient.WithInsecureSkipVerify())
+
+			// when
+			resp, body := post(t, client, "")
+
+			// then
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, "Bearer token payload", body) // the body was sent again
+			require.Len(t, l.lines, 8)
+			assert.Equal(t, "sending request POST "+server.URL+"/api/v1/signup", l.lines[0])
+			assert.Contains(t, l.lines[1], "received response 503 Service Unavailable from POST "+server.URL+"/api/v1/signup after")
+			assert.Equal(t, "retrying POST "+server.URL+"/api/v1/signup in 0s after response 503 Service Unavailable (attempt 1/3)", l.lines[2])
+			assert.Contains(t, l.lines[7], "received response 200 OK from POST "+server.URL+"/api/v1/signup after")
+		})
+
+		t.Run("too many retries", func(t *testing.T) {
+			// given
+			atomic.StoreInt32(&unavailable, 3)
+			client := httpclient.New(httpclient.WithRetries(2, time.Millisecond), httpclient.WithInsecureSkipVerify())
+
+			// when
+			resp, _ := post(t, client, "")
+
+			// then
+			assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		})
+
+		t.Run("no retries by default", func(t *testing.T) {
+			// given
+			atomic.StoreInt32(&unavailable, 1)
+			client := httpclient.New(httpclient.WithInsecureSkipVerify())
+
+			// when
+			resp, _ := post(t, client, "")
+
+			// then
+			assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		})
+	})
+}
+
+func TestCertificates(t *testing.T) {
+	// given
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	t.Run("verified by default", func(t *testing.T) {
+		// when
+		_, err := httpclient.New().Get(server.URL)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "certificate signed by unknown authority")
+	})
+
+	t.Run("trusted with the CA bundle", func(t *testing.T) {
+		// given
+		trustServer(t, server)
+
+		// when
+		resp, err := httpclient.New().Get(server.URL)
+
+		// then
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("not verified", func(t *testing.T) {
+		// when
+		resp, err := httpclient.New(httpclient.WithInsecureSkipVerify()).Get(server.URL)
+
+		// then
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
+
+// trustServer adds the certificate of the given server to the CA bundle of the transports which verify the certificates, until
+// the end of the given test
+func trustServer(t *testing.T, server *httptest.Server) {
+	bundle := filepath.Join(t.TempDir(), "ca-bundle.crt")
+	require.NoError(t, os.WriteFile(bundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600))
+	util.SetCABundle(func() string {
+		return bundle
+	})
+	t.Cleanup(func() {
+		util.SetCABundle(func() string {
+			return ""
+		})
+	})
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	for value, expected := range map[string]time.Duration{
+		"":                              time.Second,
+		"3":                             3 * time.Second,
+		"3600":                          httpclient.MaxRetryAfter,
+		"Mon, 01 Jan 2024 12:00:05 GMT": 5 * time.Second,
+		"Mon, 01 Jan 2024 11:00:00 GMT": 0,
+		"soon":                          time.Second,
+	} {
+		t.Run(value, func(t *testing.T) {
+			header := http.Header{}
+			if value != "" {
+				header.Set("Retry-After", value)
+			}
+			assert.Equal(t, expected, httpclient.RetryAfter(header, now, time.Second))
+		})
+	}
+}
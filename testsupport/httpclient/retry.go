@@ -0,0 +1,86 @@
+package httpclient
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultRetryDelay the default delay before a request is retried when the response has no `Retry-After` header
+	DefaultRetryDelay = time.Second
+	// MaxRetryAfter the maximum delay before a request is retried, regardless of the `Retry-After` header of the response, so that
+	// a server asking for a long delay does not make the test hang until its timeout
+	MaxRetryAfter = 10 * time.Second
+)
+
+// retryTransport sends the requests again when the response has the `502 Bad Gateway` or `503 Service Unavailable` status
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	delay      time.Duration
+	logger     Logger
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || !retryable(resp.StatusCode) || attempt >= t.maxRetries {
+			return resp, err
+		}
+		// the request can't be sent again if its body can't be read again
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return resp, nil
+		}
+		delay := RetryAfter(resp.Header, time.Now(), t.delay)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		if t.logger != nil {
+			t.logger.Logf("retrying %s %s in %s after response %s (attempt %d/%d)", req.Method, req.URL, delay, resp.Status, attempt+1, t.maxRetries)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+func retryable(status int) bool {
+	return status == http.StatusBadGateway || status == http.StatusServiceUnavailable
+}
+
+// RetryAfter returns the delay of the `Retry-After` header (either a number of seconds or an HTTP date) relative to the given time,
+// capped at MaxRetryAfter, or the given default delay if the header is absent or invalid
+func RetryAfter(header http.Header, now time.Time, defaultDelay time.Duration) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return defaultDelay
+	}
+	var delay time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		delay = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		delay = date.Sub(now)
+	} else {
+		return defaultDelay
+	}
+	if delay < 0 {
+		return 0
+	}
+	if delay > MaxRetryAfter {
+		return MaxRetryAfter
+	}
+	return delay
+}
@@ -0,0 +1,183 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// TokenProvider provides the bearer token of the outbound requests (eg: to the routes, to the registration service or to the proxy).
+// An empty token means that the requests are sent without an `Authorization` header.
+type TokenProvider interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenProviderFunc a func which implements TokenProvider
+type TokenProviderFunc func(ctx context.Context) (string, error)
+
+// Token returns the token returned by the func
+func (f TokenProviderFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticToken a TokenProvider which always provides the same token
+type StaticToken string
+
+// Token returns the static token
+func (s StaticToken) Token(_ context.Context) (string, error) {
+	return string(s), nil
+}
+
+// RestConfigTokenProvider returns a TokenProvider which provides the bearer token that the given config sends to the API server, be
+// it a static token, a token file (which is read again when it changes) or a token obtained with an exec plugin or an auth provider
+// of the kubeconfig. It provides an empty token when the config does not authenticate with a bearer token (eg: with a client
+// certificate), in which case a ServiceAccountTokenProvider can be used instead.
+func RestConfigTokenProvider(config *rest.Config) TokenProvider {
+	var once sync.Once
+	var wrapped http.RoundTripper
+	var wrapErr error
+	return TokenProviderFunc(func(ctx context.Context) (string, error) {
+		if config == nil {
+			return "", nil
+		}
+		// the round trippers of the config are created once, so that the tokens obtained by the exec plugins are cached
+		once.Do(func() {
+			wrapped, wrapErr = rest.HTTPWrappersForConfig(config, captureAuthorization{})
+		})
+		if wrapErr != nil {
+			return "", fmt.Errorf("unable to obtain the credentials of the cluster config: %w", wrapErr)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, config.Host, nil)
+		if err != nil {
+			return "", err
+		}
+		resp, err := wrapped.RoundTrip(req)
+		if err != nil {
+			return "", fmt.Errorf("unable to obtain the credentials of the cluster config: %w", err)
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		authorization := resp.Header.Get("Authorization")
+		if token := strings.TrimPrefix(authorization, "Bearer "); token != authorization {
+			return token, nil
+		}
+		return "", nil
+	})
+}
+
+// captureAuthorization a round tripper which does not send the requests, but responds with their `Authorization` header
+type captureAuthorization struct{}
+
+func (captureAuthorization) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := http.Header{}
+	header.Set("Authorization", req.Header.Get("Authorization"))
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     header,
+		Body:       http.NoBody,
+		Request:    req,
+	}, nil
+}
+
+// ServiceAccountTokenProvider returns a TokenProvider which provides a token of the given ServiceAccount, requested with the
+// TokenRequest API with the given expiration. The token is requested again when 80% of its lifetime has elapsed.
+func ServiceAccountTokenProvider(clientset kubernetes.Interface, namespace, name string, expiration time.Duration) TokenProvider {
+	seconds := int64(expiration.Seconds())
+	return newCachingTokenProvider(func(ctx context.Context) (string, time.Time, error) {
+		tokenRequest, err := clientset.CoreV1().ServiceAccounts(namespace).CreateToken(ctx, name, &authenticationv1.TokenRequest{
+			Spec: authenticationv1.TokenRequestSpec{
+				ExpirationSeconds: &seconds,
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("unable to request a token for the ServiceAccount '%s' in namespace '%s': %w", name, namespace, err)
+		}
+		return tokenRequest.Status.Token, tokenRequest.Status.ExpirationTimestamp.Time, nil
+	})
+}
+
+// PasswordGrantTokenProvider returns a TokenProvider which provides the access token of the given user, obtained from the token
+// endpoint of an OpenID Connect provider (eg: `https://<dev-sso>/auth/realms/<realm>/protocol/openid-connect/token`) with the
+// resource owner password credentials grant, over a connection which verifies the certificate of the provider (see New). The token
+// is requested again when 80% of its lifetime has elapsed.
+func PasswordGrantTokenProvider(tokenURL, clientID, username, password string) TokenProvider {
+	client := New()
+	return newCachingTokenProvider(func(ctx context.Context) (string, time.Time, error) {
+		form := url.Values{
+			"grant_type": {"password"},
+			"client_id":  {clientID},
+			"username":   {username},
+			"password":   {password},
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		resp, err := client.Do(req)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("unable to obtain a token for user '%s': %w", username, err)
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return "", time.Time{}, fmt.Errorf("unable to obtain a token for user '%s': %s: %s", username, resp.Status, body)
+		}
+		token := struct {
+			AccessToken string `json:"access_token"`
+			ExpiresIn   int64  `json:"expires_in"`
+		}{}
+		if err := json.Unmarshal(body, &token); err != nil {
+			return "", time.Time{}, fmt.Errorf("unable to parse the token response for user '%s': %w", username, err)
+		}
+		return token.AccessToken, time.Now().Add(time.Duration(token.ExpiresIn) * time.Second), nil
+	})
+}
+
+// cachingTokenProvider caches the token obtained with its func until 80% of its lifetime has elapsed
+type cachingTokenProvider struct {
+	obtain func(ctx context.Context) (token string, expiresAt time.Time, err error)
+
+	mu        sync.Mutex
+	token     string
+	refreshAt time.Time
+}
+
+func newCachingTokenProvider(obtain func(ctx context.Context) (string, time.Time, error)) *cachingTokenProvider {
+	return &cachingTokenProvider{
+		obtain: obtain,
+	}
+}
+
+func (p *cachingTokenProvider) Token(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	if p.token != "" && now.Before(p.refreshAt) {
+		return p.token, nil
+	}
+	token, expiresAt, err := p.obtain(ctx)
+	if err != nil {
+		return "", err
+	}
+	p.token = token
+	p.refreshAt = now.Add(expiresAt.Sub(now) * 4 / 5)
+	return token, nil
+}
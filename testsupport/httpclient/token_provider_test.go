@@ -0,0 +1,216 @@
+package httpclient_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/httpclient"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestRestConfigTokenProvider(t *testing.T) {
+	t.Run("bearer token", func(t *testing.T) {
+		// given
+		tokens := httpclient.RestConfigTokenProvider(&rest.Config{Host: "https://api.example.com:6443", BearerToken: "token"})
+
+		// when
+		token, err := tokens.Token(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "token", token)
+	})
+
+	t.Run("bearer token file", func(t *testing.T) {
+		// given
+		file := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(file, []byte("token-from-file"), 0o600))
+		tokens := httpclient.RestConfigTokenProvider(&rest.Config{Host: "https://api.example.com:6443", BearerTokenFile: file})
+
+		// when
+		token, err := tokens.Token(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "token-from-file", token)
+	})
+
+	t.Run("no bearer token", func(t *testing.T) {
+		for name, config := range map[string]*rest.Config{
+			"basic auth": {Host: "https://api.example.com:6443", Username: "kubeadmin", Password: "secret"},
+			"nil config": nil,
+		} {
+			t.Run(name, func(t *testing.T) {
+				// when
+				token, err := httpclient.RestConfigTokenProvider(config).Token(context.TODO())
+
+				// then
+				require.NoError(t, err)
+				assert.Empty(t, token)
+			})
+		}
+	})
+}
+
+func TestServiceAccountTokenProvider(t *testing.T) {
+	// given
+	var requests int32
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "token" {
+			return false, nil, nil
+		}
+		n := atomic.AddInt32(&requests, 1)
+		if action.(k8stesting.CreateActionImpl).Name == "unknown" {
+			return true, nil, fmt.Errorf("serviceaccounts \"unknown\" not found")
+		}
+		request := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenRequest)
+		expiration := time.Duration(*request.Spec.ExpirationSeconds) * time.Second
+		request.Status = authenticationv1.TokenRequestStatus{
+			Token:               fmt.Sprintf("token-%d", n),
+			ExpirationTimestamp: metav1.NewTime(time.Now().Add(expiration)),
+		}
+		return true, request, nil
+	})
+
+	t.Run("cached", func(t *testing.T) {
+		// given
+		atomic.StoreInt32(&requests, 0)
+		tokens := httpclient.ServiceAccountTokenProvider(clientset, "toolchain-host-operator", "e2e-tests", time.Hour)
+
+		// when
+		first, err := tokens.Token(context.TODO())
+		require.NoError(t, err)
+		second, err := tokens.Token(context.TODO())
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, "token-1", first)
+		assert.Equal(t, "token-1", second)
+	})
+
+	t.Run("refreshed", func(t *testing.T) {
+		// given
+		atomic.StoreInt32(&requests, 0)
+		tokens := httpclient.ServiceAccountTokenProvider(clientset, "toolchain-host-operator", "e2e-tests", 0)
+
+		// when
+		first, err := tokens.Token(context.TODO())
+		require.NoError(t, err)
+		second, err := tokens.Token(context.TODO())
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, "token-1", first)
+		assert.Equal(t, "token-2", second)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// when
+		_, err := httpclient.ServiceAccountTokenProvider(clientset, "toolchain-host-operator", "unknown", time.Hour).Token(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to request a token for the ServiceAccount 'unknown' in namespace 'toolchain-host-operator'")
+	})
+}
+
+func TestPasswordGrantTokenProvider(t *testing.T) {
+	// given
+	var requests int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		require.NoError(t, r.ParseForm())
+		if r.PostForm.Get("grant_type") != "password" || r.PostForm.Get("client_id") != "sandbox-public" ||
+			r.PostForm.Get("username") != "john" || r.PostForm.Get("password") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error":"invalid_grant"}`)
+			return
+		}
+		fmt.Fprint(w, `{"access_token":"access-token","expires_in":300,"token_type":"Bearer"}`)
+	}))
+	defer server.Close()
+	// the token endpoint is called with a client which verifies the certificates
+	trustServer(t, server)
+	tokenURL := server.URL + "/auth/realms/sandbox-dev/protocol/openid-connect/token"
+
+	t.Run("cached", func(t *testing.T) {
+		// given
+		atomic.StoreInt32(&requests, 0)
+		tokens := httpclient.PasswordGrantTokenProvider(tokenURL, "sandbox-public", "john", "secret")
+
+		// when
+		first, err := tokens.Token(context.TODO())
+		require.NoError(t, err)
+		second, err := tokens.Token(context.TODO())
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, "access-token", first)
+		assert.Equal(t, "access-token", second)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		// when
+		_, err := httpclient.PasswordGrantTokenProvider(tokenURL, "sandbox-public", "john", "wrong").Token(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.EqualError(t, err, `unable to obtain a token for user 'john': 401 Unauthorized: {"error":"invalid_grant"}`)
+	})
+
+	t.Run("used by the client", func(t *testing.T) {
+		// given
+		api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, r.Header.Get("Authorization"))
+		}))
+		defer api.Close()
+		client := httpclient.New(httpclient.WithTokenProvider(httpclient.PasswordGrantTokenProvider(tokenURL, "sandbox-public", "john", "secret")))
+
+		// when
+		resp, err := client.Get(api.URL)
+
+		// then
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body := make([]byte, 64)
+		n, _ := resp.Body.Read(body)
+		assert.Equal(t, "Bearer access-token", string(body[:n]))
+	})
+
+	t.Run("no header with an empty token", func(t *testing.T) {
+		// given
+		api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, found := r.Header["Authorization"]
+			fmt.Fprint(w, found)
+		}))
+		defer api.Close()
+		client := httpclient.New(httpclient.WithTokenProvider(httpclient.StaticToken("")))
+
+		// when
+		resp, err := client.Get(api.URL)
+
+		// then
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body := make([]byte, 8)
+		n, _ := resp.Body.Read(body)
+		assert.Equal(t, "false", string(body[:n]))
+	})
+}
@@ -1,8 +1,8 @@
 package testsupport
 
 import (
+	"context"
 	"fmt"
-	"os"
 	"strings"
 	"sync"
 	"testing"
@@ -20,6 +20,7 @@ import (
 	openshiftappsv1 "github.com/openshift/api/apps/v1"
 	quotav1 "github.com/openshift/api/quota/v1"
 	routev1 "github.com/openshift/api/route/v1"
+	securityv1 "github.com/openshift/api/security/v1"
 	templatev1 "github.com/openshift/api/template/v1"
 	userv1 "github.com/openshift/api/user/v1"
 	"github.com/stretchr/testify/require"
@@ -43,10 +44,11 @@ var (
 // Returns the test context and an instance of Awaitility that contains all necessary information
 func WaitForDeployments(t *testing.T) wait.Awaitilities {
 	initOnce.Do(func() {
-		memberNs := os.Getenv(wait.MemberNsVar)
-		memberNs2 := os.Getenv(wait.MemberNsVar2)
-		hostNs := os.Getenv(wait.HostNsVar)
-		registrationServiceNs := os.Getenv(wait.RegistrationServiceVar)
+		env := wait.CurrentEnvironment()
+		memberNs := env.MemberNs
+		memberNs2 := env.MemberNs2
+		hostNs := env.HostNs
+		registrationServiceNs := env.RegistrationServiceNs
 		t.Logf("Host Operator namespace: %s", hostNs)
 		t.Logf("Member1 Operator namespace: %s", memberNs)
 		t.Logf("Member2 Operator namespace: %s", memberNs2)
@@ -57,6 +59,9 @@ func WaitForDeployments(t *testing.T) wait.Awaitilities {
 
 		kubeconfig, err := util.BuildKubernetesRESTConfig(*apiConfig)
 		require.NoError(t, err)
+		// avoid the client-side throttling when many tests run in parallel (or throttle down on a shared cluster)
+		rateLimits := env.ClientRateLimits
+		rateLimits.ApplyTo(kubeconfig)
 
 		cl, err := client.New(kubeconfig, client.Options{
 			Scheme: schemeWithAllAPIs(t),
@@ -92,25 +97,27 @@ func WaitForDeployments(t *testing.T) wait.Awaitilities {
 		require.NoError(t, err, "failed to find proxy metrics service")
 
 		// wait for member operators to be ready
-		initMemberAwait = getMemberAwaitility(t, cl, initHostAwait, memberNs)
+		var memberToolchainCluster toolchainv1alpha1.ToolchainCluster
+		initMemberAwait, memberToolchainCluster = getMemberAwaitility(t, cl, initHostAwait, memberNs, rateLimits)
 
-		initMember2Await = getMemberAwaitility(t, cl, initHostAwait, memberNs2)
+		var member2ToolchainCluster toolchainv1alpha1.ToolchainCluster
+		initMember2Await, member2ToolchainCluster = getMemberAwaitility(t, cl, initHostAwait, memberNs2, rateLimits)
 
 		hostToolchainCluster, err := initMemberAwait.WaitForToolchainClusterWithCondition(t, "e2e", hostNs, wait.ReadyToolchainCluster)
 		require.NoError(t, err)
 		hostConfig, err := cluster.NewClusterConfig(cl, &hostToolchainCluster, 6*time.Second)
 		require.NoError(t, err)
-		initHostAwait.RestConfig = hostConfig.RestConfig
+		initHostAwait.RestConfig = rateLimits.ApplyTo(hostConfig.RestConfig)
 
-		// setup host metrics route for metrics verification in tests
-		hostMetricsRoute, err := initHostAwait.SetupRouteForService(t, "host-operator-metrics-service", "/metrics")
-		require.NoError(t, err)
-		initHostAwait.MetricsURL = hostMetricsRoute.Status.Ingress[0].Host
+		// setup host metrics route for metrics verification in tests (the metrics are scraped via a port-forward if the route is unavailable)
+		if _, err := initHostAwait.SetupRouteForService(t, "host-operator-metrics-service", "/metrics"); err != nil {
+			t.Logf("unable to set up the route to the 'host-operator-metrics-service' service, falling back to a port-forward: %s", err.Error())
+		}
+		initHostAwait.MetricsClient = initHostAwait.NewMetricsClient("host-operator-metrics-service", toolchainClusterToken(cl, hostToolchainCluster))
 
-		// setup member metrics route for metrics verification in tests
-		memberMetricsRoute, err := initMemberAwait.SetupRouteForService(t, "member-operator-metrics-service", "/metrics")
-		require.NoError(t, err, "failed while setting up or waiting for the route to the 'member-operator-metrics' service to be available")
-		initMemberAwait.MetricsURL = memberMetricsRoute.Status.Ingress[0].Host
+		// setup member metrics for metrics verification in tests
+		initMemberAwait.SetupMetrics(t, toolchainClusterToken(cl, memberToolchainCluster))
+		initMember2Await.SetupMetrics(t, toolchainClusterToken(cl, member2ToolchainCluster))
 
 		_, err = initMemberAwait.WaitForToolchainClusterWithCondition(t, initHostAwait.Type, initHostAwait.Namespace, wait.ReadyToolchainCluster)
 		require.NoError(t, err)
@@ -139,16 +146,56 @@ func WaitForDeployments(t *testing.T) wait.Awaitilities {
 		require.NoError(t, err)
 
 		t.Log("all operators are ready and in running state")
+
+		// fail early if the tests are compiled against API types which don't match the CRDs of the deployed operators
+		VerifyAPISchemas(t, initHostAwait.Awaitility)
+		VerifyAPISchemas(t, initMemberAwait.Awaitility)
+
+		if wait.CachedReadsEnabled() {
+			// serve the repeated reads of the hot resources from a cache, for the whole test suite
+			initHostAwait, err = initHostAwait.WithCachedReads(context.Background())
+			require.NoError(t, err)
+			initMemberAwait, err = initMemberAwait.WithCachedReads(context.Background())
+			require.NoError(t, err)
+			initMember2Await, err = initMember2Await.WithCachedReads(context.Background())
+			require.NoError(t, err)
+		}
+
+		// collect the resource usage of the operators until the end of the test suite (see RunSuite)
+		initResourceUsage = NewResourceUsageReporter(wait.NewAwaitilities(initHostAwait, initMemberAwait, initMember2Await))
+		initResourceUsage.Start(ResourceUsageSamplingInterval)
 	})
 
-	return wait.NewAwaitilities(initHostAwait, initMemberAwait, initMember2Await)
+	awaitilities := wait.NewAwaitilities(initHostAwait, initMemberAwait, initMember2Await)
+	if wait.WaitTelemetryEnabled() {
+		// record the duration of the waits of the test, and report them at its end
+		awaitilities = awaitilities.WithWaitTelemetry(wait.NewWaitTelemetry(t, wait.CurrentEnvironment().ArtifactDir))
+	}
+	if wait.NarrativeEnabled() {
+		// record the major steps of the test, and write them as a readable narrative at its end
+		awaitilities = awaitilities.WithNarrative(wait.NewNarrative(t, wait.CurrentEnvironment().ArtifactDir))
+	}
+	if wait.MetricDeltasEnabled() {
+		// report the metrics which changed during the test, at its end
+		awaitilities.ReportMetricDeltas(t)
+	}
+	// write the logs of the operators since the beginning of the test if it fails, so that the failure can be analyzed post-mortem
+	awaitilities.AttachOperatorLogsOnFailure(t, wait.CurrentEnvironment().ArtifactDir)
+	if wait.MetricArtifactsEnabled() {
+		// export the metrics at the beginning and at the end of the test, and when a metric wait fails
+		awaitilities = awaitilities.WithMetricArtifacts(t, wait.CurrentEnvironment().ArtifactDir)
+	}
+	return awaitilities
 }
 
-func getMemberAwaitility(t *testing.T, cl client.Client, hostAwait *wait.HostAwaitility, namespace string) *wait.MemberAwaitility {
+// getMemberAwaitility returns the MemberAwaitility for the member operator in the given namespace, along with the `e2e` ToolchainCluster
+// (in the host namespace) which is used to access the member cluster with the given rate limits
+func getMemberAwaitility(t *testing.T, cl client.Client, hostAwait *wait.HostAwaitility, namespace string, rateLimits wait.ClientRateLimits) (*wait.MemberAwaitility, toolchainv1alpha1.ToolchainCluster) {
 	memberClusterE2e, err := hostAwait.WaitForToolchainClusterWithCondition(t, "e2e", namespace, wait.ReadyToolchainCluster)
 	require.NoError(t, err)
 	memberConfig, err := cluster.NewClusterConfig(cl, &memberClusterE2e, 6*time.Second)
 	require.NoError(t, err)
+	rateLimits.ApplyTo(memberConfig.RestConfig)
 
 	memberClient, err := client.New(memberConfig.RestConfig, client.Options{
 		Scheme: schemeWithAllAPIs(t),
@@ -162,7 +209,19 @@ func getMemberAwaitility(t *testing.T, cl client.Client, hostAwait *wait.HostAwa
 
 	memberAwait.WaitForDeploymentToGetReady(t, "member-operator-controller-manager", 1)
 
-	return memberAwait
+	return memberAwait, memberClusterE2e
+}
+
+// toolchainClusterToken returns a function which reads the bearer token of the given ToolchainCluster,
+// so that a refreshed token can be picked up after the secret has been updated
+func toolchainClusterToken(cl client.Client, toolchainCluster toolchainv1alpha1.ToolchainCluster) func() (string, error) {
+	return func() (string, error) {
+		clusterConfig, err := cluster.NewClusterConfig(cl, &toolchainCluster, 6*time.Second)
+		if err != nil {
+			return "", err
+		}
+		return clusterConfig.RestConfig.BearerToken, nil
+	}
 }
 
 func schemeWithAllAPIs(t *testing.T) *runtime.Scheme {
@@ -171,6 +230,7 @@ func schemeWithAllAPIs(t *testing.T) *runtime.Scheme {
 		userv1.Install,
 		templatev1.Install,
 		routev1.Install,
+		securityv1.Install,
 		quotav1.Install,
 		openshiftappsv1.Install,
 		corev1.AddToScheme,
@@ -0,0 +1,96 @@
+package testsupport
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/httpclient"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// LatencyStats the latencies of the successful requests to an endpoint, and the number of failed ones
+type LatencyStats struct {
+	URL string
+	// Latencies the latency of each successful request, sorted
+	Latencies []time.Duration
+	// Failures the requests which failed or which had a response with another status than `2xx`
+	Failures []string
+}
+
+// Percentile returns the latency below which the given percentage of the successful requests completed (or 0 if none succeeded)
+func (s LatencyStats) Percentile(p int) time.Duration {
+	if len(s.Latencies) == 0 {
+		return 0
+	}
+	i := (len(s.Latencies)*p+99)/100 - 1
+	if i < 0 {
+		i = 0
+	}
+	return s.Latencies[i]
+}
+
+func (s LatencyStats) String() string {
+	return fmt.Sprintf("%s: %d request(s), %d failure(s), p50=%s p95=%s max=%s", s.URL, len(s.Latencies)+len(s.Failures), len(s.Failures),
+		s.Percentile(50).Round(time.Millisecond), s.Percentile(95).Round(time.Millisecond), s.Percentile(100).Round(time.Millisecond))
+}
+
+// MeasureEndpointLatency sends the given number of `GET` requests to the given URL, one after the other, with a client configured
+// with the given options (eg: httpclient.WithToken or httpclient.WithInsecureSkipVerify), and returns their latencies, including the time to read the response body.
+// A first request is sent beforehand and is not measured, so that the latencies do not include the setup of the connection.
+func MeasureEndpointLatency(t *testing.T, url string, samples int, opts ...httpclient.Option) LatencyStats {
+	client := httpclient.New(opts...)
+	stats := LatencyStats{
+		URL:       url,
+		Latencies: make([]time.Duration, 0, samples),
+	}
+	// warm-up request
+	_, _ = getAndDiscard(client, url)
+	for i := 0; i < samples; i++ {
+		start := time.Now()
+		status, err := getAndDiscard(client, url)
+		latency := time.Since(start)
+		switch {
+		case err != nil:
+			stats.Failures = append(stats.Failures, err.Error())
+		case status < 200 || status > 299:
+			stats.Failures = append(stats.Failures, fmt.Sprintf("unexpected response status: %d", status))
+		default:
+			stats.Latencies = append(stats.Latencies, latency)
+		}
+	}
+	sort.Slice(stats.Latencies, func(i, j int) bool {
+		return stats.Latencies[i] < stats.Latencies[j]
+	})
+	t.Logf("latency of %s", stats)
+	return stats
+}
+
+// AssertEndpointLatency sends the given number of `GET` requests to the given URL (see MeasureEndpointLatency), and verifies that
+// all of them succeeded and that the 95th percentile of their latencies is within the given budget, eg: as a smoke check of the SLO
+// of the registration service or of the proxy
+func AssertEndpointLatency(t *testing.T, url string, p95 time.Duration, samples int, opts ...httpclient.Option) {
+	require.Positive(t, samples, "the number of samples must be positive")
+	stats := MeasureEndpointLatency(t, url, samples, opts...)
+	assert.Empty(t, stats.Failures, "some requests to '%s' failed", url)
+	assert.LessOrEqual(t, stats.Percentile(95), p95, "the p95 latency of '%s' exceeds the budget of %s: %s", url, p95, stats)
+}
+
+func getAndDiscard(client *http.Client, url string) (int, error) {
+	resp, err := client.Get(url) // nolint:noctx
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		return 0, err
+	}
+	return resp.StatusCode, nil
+}
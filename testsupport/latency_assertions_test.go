@@ -0,0 +1,77 @@
+package testsupport_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/httpclient"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatencyStats(t *testing.T) {
+	// given
+	stats := testsupport.LatencyStats{
+		URL:       "https://api.example.com/proxyhealth",
+		Latencies: []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 30 * time.Millisecond, 100 * time.Millisecond},
+		Failures:  []string{"unexpected response status: 503"},
+	}
+
+	t.Run("percentiles", func(t *testing.T) {
+		assert.Equal(t, 20*time.Millisecond, stats.Percentile(50))
+		assert.Equal(t, 100*time.Millisecond, stats.Percentile(95))
+		assert.Equal(t, 10*time.Millisecond, stats.Percentile(0))
+		assert.Zero(t, testsupport.LatencyStats{}.Percentile(95))
+	})
+
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "https://api.example.com/proxyhealth: 5 request(s), 1 failure(s), p50=20ms p95=100ms max=100ms", stats.String())
+	})
+}
+
+func TestMeasureEndpointLatency(t *testing.T) {
+	// given
+	var requests int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		// the 3rd measured request fails (the 1st request is the warm-up)
+		if atomic.AddInt32(&requests, 1) == 4 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	t.Run("with failures", func(t *testing.T) {
+		// when
+		stats := testsupport.MeasureEndpointLatency(t, server.URL+"/api/v1/health", 5, httpclient.WithToken("token"), httpclient.WithInsecureSkipVerify())
+
+		// then
+		assert.Len(t, stats.Latencies, 4)
+		assert.Equal(t, []string{"unexpected response status: 503"}, stats.Failures)
+		assert.IsNonDecreasing(t, stats.Latencies)
+	})
+
+	t.Run("within budget", func(t *testing.T) {
+		testsupport.AssertEndpointLatency(t, server.URL+"/api/v1/health", time.Second, 10, httpclient.WithToken("token"), httpclient.WithInsecureSkipVerify())
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		// when
+		stats := testsupport.MeasureEndpointLatency(t, server.URL+"/api/v1/health", 3, httpclient.WithInsecureSkipVerify())
+
+		// then
+		require.Empty(t, stats.Latencies)
+		assert.Len(t, stats.Failures, 3)
+		assert.Zero(t, stats.Percentile(95))
+	})
+}
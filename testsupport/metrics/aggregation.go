@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"sort"
+)
+
+// SumBy returns the sums of the values of the given series grouped by the values of the given labels, sorted by labels, eg: the number
+// of users per domain regardless of their number of activations. The labels of the resulting series are only the given ones (a series
+// which does not have one of these labels is grouped with the series whose label is empty), and there is a single resulting series
+// with no labels when no label is given (or no series at all when none is given).
+func SumBy(series []Series, groupBy ...string) []Series {
+	sums := map[string]*Series{}
+	for _, s := range series {
+		labels := make(map[string]string, len(groupBy))
+		for _, name := range groupBy {
+			labels[name] = s.Labels[name]
+		}
+		key := labelsString(labels)
+		if sum, found := sums[key]; found {
+			sum.Value += s.Value
+			continue
+		}
+		sums[key] = &Series{Labels: labels, Value: s.Value}
+	}
+	keys := make([]string, 0, len(sums))
+	for key := range sums {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	result := make([]Series, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, *sums[key])
+	}
+	return result
+}
+
+// Sum returns the sum of the values of the given series, eg: the total number of users across all the activations and domains
+func Sum(series []Series) float64 {
+	var sum float64
+	for _, s := range series {
+		sum += s.Value
+	}
+	return sum
+}
+
+// SumMetricAcrossLabels returns the sums of the values of all the series of the given metric family grouped by the values of the given
+// labels (see SumBy), so that the totals don't depend on the label values which are exposed (eg: the activations of the users)
+func (c *Client) SumMetricAcrossLabels(family string, groupBy ...string) ([]Series, error) {
+	series, err := c.GetSeries(family, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return SumBy(series, groupBy...), nil
+}
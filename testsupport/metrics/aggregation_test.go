@@ -0,0 +1,76 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const usersPerActivationsAndDomain = `# TYPE sandbox_users_per_activations_and_domain gauge
+sandbox_users_per_activations_and_domain{activations="1",domain="external"} 5
+sandbox_users_per_activations_and_domain{activations="2",domain="external"} 2
+sandbox_users_per_activations_and_domain{activations="1",domain="internal"} 3
+sandbox_users_per_activations_and_domain{activations="3",domain="internal"} 1
+`
+
+func TestSumMetricAcrossLabels(t *testing.T) {
+	// given
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, usersPerActivationsAndDomain)
+	}))
+	defer ts.Close()
+	c := NewClient(StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), StaticToken("token"))
+
+	t.Run("grouped by label", func(t *testing.T) {
+		// when
+		sums, err := c.SumMetricAcrossLabels("sandbox_users_per_activations_and_domain", "domain")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []Series{
+			{Labels: map[string]string{"domain": "external"}, Value: 7},
+			{Labels: map[string]string{"domain": "internal"}, Value: 4},
+		}, sums)
+	})
+
+	t.Run("grouped by unknown label", func(t *testing.T) {
+		// when
+		sums, err := c.SumMetricAcrossLabels("sandbox_users_per_activations_and_domain", "cluster_name")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []Series{
+			{Labels: map[string]string{"cluster_name": ""}, Value: 11},
+		}, sums)
+	})
+
+	t.Run("total", func(t *testing.T) {
+		// when
+		sums, err := c.SumMetricAcrossLabels("sandbox_users_per_activations_and_domain")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []Series{
+			{Labels: map[string]string{}, Value: 11},
+		}, sums)
+	})
+
+	t.Run("unknown family", func(t *testing.T) {
+		// when
+		sums, err := c.SumMetricAcrossLabels("non_existent_counter", "domain")
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, sums)
+	})
+}
+
+func TestSum(t *testing.T) {
+	assert.Equal(t, float64(0), Sum(nil))
+	assert.Equal(t, float64(3.5), Sum([]Series{{Value: 1}, {Value: 2.5}}))
+}
@@ -0,0 +1,284 @@
+package metrics
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/httpclient"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+const (
+	// DefaultRequestTimeout the default timeout of a single request to the metrics endpoint
+	DefaultRequestTimeout = 30 * time.Second
+	// DefaultCacheTTL the default duration during which a scraped response is reused. This is a best effort to read the values
+	// of a single poll tick from the same scrape: the cache is not tied to the poll ticks, so with a retry interval shorter
+	// than the TTL, consecutive ticks may also reuse the same response
+	DefaultCacheTTL = 50 * time.Millisecond
+)
+
+// EndpointFunc returns the host (and optional port) of the metrics endpoint
+type EndpointFunc func() (string, error)
+
+// TokenFunc returns the bearer token to use when calling the metrics endpoint
+type TokenFunc func() (string, error)
+
+// StaticEndpoint returns an EndpointFunc which always returns the given host
+func StaticEndpoint(host string) EndpointFunc {
+	return func() (string, error) {
+		return host, nil
+	}
+}
+
+// StaticToken returns a TokenFunc which always returns the given token
+func StaticToken(token string) TokenFunc {
+	return func() (string, error) {
+		return token, nil
+	}
+}
+
+// ScrapeError the error returned when the metrics endpoint can't be scraped (eg: it can't be discovered, it responds with an unexpected
+// status or its response can't be parsed), as opposed to the errors about the scraped metrics (eg: a metric which is not exposed)
+type ScrapeError struct {
+	Cause error
+}
+
+func (e *ScrapeError) Error() string {
+	return e.Cause.Error()
+}
+
+func (e *ScrapeError) Unwrap() error {
+	return e.Cause
+}
+
+// IsScrapeError returns `true` if the given error is returned because the metrics endpoint can't be scraped
+func IsScrapeError(err error) bool {
+	var scrapeErr *ScrapeError
+	return errors.As(err, &scrapeErr)
+}
+
+// ClientOption an option to configure a Client
+type ClientOption func(*Client)
+
+// WithRequestTimeout configures the timeout of each request to the metrics endpoint
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.httpClient.Timeout = timeout
+	}
+}
+
+// WithCacheTTL configures the duration during which a scraped response is reused (0 disables the cache)
+func WithCacheTTL(ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.cacheTTL = ttl
+	}
+}
+
+// WithTransport configures the transport of the requests to the metrics endpoints (eg: with a custom proxy), instead of a transport
+// which skips the verification of the server certificates (see util.NewInsecureTransport)
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		c.httpClient.Transport = transport
+	}
+}
+
+// WithFallbackEndpoint configures the endpoint which is scraped when the primary one can't be discovered or doesn't respond,
+// eg: a local port forwarded to a pod of the metrics service when its route does not exist or is being modified
+func WithFallbackEndpoint(endpointFunc EndpointFunc) ClientOption {
+	return func(c *Client) {
+		c.fallbackEndpointFunc = endpointFunc
+	}
+}
+
+// Client retrieves the metrics exposed by an operator. The endpoint is discovered on the first call and
+// discovered again after a failed request (in which case the fallback endpoint is scraped, if any), the bearer token is refreshed when the endpoint responds with
+// `401 Unauthorized`, and the parsed response is cached for a short period of time.
+// A Client is safe for concurrent use.
+type Client struct {
+	endpointFunc         EndpointFunc
+	fallbackEndpointFunc EndpointFunc
+	tokenFunc            TokenFunc
+	httpClient           *http.Client
+	cacheTTL             time.Duration
+
+	mu               sync.Mutex
+	endpoint         string
+	fallbackEndpoint string
+	token            string
+	families         map[string]*dto.MetricFamily
+	scrapedAt        time.Time
+}
+
+// NewClient returns a new Client which uses the given functions to discover the endpoint and to obtain the bearer token
+func NewClient(endpointFunc EndpointFunc, tokenFunc TokenFunc, options ...ClientOption) *Client {
+	c := &Client{
+		endpointFunc: endpointFunc,
+		tokenFunc:    tokenFunc,
+		cacheTTL:     DefaultCacheTTL,
+		httpClient:   httpclient.New(httpclient.WithTimeout(DefaultRequestTimeout), httpclient.WithInsecureSkipVerify()),
+	}
+	for _, apply := range options {
+		apply(c)
+	}
+	return c
+}
+
+// GetMetricValue returns the value of the metric with the given family and label key-value pairs
+func (c *Client) GetMetricValue(family string, expectedLabels []string) (float64, error) {
+	if len(expectedLabels)%2 != 0 {
+		return -1, fmt.Errorf("received odd number of label arguments, labels must be key-value pairs")
+	}
+	families, err := c.scrape()
+	if err != nil {
+		return -1, err
+	}
+	return metricValue(families, family, expectedLabels)
+}
+
+// GetMetricLabels return all labels (indexed by key) for all metrics of the given `family`
+func (c *Client) GetMetricLabels(family string) ([]map[string]*string, error) {
+	families, err := c.scrape()
+	if err != nil {
+		return nil, err
+	}
+	return metricLabels(families, family), nil
+}
+
+// GetSeries returns the series of the given metric family which have the given label key-value pairs (among others),
+// or an empty slice if the family is not exposed or if none of its series has these labels
+func (c *Client) GetSeries(family string, expectedLabels []string) ([]Series, error) {
+	if len(expectedLabels)%2 != 0 {
+		return nil, fmt.Errorf("received odd number of label arguments, labels must be key-value pairs")
+	}
+	families, err := c.scrape()
+	if err != nil {
+		return nil, err
+	}
+	f, found := families[family]
+	if !found {
+		return []Series{}, nil
+	}
+	series, err := seriesOf(f)
+	if err != nil {
+		return nil, err
+	}
+	matching := make([]Series, 0, len(series))
+	for i, m := range f.GetMetric() {
+		if hasLabels(m, expectedLabels) {
+			matching = append(matching, series[i])
+		}
+	}
+	return matching, nil
+}
+
+// Invalidate discards the cached response, so that the next call scrapes the endpoint again
+func (c *Client) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.families = nil
+}
+
+// scrape returns the metric families exposed by the endpoint (or the cached ones), or a ScrapeError
+func (c *Client) scrape() (map[string]*dto.MetricFamily, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.families != nil && time.Since(c.scrapedAt) < c.cacheTTL {
+		return c.families, nil
+	}
+	families, err := c.scrapeEndpoints()
+	if err != nil {
+		return nil, &ScrapeError{Cause: err}
+	}
+	c.families = families
+	c.scrapedAt = time.Now()
+	return families, nil
+}
+
+// scrapeEndpoints returns the metric families exposed by the endpoint, or by the fallback endpoint if the endpoint can't be scraped
+func (c *Client) scrapeEndpoints() (map[string]*dto.MetricFamily, error) {
+	body, err := c.fetch(&c.endpoint, c.endpointFunc)
+	if err != nil && c.fallbackEndpointFunc != nil {
+		var fallbackErr error
+		if body, fallbackErr = c.fetch(&c.fallbackEndpoint, c.fallbackEndpointFunc); fallbackErr != nil {
+			return nil, fmt.Errorf("%w (fallback: %s)", err, fallbackErr.Error())
+		}
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parse(body)
+}
+
+// fetch returns the response of the given endpoint, which is discovered with the given function first if needed
+func (c *Client) fetch(endpoint *string, endpointFunc EndpointFunc) ([]byte, error) {
+	if *endpoint == "" {
+		e, err := endpointFunc()
+		if err != nil {
+			return nil, fmt.Errorf("unable to discover the metrics endpoint: %w", err)
+		}
+		*endpoint = e
+	}
+	if c.token == "" {
+		if err := c.refreshToken(); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := c.get(*endpoint)
+	if err != nil {
+		// the endpoint may have changed (eg, the route was recreated), so discover it again on the next call
+		*endpoint = ""
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		// the token may have expired, refresh it and try again (only once)
+		closeBody(resp)
+		if err := c.refreshToken(); err != nil {
+			return nil, err
+		}
+		if resp, err = c.get(*endpoint); err != nil {
+			*endpoint = ""
+			return nil, err
+		}
+	}
+	defer closeBody(resp)
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status from the metrics endpoint 'https://%s/metrics': %s", *endpoint, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func (c *Client) refreshToken() error {
+	token, err := c.tokenFunc()
+	if err != nil {
+		return fmt.Errorf("unable to obtain a token for the metrics endpoint: %w", err)
+	}
+	c.token = token
+	return nil
+}
+
+func (c *Client) get(endpoint string) (*http.Response, error) {
+	request, err := http.NewRequest("GET", fmt.Sprintf("https://%s/metrics", endpoint), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	return c.httpClient.Do(request)
+}
+
+func closeBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
+
+func parse(body []byte) (map[string]*dto.MetricFamily, error) {
+	parser := expfmt.TextParser{}
+	return parser.TextToMetricFamilies(bytes.NewReader(body))
+}
@@ -0,0 +1,182 @@
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+// Bucket a bucket of a histogram: the number of observations less than or equal to its upper bound
+type Bucket struct {
+	UpperBound      float64
+	CumulativeCount uint64
+}
+
+// Histogram the buckets, the number of observations (`_count`) and the sum of the observed values (`_sum`) of a histogram.
+// The buckets are sorted by upper bound, and the last one is always the `+Inf` bucket, whose count is the number of observations.
+type Histogram struct {
+	Buckets     []Bucket
+	SampleCount uint64
+	SampleSum   float64
+}
+
+// GetHistogram returns the sum of all the series of the given histogram family which have the given label key-value pairs (among others),
+// eg: the durations of the reconciles of a given controller, regardless of their result
+func (c *Client) GetHistogram(family string, expectedLabels []string) (Histogram, error) {
+	if len(expectedLabels)%2 != 0 {
+		return Histogram{}, fmt.Errorf("received odd number of label arguments, labels must be key-value pairs")
+	}
+	families, err := c.scrape()
+	if err != nil {
+		return Histogram{}, err
+	}
+	h, found, err := histogramOf(families, family, expectedLabels)
+	if err != nil {
+		return Histogram{}, err
+	}
+	if !found {
+		return Histogram{}, fmt.Errorf("histogram '%s{%v}' not found", family, expectedLabels)
+	}
+	return h, nil
+}
+
+// Histogram returns the sum of all the series of the given histogram family which have the given label key-value pairs (see Client.GetHistogram),
+// or an empty histogram if the snapshot does not contain them
+func (s Snapshot) Histogram(family string, labelAndValues ...string) (Histogram, error) {
+	if len(labelAndValues)%2 != 0 {
+		return Histogram{}, fmt.Errorf("received odd number of label arguments, labels must be key-value pairs")
+	}
+	h, _, err := histogramOf(s.families, family, labelAndValues)
+	return h, err
+}
+
+// histogramOf returns the sum of all the series of the given histogram family which have the given label key-value pairs,
+// and whether there was any
+func histogramOf(families map[string]*dto.MetricFamily, family string, expectedLabels []string) (Histogram, bool, error) {
+	f, found := families[family]
+	if !found {
+		return Histogram{}, false, nil
+	}
+	if f.GetType() != dto.MetricType_HISTOGRAM {
+		return Histogram{}, false, fmt.Errorf("metric '%s' is a %s, not a histogram", family, f.GetType().String())
+	}
+	counts := map[float64]uint64{}
+	result := Histogram{}
+	found = false
+	for _, m := range f.GetMetric() {
+		if !hasLabels(m, expectedLabels) {
+			continue
+		}
+		found = true
+		h := m.GetHistogram()
+		result.SampleCount += h.GetSampleCount()
+		result.SampleSum += h.GetSampleSum()
+		for _, b := range h.GetBucket() {
+			counts[b.GetUpperBound()] += b.GetCumulativeCount()
+		}
+	}
+	for le, count := range counts {
+		if !math.IsInf(le, +1) {
+			result.Buckets = append(result.Buckets, Bucket{UpperBound: le, CumulativeCount: count})
+		}
+	}
+	sort.Slice(result.Buckets, func(i, j int) bool {
+		return result.Buckets[i].UpperBound < result.Buckets[j].UpperBound
+	})
+	// the `+Inf` bucket is not always part of the parsed response
+	result.Buckets = append(result.Buckets, Bucket{UpperBound: math.Inf(+1), CumulativeCount: result.SampleCount})
+	return result, found, nil
+}
+
+func hasLabels(m *dto.Metric, expectedLabels []string) bool {
+	for i := 0; i < len(expectedLabels); i += 2 {
+		found := false
+		for _, l := range m.GetLabel() {
+			if l.GetName() == expectedLabels[i] && l.GetValue() == expectedLabels[i+1] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Since returns the observations of this histogram which were made after the given one was captured, eg: the durations of the
+// requests made by a test since the baseline of the metrics was captured. If the histogram was reset in the meantime (eg: the
+// operator was restarted) or if the buckets differ, then this histogram is returned as is.
+func (h Histogram) Since(before Histogram) Histogram {
+	if before.SampleCount == 0 || len(before.Buckets) != len(h.Buckets) || before.SampleCount > h.SampleCount {
+		return h
+	}
+	result := Histogram{
+		SampleCount: h.SampleCount - before.SampleCount,
+		SampleSum:   h.SampleSum - before.SampleSum,
+		Buckets:     make([]Bucket, len(h.Buckets)),
+	}
+	for i, b := range h.Buckets {
+		if b.UpperBound != before.Buckets[i].UpperBound || b.CumulativeCount < before.Buckets[i].CumulativeCount {
+			return h
+		}
+		result.Buckets[i] = Bucket{UpperBound: b.UpperBound, CumulativeCount: b.CumulativeCount - before.Buckets[i].CumulativeCount}
+	}
+	return result
+}
+
+// Count returns the number of observations less than or equal to the given upper bound, which must be the upper bound of one
+// of the buckets
+func (h Histogram) Count(upperBound float64) (uint64, error) {
+	for _, b := range h.Buckets {
+		if b.UpperBound == upperBound {
+			return b.CumulativeCount, nil
+		}
+	}
+	return 0, fmt.Errorf("no bucket with the upper bound %v", upperBound)
+}
+
+// Mean returns the average of the observed values, or 0 if there is no observation
+func (h Histogram) Mean() float64 {
+	if h.SampleCount == 0 {
+		return 0
+	}
+	return h.SampleSum / float64(h.SampleCount)
+}
+
+// Quantile returns the approximate φ-quantile (0 ≤ φ ≤ 1) of the observations, calculated like the `histogram_quantile()` function
+// of Prometheus: the observations are assumed to be uniformly distributed within their bucket. Returns `+Inf` if the quantile falls
+// into the `+Inf` bucket, since nothing is known about the observations above the upper bound of the highest finite bucket
+func (h Histogram) Quantile(q float64) (float64, error) {
+	if q < 0 || q > 1 {
+		return 0, fmt.Errorf("invalid quantile %v: must be between 0 and 1", q)
+	}
+	if h.SampleCount == 0 || len(h.Buckets) < 2 {
+		return 0, fmt.Errorf("no observation to calculate the quantile %v from", q)
+	}
+	rank := q * float64(h.SampleCount)
+	b := sort.Search(len(h.Buckets)-1, func(i int) bool {
+		return float64(h.Buckets[i].CumulativeCount) >= rank
+	})
+	if b == len(h.Buckets)-1 {
+		return math.Inf(+1), nil
+	}
+	if b == 0 && h.Buckets[0].UpperBound <= 0 {
+		return h.Buckets[0].UpperBound, nil
+	}
+	bucketStart := 0.0
+	bucketEnd := h.Buckets[b].UpperBound
+	count := float64(h.Buckets[b].CumulativeCount)
+	if b > 0 {
+		bucketStart = h.Buckets[b-1].UpperBound
+		count -= float64(h.Buckets[b-1].CumulativeCount)
+		rank -= float64(h.Buckets[b-1].CumulativeCount)
+	}
+	if count == 0 {
+		// only when the quantile is 0 and there is no observation in the bucket
+		return bucketStart, nil
+	}
+	return bucketStart + (bucketEnd-bucketStart)*(rank/count), nil
+}
@@ -0,0 +1,175 @@
+package metrics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const reconcileTimes = `# TYPE controller_runtime_reconcile_time_seconds histogram
+controller_runtime_reconcile_time_seconds_bucket{controller="usersignup",le="0.1"} 6
+controller_runtime_reconcile_time_seconds_bucket{controller="usersignup",le="0.5"} 8
+controller_runtime_reconcile_time_seconds_bucket{controller="usersignup",le="1"} 9
+controller_runtime_reconcile_time_seconds_bucket{controller="usersignup",le="+Inf"} 10
+controller_runtime_reconcile_time_seconds_sum{controller="usersignup"} 4.5
+controller_runtime_reconcile_time_seconds_count{controller="usersignup"} 10
+controller_runtime_reconcile_time_seconds_bucket{controller="space",le="0.1"} 2
+controller_runtime_reconcile_time_seconds_bucket{controller="space",le="0.5"} 2
+controller_runtime_reconcile_time_seconds_bucket{controller="space",le="1"} 2
+controller_runtime_reconcile_time_seconds_bucket{controller="space",le="+Inf"} 2
+controller_runtime_reconcile_time_seconds_sum{controller="space"} 0.1
+controller_runtime_reconcile_time_seconds_count{controller="space"} 2
+# TYPE sandbox_user_signups_total counter
+sandbox_user_signups_total 10
+`
+
+func TestSnapshotHistogram(t *testing.T) {
+	// given
+	snapshot, err := ParseSnapshot([]byte(reconcileTimes))
+	require.NoError(t, err)
+
+	t.Run("single series", func(t *testing.T) {
+		// when
+		h, err := snapshot.Histogram("controller_runtime_reconcile_time_seconds", "controller", "usersignup")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, Histogram{
+			Buckets: []Bucket{
+				{UpperBound: 0.1, CumulativeCount: 6},
+				{UpperBound: 0.5, CumulativeCount: 8},
+				{UpperBound: 1, CumulativeCount: 9},
+				{UpperBound: math.Inf(+1), CumulativeCount: 10},
+			},
+			SampleCount: 10,
+			SampleSum:   4.5,
+		}, h)
+		assert.InDelta(t, 0.45, h.Mean(), 0.0001)
+		count, err := h.Count(0.5)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(8), count)
+		_, err = h.Count(2)
+		require.EqualError(t, err, "no bucket with the upper bound 2")
+	})
+
+	t.Run("sum of all series", func(t *testing.T) {
+		// when
+		h, err := snapshot.Histogram("controller_runtime_reconcile_time_seconds")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, uint64(12), h.SampleCount)
+		count, err := h.Count(0.1)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(8), count)
+	})
+
+	t.Run("missing histogram", func(t *testing.T) {
+		// when
+		h, err := snapshot.Histogram("controller_runtime_reconcile_time_seconds", "controller", "unknown")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), h.SampleCount)
+	})
+
+	t.Run("not a histogram", func(t *testing.T) {
+		// when
+		_, err := snapshot.Histogram("sandbox_user_signups_total")
+
+		// then
+		require.EqualError(t, err, "metric 'sandbox_user_signups_total' is a COUNTER, not a histogram")
+	})
+}
+
+func TestHistogramQuantile(t *testing.T) {
+	// given
+	h := Histogram{
+		Buckets: []Bucket{
+			{UpperBound: 0.1, CumulativeCount: 6},
+			{UpperBound: 0.5, CumulativeCount: 8},
+			{UpperBound: 1, CumulativeCount: 9},
+			{UpperBound: math.Inf(+1), CumulativeCount: 10},
+		},
+		SampleCount: 10,
+		SampleSum:   4.5,
+	}
+
+	for q, expected := range map[float64]float64{
+		0:   0,
+		0.3: 0.05, // 3 out of the 6 observations in the first bucket
+		0.6: 0.1,
+		0.7: 0.3, // half of the observations in the second bucket
+		0.9: 1,
+	} {
+		// when
+		actual, err := h.Quantile(q)
+
+		// then
+		require.NoError(t, err)
+		assert.InDelta(t, expected, actual, 0.0001, "quantile %v", q)
+	}
+
+	t.Run("in the +Inf bucket", func(t *testing.T) {
+		// when
+		actual, err := h.Quantile(0.99)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, math.IsInf(actual, +1), "quantile 0.99: %v", actual)
+	})
+
+	t.Run("invalid quantile", func(t *testing.T) {
+		_, err := h.Quantile(1.5)
+		require.EqualError(t, err, "invalid quantile 1.5: must be between 0 and 1")
+	})
+
+	t.Run("no observation", func(t *testing.T) {
+		_, err := Histogram{}.Quantile(0.5)
+		require.EqualError(t, err, "no observation to calculate the quantile 0.5 from")
+	})
+}
+
+func TestHistogramSince(t *testing.T) {
+	// given
+	before := Histogram{
+		Buckets: []Bucket{
+			{UpperBound: 0.1, CumulativeCount: 5},
+			{UpperBound: 1, CumulativeCount: 6},
+			{UpperBound: math.Inf(+1), CumulativeCount: 6},
+		},
+		SampleCount: 6,
+		SampleSum:   1,
+	}
+	after := Histogram{
+		Buckets: []Bucket{
+			{UpperBound: 0.1, CumulativeCount: 6},
+			{UpperBound: 1, CumulativeCount: 9},
+			{UpperBound: math.Inf(+1), CumulativeCount: 10},
+		},
+		SampleCount: 10,
+		SampleSum:   4.5,
+	}
+
+	t.Run("observations since", func(t *testing.T) {
+		assert.Equal(t, Histogram{
+			Buckets: []Bucket{
+				{UpperBound: 0.1, CumulativeCount: 1},
+				{UpperBound: 1, CumulativeCount: 3},
+				{UpperBound: math.Inf(+1), CumulativeCount: 4},
+			},
+			SampleCount: 4,
+			SampleSum:   3.5,
+		}, after.Since(before))
+	})
+
+	t.Run("no baseline", func(t *testing.T) {
+		assert.Equal(t, after, after.Since(Histogram{}))
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		assert.Equal(t, before, before.Since(after))
+	})
+}
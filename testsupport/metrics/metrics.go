@@ -1,60 +1,38 @@
 package metrics
 
 import (
-	"bytes"
-	"crypto/tls"
 	"fmt"
-	"io"
-	"net/http"
-	"time"
 
 	dto "github.com/prometheus/client_model/go"
-	"github.com/prometheus/common/expfmt"
 	"k8s.io/client-go/rest"
 )
 
+// GetMetricValue returns the value of the metric with the given family and label key-value pairs, exposed at the given url
 func GetMetricValue(restConfig *rest.Config, url string, family string, expectedLabels []string) (float64, error) {
-	if len(expectedLabels)%2 != 0 {
-		return -1, fmt.Errorf("received odd number of label arguments, labels must be key-value pairs")
-	}
-	uri := fmt.Sprintf("https://%s/metrics", url)
-	var metrics []byte
+	return NewClient(StaticEndpoint(url), StaticToken(restConfig.BearerToken), WithCacheTTL(0)).GetMetricValue(family, expectedLabels)
+}
 
-	client := http.Client{
-		Timeout: time.Duration(30 * time.Second),
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
-		},
-	}
-	request, err := http.NewRequest("Get", uri, nil)
-	if err != nil {
-		return -1, err
-	}
-	request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", restConfig.BearerToken))
-	resp, err := client.Do(request)
-	if err != nil {
-		return -1, err
-	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-	metrics, err = io.ReadAll(resp.Body)
-	if err != nil {
-		return -1, err
-	}
+// GetMetricLabels return all labels (indexed by key) for all metrics of the given `family`
+func GetMetricLabels(restConfig *rest.Config, url string, family string) ([]map[string]*string, error) {
+	return NewClient(StaticEndpoint(url), StaticToken(restConfig.BearerToken), WithCacheTTL(0)).GetMetricLabels(family)
+}
 
-	// parse the metrics
-	parser := expfmt.TextParser{}
-	families, err := parser.TextToMetricFamilies(bytes.NewReader(metrics))
+func metricValue(families map[string]*dto.MetricFamily, family string, expectedLabels []string) (float64, error) {
+	metricType, m, err := findMetric(families, family, expectedLabels)
 	if err != nil {
-		return -1, err
+		return 0, err
 	}
+	return getValue(metricType, m)
+}
+
+// findMetric returns the type of the given family and its metric with the given label key-value pairs
+func findMetric(families map[string]*dto.MetricFamily, family string, expectedLabels []string) (dto.MetricType, *dto.Metric, error) {
 	for _, f := range families {
 		if f.GetName() == family {
 			metricType := f.GetType()
 			// metric without labels
 			if len(f.GetMetric()) == 1 && len(expectedLabels) == 0 {
-				return getValue(metricType, f.GetMetric()[0])
+				return metricType, f.GetMetric()[0], nil
 			}
 
 		metricSearch:
@@ -75,12 +53,12 @@ func GetMetricValue(restConfig *rest.Config, url string, family string, expected
 					}
 					i += 2
 				}
-				return getValue(metricType, m)
+				return metricType, m, nil
 			}
 		}
 	}
-	// here we can return `0` is the metric does not exist, which may be valid if the expected value is `0`, too.
-	return 0, fmt.Errorf("metric '%s{%v}' not found", family, expectedLabels)
+	// here the callers can use `0` if the metric does not exist, which may be valid if the expected value is `0`, too.
+	return 0, nil, fmt.Errorf("metric '%s{%v}' not found", family, expectedLabels)
 }
 
 func getValue(t dto.MetricType, m *dto.Metric) (float64, error) {
@@ -91,46 +69,15 @@ func getValue(t dto.MetricType, m *dto.Metric) (float64, error) {
 		return m.GetGauge().GetValue(), nil
 	case dto.MetricType_UNTYPED:
 		return m.GetUntyped().GetValue(), nil
+	case dto.MetricType_HISTOGRAM:
+		// the number of observations, eg: the number of requests for a histogram of the request durations
+		return float64(m.GetHistogram().GetSampleCount()), nil
 	default:
 		return -1, fmt.Errorf("unknown or unsupported metric type %s", t.String())
 	}
 }
 
-// GetMetricLabels return all labels (indexed by key) for all metrics of the given `family`
-func GetMetricLabels(restConfig *rest.Config, url string, family string) ([]map[string]*string, error) {
-	uri := fmt.Sprintf("https://%s/metrics", url)
-	var metrics []byte
-
-	client := http.Client{
-		Timeout: time.Duration(30 * time.Second),
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
-		},
-	}
-	request, err := http.NewRequest("Get", uri, nil)
-	if err != nil {
-		return nil, err
-	}
-	request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", restConfig.BearerToken))
-	resp, err := client.Do(request)
-	if err != nil {
-		return nil, err
-	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-	metrics, err = io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	// parse the metrics
-	parser := expfmt.TextParser{}
-	families, err := parser.TextToMetricFamilies(bytes.NewReader(metrics))
-	if err != nil {
-		return nil, err
-	}
-
+func metricLabels(families map[string]*dto.MetricFamily, family string) []map[string]*string {
 	labels := make([]map[string]*string, 0, len(families))
 	for _, f := range families {
 		if f.GetName() == family {
@@ -145,6 +92,30 @@ func GetMetricLabels(restConfig *rest.Config, url string, family string) ([]map[
 			}
 		}
 	}
-	// here we can return `0` is the metric does not exist, which may be valid if the expected value is `0`, too.
-	return labels, nil
+	return labels
+}
+
+// Series the value of a metric with a given set of labels. The value of a histogram is its number of observations.
+type Series struct {
+	Labels map[string]string
+	Value  float64
+}
+
+// LabelsString returns the labels of the series in the Prometheus format, sorted by name, eg: `{cluster_name="member-1"}`
+func (s Series) LabelsString() string {
+	return labelsString(s.Labels)
+}
+
+// ParseSeries returns all the series of the given metric family in the given response of a metrics endpoint
+// (or an empty slice if the response does not contain the family)
+func ParseSeries(body []byte, family string) ([]Series, error) {
+	families, err := parse(body)
+	if err != nil {
+		return nil, err
+	}
+	f, found := families[family]
+	if !found {
+		return []Series{}, nil
+	}
+	return seriesOf(f)
 }
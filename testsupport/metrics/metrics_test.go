@@ -5,7 +5,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -127,3 +129,269 @@ func TestGetMetricValue(t *testing.T) {
 		})
 	})
 }
+
+func TestClient(t *testing.T) {
+
+	newServer := func(validToken string, calls *atomic.Int32) *httptest.Server {
+		return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			if r.Header.Get("Authorization") != "Bearer "+validToken {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, response)
+		}))
+	}
+
+	t.Run("refresh token on 401", func(t *testing.T) {
+		// given
+		calls := &atomic.Int32{}
+		ts := newServer("new-token", calls)
+		defer ts.Close()
+		tokens := []string{"expired-token", "new-token"}
+		tokenCalls := 0
+		c := NewClient(StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), func() (string, error) {
+			token := tokens[tokenCalls]
+			tokenCalls++
+			return token, nil
+		})
+
+		// when
+		result, err := c.GetMetricValue("sandbox_user_signups_total", []string{})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, float64(7), result)
+		assert.Equal(t, 2, tokenCalls)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("unauthorized after token refresh", func(t *testing.T) {
+		// given
+		calls := &atomic.Int32{}
+		ts := newServer("valid-token", calls)
+		defer ts.Close()
+		c := NewClient(StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), StaticToken("invalid-token"))
+
+		// when
+		_, err := c.GetMetricValue("sandbox_user_signups_total", []string{})
+
+		// then
+		require.EqualError(t, err, fmt.Sprintf("unexpected response status from the metrics endpoint '%s/metrics': 401 Unauthorized", ts.URL))
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("response is cached", func(t *testing.T) {
+		// given
+		calls := &atomic.Int32{}
+		ts := newServer("valid-token", calls)
+		defer ts.Close()
+		c := NewClient(StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), StaticToken("valid-token"), WithCacheTTL(time.Minute))
+
+		// when
+		_, err := c.GetMetricValue("sandbox_user_signups_total", []string{})
+		require.NoError(t, err)
+		_, err = c.GetMetricValue("sandbox_master_user_record_current", []string{})
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, int32(1), calls.Load())
+
+		t.Run("scrape again after invalidation", func(t *testing.T) {
+			// when
+			c.Invalidate()
+			_, err := c.GetMetricValue("sandbox_user_signups_total", []string{})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int32(2), calls.Load())
+		})
+	})
+
+	t.Run("endpoint discovery failure", func(t *testing.T) {
+		// given
+		c := NewClient(func() (string, error) {
+			return "", fmt.Errorf("route not found")
+		}, StaticToken("valid-token"))
+
+		// when
+		_, err := c.GetMetricValue("sandbox_user_signups_total", []string{})
+
+		// then
+		require.EqualError(t, err, "unable to discover the metrics endpoint: route not found")
+		assert.True(t, IsScrapeError(err))
+	})
+
+	t.Run("metric not found is not a scrape error", func(t *testing.T) {
+		// given
+		calls := &atomic.Int32{}
+		ts := newServer("valid-token", calls)
+		defer ts.Close()
+		c := NewClient(StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), StaticToken("valid-token"))
+
+		// when
+		_, err := c.GetMetricValue("non_existent_counter", []string{})
+
+		// then
+		require.Error(t, err)
+		assert.False(t, IsScrapeError(err))
+	})
+
+	t.Run("fallback endpoint", func(t *testing.T) {
+		// given
+		calls := &atomic.Int32{}
+		ts := newServer("valid-token", calls)
+		defer ts.Close()
+		unavailable := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer unavailable.Close()
+
+		t.Run("when the endpoint can't be discovered", func(t *testing.T) {
+			// given
+			c := NewClient(func() (string, error) {
+				return "", fmt.Errorf("route not found")
+			}, StaticToken("valid-token"), WithFallbackEndpoint(StaticEndpoint(strings.TrimPrefix(ts.URL, "https://"))))
+
+			// when
+			result, err := c.GetMetricValue("sandbox_user_signups_total", []string{})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, float64(7), result)
+		})
+
+		t.Run("when the endpoint is unavailable", func(t *testing.T) {
+			// given
+			c := NewClient(StaticEndpoint(strings.TrimPrefix(unavailable.URL, "https://")), StaticToken("valid-token"),
+				WithFallbackEndpoint(StaticEndpoint(strings.TrimPrefix(ts.URL, "https://"))))
+
+			// when
+			result, err := c.GetMetricValue("sandbox_user_signups_total", []string{})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, float64(7), result)
+		})
+
+		t.Run("when both are unavailable", func(t *testing.T) {
+			// given
+			c := NewClient(StaticEndpoint(strings.TrimPrefix(unavailable.URL, "https://")), StaticToken("valid-token"),
+				WithFallbackEndpoint(func() (string, error) {
+					return "", fmt.Errorf("no running pod")
+				}))
+
+			// when
+			_, err := c.GetMetricValue("sandbox_user_signups_total", []string{})
+
+			// then
+			require.EqualError(t, err, fmt.Sprintf("unexpected response status from the metrics endpoint '%s/metrics': 503 Service Unavailable "+
+				"(fallback: unable to discover the metrics endpoint: no running pod)", unavailable.URL))
+		})
+	})
+}
+
+func TestParseSeries(t *testing.T) {
+	// given
+	body := []byte(response + `# HELP sandbox_proxy_api_http_request_time Histogram of the duration of the requests handled by the proxy
+# TYPE sandbox_proxy_api_http_request_time histogram
+sandbox_proxy_api_http_request_time_bucket{route_to="api_server",status_code="202",le="0.5"} 3
+sandbox_proxy_api_http_request_time_bucket{route_to="api_server",status_code="202",le="+Inf"} 4
+sandbox_proxy_api_http_request_time_sum{route_to="api_server",status_code="202"} 1.2
+sandbox_proxy_api_http_request_time_count{route_to="api_server",status_code="202"} 4
+sandbox_proxy_api_http_request_time_bucket{route_to="Rejected",status_code="401",le="0.5"} 1
+sandbox_proxy_api_http_request_time_bucket{route_to="Rejected",status_code="401",le="+Inf"} 1
+sandbox_proxy_api_http_request_time_sum{route_to="Rejected",status_code="401"} 0.01
+sandbox_proxy_api_http_request_time_count{route_to="Rejected",status_code="401"} 1
+`)
+
+	t.Run("histogram", func(t *testing.T) {
+		// when
+		series, err := ParseSeries(body, "sandbox_proxy_api_http_request_time")
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []Series{
+			{Labels: map[string]string{"route_to": "api_server", "status_code": "202"}, Value: 4},
+			{Labels: map[string]string{"route_to": "Rejected", "status_code": "401"}, Value: 1},
+		}, series)
+	})
+
+	t.Run("counter", func(t *testing.T) {
+		// when
+		series, err := ParseSeries(body, "controller_runtime_reconcile_total")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []Series{
+			{Labels: map[string]string{"controller": "usersignup-controller", "result": "success"}, Value: 10},
+		}, series)
+	})
+
+	t.Run("unknown family", func(t *testing.T) {
+		// when
+		series, err := ParseSeries(body, "non_existent_counter")
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, series)
+	})
+}
+
+func TestGetSeries(t *testing.T) {
+	// given
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, response)
+	}))
+	defer ts.Close()
+	c := NewClient(StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), StaticToken("token"))
+
+	t.Run("all series", func(t *testing.T) {
+		// when
+		series, err := c.GetSeries("workqueue_depth", []string{})
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []Series{
+			{Labels: map[string]string{"name": "usersignup-controller"}, Value: 0},
+			{Labels: map[string]string{"name": "masteruserrecord-controller"}, Value: 0},
+		}, series)
+	})
+
+	t.Run("series with labels", func(t *testing.T) {
+		// when
+		series, err := c.GetSeries("workqueue_depth", []string{"name", "usersignup-controller"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []Series{
+			{Labels: map[string]string{"name": "usersignup-controller"}, Value: 0},
+		}, series)
+	})
+
+	t.Run("no series with labels", func(t *testing.T) {
+		// when
+		series, err := c.GetSeries("workqueue_depth", []string{"name", "space-controller"})
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, series)
+	})
+
+	t.Run("unknown family", func(t *testing.T) {
+		// when
+		series, err := c.GetSeries("non_existent_counter", []string{})
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, series)
+	})
+
+	t.Run("odd number of labels", func(t *testing.T) {
+		// when
+		_, err := c.GetSeries("workqueue_depth", []string{"name"})
+
+		// then
+		require.EqualError(t, err, "received odd number of label arguments, labels must be key-value pairs")
+	})
+}
@@ -0,0 +1,229 @@
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/httpclient"
+)
+
+// Sample the value of a series at a given time, in the result of a PromQL query
+type Sample struct {
+	Labels map[string]string
+	Time   time.Time
+	Value  float64
+}
+
+// LabelsString returns the labels of the sample in the Prometheus format, sorted by name, eg: `{controller="usersignup"}`
+func (s Sample) LabelsString() string {
+	return labelsString(s.Labels)
+}
+
+// RangeSeries the values of a series over time, in the result of a PromQL range query
+type RangeSeries struct {
+	Labels map[string]string
+	Points []Sample
+}
+
+// LabelsString returns the labels of the series in the Prometheus format, sorted by name, eg: `{controller="usersignup"}`
+func (s RangeSeries) LabelsString() string {
+	return labelsString(s.Labels)
+}
+
+// PrometheusClient runs PromQL queries against the HTTP API of a Prometheus instance (or of the Thanos querier of the OpenShift
+// monitoring stack, which exposes the same API), eg: to verify the rates and the historical values of the metrics, which can't be
+// verified with a single scrape of the metrics endpoint of an operator.
+// The bearer token is obtained with the given function on every query.
+type PrometheusClient struct {
+	url        string
+	tokenFunc  TokenFunc
+	httpClient *http.Client
+}
+
+// PrometheusClientOption an option to configure a PrometheusClient
+type PrometheusClientOption func(*PrometheusClient)
+
+// WithPrometheusTransport configures the transport of the requests to the Prometheus API (eg: with a custom proxy), instead of a
+// transport which skips the verification of the server certificates (see util.NewInsecureTransport)
+func WithPrometheusTransport(transport http.RoundTripper) PrometheusClientOption {
+	return func(c *PrometheusClient) {
+		c.httpClient.Transport = transport
+	}
+}
+
+// NewPrometheusClient returns a new PrometheusClient for the Prometheus API at the given URL (eg: `https://thanos-querier-openshift-monitoring.apps...`)
+func NewPrometheusClient(url string, tokenFunc TokenFunc, options ...PrometheusClientOption) *PrometheusClient {
+	c := &PrometheusClient{
+		url:        strings.TrimSuffix(url, "/"),
+		tokenFunc:  tokenFunc,
+		httpClient: httpclient.New(httpclient.WithTimeout(DefaultRequestTimeout), httpclient.WithInsecureSkipVerify()),
+	}
+	for _, apply := range options {
+		apply(c)
+	}
+	return c
+}
+
+// Query runs the given PromQL query at the given time and returns the samples of the resulting instant vector
+// (or the single sample of the resulting scalar)
+func (c *PrometheusClient) Query(query string, at time.Time) ([]Sample, error) {
+	params := url.Values{
+		"query": []string{query},
+		"time":  []string{formatTime(at)},
+	}
+	result, err := c.get("/api/v1/query", params)
+	if err != nil {
+		return nil, err
+	}
+	switch result.ResultType {
+	case "vector":
+		var vector []struct {
+			Metric map[string]string `json:"metric"`
+			Value  []interface{}     `json:"value"`
+		}
+		if err := json.Unmarshal(result.Result, &vector); err != nil {
+			return nil, fmt.Errorf("unable to parse the vector of query '%s': %w", query, err)
+		}
+		samples := make([]Sample, 0, len(vector))
+		for _, v := range vector {
+			s, err := sampleOf(v.Metric, v.Value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse the vector of query '%s': %w", query, err)
+			}
+			samples = append(samples, s)
+		}
+		return samples, nil
+	case "scalar":
+		var scalar []interface{}
+		if err := json.Unmarshal(result.Result, &scalar); err != nil {
+			return nil, fmt.Errorf("unable to parse the scalar of query '%s': %w", query, err)
+		}
+		s, err := sampleOf(map[string]string{}, scalar)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the scalar of query '%s': %w", query, err)
+		}
+		return []Sample{s}, nil
+	default:
+		return nil, fmt.Errorf("unsupported result type of query '%s': %s", query, result.ResultType)
+	}
+}
+
+// QueryRange runs the given PromQL query over the given time range, with the given resolution step, and returns the series of
+// the resulting range vector
+func (c *PrometheusClient) QueryRange(query string, start, end time.Time, step time.Duration) ([]RangeSeries, error) {
+	params := url.Values{
+		"query": []string{query},
+		"start": []string{formatTime(start)},
+		"end":   []string{formatTime(end)},
+		"step":  []string{strconv.FormatFloat(step.Seconds(), 'f', -1, 64)},
+	}
+	result, err := c.get("/api/v1/query_range", params)
+	if err != nil {
+		return nil, err
+	}
+	if result.ResultType != "matrix" {
+		return nil, fmt.Errorf("unsupported result type of range query '%s': %s", query, result.ResultType)
+	}
+	var matrix []struct {
+		Metric map[string]string `json:"metric"`
+		Values [][]interface{}   `json:"values"`
+	}
+	if err := json.Unmarshal(result.Result, &matrix); err != nil {
+		return nil, fmt.Errorf("unable to parse the matrix of range query '%s': %w", query, err)
+	}
+	series := make([]RangeSeries, 0, len(matrix))
+	for _, m := range matrix {
+		s := RangeSeries{
+			Labels: m.Metric,
+			Points: make([]Sample, 0, len(m.Values)),
+		}
+		for _, v := range m.Values {
+			p, err := sampleOf(m.Metric, v)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse the matrix of range query '%s': %w", query, err)
+			}
+			s.Points = append(s.Points, p)
+		}
+		series = append(series, s)
+	}
+	return series, nil
+}
+
+// prometheusResponse the envelope of the responses of the query API of Prometheus
+type prometheusResponse struct {
+	Status    string         `json:"status"`
+	ErrorType string         `json:"errorType"`
+	Error     string         `json:"error"`
+	Data      prometheusData `json:"data"`
+}
+
+// prometheusData the result of a query, whose format depends on its type (`vector`, `scalar`, `matrix` or `string`)
+type prometheusData struct {
+	ResultType string          `json:"resultType"`
+	Result     json.RawMessage `json:"result"`
+}
+
+func (c *PrometheusClient) get(path string, params url.Values) (prometheusData, error) {
+	request, err := http.NewRequest("GET", c.url+path+"?"+params.Encode(), nil)
+	if err != nil {
+		return prometheusData{}, err
+	}
+	token, err := c.tokenFunc()
+	if err != nil {
+		return prometheusData{}, fmt.Errorf("unable to obtain a token for the Prometheus API: %w", err)
+	}
+	if token != "" {
+		request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	}
+	resp, err := c.httpClient.Do(request)
+	if err != nil {
+		return prometheusData{}, err
+	}
+	defer closeBody(resp)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return prometheusData{}, err
+	}
+	response := prometheusResponse{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return prometheusData{}, fmt.Errorf("unexpected response from the Prometheus API '%s%s' (%s): %s", c.url, path, resp.Status, string(body))
+	}
+	if response.Status != "success" {
+		return prometheusData{}, fmt.Errorf("query of the Prometheus API '%s%s' failed (%s): %s: %s", c.url, path, resp.Status, response.ErrorType, response.Error)
+	}
+	return response.Data, nil
+}
+
+// sampleOf returns the sample with the given labels and `[<unix time>, "<value>"]` pair
+func sampleOf(labels map[string]string, pair []interface{}) (Sample, error) {
+	if len(pair) != 2 {
+		return Sample{}, fmt.Errorf("unexpected sample: %v", pair)
+	}
+	timestamp, ok := pair[0].(float64)
+	if !ok {
+		return Sample{}, fmt.Errorf("unexpected timestamp of sample: %v", pair)
+	}
+	v, ok := pair[1].(string)
+	if !ok {
+		return Sample{}, fmt.Errorf("unexpected value of sample: %v", pair)
+	}
+	value, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return Sample{}, fmt.Errorf("unexpected value of sample: %w", err)
+	}
+	return Sample{
+		Labels: labels,
+		Time:   time.UnixMilli(int64(timestamp * 1000)),
+		Value:  value,
+	}, nil
+}
+
+func formatTime(t time.Time) string {
+	return strconv.FormatFloat(float64(t.UnixMilli())/1000, 'f', -1, 64)
+}
@@ -0,0 +1,112 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusClient(t *testing.T) {
+
+	newPrometheus := func(t *testing.T, path, response string) *httptest.Server {
+		return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, path, r.URL.Path)
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			assert.Equal(t, `sum(rate(controller_runtime_reconcile_errors_total[1m]))`, r.URL.Query().Get("query"))
+			fmt.Fprint(w, response)
+		}))
+	}
+	query := `sum(rate(controller_runtime_reconcile_errors_total[1m]))`
+
+	t.Run("query", func(t *testing.T) {
+
+		t.Run("vector", func(t *testing.T) {
+			// given
+			ts := newPrometheus(t, "/api/v1/query", `{"status":"success","data":{"resultType":"vector","result":[`+
+				`{"metric":{"controller":"usersignup"},"value":[1700000000.5,"0.25"]},`+
+				`{"metric":{"controller":"space"},"value":[1700000000.5,"0"]}]}}`)
+			defer ts.Close()
+			c := NewPrometheusClient(ts.URL+"/", StaticToken("token"))
+
+			// when
+			samples, err := c.Query(query, time.Unix(1700000000, 500000000))
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, []Sample{
+				{Labels: map[string]string{"controller": "usersignup"}, Time: time.UnixMilli(1700000000500), Value: 0.25},
+				{Labels: map[string]string{"controller": "space"}, Time: time.UnixMilli(1700000000500), Value: 0},
+			}, samples)
+		})
+
+		t.Run("scalar", func(t *testing.T) {
+			// given
+			ts := newPrometheus(t, "/api/v1/query", `{"status":"success","data":{"resultType":"scalar","result":[1700000000,"2"]}}`)
+			defer ts.Close()
+			c := NewPrometheusClient(ts.URL, StaticToken("token"))
+
+			// when
+			samples, err := c.Query(query, time.Now())
+
+			// then
+			require.NoError(t, err)
+			require.Len(t, samples, 1)
+			assert.Equal(t, float64(2), samples[0].Value)
+		})
+
+		t.Run("error", func(t *testing.T) {
+			// given
+			ts := newPrometheus(t, "/api/v1/query", `{"status":"error","errorType":"bad_data","error":"invalid parameter \"query\""}`)
+			defer ts.Close()
+			c := NewPrometheusClient(ts.URL, StaticToken("token"))
+
+			// when
+			_, err := c.Query(query, time.Now())
+
+			// then
+			require.EqualError(t, err, fmt.Sprintf(`query of the Prometheus API '%s/api/v1/query' failed (200 OK): bad_data: invalid parameter "query"`, ts.URL))
+		})
+
+		t.Run("not the Prometheus API", func(t *testing.T) {
+			// given
+			ts := newPrometheus(t, "/api/v1/query", `<html>Application is not available</html>`)
+			defer ts.Close()
+			c := NewPrometheusClient(ts.URL, StaticToken("token"))
+
+			// when
+			_, err := c.Query(query, time.Now())
+
+			// then
+			require.EqualError(t, err, fmt.Sprintf(`unexpected response from the Prometheus API '%s/api/v1/query' (200 OK): <html>Application is not available</html>`, ts.URL))
+		})
+	})
+
+	t.Run("range query", func(t *testing.T) {
+		// given
+		ts := newPrometheus(t, "/api/v1/query_range", `{"status":"success","data":{"resultType":"matrix","result":[`+
+			`{"metric":{"controller":"usersignup"},"values":[[1700000000,"0"],[1700000015,"0.5"]]}]}}`)
+		defer ts.Close()
+		c := NewPrometheusClient(ts.URL, StaticToken("token"))
+
+		// when
+		series, err := c.QueryRange(query, time.Unix(1700000000, 0), time.Unix(1700000015, 0), 15*time.Second)
+
+		// then
+		require.NoError(t, err)
+		labels := map[string]string{"controller": "usersignup"}
+		assert.Equal(t, []RangeSeries{
+			{
+				Labels: labels,
+				Points: []Sample{
+					{Labels: labels, Time: time.Unix(1700000000, 0), Value: 0},
+					{Labels: labels, Time: time.Unix(1700000015, 0), Value: 0.5},
+				},
+			},
+		}, series)
+	})
+}
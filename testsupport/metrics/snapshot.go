@@ -0,0 +1,196 @@
+package metrics
+
+import (
+	"io"
+	"sort"
+	"strings"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// Snapshot all the metric families exposed by an endpoint at a given time (see Client.Snapshot), eg: to capture the baseline
+// values of the metrics at the beginning of a test, without having to enumerate them
+type Snapshot struct {
+	families map[string]*dto.MetricFamily
+}
+
+// ParseSnapshot returns the Snapshot of all the metric families in the given response of a metrics endpoint
+func ParseSnapshot(body []byte) (Snapshot, error) {
+	families, err := parse(body)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	return Snapshot{families: families}, nil
+}
+
+// Snapshot scrapes the endpoint (regardless of the cached response) and returns all the metric families
+func (c *Client) Snapshot() (Snapshot, error) {
+	c.Invalidate()
+	families, err := c.scrape()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	return Snapshot{families: families}, nil
+}
+
+// Families returns the names of all the metric families of the snapshot, sorted by name
+func (s Snapshot) Families() []string {
+	names := make([]string, 0, len(s.families))
+	for name := range s.families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// WriteOpenMetrics writes all the metric families of the snapshot in the OpenMetrics text format, sorted by name,
+// eg: to keep a record of what the endpoint returned when a metric assertion failed
+func (s Snapshot) WriteOpenMetrics(out io.Writer) error {
+	for _, name := range s.Families() {
+		if _, err := expfmt.MetricFamilyToOpenMetrics(out, s.families[name]); err != nil {
+			return err
+		}
+	}
+	_, err := expfmt.FinalizeOpenMetrics(out)
+	return err
+}
+
+// WriteText writes all the metric families of the snapshot in the text format of Prometheus, sorted by name, which can be parsed
+// again with ParseSnapshot, eg: to persist the baseline values of the metrics
+func (s Snapshot) WriteText(out io.Writer) error {
+	for _, name := range s.Families() {
+		if _, err := expfmt.MetricFamilyToText(out, s.families[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Value returns the value of the metric with the given family and label key-value pairs (see Client.GetMetricValue),
+// or 0 if the snapshot does not contain it
+func (s Snapshot) Value(family string, labelAndValues ...string) float64 {
+	if len(labelAndValues)%2 != 0 {
+		return 0
+	}
+	value, err := metricValue(s.families, family, labelAndValues)
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+// Series returns all the series of the given metric family (or an empty slice if the snapshot does not contain the family)
+func (s Snapshot) Series(family string) ([]Series, error) {
+	f, found := s.families[family]
+	if !found {
+		return []Series{}, nil
+	}
+	return seriesOf(f)
+}
+
+// SeriesDelta the values of a metric with a given set of labels in two snapshots
+type SeriesDelta struct {
+	Family string
+	Labels map[string]string
+	Before float64
+	After  float64
+}
+
+// Delta returns the difference between the value after and the value before
+func (d SeriesDelta) Delta() float64 {
+	return d.After - d.Before
+}
+
+// LabelsString returns the labels of the series in the Prometheus format, sorted by name, eg: `{cluster_name="member-1",domain="external"}`
+func (d SeriesDelta) LabelsString() string {
+	return labelsString(d.Labels)
+}
+
+// Diff returns the series of the given families (or of all the families when none is given) whose value in the given snapshot
+// differs from the value in this snapshot, sorted by family and labels. A series which is missing from one of the snapshots
+// has a value of 0 in it. The families whose values are not supported (eg: summaries) are ignored.
+func (s Snapshot) Diff(after Snapshot, families ...string) []SeriesDelta {
+	if len(families) == 0 {
+		families = union(s.Families(), after.Families())
+	}
+	var deltas []SeriesDelta
+	for _, family := range families {
+		before, err := s.Series(family)
+		if err != nil {
+			continue
+		}
+		current, err := after.Series(family)
+		if err != nil {
+			continue
+		}
+		byLabels := map[string]*SeriesDelta{}
+		for _, series := range before {
+			byLabels[labelsString(series.Labels)] = &SeriesDelta{Family: family, Labels: series.Labels, Before: series.Value}
+		}
+		for _, series := range current {
+			key := labelsString(series.Labels)
+			if d, found := byLabels[key]; found {
+				d.After = series.Value
+				continue
+			}
+			byLabels[key] = &SeriesDelta{Family: family, Labels: series.Labels, After: series.Value}
+		}
+		keys := make([]string, 0, len(byLabels))
+		for key := range byLabels {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if d := byLabels[key]; d.Before != d.After {
+				deltas = append(deltas, *d)
+			}
+		}
+	}
+	return deltas
+}
+
+func seriesOf(f *dto.MetricFamily) ([]Series, error) {
+	series := make([]Series, 0, len(f.GetMetric()))
+	for _, m := range f.GetMetric() {
+		value, err := getValue(f.GetType(), m)
+		if err != nil {
+			return nil, err
+		}
+		labels := map[string]string{}
+		for _, l := range m.GetLabel() {
+			labels[l.GetName()] = l.GetValue()
+		}
+		series = append(series, Series{
+			Labels: labels,
+			Value:  value,
+		})
+	}
+	return series, nil
+}
+
+func labelsString(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		pairs = append(pairs, name+"=\""+labels[name]+"\"")
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func union(a, b []string) []string {
+	seen := map[string]bool{}
+	result := []string{}
+	for _, s := range append(append([]string{}, a...), b...) {
+		if !seen[s] {
+			seen[s] = true
+			result = append(result, s)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
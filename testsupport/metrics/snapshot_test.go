@@ -0,0 +1,136 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const before = `# TYPE sandbox_spaces_current gauge
+sandbox_spaces_current{cluster_name="member-1"} 3
+sandbox_spaces_current{cluster_name="member-2"} 1
+# TYPE sandbox_user_signups_total counter
+sandbox_user_signups_total 10
+# TYPE go_gc_duration_seconds summary
+go_gc_duration_seconds{quantile="0"} 1.4465e-05
+go_gc_duration_seconds_sum 0.1
+go_gc_duration_seconds_count 5
+`
+
+const after = `# TYPE sandbox_spaces_current gauge
+sandbox_spaces_current{cluster_name="member-1"} 5
+sandbox_spaces_current{cluster_name="member-2"} 1
+sandbox_spaces_current{cluster_name="member-3"} 2
+# TYPE sandbox_user_signups_total counter
+sandbox_user_signups_total 12
+# TYPE go_gc_duration_seconds summary
+go_gc_duration_seconds{quantile="0"} 2.1044e-05
+go_gc_duration_seconds_sum 0.2
+go_gc_duration_seconds_count 6
+`
+
+func TestSnapshot(t *testing.T) {
+	// given
+	snapshot, err := ParseSnapshot([]byte(before))
+	require.NoError(t, err)
+
+	t.Run("families", func(t *testing.T) {
+		assert.Equal(t, []string{"go_gc_duration_seconds", "sandbox_spaces_current", "sandbox_user_signups_total"}, snapshot.Families())
+	})
+
+	t.Run("value", func(t *testing.T) {
+		assert.Equal(t, float64(3), snapshot.Value("sandbox_spaces_current", "cluster_name", "member-1"))
+		assert.Equal(t, float64(10), snapshot.Value("sandbox_user_signups_total"))
+	})
+
+	t.Run("missing value", func(t *testing.T) {
+		assert.Equal(t, float64(0), snapshot.Value("sandbox_spaces_current", "cluster_name", "member-3"))
+		assert.Equal(t, float64(0), snapshot.Value("sandbox_unknown_total"))
+	})
+
+	t.Run("empty snapshot", func(t *testing.T) {
+		assert.Equal(t, float64(0), Snapshot{}.Value("sandbox_user_signups_total"))
+		assert.Empty(t, Snapshot{}.Families())
+	})
+}
+
+func TestSnapshotWriteText(t *testing.T) {
+	// given
+	snapshot, err := ParseSnapshot([]byte(before))
+	require.NoError(t, err)
+	out := &strings.Builder{}
+
+	// when
+	err = snapshot.WriteText(out)
+
+	// then
+	require.NoError(t, err)
+	parsed, err := ParseSnapshot([]byte(out.String()))
+	require.NoError(t, err)
+	assert.Equal(t, snapshot.Families(), parsed.Families())
+	assert.Empty(t, snapshot.Diff(parsed))
+}
+
+func TestSnapshotWriteOpenMetrics(t *testing.T) {
+	// given
+	snapshot, err := ParseSnapshot([]byte(before))
+	require.NoError(t, err)
+	out := &strings.Builder{}
+
+	// when
+	err = snapshot.WriteOpenMetrics(out)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, `# TYPE go_gc_duration_seconds summary
+go_gc_duration_seconds{quantile="0.0"} 1.4465e-05
+go_gc_duration_seconds_sum 0.1
+go_gc_duration_seconds_count 5
+# TYPE sandbox_spaces_current gauge
+sandbox_spaces_current{cluster_name="member-1"} 3.0
+sandbox_spaces_current{cluster_name="member-2"} 1.0
+# TYPE sandbox_user_signups counter
+sandbox_user_signups_total 10.0
+# EOF
+`, out.String())
+}
+
+func TestSnapshotDiff(t *testing.T) {
+	// given
+	s1, err := ParseSnapshot([]byte(before))
+	require.NoError(t, err)
+	s2, err := ParseSnapshot([]byte(after))
+	require.NoError(t, err)
+
+	t.Run("all families", func(t *testing.T) {
+		// when
+		deltas := s1.Diff(s2)
+
+		// then
+		assert.Equal(t, []SeriesDelta{
+			{Family: "sandbox_spaces_current", Labels: map[string]string{"cluster_name": "member-1"}, Before: 3, After: 5},
+			{Family: "sandbox_spaces_current", Labels: map[string]string{"cluster_name": "member-3"}, Before: 0, After: 2},
+			{Family: "sandbox_user_signups_total", Labels: map[string]string{}, Before: 10, After: 12},
+		}, deltas)
+		assert.Equal(t, `{cluster_name="member-1"}`, deltas[0].LabelsString())
+		assert.Equal(t, float64(2), deltas[0].Delta())
+		assert.Equal(t, `{}`, deltas[2].LabelsString())
+	})
+
+	t.Run("given families", func(t *testing.T) {
+		// when
+		deltas := s2.Diff(s1, "sandbox_user_signups_total")
+
+		// then
+		assert.Equal(t, []SeriesDelta{
+			{Family: "sandbox_user_signups_total", Labels: map[string]string{}, Before: 12, After: 10},
+		}, deltas)
+		assert.Equal(t, float64(-2), deltas[0].Delta())
+	})
+
+	t.Run("no difference", func(t *testing.T) {
+		assert.Empty(t, s1.Diff(s1))
+	})
+}
@@ -0,0 +1,104 @@
+package testsupport
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ProxyResponse the raw response of a request sent to the proxy, used to verify the error responses returned to the users
+type ProxyResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       string
+	Timings    util.RequestTimings
+}
+
+// InvokeProxyEndpoint sends a request to the given proxy URL with the given token (no `Authorization` header if the token is empty)
+// and returns the raw response
+func InvokeProxyEndpoint(t *testing.T, method, url, token string) *ProxyResponse {
+	t.Logf("invoking proxy request: %s %s", method, url)
+	req, err := http.NewRequest(method, url, nil)
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, timings, err := util.DoTraced(httpClient, req) // nolint:bodyclose // see `defer Close(t, resp)`
+	require.NoError(t, err)
+	defer Close(t, resp)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	t.Logf("proxy response status code: %d", resp.StatusCode)
+	return &ProxyResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       strings.TrimSpace(string(body)),
+		Timings:    timings,
+	}
+}
+
+// RequireUnauthorized verifies that the response is a `401 Unauthorized` with an `invalid bearer token` message
+// (and the given details, if not empty)
+func (r *ProxyResponse) RequireUnauthorized(t *testing.T, details string) {
+	require.Equal(t, http.StatusUnauthorized, r.StatusCode, "unexpected response status with body: %s", r.Body)
+	if details == "" {
+		assert.True(t, strings.HasPrefix(r.Body, "invalid bearer token"), "unexpected response body: %s", r.Body)
+		return
+	}
+	assert.Equal(t, fmt.Sprintf("invalid bearer token: %s", details), r.Body)
+}
+
+// RequireNotCacheable verifies that the response can't be served again by a cache without being revalidated with the server
+func (r *ProxyResponse) RequireNotCacheable(t *testing.T) {
+	RequireNotCacheable(t, r.Header)
+}
+
+// RequireForbiddenWorkspace verifies that the response is a `403 Forbidden` with a message telling that the access to the given
+// workspace is forbidden
+func (r *ProxyResponse) RequireForbiddenWorkspace(t *testing.T, workspace string) {
+	require.Equal(t, http.StatusForbidden, r.StatusCode, "unexpected response status with body: %s", r.Body)
+	assert.Equal(t, fmt.Sprintf("invalid workspace request: access to workspace '%s' is forbidden", workspace), r.Body)
+}
+
+// RequireForbiddenNamespace verifies that the response is a `403 Forbidden` with a message telling that the access to the given
+// namespace in the given workspace is forbidden
+func (r *ProxyResponse) RequireForbiddenNamespace(t *testing.T, namespace, workspace string) {
+	require.Equal(t, http.StatusForbidden, r.StatusCode, "unexpected response status with body: %s", r.Body)
+	assert.Equal(t, fmt.Sprintf("invalid workspace request: access to namespace '%s' in workspace '%s' is forbidden", namespace, workspace), r.Body)
+}
+
+// RequireWorkspaceNotFound verifies that the response is a `500 Internal Server Error` with a message telling that the requested
+// workspace is not available
+func (r *ProxyResponse) RequireWorkspaceNotFound(t *testing.T) {
+	require.Equal(t, http.StatusInternalServerError, r.StatusCode, "unexpected response status with body: %s", r.Body)
+	assert.Equal(t, "unable to get target cluster: the requested space is not available", r.Body)
+}
+
+// RequireThrottled verifies that the response is a `429 Too Many Requests` with a valid `Retry-After` header
+// (ie, a number of seconds or an HTTP date), and returns the duration after which the request can be retried
+// (0 if the date is already past)
+func (r *ProxyResponse) RequireThrottled(t *testing.T) time.Duration {
+	require.Equal(t, http.StatusTooManyRequests, r.StatusCode, "unexpected response status with body: %s", r.Body)
+	retryAfter := r.Header.Get("Retry-After")
+	require.NotEmpty(t, retryAfter, "missing 'Retry-After' header in throttled response")
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		require.GreaterOrEqual(t, seconds, 0, "invalid 'Retry-After' header: %s", retryAfter)
+		return time.Duration(seconds) * time.Second
+	}
+	date, err := http.ParseTime(retryAfter)
+	require.NoError(t, err, "invalid 'Retry-After' header: %s", retryAfter)
+	if delay := time.Until(date); delay > 0 {
+		return delay
+	}
+	return 0
+}
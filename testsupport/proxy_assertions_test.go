@@ -0,0 +1,39 @@
+package testsupport_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireThrottled(t *testing.T) {
+	for name, tc := range map[string]struct {
+		retryAfter string
+		min, max   time.Duration
+	}{
+		"seconds":   {retryAfter: "3", min: 3 * time.Second, max: 3 * time.Second},
+		"date":      {retryAfter: time.Now().Add(time.Minute).UTC().Format(http.TimeFormat), min: 58 * time.Second, max: time.Minute},
+		"past date": {retryAfter: time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), min: 0, max: 0},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Retry-After", tc.retryAfter)
+				w.WriteHeader(http.StatusTooManyRequests)
+			}))
+			defer ts.Close()
+
+			// when
+			delay := testsupport.InvokeProxyEndpoint(t, "GET", ts.URL+"/workspaces/john/api/v1/namespaces", "token").RequireThrottled(t)
+
+			// then
+			assert.GreaterOrEqual(t, delay, tc.min)
+			assert.LessOrEqual(t, delay, tc.max)
+		})
+	}
+}
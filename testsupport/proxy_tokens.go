@@ -0,0 +1,62 @@
+package testsupport
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	authsupport "github.com/codeready-toolchain/toolchain-e2e/testsupport/auth"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenExpiryLeeway the additional time to wait after the expiry of a token, to account for the clock skew between the tests
+// and the proxy
+const tokenExpiryLeeway = 2 * time.Second
+
+// NewShortLivedToken returns a new token for the user of the given (executed) signup request, which expires after the given lifetime,
+// along with its expiry time
+func NewShortLivedToken(t *testing.T, signupRequest *SignupRequest, lifetime time.Duration) (string, time.Time) {
+	// the `exp` claim has a precision of 1 second
+	expiry := time.Now().Add(lifetime).Truncate(time.Second)
+	token, err := signupRequest.NewToken(authsupport.WithExp(expiry))
+	require.NoError(t, err)
+	return token, expiry
+}
+
+// WaitForTokenExpiry waits until the given expiry time of a token has passed
+func WaitForTokenExpiry(t *testing.T, expiry time.Time) {
+	if d := time.Until(expiry.Add(tokenExpiryLeeway)); d > 0 {
+		t.Logf("waiting %s for the token to expire", d)
+		time.Sleep(d)
+	}
+}
+
+// RequireExpiredToken verifies that the response is a `401 Unauthorized` with a message telling that the token is expired
+func (r *ProxyResponse) RequireExpiredToken(t *testing.T) {
+	require.Equal(t, http.StatusUnauthorized, r.StatusCode, "unexpected response status with body: %s", r.Body)
+	assert.True(t, strings.HasPrefix(r.Body, "invalid bearer token"), "unexpected response body: %s", r.Body)
+	assert.Contains(t, r.Body, "token is expired")
+}
+
+// VerifyProxyTokenLifecycle verifies that the proxy accepts a short-lived token of the user of the given (executed) signup request
+// until it expires, then rejects it with the appropriate error, while a refreshed token for the same user keeps working
+func VerifyProxyTokenLifecycle(t *testing.T, proxyURL string, signupRequest *SignupRequest) {
+	url := proxyURL + "/api/v1/namespaces"
+	token, expiry := NewShortLivedToken(t, signupRequest, 10*time.Second)
+
+	// the token is accepted before its expiry
+	resp := InvokeProxyEndpoint(t, http.MethodGet, url, token)
+	require.NotEqual(t, http.StatusUnauthorized, resp.StatusCode, "short-lived token rejected before its expiry: %s", resp.Body)
+
+	// the token is rejected after its expiry
+	WaitForTokenExpiry(t, expiry)
+	InvokeProxyEndpoint(t, http.MethodGet, url, token).RequireExpiredToken(t)
+
+	// a refreshed token keeps working
+	refreshed, _ := NewShortLivedToken(t, signupRequest, time.Hour)
+	resp = InvokeProxyEndpoint(t, http.MethodGet, url, refreshed)
+	require.NotEqual(t, http.StatusUnauthorized, resp.StatusCode, "refreshed token rejected: %s", resp.Body)
+}
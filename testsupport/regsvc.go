@@ -7,12 +7,18 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/httpclient"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
+
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 type HTTPRequest struct {
-	body []byte
-	t    *testing.T
+	body      []byte
+	header    http.Header
+	bustCache bool
+	t         *testing.T
 }
 
 func NewHTTPRequest(t *testing.T) *HTTPRequest {
@@ -21,6 +27,13 @@ func NewHTTPRequest(t *testing.T) *HTTPRequest {
 	}
 }
 
+// BustCache sends the request so that its response is never served from a cache between the test and the server
+// (see util.NewCacheBustingTransport), eg: when polling an endpoint until its response changes
+func (h HTTPRequest) BustCache() *HTTPRequest {
+	h.bustCache = true
+	return &h
+}
+
 // InvokeEndpoint invokes given http URL and returns the json body response
 func (h HTTPRequest) InvokeEndpoint(method, path, authToken, requestBody string, requiredStatus int) *HTTPRequest {
 	var reqBody io.Reader
@@ -33,7 +46,13 @@ func (h HTTPRequest) InvokeEndpoint(method, path, authToken, requestBody string,
 	require.NoError(h.t, err)
 	req.Header.Set("Authorization", "Bearer "+authToken)
 	req.Header.Set("content-type", "application/json")
-	resp, err := httpClient.Do(req) // nolint:bodyclose // see `defer.Close(...)`
+	client := httpClient
+	if h.bustCache {
+		client = httpclient.New(
+			httpclient.WithTimeout(httpClient.Timeout),
+			httpclient.WithTransport(util.NewCacheBustingTransport(httpClient.Transport)))
+	}
+	resp, err := client.Do(req) // nolint:bodyclose // see `defer.Close(...)`
 
 	if resp != nil {
 		h.t.Logf("response status code: %d", resp.StatusCode)
@@ -41,6 +60,7 @@ func (h HTTPRequest) InvokeEndpoint(method, path, authToken, requestBody string,
 	require.NoError(h.t, err)
 	defer Close(h.t, resp)
 
+	h.header = resp.Header
 	h.body, err = io.ReadAll(resp.Body)
 	require.NoError(h.t, err)
 	require.NotNil(h.t, h.body)
@@ -48,6 +68,18 @@ func (h HTTPRequest) InvokeEndpoint(method, path, authToken, requestBody string,
 	return &h
 }
 
+// RequireNotCacheable verifies that the response can't be served again by a cache without being revalidated with the server,
+// so that a stale response (eg: the status of a signup before its approval) is never served to the user
+func (h HTTPRequest) RequireNotCacheable() *HTTPRequest {
+	RequireNotCacheable(h.t, h.header)
+	return &h
+}
+
+// ETag returns the `ETag` header of the response, if any
+func (h HTTPRequest) ETag() string {
+	return h.header.Get("ETag")
+}
+
 // UnmarshalMap unmarshal the response body into a map type
 func (h HTTPRequest) UnmarshalMap() map[string]interface{} {
 	mp := make(map[string]interface{})
@@ -75,3 +107,19 @@ func ParseSignupResponse(t *testing.T, responseBody map[string]interface{}) (map
 	require.True(t, ok)
 	return responseBody, status
 }
+
+// RequireNotCacheable verifies that the response with the given header can't be served again by a cache without being revalidated
+// with the server (see util.CachePolicy)
+func RequireNotCacheable(t *testing.T, header http.Header) {
+	policy := util.ParseCachePolicy(header)
+	require.False(t, policy.Cacheable(), "the response must not be cacheable, but its caching directives are: %s", policy)
+}
+
+// AssertETagChanged verifies that the given ETags of two responses with different contents are different, if both responses
+// have an ETag: otherwise, a cache revalidating the previous response with its ETag would keep serving it.
+func AssertETagChanged(t *testing.T, previous, current string) {
+	if previous == "" || current == "" {
+		return
+	}
+	assert.NotEqual(t, previous, current, "the ETag of the response did not change along with its content")
+}
@@ -0,0 +1,269 @@
+package testsupport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	k8swait "k8s.io/apimachinery/pkg/util/wait"
+	k8smetrics "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ResourceUsageSamplingInterval the interval between two samples of the resource usage during the test suite
+	ResourceUsageSamplingInterval = 30 * time.Second
+	// ResourceUsageReportFile the name of the file in which the resource usage report is written, in the `ARTIFACT_DIR`
+	ResourceUsageReportFile = "resource-usage.txt"
+)
+
+// GetMemoryUsageOrSkip returns the memory usage (in KB) of the given pod (see Awaitility.GetMemoryUsage). If it can't be retrieved because
+// neither the metrics-server nor the OpenShift monitoring stack is available on the cluster, then the test is skipped, unless
+// wait.PodMetricsRequiredVar is set to `true`, in which case the test fails.
+func GetMemoryUsageOrSkip(t *testing.T, await *wait.Awaitility, podname, ns string) int64 {
+	usage, err := await.GetMemoryUsage(podname, ns)
+	if errors.Is(err, wait.ErrPodMetricsUnavailable) && !wait.CurrentEnvironment().PodMetricsRequired {
+		t.Skipf("skipping the test since the memory usage of pod '%s' can't be retrieved (set %s=true to fail instead): %s", podname, wait.PodMetricsRequiredVar, err.Error())
+	}
+	require.NoError(t, err, "unable to retrieve the memory usage of pod '%s': deploy the metrics-server, or enable the OpenShift monitoring stack", podname)
+	return usage
+}
+
+// ResourceUsage the CPU (in millicores) and memory (in KB) used by all the pods of a component
+type ResourceUsage struct {
+	CPU    int64
+	Memory int64
+}
+
+// resourceUsageTarget the pods of a component, selected by the selector of a Deployment or of a Service
+type resourceUsageTarget struct {
+	component  string
+	cl         client.Client
+	namespace  string
+	deployment string
+	service    string
+}
+
+type resourceUsageSample struct {
+	timestamp time.Time
+	usages    map[string]ResourceUsage
+}
+
+// ResourceUsageReporter periodically collects the CPU and memory usage of the host operator, the member operators,
+// the registration service and the proxy via the metrics API, and reports the usage at the start, the midpoint and the end
+// of the test suite. The pods of the proxy are the ones selected by the proxy metrics Service, so they are the same as
+// the pods of the registration service when the proxy is served by the registration service.
+type ResourceUsageReporter struct {
+	targets []resourceUsageTarget
+	mu      sync.Mutex
+	samples []resourceUsageSample
+	errs    []error
+	stop    chan struct{}
+}
+
+// NewResourceUsageReporter returns a new ResourceUsageReporter for the components of the given awaitilities
+func NewResourceUsageReporter(awaitilities wait.Awaitilities) *ResourceUsageReporter {
+	hostAwait := awaitilities.Host()
+	targets := []resourceUsageTarget{
+		{
+			component:  "host-operator",
+			cl:         hostAwait.Client,
+			namespace:  hostAwait.Namespace,
+			deployment: "host-operator-controller-manager",
+		},
+		{
+			component:  "registration-service",
+			cl:         hostAwait.Client,
+			namespace:  hostAwait.RegistrationServiceNs,
+			deployment: "registration-service",
+		},
+		{
+			component: "proxy",
+			cl:        hostAwait.Client,
+			namespace: hostAwait.Namespace,
+			service:   "proxy-metrics-service",
+		},
+	}
+	for _, memberAwait := range awaitilities.AllMembers() {
+		targets = append(targets, resourceUsageTarget{
+			component:  fmt.Sprintf("member-operator (%s)", memberAwait.Namespace),
+			cl:         memberAwait.Client,
+			namespace:  memberAwait.Namespace,
+			deployment: "member-operator-controller-manager",
+		})
+	}
+	return &ResourceUsageReporter{
+		targets: targets,
+	}
+}
+
+// Start collects a first sample and then keeps collecting samples at the given interval, until Stop is called
+func (r *ResourceUsageReporter) Start(interval time.Duration) {
+	r.stop = make(chan struct{})
+	go k8swait.Until(r.collect, interval, r.stop)
+}
+
+// Stop stops the periodic collection and collects a last sample
+func (r *ResourceUsageReporter) Stop() {
+	if r.stop != nil {
+		close(r.stop)
+		r.stop = nil
+	}
+	r.collect()
+}
+
+func (r *ResourceUsageReporter) collect() {
+	sample := resourceUsageSample{
+		timestamp: time.Now(),
+		usages:    map[string]ResourceUsage{},
+	}
+	var errs []error
+	for _, target := range r.targets {
+		usage, err := target.usage()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to collect the resource usage of '%s': %w", target.component, err))
+			continue
+		}
+		sample.usages[target.component] = usage
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.samples = append(r.samples, sample)
+	r.errs = append(r.errs, errs...)
+}
+
+func (target resourceUsageTarget) usage() (ResourceUsage, error) {
+	selector, err := target.podSelector()
+	if err != nil {
+		return ResourceUsage{}, err
+	}
+	pods := &corev1.PodList{}
+	if err := target.cl.List(context.TODO(), pods, client.InNamespace(target.namespace), client.MatchingLabels(selector)); err != nil {
+		return ResourceUsage{}, err
+	}
+	usage := ResourceUsage{}
+	for _, pod := range pods.Items {
+		podMetrics := &k8smetrics.PodMetrics{}
+		if err := target.cl.Get(context.TODO(), types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}, podMetrics); err != nil {
+			return ResourceUsage{}, err
+		}
+		for _, c := range podMetrics.Containers {
+			usage.CPU += c.Usage.Cpu().MilliValue()
+			usage.Memory += c.Usage.Memory().ScaledValue(resource.Kilo)
+		}
+	}
+	return usage, nil
+}
+
+func (target resourceUsageTarget) podSelector() (map[string]string, error) {
+	if target.service != "" {
+		service := &corev1.Service{}
+		if err := target.cl.Get(context.TODO(), types.NamespacedName{Namespace: target.namespace, Name: target.service}, service); err != nil {
+			return nil, err
+		}
+		return service.Spec.Selector, nil
+	}
+	deployment := &appsv1.Deployment{}
+	if err := target.cl.Get(context.TODO(), types.NamespacedName{Namespace: target.namespace, Name: target.deployment}, deployment); err != nil {
+		return nil, err
+	}
+	return deployment.Spec.Selector.MatchLabels, nil
+}
+
+// Report writes a table with the resource usage of each component at the start, the midpoint and the end of the test suite,
+// followed by the errors that occurred while collecting the samples (if any)
+func (r *ResourceUsageReporter) Report(out io.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.samples) == 0 {
+		_, err := fmt.Fprintln(out, "no resource usage was collected")
+		return err
+	}
+	start, end := r.samples[0], r.samples[len(r.samples)-1]
+	midpoint := start.timestamp.Add(end.timestamp.Sub(start.timestamp) / 2)
+	mid := start
+	for _, s := range r.samples {
+		if absDuration(s.timestamp.Sub(midpoint)) < absDuration(mid.timestamp.Sub(midpoint)) {
+			mid = s
+		}
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "resource usage collected between %s and %s (%d samples)\n", start.timestamp.Format(time.RFC3339), end.timestamp.Format(time.RFC3339), len(r.samples))
+	fmt.Fprintln(w, "COMPONENT\tCPU START (m)\tCPU MID (m)\tCPU END (m)\tMEMORY START (KB)\tMEMORY MID (KB)\tMEMORY END (KB)\tMEMORY DELTA (KB)")
+	for _, target := range r.targets {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", target.component,
+			cpuOf(start, target.component), cpuOf(mid, target.component), cpuOf(end, target.component),
+			memoryOf(start, target.component), memoryOf(mid, target.component), memoryOf(end, target.component),
+			memoryDelta(start, end, target.component))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	for _, err := range r.errs {
+		if _, err := fmt.Fprintf(out, "warning: %s\n", err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func cpuOf(s resourceUsageSample, component string) string {
+	if u, found := s.usages[component]; found {
+		return fmt.Sprintf("%d", u.CPU)
+	}
+	return "n/a"
+}
+
+func memoryOf(s resourceUsageSample, component string) string {
+	if u, found := s.usages[component]; found {
+		return fmt.Sprintf("%d", u.Memory)
+	}
+	return "n/a"
+}
+
+func memoryDelta(start, end resourceUsageSample, component string) string {
+	s, startFound := start.usages[component]
+	e, endFound := end.usages[component]
+	if !startFound || !endFound {
+		return "n/a"
+	}
+	return fmt.Sprintf("%+d", e.Memory-s.Memory)
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+var initResourceUsage *ResourceUsageReporter
+
+// reportResourceUsage writes the resource usage report (if the operators were initialized via WaitForDeployments)
+func reportResourceUsage() {
+	if initResourceUsage == nil {
+		return
+	}
+	initResourceUsage.Stop()
+	report := &strings.Builder{}
+	if err := initResourceUsage.Report(report); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to generate the resource usage report: %s\n", err)
+		return
+	}
+	writeReport(ResourceUsageReportFile, report.String())
+}
@@ -0,0 +1,36 @@
+package testsupport_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMemoryUsageOrSkip(t *testing.T) {
+	// given
+	wait.OverrideEnvironment(t, func(env *wait.Environment) {
+		env.PodMetricsRequired = false
+	})
+	// neither the metrics-server nor the OpenShift monitoring stack is available
+	a := &wait.Awaitility{
+		Client:        test.NewFakeClient(t),
+		Namespace:     "toolchain-member-operator",
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       time.Second,
+	}
+	var sub *testing.T
+
+	// when
+	t.Run("pod metrics unavailable", func(t *testing.T) {
+		sub = t
+		testsupport.GetMemoryUsageOrSkip(t, a, "member-operator-controller-manager-abcde", "toolchain-member-operator")
+	})
+
+	// then
+	assert.True(t, sub.Skipped())
+}
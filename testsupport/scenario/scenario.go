@@ -0,0 +1,124 @@
+package scenario
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Scenario a multi-step e2e flow, whose steps are run in sequence as subtests of the test, eg:
+//
+//	sc := scenario.New(t)
+//	sc.Step("signup", func(t *testing.T) {
+//		userSignup, _ := NewSignupRequest(awaitilities).Execute(t).Resources()
+//		sc.Record("UserSignup", userSignup.Name)
+//	}).Step("approve", func(t *testing.T) {
+//		...
+//	}).Run()
+//
+// The boundaries and the duration of each step are logged, the steps after a failed step are skipped, and the failure is reported
+// with the name of the failed step, along with the outcome of the previous steps and the context they recorded (see Record).
+type Scenario struct {
+	t           *testing.T
+	steps       []step
+	currentStep string
+	context     []entry
+}
+
+type step struct {
+	name string
+	run  func(t *testing.T)
+}
+
+// entry a key/value recorded in the context of a scenario, by the given step
+type entry struct {
+	step  string
+	key   string
+	value interface{}
+}
+
+// stepResult the outcome of a step which was run
+type stepResult struct {
+	name     string
+	duration time.Duration
+	passed   bool
+}
+
+// New returns a new, empty Scenario for the given test
+func New(t *testing.T) *Scenario {
+	return &Scenario{
+		t: t,
+	}
+}
+
+// Step adds a step with the given name to the scenario. The given func is run as a subtest of the test of the scenario,
+// if all the previous steps passed.
+func (s *Scenario) Step(name string, run func(t *testing.T)) *Scenario {
+	s.steps = append(s.steps, step{
+		name: name,
+		run:  run,
+	})
+	return s
+}
+
+// Record adds the given key/value to the context of the scenario (eg: the name of a resource created by the current step),
+// which is reported if a step fails
+func (s *Scenario) Record(key string, value interface{}) {
+	s.context = append(s.context, entry{
+		step:  s.currentStep,
+		key:   key,
+		value: value,
+	})
+}
+
+// Run runs the steps of the scenario in sequence, until one of them fails.
+// Returns `true` if all the steps passed.
+func (s *Scenario) Run() bool {
+	s.t.Helper()
+	results := make([]stepResult, 0, len(s.steps))
+	for i, st := range s.steps {
+		s.currentStep = st.name
+		s.t.Logf("scenario step %d/%d '%s' started", i+1, len(s.steps), st.name)
+		start := time.Now()
+		passed := s.t.Run(st.name, st.run)
+		result := stepResult{
+			name:     st.name,
+			duration: time.Since(start),
+			passed:   passed,
+		}
+		results = append(results, result)
+		if !passed {
+			s.t.Log(report(results, s.steps[i+1:], s.context))
+			return false
+		}
+		s.t.Logf("scenario step %d/%d '%s' passed in %s", i+1, len(s.steps), st.name, result.duration)
+	}
+	return true
+}
+
+// report returns the description of a scenario whose last step failed, with the outcome of the steps which were run,
+// the steps which were skipped and the context recorded by the steps
+func report(results []stepResult, skipped []step, context []entry) string {
+	buf := &strings.Builder{}
+	failed := results[len(results)-1]
+	buf.WriteString(fmt.Sprintf("scenario failed at step %d/%d '%s' after %s\n", len(results), len(results)+len(skipped), failed.name, failed.duration))
+	buf.WriteString("steps:\n")
+	for _, r := range results {
+		outcome := "passed"
+		if !r.passed {
+			outcome = "FAILED"
+		}
+		buf.WriteString(fmt.Sprintf("  - %s: %s in %s\n", r.name, outcome, r.duration))
+	}
+	for _, st := range skipped {
+		buf.WriteString(fmt.Sprintf("  - %s: skipped\n", st.name))
+	}
+	if len(context) > 0 {
+		buf.WriteString("context:\n")
+		for _, e := range context {
+			buf.WriteString(fmt.Sprintf("  - %s (%s): %v\n", e.key, e.step, e.value))
+		}
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
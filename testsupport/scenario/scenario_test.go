@@ -0,0 +1,55 @@
+package scenario
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	// given
+	var run []string
+	sc := New(t)
+	sc.Step("signup", func(t *testing.T) {
+		run = append(run, t.Name())
+		sc.Record("UserSignup", "john")
+	}).Step("approve", func(t *testing.T) {
+		run = append(run, t.Name())
+	})
+
+	// when
+	passed := sc.Run()
+
+	// then
+	require.True(t, passed)
+	assert.Equal(t, []string{"TestRun/signup", "TestRun/approve"}, run)
+	assert.Equal(t, []entry{{step: "signup", key: "UserSignup", value: "john"}}, sc.context)
+}
+
+func TestReport(t *testing.T) {
+	// given
+	results := []stepResult{
+		{name: "signup", duration: 2 * time.Second, passed: true},
+		{name: "approve", duration: time.Minute, passed: false},
+	}
+	skipped := []step{{name: "deactivate"}}
+	context := []entry{
+		{step: "signup", key: "UserSignup", value: "john"},
+		{step: "approve", key: "MasterUserRecord", value: "john"},
+	}
+
+	// when
+	actual := report(results, skipped, context)
+
+	// then
+	assert.Equal(t, `scenario failed at step 2/3 'approve' after 1m0s
+steps:
+  - signup: passed in 2s
+  - approve: FAILED in 1m0s
+  - deactivate: skipped
+context:
+  - UserSignup (signup): john
+  - MasterUserRecord (approve): john`, actual)
+}
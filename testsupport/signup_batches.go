@@ -0,0 +1,186 @@
+package testsupport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-common/pkg/condition"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/factories"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// SignupBatch the UserSignups which were created together by CreateSignupsInBatches
+type SignupBatch struct {
+	Index       int
+	CreatedAt   time.Time
+	UserSignups []*toolchainv1alpha1.UserSignup
+}
+
+// SignupBatches the batches of UserSignups created by CreateSignupsInBatches
+type SignupBatches []SignupBatch
+
+// All returns the UserSignups of all the batches
+func (b SignupBatches) All() []*toolchainv1alpha1.UserSignup {
+	var all []*toolchainv1alpha1.UserSignup
+	for _, batch := range b {
+		all = append(all, batch.UserSignups...)
+	}
+	return all
+}
+
+// CreateSignupsInBatches creates `count` UserSignups (see factories.NewUserSignup) configured with the given options,
+// by batches of `batchSize` with the given interval between two batches, so that the host operator is not flooded with
+// new UserSignups when a test needs many users.
+// All the UserSignups are registered for cleanup. The creation errors (if any) are collected and reported at the end,
+// instead of failing on the first one.
+func CreateSignupsInBatches(t *testing.T, hostAwait *wait.HostAwaitility, count, batchSize int, interval time.Duration, opts ...factories.UserSignupOption) SignupBatches {
+	require.Greater(t, batchSize, 0, "the batch size must be greater than 0")
+	var batches SignupBatches
+	var errs []string
+	for created := 0; created < count; created += batchSize {
+		if created > 0 {
+			time.Sleep(interval)
+		}
+		batch := SignupBatch{
+			Index:     len(batches),
+			CreatedAt: time.Now(),
+		}
+		for i := created; i < count && i < created+batchSize; i++ {
+			userSignup := factories.NewUserSignup(t, hostAwait.Namespace, opts...)
+			if err := hostAwait.CreateWithCleanup(t, userSignup); err != nil {
+				errs = append(errs, fmt.Sprintf("batch #%d: unable to create UserSignup '%s': %s", batch.Index, userSignup.Name, err.Error()))
+				continue
+			}
+			batch.UserSignups = append(batch.UserSignups, userSignup)
+		}
+		t.Logf("created batch #%d with %d UserSignup(s)", batch.Index, len(batch.UserSignups))
+		batches = append(batches, batch)
+	}
+	require.Empty(t, errs, "failed to create %d UserSignup(s) out of %d:\n%s", len(errs), count, strings.Join(errs, "\n"))
+	return batches
+}
+
+// WaitForAllSignupsReady waits concurrently until all the given UserSignups are complete, with their MasterUserRecord and
+// their Space ready, within the given duration. If some users are not ready in time, then the test fails with the list of
+// the stuck users and the stage at which each of them is stuck, along with the number of users at each stage.
+func WaitForAllSignupsReady(t *testing.T, hostAwait *wait.HostAwaitility, userSignups []*toolchainv1alpha1.UserSignup, within time.Duration) {
+	err := TryWaitForAllSignupsReady(t, hostAwait, userSignups, within)
+	require.NoError(t, err)
+}
+
+// TryWaitForAllSignupsReady is like WaitForAllSignupsReady, but returns an error with the list of the stuck users instead of failing the test.
+// The users whose stage could not be evaluated at all (eg: because the wait was shorter than the retry interval, or its context is done)
+// are reported as "not evaluated".
+func TryWaitForAllSignupsReady(t *testing.T, hostAwait *wait.HostAwaitility, userSignups []*toolchainv1alpha1.UserSignup, within time.Duration) error {
+	t.Logf("waiting for %d user(s) to be ready within %s", len(userSignups), within)
+	start := time.Now()
+	stages := map[string]string{}
+	for _, userSignup := range userSignups {
+		stages[userSignup.Name] = signupStageNotEvaluated
+	}
+	errs := map[string]error{}
+	lock := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	await := hostAwait.WithRetryOptions(wait.TimeoutOption(within))
+	for _, userSignup := range userSignups {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			err := await.Poll(t, func() (done bool, err error) {
+				stage, ready := signupStage(hostAwait, name)
+				lock.Lock()
+				defer lock.Unlock()
+				stages[name] = stage
+				return ready, nil
+			})
+			if err != nil {
+				lock.Lock()
+				defer lock.Unlock()
+				errs[name] = err
+			}
+		}(userSignup.Name)
+	}
+	wg.Wait()
+
+	var stuck []string
+	countByStage := map[string]int{}
+	for name, stage := range stages {
+		countByStage[stage]++
+		err := errs[name]
+		if stage == signupStageReady && err == nil {
+			continue
+		}
+		if err != nil && (!wait.IsTimeout(err) || errors.Is(err, wait.ErrTestDeadlineImminent)) {
+			// the plain timeouts are already explained by the stage of the user
+			stuck = append(stuck, fmt.Sprintf("  %s: %s (%s)", name, stage, err.Error()))
+			continue
+		}
+		stuck = append(stuck, fmt.Sprintf("  %s: %s", name, stage))
+	}
+	if len(stuck) == 0 {
+		t.Logf("all %d user(s) are ready after %s", len(userSignups), time.Since(start))
+		return nil
+	}
+	sort.Strings(stuck)
+	overview := make([]string, 0, len(countByStage))
+	for stage, count := range countByStage {
+		overview = append(overview, fmt.Sprintf("  %s: %d", stage, count))
+	}
+	sort.Strings(overview)
+	return fmt.Errorf("%d user(s) out of %d were not ready after %s\nusers per stage:\n%s\nstuck users:\n%s",
+		len(stuck), len(userSignups), within, strings.Join(overview, "\n"), strings.Join(stuck, "\n"))
+}
+
+const (
+	signupStageReady        = "ready"
+	signupStageNotEvaluated = "not evaluated"
+)
+
+// signupStage returns the provisioning stage of the UserSignup with the given name, and `true` if the user is ready
+func signupStage(hostAwait *wait.HostAwaitility, name string) (string, bool) {
+	userSignup := &toolchainv1alpha1.UserSignup{}
+	if err := hostAwait.Client.Get(context.TODO(), types.NamespacedName{Namespace: hostAwait.Namespace, Name: name}, userSignup); err != nil {
+		return fmt.Sprintf("unable to get the UserSignup: %s", err.Error()), false
+	}
+	if !condition.IsTrue(userSignup.Status.Conditions, toolchainv1alpha1.UserSignupApproved) {
+		return "UserSignup not approved", false
+	}
+	if userSignup.Status.CompliantUsername == "" {
+		return "UserSignup without compliant username", false
+	}
+	mur := &toolchainv1alpha1.MasterUserRecord{}
+	if err := hostAwait.Client.Get(context.TODO(), types.NamespacedName{Namespace: hostAwait.Namespace, Name: userSignup.Status.CompliantUsername}, mur); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "MasterUserRecord not created", false
+		}
+		return fmt.Sprintf("unable to get the MasterUserRecord: %s", err.Error()), false
+	}
+	if !condition.IsTrue(mur.Status.Conditions, toolchainv1alpha1.ConditionReady) {
+		return "MasterUserRecord not ready", false
+	}
+	space := &toolchainv1alpha1.Space{}
+	if err := hostAwait.Client.Get(context.TODO(), types.NamespacedName{Namespace: hostAwait.Namespace, Name: userSignup.Status.CompliantUsername}, space); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "Space not created", false
+		}
+		return fmt.Sprintf("unable to get the Space: %s", err.Error()), false
+	}
+	if !condition.IsTrue(space.Status.Conditions, toolchainv1alpha1.ConditionReady) {
+		return "Space not ready", false
+	}
+	if !condition.IsTrue(userSignup.Status.Conditions, toolchainv1alpha1.UserSignupComplete) {
+		return "UserSignup not complete", false
+	}
+	return signupStageReady, true
+}
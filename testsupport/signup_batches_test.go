@@ -0,0 +1,87 @@
+package testsupport_test
+
+import (
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestTryWaitForAllSignupsReady(t *testing.T) {
+	// given
+	hostNs := "toolchain-host-operator"
+	ready := func(conditionTypes ...toolchainv1alpha1.ConditionType) []toolchainv1alpha1.Condition {
+		conditions := make([]toolchainv1alpha1.Condition, 0, len(conditionTypes))
+		for _, conditionType := range conditionTypes {
+			conditions = append(conditions, toolchainv1alpha1.Condition{Type: conditionType, Status: corev1.ConditionTrue})
+		}
+		return conditions
+	}
+	readySignup := &toolchainv1alpha1.UserSignup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: hostNs, Name: "ready"},
+		Status: toolchainv1alpha1.UserSignupStatus{
+			Conditions:        ready(toolchainv1alpha1.UserSignupApproved, toolchainv1alpha1.UserSignupComplete),
+			CompliantUsername: "ready",
+		},
+	}
+	stuckSignup := &toolchainv1alpha1.UserSignup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: hostNs, Name: "stuck"},
+		Status: toolchainv1alpha1.UserSignupStatus{
+			Conditions: ready(toolchainv1alpha1.UserSignupApproved),
+		},
+	}
+	objects := []client.Object{
+		readySignup,
+		stuckSignup,
+		&toolchainv1alpha1.MasterUserRecord{
+			ObjectMeta: metav1.ObjectMeta{Namespace: hostNs, Name: "ready"},
+			Status:     toolchainv1alpha1.MasterUserRecordStatus{Conditions: ready(toolchainv1alpha1.ConditionReady)},
+		},
+		&toolchainv1alpha1.Space{
+			ObjectMeta: metav1.ObjectMeta{Namespace: hostNs, Name: "ready"},
+			Status:     toolchainv1alpha1.SpaceStatus{Conditions: ready(toolchainv1alpha1.ConditionReady)},
+		},
+	}
+	hostAwait := wait.NewHostAwaitility(nil, test.NewFakeClient(t, objects...), hostNs, hostNs)
+
+	t.Run("all ready", func(t *testing.T) {
+		// when
+		err := testsupport.TryWaitForAllSignupsReady(t, hostAwait.WithRetryOptions(wait.RetryInterval(10*time.Millisecond)),
+			[]*toolchainv1alpha1.UserSignup{readySignup}, time.Second)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("one signup never ready", func(t *testing.T) {
+		// when
+		err := testsupport.TryWaitForAllSignupsReady(t, hostAwait.WithRetryOptions(wait.RetryInterval(10*time.Millisecond)),
+			[]*toolchainv1alpha1.UserSignup{readySignup, stuckSignup}, 200*time.Millisecond)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "1 user(s) out of 2 were not ready after 200ms")
+		assert.Contains(t, err.Error(), "stuck users:\n  stuck: UserSignup without compliant username")
+		assert.NotContains(t, err.Error(), "  ready: ")
+	})
+
+	t.Run("signups not evaluated when the wait is shorter than the retry interval", func(t *testing.T) {
+		// when
+		err := testsupport.TryWaitForAllSignupsReady(t, hostAwait.WithRetryOptions(wait.RetryInterval(time.Second)),
+			[]*toolchainv1alpha1.UserSignup{readySignup, stuckSignup}, 10*time.Millisecond)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "2 user(s) out of 2 were not ready after 10ms")
+		assert.Contains(t, err.Error(), "stuck users:\n  ready: not evaluated\n  stuck: not evaluated")
+	})
+}
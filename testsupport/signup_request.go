@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 	"strings"
 	"sync"
 	"testing"
@@ -47,26 +46,27 @@ func NewSignupRequest(awaitilities wait.Awaitilities) *SignupRequest {
 // RequireConditions(wait.ConditionSet(wait.Default(), wait.ApprovedByAdmin())...).
 // Execute(t).Resources()
 type SignupRequest struct {
-	awaitilities         wait.Awaitilities
-	ensureMUR            bool
-	waitForMUR           bool
-	manuallyApprove      bool
-	verificationRequired bool
-	identityID           uuid.UUID
-	username             string
-	email                string
-	requiredHTTPStatus   int
-	targetCluster        *wait.MemberAwaitility
-	conditions           []toolchainv1alpha1.Condition
-	userSignup           *toolchainv1alpha1.UserSignup
-	mur                  *toolchainv1alpha1.MasterUserRecord
-	token                string
-	originalSub          string
-	userID               string
-	accountID            string
-	cleanupDisabled      bool
-	noSpace              bool
-	activationCode       string
+	awaitilities           wait.Awaitilities
+	ensureMUR              bool
+	waitForMUR             bool
+	manuallyApprove        bool
+	verificationRequired   bool
+	verificationFromConfig bool
+	identityID             uuid.UUID
+	username               string
+	email                  string
+	requiredHTTPStatus     int
+	targetCluster          *wait.MemberAwaitility
+	conditions             []toolchainv1alpha1.Condition
+	userSignup             *toolchainv1alpha1.UserSignup
+	mur                    *toolchainv1alpha1.MasterUserRecord
+	token                  string
+	originalSub            string
+	userID                 string
+	accountID              string
+	cleanupDisabled        bool
+	noSpace                bool
+	activationCode         string
 }
 
 // IdentityID specifies the ID value for the user's Identity.  This value if set will be used to set both the
@@ -156,6 +156,14 @@ func (r *SignupRequest) VerificationRequired() *SignupRequest {
 	return r
 }
 
+// VerificationFromConfig specifies that the "verification-required" state of the new UserSignup is left as it was set by
+// the registration service according to the verification settings of the ToolchainConfig, instead of being overridden
+// to match VerificationRequired(). This allows to verify the settings themselves.
+func (r *SignupRequest) VerificationFromConfig() *SignupRequest {
+	r.verificationFromConfig = true
+	return r
+}
+
 // TargetCluster may be provided in order to specify the user's target cluster
 func (r *SignupRequest) TargetCluster(targetCluster *wait.MemberAwaitility) *SignupRequest {
 	r.targetCluster = targetCluster
@@ -181,6 +189,29 @@ func (r *SignupRequest) NoSpace() *SignupRequest {
 	return r
 }
 
+func (r *SignupRequest) identity() *commonauth.Identity {
+	return &commonauth.Identity{
+		ID:       r.identityID,
+		Username: r.username,
+	}
+}
+
+// NewToken generates a new token for the user of the request, with the same claims as the token used to sign up
+// and the given additional claims (eg: to obtain a short-lived token with `authsupport.WithExp(...)`)
+func (r *SignupRequest) NewToken(additionalClaims ...commonauth.ExtraClaim) (string, error) {
+	claims := []commonauth.ExtraClaim{commonauth.WithEmailClaim(r.email)}
+	if r.originalSub != "" {
+		claims = append(claims, commonauth.WithOriginalSubClaim(r.originalSub))
+	}
+	if r.userID != "" {
+		claims = append(claims, commonauth.WithUserIDClaim(r.userID))
+	}
+	if r.accountID != "" {
+		claims = append(claims, commonauth.WithAccountIDClaim(r.accountID))
+	}
+	return authsupport.NewTokenFromIdentity(r.identity(), append(claims, additionalClaims...)...)
+}
+
 var usernamesInParallel = &namesRegistry{usernames: map[string]string{}}
 
 type namesRegistry struct {
@@ -191,7 +222,7 @@ type namesRegistry struct {
 func (r *namesRegistry) add(t *testing.T, name string) {
 	r.Lock()
 	defer r.Unlock()
-	pwd := os.Getenv("PWD")
+	pwd := wait.CurrentEnvironment().WorkingDir
 	if !strings.HasSuffix(pwd, "parallel") {
 		return
 	}
@@ -211,21 +242,8 @@ func (r *SignupRequest) Execute(t *testing.T) *SignupRequest {
 	// Create a token and identity to sign up with
 	usernamesInParallel.add(t, r.username)
 
-	userIdentity := &commonauth.Identity{
-		ID:       r.identityID,
-		Username: r.username,
-	}
-	claims := []commonauth.ExtraClaim{commonauth.WithEmailClaim(r.email)}
-	if r.originalSub != "" {
-		claims = append(claims, commonauth.WithOriginalSubClaim(r.originalSub))
-	}
-	if r.userID != "" {
-		claims = append(claims, commonauth.WithUserIDClaim(r.userID))
-	}
-	if r.accountID != "" {
-		claims = append(claims, commonauth.WithAccountIDClaim(r.accountID))
-	}
-	r.token, err = authsupport.NewTokenFromIdentity(userIdentity, claims...)
+	userIdentity := r.identity()
+	r.token, err = r.NewToken()
 	require.NoError(t, err)
 
 	queryParams := map[string]string{}
@@ -249,11 +267,12 @@ func (r *SignupRequest) Execute(t *testing.T) *SignupRequest {
 			"cannot specify a target cluster for new signup requests while automatic approval is enabled")
 	}
 
-	if r.manuallyApprove || r.targetCluster != nil || (r.verificationRequired != states.VerificationRequired(userSignup)) {
+	overrideVerification := !r.verificationFromConfig && r.verificationRequired != states.VerificationRequired(userSignup)
+	if r.manuallyApprove || r.targetCluster != nil || overrideVerification {
 		doUpdate := func(instance *toolchainv1alpha1.UserSignup) {
 			// We set the VerificationRequired state first, because if manuallyApprove is also set then it will
 			// reset the VerificationRequired state to false.
-			if r.verificationRequired != states.VerificationRequired(instance) {
+			if !r.verificationFromConfig && r.verificationRequired != states.VerificationRequired(instance) {
 				states.SetVerificationRequired(userSignup, r.verificationRequired)
 			}
 
@@ -270,6 +289,10 @@ func (r *SignupRequest) Execute(t *testing.T) *SignupRequest {
 	}
 
 	t.Logf("user signup '%s' created", userSignup.Name)
+	hostAwait.Narrative().When("the user '%s' signs up", r.username)
+	if r.manuallyApprove {
+		hostAwait.Narrative().When("the UserSignup '%s' is approved by an admin", userSignup.Name)
+	}
 
 	// If any required conditions have been specified, confirm the UserSignup has them
 	if len(r.conditions) > 0 {
@@ -300,12 +323,14 @@ func (r *SignupRequest) Execute(t *testing.T) *SignupRequest {
 		mur, err := hostAwait.WaitForMasterUserRecord(t, userSignup.Status.CompliantUsername)
 		require.NoError(t, err)
 		r.mur = mur
+		hostAwait.Narrative().Then("the MasterUserRecord '%s' is provisioned", mur.Name)
 	}
 
 	// We also need to ensure that the UserSignup is deleted at the end of the test (if the test itself doesn't delete it)
 	// and if cleanup hasn't been disabled
 	if !r.cleanupDisabled {
 		cleanup.AddCleanTasks(t, hostAwait.Client, r.userSignup)
+		hostAwait.Narrative().Finally("the UserSignup '%s' and its resources are deleted", r.userSignup.Name)
 	}
 
 	return r
@@ -0,0 +1,41 @@
+package testsupport
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/types"
+	k8swait "k8s.io/apimachinery/pkg/util/wait"
+)
+
+// socialEventActivationCountStabilityPeriod the period during which the activation count of a SocialEvent
+// must remain unchanged once it reached the expected value
+const socialEventActivationCountStabilityPeriod = 5 * time.Second
+
+// VerifySocialEventActivationCount verifies that the activation count of the SocialEvent with the given name reaches
+// the expected value (ie, the number of successful signups with the event's activation code) and that it is not
+// incremented any further, so that a signup which is counted more than once is detected
+func VerifySocialEventActivationCount(t *testing.T, hostAwait *wait.HostAwaitility, name string, expected int) *toolchainv1alpha1.SocialEvent {
+	event, err := hostAwait.WaitForSocialEvent(t, name, wait.UntilSocialEventHasActivationCount(expected))
+	require.NoError(t, err)
+
+	t.Logf("verifying that the activation count of SocialEvent '%s' remains at %d", name, expected)
+	err = hostAwait.WithRetryOptions(wait.TimeoutOption(socialEventActivationCountStabilityPeriod)).Poll(t, func() (done bool, err error) {
+		event = &toolchainv1alpha1.SocialEvent{}
+		if err := hostAwait.Client.Get(context.TODO(), types.NamespacedName{Namespace: hostAwait.Namespace, Name: name}, event); err != nil {
+			return false, err
+		}
+		if event.Status.ActivationCount != expected {
+			return false, fmt.Errorf("expected SocialEvent '%s' to have activation count: %d \nactual: %d", name, expected, event.Status.ActivationCount)
+		}
+		return false, nil // keep checking until the end of the stability period
+	})
+	require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+	return event
+}
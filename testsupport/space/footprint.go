@@ -0,0 +1,23 @@
+package space
+
+import (
+	"testing"
+
+	testspace "github.com/codeready-toolchain/toolchain-common/pkg/test/space"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+)
+
+// CompareTierFootprints provisions a Space with each of the given tiers, collects the footprint of each tier (ie: the set and the size
+// of the objects provisioned for the Space, see testsupport.CollectTierFootprint), and reports the comparison matrix of the footprints
+// (see testsupport.ReportTierFootprints). The Spaces are deleted at the end of the test.
+func CompareTierFootprints(t *testing.T, awaitilities wait.Awaitilities, tierNames ...string) testsupport.TierFootprints {
+	footprints := make(testsupport.TierFootprints, 0, len(tierNames))
+	for _, tierName := range tierNames {
+		space, _, _ := CreateSpace(t, awaitilities, testspace.WithTierName(tierName), testspace.WithSpecTargetCluster(awaitilities.Member1().ClusterName))
+		space, _ = VerifyResourcesProvisionedForSpace(t, awaitilities, space.Name)
+		footprints = append(footprints, testsupport.CollectTierFootprint(t, getSpaceTargetMember(t, awaitilities, space), space))
+	}
+	testsupport.ReportTierFootprints(t, footprints)
+	return footprints
+}
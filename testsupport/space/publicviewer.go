@@ -0,0 +1,81 @@
+package space
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// PublicViewerUsername the username reserved by the host operator for all the authenticated users, when the public-viewer
+	// feature is enabled. There is no MasterUserRecord with this name: it is only referred to by SpaceBindings.
+	// Note: this is the value of `KubesawAuthenticatedUsername` in the newer versions of the API, which are not used by this module yet
+	PublicViewerUsername = "kubesaw-authenticated"
+	// PublicViewerSpaceRole the role granted to all the authenticated users in a Space which is shared with the community
+	PublicViewerSpaceRole = "viewer"
+)
+
+// EnablePublicViewer enables the public-viewer feature in the ToolchainConfig, and disables it back at the end of the test
+func EnablePublicViewer(t *testing.T, hostAwait *wait.HostAwaitility) {
+	setPublicViewerEnabled(t, hostAwait, true)
+	t.Cleanup(func() {
+		setPublicViewerEnabled(t, hostAwait, false)
+	})
+}
+
+func setPublicViewerEnabled(t *testing.T, hostAwait *wait.HostAwaitility, enabled bool) {
+	hostAwait.PatchToolchainConfig(t, fmt.Sprintf(`{"spec":{"host":{"publicViewerConfig":{"enabled":%t}}}}`, enabled))
+}
+
+// ShareWithCommunity creates a SpaceBinding which grants the `viewer` role in the given Space to all the authenticated users
+// (the SpaceBinding is deleted at the end of the test)
+func ShareWithCommunity(t *testing.T, hostAwait *wait.HostAwaitility, space *toolchainv1alpha1.Space) *toolchainv1alpha1.SpaceBinding {
+	binding := &toolchainv1alpha1.SpaceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    space.Namespace,
+			GenerateName: space.Name + "-",
+			Labels: map[string]string{
+				toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey: PublicViewerUsername,
+				toolchainv1alpha1.SpaceBindingSpaceLabelKey:            space.Name,
+			},
+		},
+		Spec: toolchainv1alpha1.SpaceBindingSpec{
+			MasterUserRecord: PublicViewerUsername,
+			Space:            space.Name,
+			SpaceRole:        PublicViewerSpaceRole,
+		},
+	}
+	err := hostAwait.CreateWithCleanup(t, binding)
+	require.NoError(t, err)
+	t.Logf("Space '%s' shared with the community", space.Name)
+	return binding
+}
+
+// VerifyCommunityAccess verifies that a user who has no SpaceBinding for the given Space can read resources in its default namespace
+// via the proxy (once the access was propagated), but can't create any resource
+func VerifyCommunityAccess(t *testing.T, hostAwait *wait.HostAwaitility, spaceName, communityUserToken string) {
+	space, err := hostAwait.WaitForSpace(t, spaceName, wait.UntilSpaceHasAnyProvisionedNamespaces())
+	require.NoError(t, err)
+	namespace := GetDefaultNamespace(space.Status.ProvisionedNamespaces)
+	require.NotEmpty(t, namespace, "no default namespace provisioned for space '%s'", spaceName)
+	url := fmt.Sprintf("%s/api/v1/namespaces/%s/configmaps", hostAwait.ProxyURLWithWorkspaceContext(spaceName), namespace)
+
+	// read-only access is allowed
+	var resp *testsupport.ProxyResponse
+	err = hostAwait.Poll(t, func() (bool, error) {
+		resp = testsupport.InvokeProxyEndpoint(t, http.MethodGet, url, communityUserToken)
+		return resp.StatusCode == http.StatusOK, nil
+	})
+	require.NoError(t, err, "community user can't read resources in space '%s': %d %s", spaceName, resp.StatusCode, resp.Body)
+
+	// writes are rejected
+	resp = testsupport.InvokeProxyEndpoint(t, http.MethodPost, url, communityUserToken)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode, "community user should not be allowed to create resources in space '%s': %s", spaceName, resp.Body)
+}
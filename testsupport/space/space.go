@@ -1,6 +1,7 @@
 package space
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -9,6 +10,7 @@ import (
 	testspace "github.com/codeready-toolchain/toolchain-common/pkg/test/space"
 	testtier "github.com/codeready-toolchain/toolchain-common/pkg/test/tier"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/factories"
 	testsupportsb "github.com/codeready-toolchain/toolchain-e2e/testsupport/spacebinding"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/tiers"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
@@ -17,6 +19,8 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // CreateSpace initializes a new Space object using the NewSpace function, and then creates it in the cluster
@@ -41,24 +45,36 @@ func CreateSpace(t *testing.T, awaitilities wait.Awaitilities, opts ...testspace
 		wait.UntilSpaceHasAnyTargetClusterSet(),
 		wait.UntilSpaceHasAnyTierNameSet())
 	require.NoError(t, err)
-	// let's see if spacebinding was provisioned as expected
-	spaceBinding, err := awaitilities.Host().WaitForSpaceBinding(t, mur.Name, space.Name,
-		wait.UntilSpaceBindingHasMurName(mur.Name),
-		wait.UntilSpaceBindingHasSpaceName(space.Name),
-		wait.UntilSpaceBindingHasSpaceRole("admin"),
-	)
-	require.NoError(t, err)
-	// make sure that the NSTemplateSet associated with the Space was updated after the space binding was created (new entry in the `spec.SpaceRoles`)
-	// before we can check the resources (roles and rolebindings)
-	tier, err := awaitilities.Host().WaitForNSTemplateTier(t, space.Spec.TierName)
+	var spaceBinding *toolchainv1alpha1.SpaceBinding
+	err = wait.WaitForAll(t,
+		func(ctx context.Context) error {
+			// let's see if spacebinding was provisioned as expected
+			var err error
+			spaceBinding, err = awaitilities.Host().WithContext(ctx).WaitForSpaceBinding(t, mur.Name, space.Name,
+				wait.UntilSpaceBindingHasMurName(mur.Name),
+				wait.UntilSpaceBindingHasSpaceName(space.Name),
+				wait.UntilSpaceBindingHasSpaceRole("admin"),
+			)
+			return err
+		},
+		func(ctx context.Context) error {
+			// make sure that the NSTemplateSet associated with the Space was updated after the space binding was created (new entry in the `spec.SpaceRoles`)
+			// before we can check the resources (roles and rolebindings)
+			tier, err := awaitilities.Host().WithContext(ctx).WaitForNSTemplateTier(t, space.Spec.TierName)
+			if err != nil {
+				return err
+			}
+			memberAwait, err := awaitilities.Member(space.Status.TargetCluster)
+			if err != nil {
+				// if member is `unknown` or invalid (depending on the test case), then don't try to check the associated NSTemplateSet
+				return nil
+			}
+			_, err = memberAwait.WithContext(ctx).WaitForNSTmplSet(t, space.Name,
+				wait.UntilNSTemplateSetHasSpaceRoles(
+					wait.SpaceRole(tier.Spec.SpaceRoles["admin"].TemplateRef, mur.Name)))
+			return err
+		})
 	require.NoError(t, err)
-	if memberAwait, err := awaitilities.Member(space.Status.TargetCluster); err == nil {
-		// if member is `unknown` or invalid (depending on the test case), then don't try to check the associated NSTemplateSet
-		_, err = memberAwait.WaitForNSTmplSet(t, space.Name,
-			wait.UntilNSTemplateSetHasSpaceRoles(
-				wait.SpaceRole(tier.Spec.SpaceRoles["admin"].TemplateRef, mur.Name)))
-		require.NoError(t, err)
-	}
 
 	return space, signup, spaceBinding
 }
@@ -72,7 +88,7 @@ func CreateSpaceWithBinding(t *testing.T, awaitilities wait.Awaitilities, mur *t
 	require.NoError(t, err)
 
 	// we need to  create the SpaceBinding, otherwise, the Space could be automatically deleted by the SpaceCleanup controller
-	spaceBinding := testsupportsb.CreateSpaceBinding(t, awaitilities.Host(), mur, space, "admin")
+	spaceBinding := factories.CreateSpaceBinding(t, awaitilities.Host(), mur, space)
 
 	return space, spaceBinding
 }
@@ -156,6 +172,14 @@ func verifyResourcesProvisionedForSpace(t *testing.T, hostAwait *wait.HostAwaiti
 	// verify NSTemplateSet with namespace & cluster scoped resources
 	tiers.VerifyNSTemplateSet(t, hostAwait, targetCluster, nsTmplSet, checks)
 
+	// verify that exactly one active namespace was provisioned per namespace template of the tier
+	_, err = wait.WaitForObjectsMatching(t, targetCluster.Awaitility, &corev1.NamespaceList{},
+		client.MatchingLabels{toolchainv1alpha1.SpaceLabelKey: spaceName}, len(tier.Spec.Namespaces),
+		wait.UntilObjectMatches("is active", func(ns *corev1.Namespace) bool {
+			return ns.Status.Phase == corev1.NamespaceActive
+		}))
+	require.NoError(t, err)
+
 	// Wait for space to have list of provisioned namespaces in Space status.
 	// the expected namespaces for `nsTmplSet.Status.ProvisionedNamespaces` are checked as part of VerifyNSTemplateSet function above.
 	_, err = hostAwait.WaitForSpace(t, spaceName,
@@ -181,7 +205,7 @@ func CreateMurWithAdminSpaceBindingForSpace(t *testing.T, awaitilities wait.Awai
 	t.Logf("The UserSignup %s and MUR %s were created", signup.Name, mur.Name)
 	var binding *toolchainv1alpha1.SpaceBinding
 	if cleanup {
-		binding = testsupportsb.CreateSpaceBinding(t, awaitilities.Host(), mur, space, "admin")
+		binding = factories.CreateSpaceBinding(t, awaitilities.Host(), mur, space)
 	} else {
 		binding = testsupportsb.CreateSpaceBindingWithoutCleanup(t, awaitilities.Host(), mur, space, "admin")
 	}
@@ -197,3 +221,18 @@ func GetDefaultNamespace(provisionedNamespaces []toolchainv1alpha1.SpaceNamespac
 	}
 	return ""
 }
+
+// VerifySpaceRoleBindings waits until all the namespaces provisioned for the Space with the given name contain the RoleBindings
+// for the expected users and roles, and no RoleBinding for the removed users
+func VerifySpaceRoleBindings(t *testing.T, awaitilities wait.Awaitilities, spaceName string, expected []wait.UserRoleRefs, removedUsers ...string) {
+	space, err := awaitilities.Host().WaitForSpace(t, spaceName,
+		wait.UntilSpaceHasAnyTargetClusterSet(),
+		wait.UntilSpaceHasAnyProvisionedNamespaces())
+	require.NoError(t, err)
+	namespaces := make([]string, 0, len(space.Status.ProvisionedNamespaces))
+	for _, ns := range space.Status.ProvisionedNamespaces {
+		namespaces = append(namespaces, ns.Name)
+	}
+	err = getSpaceTargetMember(t, awaitilities, space).WaitUntilSpaceRoleBindingsPropagated(t, namespaces, expected, removedUsers...)
+	require.NoError(t, err)
+}
@@ -0,0 +1,81 @@
+package testsupport
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+)
+
+const (
+	// WaiterCoverageReportFile the name of the file in which the waiter coverage report is written, in the `ARTIFACT_DIR`
+	WaiterCoverageReportFile = "waiter-coverage.txt"
+	// FlakeReportFile the name of the file in which the flake report is written, in the `ARTIFACT_DIR`
+	FlakeReportFile = "flakes.txt"
+)
+
+// RunSuite loads and validates the environment of the tests (see wait.LoadEnvironment), runs the tests and then reports the
+// resource usage of the operators, the flakes of the retried wait blocks and the coverage of the waiters (if enabled).
+// It is meant to be called from the `TestMain` func of the test packages. It returns `1` without running the tests if the
+// environment is invalid, or if any of the `HOST_NS`, `MEMBER_NS`, `MEMBER_NS_2` and `REGISTRATION_SERVICE_NS` env vars is not set,
+// even if the tests of the package only use some of the namespaces (see wait.Environment.Validate)
+func RunSuite(m *testing.M) int {
+	env, err := wait.LoadEnvironment()
+	if err == nil {
+		err = env.Validate()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to run the tests: %s\n", err)
+		return 1
+	}
+	wait.SetEnvironment(env)
+	code := m.Run()
+	reportResourceUsage()
+	reportFlakes()
+	reportWaiterCoverage()
+	return code
+}
+
+// reportWaiterCoverage writes the waiter coverage report (if enabled via the `E2E_WAITER_COVERAGE` env var)
+func reportWaiterCoverage() {
+	if !wait.WaiterCoverageEnabled() {
+		return
+	}
+	report := &strings.Builder{}
+	if err := wait.WriteWaiterCoverageReport(report); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to generate the waiter coverage report: %s\n", err)
+		return
+	}
+	writeReport(WaiterCoverageReportFile, report.String())
+}
+
+// reportFlakes writes the failed attempts of the wait blocks which were retried during the test suite (if any)
+func reportFlakes() {
+	if !wait.HasFlakes() {
+		return
+	}
+	report := &strings.Builder{}
+	if err := wait.WriteFlakeReport(report); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to generate the flake report: %s\n", err)
+		return
+	}
+	writeReport(FlakeReportFile, report.String())
+}
+
+// writeReport writes the given report in a file with the given name in the `ARTIFACT_DIR`, or in the temp dir if the `ARTIFACT_DIR`
+// is not set. Only the location of the report is printed, on the standard error, so that the reports do not get mixed with the output of the tests
+func writeReport(filename, report string) {
+	dir := wait.CurrentEnvironment().ArtifactDir
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	path := filepath.Join(dir, filename)
+	if err := os.WriteFile(path, []byte(report), 0600); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to write the '%s' report: %s\n", filename, err)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "the '%s' report was written in %s\n", filename, path)
+}
@@ -0,0 +1,222 @@
+package testsupport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"text/tabwriter"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	quotav1 "github.com/openshift/api/quota/v1"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TierFootprintReportFile the name of the file in which the comparison of the footprints of the tiers is written, in the `ARTIFACT_DIR`
+const TierFootprintReportFile = "tier-footprints.txt"
+
+// TierFootprint the set and the size of the objects provisioned on a member cluster for a Space with a given tier
+type TierFootprint struct {
+	Tier string
+	// Namespaces the types of the provisioned namespaces (eg: `dev`, `stage`), sorted
+	Namespaces []string
+	// Objects the number of objects of each kind, in all the provisioned namespaces (and for the cluster-scoped ones, for the Space)
+	Objects map[string]int
+	// Quotas the hard limits of each resource, summed over the ResourceQuotas of all the provisioned namespaces
+	Quotas map[corev1.ResourceName]resource.Quantity
+	// ClusterQuotas the hard limits of each resource, summed over the ClusterResourceQuotas of the Space
+	ClusterQuotas map[corev1.ResourceName]resource.Quantity
+	// Limits the default limits and requests of the containers, by resource (eg: `default cpu`, `defaultRequest memory`),
+	// in the LimitRanges of all the provisioned namespaces (the max if the namespaces have different ones)
+	Limits map[string]resource.Quantity
+}
+
+// footprintKind a kind of the namespaced objects which are counted in the footprint of a tier
+type footprintKind struct {
+	name    string
+	newList func() client.ObjectList
+}
+
+var footprintKinds = []footprintKind{
+	{name: "ResourceQuota", newList: func() client.ObjectList { return &corev1.ResourceQuotaList{} }},
+	{name: "LimitRange", newList: func() client.ObjectList { return &corev1.LimitRangeList{} }},
+	{name: "NetworkPolicy", newList: func() client.ObjectList { return &netv1.NetworkPolicyList{} }},
+	{name: "Role", newList: func() client.ObjectList { return &rbacv1.RoleList{} }},
+	{name: "RoleBinding", newList: func() client.ObjectList { return &rbacv1.RoleBindingList{} }},
+	{name: "ServiceAccount", newList: func() client.ObjectList { return &corev1.ServiceAccountList{} }},
+	{name: "ConfigMap", newList: func() client.ObjectList { return &corev1.ConfigMapList{} }},
+	{name: "Secret", newList: func() client.ObjectList { return &corev1.SecretList{} }},
+}
+
+// CollectTierFootprint collects the footprint of the tier of the given Space, from the objects provisioned by the member operator
+// (ie: with the provider label) in the namespaces of the Space on the given member cluster, along with its ClusterResourceQuotas
+func CollectTierFootprint(t *testing.T, memberAwait *wait.MemberAwaitility, space *toolchainv1alpha1.Space) TierFootprint {
+	footprint := TierFootprint{
+		Tier:          space.Spec.TierName,
+		Objects:       map[string]int{},
+		Quotas:        map[corev1.ResourceName]resource.Quantity{},
+		ClusterQuotas: map[corev1.ResourceName]resource.Quantity{},
+		Limits:        map[string]resource.Quantity{},
+	}
+	provisioned := client.MatchingLabels{toolchainv1alpha1.ProviderLabelKey: toolchainv1alpha1.ProviderLabelValue}
+	namespaces := &corev1.NamespaceList{}
+	err := memberAwait.Client.List(context.TODO(), namespaces, client.MatchingLabels{toolchainv1alpha1.SpaceLabelKey: space.Name})
+	require.NoError(t, err)
+	for _, ns := range namespaces.Items {
+		footprint.Namespaces = append(footprint.Namespaces, ns.Labels[toolchainv1alpha1.TypeLabelKey])
+		for _, kind := range footprintKinds {
+			list := kind.newList()
+			err := memberAwait.Client.List(context.TODO(), list, client.InNamespace(ns.Name), provisioned)
+			require.NoError(t, err)
+			footprint.Objects[kind.name] += meta.LenList(list)
+			switch l := list.(type) {
+			case *corev1.ResourceQuotaList:
+				for _, quota := range l.Items {
+					addQuantities(footprint.Quotas, quota.Spec.Hard)
+				}
+			case *corev1.LimitRangeList:
+				for _, limitRange := range l.Items {
+					addLimits(footprint.Limits, limitRange)
+				}
+			}
+		}
+	}
+	sort.Strings(footprint.Namespaces)
+	clusterQuotas := &quotav1.ClusterResourceQuotaList{}
+	err = memberAwait.Client.List(context.TODO(), clusterQuotas, client.MatchingLabels{toolchainv1alpha1.SpaceLabelKey: space.Name})
+	require.NoError(t, err)
+	footprint.Objects["ClusterResourceQuota"] = len(clusterQuotas.Items)
+	for _, quota := range clusterQuotas.Items {
+		addQuantities(footprint.ClusterQuotas, quota.Spec.Quota.Hard)
+	}
+	return footprint
+}
+
+func addQuantities(total map[corev1.ResourceName]resource.Quantity, quantities corev1.ResourceList) {
+	for name, q := range quantities {
+		sum := total[name]
+		sum.Add(q)
+		total[name] = sum
+	}
+}
+
+// addLimits records the default limits and requests of the containers in the given LimitRange, keeping the max of the existing ones
+func addLimits(limits map[string]resource.Quantity, limitRange corev1.LimitRange) {
+	for _, item := range limitRange.Spec.Limits {
+		if item.Type != corev1.LimitTypeContainer {
+			continue
+		}
+		for prefix, quantities := range map[string]corev1.ResourceList{"default": item.Default, "defaultRequest": item.DefaultRequest} {
+			for name, q := range quantities {
+				key := fmt.Sprintf("%s %s", prefix, name)
+				if existing, found := limits[key]; !found || q.Cmp(existing) > 0 {
+					limits[key] = q
+				}
+			}
+		}
+	}
+}
+
+// TierFootprints the footprints of several tiers, to be compared
+type TierFootprints []TierFootprint
+
+// Write writes a matrix with a column per tier, and a row per kind of object, per quota and per default limit, so that the changes
+// of the content of the tiers can be reviewed with the actual objects provisioned on a cluster
+func (f TierFootprints) Write(out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	header := []string{"FOOTPRINT"}
+	for _, footprint := range f {
+		header = append(header, footprint.Tier)
+	}
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	row := func(name string, value func(TierFootprint) string) {
+		cells := []string{name}
+		for _, footprint := range f {
+			cells = append(cells, value(footprint))
+		}
+		fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+	row("namespaces", func(footprint TierFootprint) string {
+		return strings.Join(footprint.Namespaces, ",")
+	})
+	for _, kind := range f.keys(func(footprint TierFootprint) []string { return keysOf(footprint.Objects) }) {
+		row("objects/"+kind, func(footprint TierFootprint) string {
+			return fmt.Sprintf("%d", footprint.Objects[kind])
+		})
+	}
+	for _, name := range f.keys(func(footprint TierFootprint) []string { return resourceNamesOf(footprint.Quotas) }) {
+		row("quota/"+name, func(footprint TierFootprint) string {
+			return quantityOf(footprint.Quotas, corev1.ResourceName(name))
+		})
+	}
+	for _, name := range f.keys(func(footprint TierFootprint) []string { return resourceNamesOf(footprint.ClusterQuotas) }) {
+		row("clusterquota/"+name, func(footprint TierFootprint) string {
+			return quantityOf(footprint.ClusterQuotas, corev1.ResourceName(name))
+		})
+	}
+	for _, name := range f.keys(func(footprint TierFootprint) []string { return keysOf(footprint.Limits) }) {
+		row("limits/"+name, func(footprint TierFootprint) string {
+			return quantityOf(footprint.Limits, name)
+		})
+	}
+	return w.Flush()
+}
+
+// keys returns the sorted union of the keys returned by the given func for all the footprints
+func (f TierFootprints) keys(keysOf func(TierFootprint) []string) []string {
+	union := map[string]bool{}
+	for _, footprint := range f {
+		for _, k := range keysOf(footprint) {
+			union[k] = true
+		}
+	}
+	keys := make([]string, 0, len(union))
+	for k := range union {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func keysOf[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func resourceNamesOf(m map[corev1.ResourceName]resource.Quantity) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, string(name))
+	}
+	return names
+}
+
+// quantityOf returns the given quantity, or `-` if there is none
+func quantityOf[K comparable](m map[K]resource.Quantity, key K) string {
+	q, found := m[key]
+	if !found {
+		return "-"
+	}
+	return q.String()
+}
+
+// ReportTierFootprints logs the comparison matrix of the given footprints, and writes it in the `ARTIFACT_DIR` (see TierFootprintReportFile)
+func ReportTierFootprints(t *testing.T, footprints TierFootprints) {
+	out := &strings.Builder{}
+	require.NoError(t, footprints.Write(out))
+	t.Log(out.String())
+	writeReport(TierFootprintReportFile, out.String())
+}
@@ -0,0 +1,152 @@
+package testsupport_test
+
+import (
+	"strings"
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	quotav1 "github.com/openshift/api/quota/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCollectTierFootprint(t *testing.T) {
+	// given
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, quotav1.AddToScheme(s))
+	provisioned := map[string]string{
+		toolchainv1alpha1.ProviderLabelKey: toolchainv1alpha1.ProviderLabelValue,
+	}
+	namespace := func(name, nsType string) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					toolchainv1alpha1.SpaceLabelKey: "johnsmith",
+					toolchainv1alpha1.TypeLabelKey:  nsType,
+				},
+			},
+		}
+	}
+	quota := func(ns, cpu string) *corev1.ResourceQuota {
+		return &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "compute", Labels: provisioned},
+			Spec: corev1.ResourceQuotaSpec{
+				Hard: corev1.ResourceList{corev1.ResourceLimitsCPU: resource.MustParse(cpu)},
+			},
+		}
+	}
+	limitRange := func(ns, memory string) *corev1.LimitRange {
+		return &corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "resource-limits", Labels: provisioned},
+			Spec: corev1.LimitRangeSpec{
+				Limits: []corev1.LimitRangeItem{
+					{
+						Type:           corev1.LimitTypeContainer,
+						Default:        corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(memory)},
+						DefaultRequest: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+					},
+				},
+			},
+		}
+	}
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		namespace("johnsmith-dev", "dev"),
+		namespace("johnsmith-stage", "stage"),
+		quota("johnsmith-dev", "2000m"),
+		quota("johnsmith-stage", "1500m"),
+		limitRange("johnsmith-dev", "750Mi"),
+		limitRange("johnsmith-stage", "1Gi"),
+		// not provisioned by the member operator
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "johnsmith-dev", Name: "kube-root-ca.crt"}},
+		// not in a namespace of the space
+		quota("other-dev", "1000m"),
+		&quotav1.ClusterResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "for-johnsmith-deployments",
+				Labels: map[string]string{toolchainv1alpha1.SpaceLabelKey: "johnsmith"},
+			},
+			Spec: quotav1.ClusterResourceQuotaSpec{
+				Quota: corev1.ResourceQuotaSpec{
+					Hard: corev1.ResourceList{"count/pods": resource.MustParse("50")},
+				},
+			},
+		},
+	).Build()
+	memberAwait := &wait.MemberAwaitility{
+		Awaitility: &wait.Awaitility{
+			Client: cl,
+		},
+	}
+	space := &toolchainv1alpha1.Space{
+		ObjectMeta: metav1.ObjectMeta{Name: "johnsmith"},
+		Spec:       toolchainv1alpha1.SpaceSpec{TierName: "base"},
+	}
+
+	// when
+	footprint := testsupport.CollectTierFootprint(t, memberAwait, space)
+
+	// then
+	assert.Equal(t, "base", footprint.Tier)
+	assert.Equal(t, []string{"dev", "stage"}, footprint.Namespaces)
+	assert.Equal(t, 2, footprint.Objects["ResourceQuota"])
+	assert.Equal(t, 2, footprint.Objects["LimitRange"])
+	assert.Equal(t, 0, footprint.Objects["ConfigMap"])
+	assert.Equal(t, 1, footprint.Objects["ClusterResourceQuota"])
+	cpu := footprint.Quotas[corev1.ResourceLimitsCPU]
+	assert.Equal(t, "3500m", cpu.String())
+	pods := footprint.ClusterQuotas["count/pods"]
+	assert.Equal(t, "50", pods.String())
+	memory := footprint.Limits["default memory"]
+	assert.Equal(t, "1Gi", memory.String())
+	request := footprint.Limits["defaultRequest memory"]
+	assert.Equal(t, "64Mi", request.String())
+}
+
+func TestTierFootprintsWrite(t *testing.T) {
+	// given
+	footprints := testsupport.TierFootprints{
+		{
+			Tier:          "base",
+			Namespaces:    []string{"dev", "stage"},
+			Objects:       map[string]int{"ResourceQuota": 2, "ClusterResourceQuota": 1},
+			Quotas:        map[corev1.ResourceName]resource.Quantity{corev1.ResourceLimitsCPU: resource.MustParse("3500m")},
+			ClusterQuotas: map[corev1.ResourceName]resource.Quantity{"count/pods": resource.MustParse("50")},
+			Limits:        map[string]resource.Quantity{"default memory": resource.MustParse("750Mi")},
+		},
+		{
+			Tier:          "base1ns",
+			Namespaces:    []string{"dev"},
+			Objects:       map[string]int{"ResourceQuota": 1, "NetworkPolicy": 6},
+			Quotas:        map[corev1.ResourceName]resource.Quantity{corev1.ResourceLimitsCPU: resource.MustParse("2")},
+			ClusterQuotas: map[corev1.ResourceName]resource.Quantity{},
+			Limits:        map[string]resource.Quantity{"default memory": resource.MustParse("1Gi")},
+		},
+	}
+	out := &strings.Builder{}
+
+	// when
+	err := footprints.Write(out)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, `FOOTPRINT                     base       base1ns
+namespaces                    dev,stage  dev
+objects/ClusterResourceQuota  1          0
+objects/NetworkPolicy         0          6
+objects/ResourceQuota         2          1
+quota/limits.cpu              3500m      2
+clusterquota/count/pods       50         -
+limits/default memory         750Mi      1Gi
+`, out.String())
+}
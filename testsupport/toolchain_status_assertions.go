@@ -1,6 +1,8 @@
 package testsupport
 
 import (
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/codeready-toolchain/toolchain-common/pkg/cluster"
@@ -31,6 +33,22 @@ func VerifyToolchainStatus(t *testing.T, hostAwait *wait.HostAwaitility, memberA
 	require.NoError(t, err, "failed while waiting for ToolchainStatus")
 }
 
+// VerifyHostRoutesReachable waits until the host routes of the ToolchainStatus are ready, and verifies that the health endpoint
+// of the proxy URL which they publish responds with `200 OK`
+func VerifyHostRoutesReachable(t *testing.T, hostAwait *wait.HostAwaitility) {
+	status, err := hostAwait.WaitForToolchainStatus(t,
+		wait.UntilProxyURLIsPresent(hostAwait.APIProxyURL),
+		wait.UntilHostRoutesAreReady())
+	require.NoError(t, err, "failed while waiting for the host routes of the ToolchainStatus")
+
+	req, err := http.NewRequest("GET", strings.TrimSuffix(status.Status.HostRoutes.ProxyURL, "/")+"/proxyhealth", nil)
+	require.NoError(t, err)
+	resp, err := HTTPClient.Do(req) // nolint:bodyclose // see `defer Close(t, resp)`
+	require.NoError(t, err)
+	defer Close(t, resp)
+	require.Equal(t, http.StatusOK, resp.StatusCode, "the proxy URL published in the ToolchainStatus is not reachable: '%s'", status.Status.HostRoutes.ProxyURL)
+}
+
 func VerifyIncreaseOfSpaceCount(t *testing.T, previous, current *toolchainv1alpha1.ToolchainStatus, memberClusterName string, increase int) {
 	found := false
 CurrentMembers:
@@ -0,0 +1,25 @@
+package testsupport
+
+import (
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+	"github.com/stretchr/testify/require"
+)
+
+// VerifyClusterPairing verifies both directions of the pairing between the host and the given member cluster:
+// the host's ToolchainCluster for the member and the member's ToolchainCluster for the host are both ready,
+// and the service account referenced by each of them can perform a canary API call on the other cluster
+func VerifyClusterPairing(t *testing.T, hostAwait *wait.HostAwaitility, memberAwait *wait.MemberAwaitility) {
+	// host -> member
+	memberToolchainCluster, err := hostAwait.WaitForToolchainClusterWithCondition(t, memberAwait.Type, memberAwait.Namespace, wait.ReadyToolchainCluster)
+	require.NoError(t, err, "ToolchainCluster for member '%s' is not ready in the host cluster", memberAwait.ClusterName)
+	err = hostAwait.WaitUntilToolchainClusterCanAccess(t, &memberToolchainCluster, memberAwait.Namespace)
+	require.NoError(t, err, "host cannot access member '%s' using ToolchainCluster '%s'", memberAwait.ClusterName, memberToolchainCluster.Name)
+
+	// member -> host
+	hostToolchainCluster, err := memberAwait.WaitForToolchainClusterWithCondition(t, hostAwait.Type, hostAwait.Namespace, wait.ReadyToolchainCluster)
+	require.NoError(t, err, "ToolchainCluster for the host is not ready in member '%s'", memberAwait.ClusterName)
+	err = memberAwait.WaitUntilToolchainClusterCanAccess(t, &hostToolchainCluster, hostAwait.Namespace)
+	require.NoError(t, err, "member '%s' cannot access the host using ToolchainCluster '%s'", memberAwait.ClusterName, hostToolchainCluster.Name)
+}
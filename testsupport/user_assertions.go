@@ -142,50 +142,60 @@ func verifyUserAccount(t *testing.T, awaitilities wait.Awaitilities, userSignup
 
 	// Verify User and Identity if SkipUserCreation is not set or it is set to false
 	if memberConfiguration.Spec.SkipUserCreation == nil || !*memberConfiguration.Spec.SkipUserCreation {
-		// Verify provisioned User
-		user, err := memberAwait.WaitForUser(t, userAccount.Name,
-			wait.UntilUserHasLabel(toolchainv1alpha1.ProviderLabelKey, toolchainv1alpha1.ProviderLabelValue),
-			wait.UntilUserHasLabel(toolchainv1alpha1.OwnerLabelKey, userAccount.Name),
-			wait.UntilUserHasAnnotation(toolchainv1alpha1.UserEmailAnnotationKey, userSignup.Annotations[toolchainv1alpha1.UserSignupUserEmailAnnotationKey]))
-		assert.NoError(t, err, fmt.Sprintf("no user with name '%s' found", userAccount.Name))
-
-		userID, found := userSignup.Annotations[toolchainv1alpha1.SSOUserIDAnnotationKey]
-		if found {
-			accountID, found := userSignup.Annotations[toolchainv1alpha1.SSOAccountIDAnnotationKey]
-			if found && userID != "" && accountID != "" {
-				require.Equal(t, userID, user.Annotations[toolchainv1alpha1.SSOUserIDAnnotationKey])
-				require.Equal(t, accountID, user.Annotations[toolchainv1alpha1.SSOAccountIDAnnotationKey])
+		// all the resources are verified, even if some of them are not as expected, so that all the failures are reported together
+		memberAwait.Soft(t, func(sa *wait.SoftAwaitility) {
+			// Verify provisioned User
+			sa.Check("User", func(t wait.T) {
+				user, err := memberAwait.WaitForUser(t, userAccount.Name,
+					wait.UntilUserHasLabel(toolchainv1alpha1.ProviderLabelKey, toolchainv1alpha1.ProviderLabelValue),
+					wait.UntilUserHasLabel(toolchainv1alpha1.OwnerLabelKey, userAccount.Name),
+					wait.UntilUserHasAnnotation(toolchainv1alpha1.UserEmailAnnotationKey, userSignup.Annotations[toolchainv1alpha1.UserSignupUserEmailAnnotationKey]))
+				require.NoError(t, err, fmt.Sprintf("no user with name '%s' found", userAccount.Name))
+
+				userID, found := userSignup.Annotations[toolchainv1alpha1.SSOUserIDAnnotationKey]
+				if found {
+					accountID, found := userSignup.Annotations[toolchainv1alpha1.SSOAccountIDAnnotationKey]
+					if found && userID != "" && accountID != "" {
+						require.Equal(t, userID, user.Annotations[toolchainv1alpha1.SSOUserIDAnnotationKey])
+						require.Equal(t, accountID, user.Annotations[toolchainv1alpha1.SSOAccountIDAnnotationKey])
+					}
+				}
+
+				if !found {
+					require.NotContains(t, user.Annotations, toolchainv1alpha1.SSOUserIDAnnotationKey)
+					require.NotContains(t, user.Annotations, toolchainv1alpha1.SSOAccountIDAnnotationKey)
+				}
+			})
+
+			// Verify provisioned Identity
+			identityName := identitypkg.NewIdentityNamingStandard(userAccount.Spec.UserID, "rhd").IdentityName()
+			sa.Check("Identity", func(t wait.T) {
+				_, err := memberAwait.WaitForIdentity(t, identityName,
+					wait.UntilIdentityHasLabel(toolchainv1alpha1.ProviderLabelKey, toolchainv1alpha1.ProviderLabelValue),
+					wait.UntilIdentityHasLabel(toolchainv1alpha1.OwnerLabelKey, userAccount.Name))
+				require.NoError(t, err, fmt.Sprintf("no identity with name '%s' found", identityName))
+			})
+
+			// Verify the originalSub identity
+			if originalSubIdentityName != "" {
+				sa.Check("originalSub Identity", func(t wait.T) {
+					_, err := memberAwait.WaitForIdentity(t, originalSubIdentityName,
+						wait.UntilIdentityHasLabel(toolchainv1alpha1.ProviderLabelKey, toolchainv1alpha1.ProviderLabelValue),
+						wait.UntilIdentityHasLabel(toolchainv1alpha1.OwnerLabelKey, userAccount.Name))
+					require.NoError(t, err, fmt.Sprintf("no encoded identity with name '%s' found", originalSubIdentityName))
+				})
 			}
-		}
-
-		if !found {
-			require.NotContains(t, user.Annotations, toolchainv1alpha1.SSOUserIDAnnotationKey)
-			require.NotContains(t, user.Annotations, toolchainv1alpha1.SSOAccountIDAnnotationKey)
-		}
-
-		// Verify provisioned Identity
-		identityName := identitypkg.NewIdentityNamingStandard(userAccount.Spec.UserID, "rhd").IdentityName()
-
-		_, err = memberAwait.WaitForIdentity(t, identityName,
-			wait.UntilIdentityHasLabel(toolchainv1alpha1.ProviderLabelKey, toolchainv1alpha1.ProviderLabelValue),
-			wait.UntilIdentityHasLabel(toolchainv1alpha1.OwnerLabelKey, userAccount.Name))
-		assert.NoError(t, err, fmt.Sprintf("no identity with name '%s' found", identityName))
-
-		// Verify the originalSub identity
-		if originalSubIdentityName != "" {
-			_, err = memberAwait.WaitForIdentity(t, originalSubIdentityName,
-				wait.UntilIdentityHasLabel(toolchainv1alpha1.ProviderLabelKey, toolchainv1alpha1.ProviderLabelValue),
-				wait.UntilIdentityHasLabel(toolchainv1alpha1.OwnerLabelKey, userAccount.Name))
-			assert.NoError(t, err, fmt.Sprintf("no encoded identity with name '%s' found", identityName))
-		}
 
-		// Verify the userID identity
-		if userIDIdentityName != "" {
-			_, err = memberAwait.WaitForIdentity(t, userIDIdentityName,
-				wait.UntilIdentityHasLabel(toolchainv1alpha1.ProviderLabelKey, toolchainv1alpha1.ProviderLabelValue),
-				wait.UntilIdentityHasLabel(toolchainv1alpha1.OwnerLabelKey, userAccount.Name))
-			assert.NoError(t, err, fmt.Sprintf("no encoded identity with name '%s' found", identityName))
-		}
+			// Verify the userID identity
+			if userIDIdentityName != "" {
+				sa.Check("userID Identity", func(t wait.T) {
+					_, err := memberAwait.WaitForIdentity(t, userIDIdentityName,
+						wait.UntilIdentityHasLabel(toolchainv1alpha1.ProviderLabelKey, toolchainv1alpha1.ProviderLabelValue),
+						wait.UntilIdentityHasLabel(toolchainv1alpha1.OwnerLabelKey, userAccount.Name))
+					require.NoError(t, err, fmt.Sprintf("no encoded identity with name '%s' found", userIDIdentityName))
+				})
+			}
+		})
 	} else {
 		// we don't expect User nor Identity resources to be present for AppStudio tier
 		// This can be removed as soon as we don't create UserAccounts in AppStudio environment.
@@ -268,6 +278,7 @@ func VerifySpaceRelatedResources(t *testing.T, awaitilities wait.Awaitilities, u
 	tierChecks, err := tiers.NewChecksForTier(tier)
 	require.NoError(t, err)
 	tiers.VerifyNSTemplateSet(t, hostAwait, memberAwait, nsTemplateSet, tierChecks)
+	hostAwait.Narrative().Then("the Space '%s' with the '%s' tier is ready in the '%s' cluster", space.Name, spaceTierName, space.Spec.TargetCluster)
 
 	return space
 }
@@ -281,6 +292,37 @@ func ExpectedUserAccount(claims toolchainv1alpha1.PropagatedClaims) toolchainv1a
 	}
 }
 
+// VerifyUserAccountsInMurTargetClusters verifies that there is a provisioned UserAccount matching the given UserSignup and MUR
+// in each member cluster where the MUR places the user, and that there is no UserAccount for the user in the other member clusters
+func VerifyUserAccountsInMurTargetClusters(t *testing.T, awaitilities wait.Awaitilities, userSignup *toolchainv1alpha1.UserSignup, mur *toolchainv1alpha1.MasterUserRecord) []*toolchainv1alpha1.UserAccount {
+	targetClusters := map[string]bool{}
+	for _, ua := range mur.Spec.UserAccounts {
+		targetClusters[ua.TargetCluster] = true
+	}
+	require.NotEmpty(t, targetClusters, "MasterUserRecord '%s' has no target cluster", mur.Name)
+
+	var userAccounts []*toolchainv1alpha1.UserAccount
+	for _, memberAwait := range awaitilities.AllMembers() {
+		if !targetClusters[memberAwait.ClusterName] {
+			err := memberAwait.WaitUntilUserAccountDeleted(t, mur.Name)
+			require.NoError(t, err, "unexpected UserAccount '%s' in cluster '%s'", mur.Name, memberAwait.ClusterName)
+			continue
+		}
+		userAccount, err := memberAwait.WaitForUserAccount(t, mur.Name,
+			wait.UntilUserAccountHasConditions(wait.Provisioned()),
+			wait.UntilUserAccountHasPropagatedClaims(userSignup.Spec.IdentityClaims.PropagatedClaims),
+			wait.UntilUserAccountIsDisabled(mur.Spec.Disabled),
+			wait.UntilUserAccountHasTierLabel(mur.Spec.TierName),
+			wait.UntilUserAccountHasOwnerAnnotations(userSignup),
+			wait.UntilUserAccountMatchesMur(awaitilities.Host()))
+		require.NoError(t, err, "no matching UserAccount '%s' in cluster '%s'", mur.Name, memberAwait.ClusterName)
+		userAccounts = append(userAccounts, userAccount)
+		delete(targetClusters, memberAwait.ClusterName)
+	}
+	require.Empty(t, targetClusters, "MasterUserRecord '%s' targets unknown member clusters", mur.Name)
+	return userAccounts
+}
+
 func GetMurTargetMember(t *testing.T, awaitilities wait.Awaitilities, mur *toolchainv1alpha1.MasterUserRecord) *wait.MemberAwaitility {
 	for _, member := range awaitilities.AllMembers() {
 		for _, ua := range mur.Status.UserAccounts {
@@ -2,19 +2,14 @@ package testsupport
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
-	"net/http"
 	"testing"
-	"time"
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
-	"github.com/codeready-toolchain/toolchain-common/pkg/hash"
 	authsupport "github.com/codeready-toolchain/toolchain-common/pkg/test/auth"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/httpclient"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
 
-	"github.com/gofrs/uuid"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -44,50 +39,7 @@ func CreateMultipleSignups(t *testing.T, awaitilities wait.Awaitilities, targetC
 
 type IdentityOption func(*authsupport.Identity) error
 
-// NewUserSignup creates a new UserSignup resoruce with the given values:
-// specApproved defines if the UserSignup should be manually approved
-// username defines the required username set in the spec
-// email is set in "user-email" annotation
-// setTargetCluster defines if the UserSignup will be created with Spec.TargetCluster set to the first found member cluster name
-func NewUserSignup(namespace, username string, email string) *toolchainv1alpha1.UserSignup {
-	name := uuid.Must(uuid.NewV4()).String()
-	return &toolchainv1alpha1.UserSignup{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: namespace,
-			Name:      name,
-			Annotations: map[string]string{
-				toolchainv1alpha1.UserSignupUserEmailAnnotationKey: email,
-			},
-			Labels: map[string]string{
-				toolchainv1alpha1.UserSignupUserEmailHashLabelKey: hash.EncodeString(email),
-			},
-		},
-		Spec: toolchainv1alpha1.UserSignupSpec{
-			Username:    username,
-			Userid:      name,
-			OriginalSub: "original-sub-" + name,
-			IdentityClaims: toolchainv1alpha1.IdentityClaimsEmbedded{
-				PropagatedClaims: toolchainv1alpha1.PropagatedClaims{
-					Sub:         name,
-					UserID:      uuid.Must(uuid.NewV4()).String(),
-					AccountID:   uuid.Must(uuid.NewV4()).String(),
-					OriginalSub: "original-sub-" + name,
-					Email:       email,
-				},
-				PreferredUsername: username,
-				GivenName:         "Reginald",
-				FamilyName:        "Smith",
-				Company:           "Alpha Bravo",
-			},
-		},
-	}
-}
-
-var HTTPClient = &http.Client{
-	Timeout: time.Second * 10,
-	Transport: &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: true, // nolint:gosec
-		},
-	},
-}
+// HTTPClient the client of the requests to the registration service and the proxy, which retries the requests when the route
+// responds with `502 Bad Gateway` or `503 Service Unavailable` (eg: while the pods are restarting), and which does not verify
+// the certificates served on the routes
+var HTTPClient = httpclient.New(httpclient.WithRetries(3, httpclient.DefaultRetryDelay), httpclient.WithInsecureSkipVerify())
@@ -0,0 +1,108 @@
+package util
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// CachePolicy the caching directives of an HTTP response, from its `Cache-Control` header (or its `Pragma` header when there is
+// no `Cache-Control` header), along with its `ETag`
+type CachePolicy struct {
+	NoStore bool
+	NoCache bool
+	Private bool
+	Public  bool
+	// MaxAge the `max-age` directive (0 if absent)
+	MaxAge time.Duration
+	// SharedMaxAge the `s-maxage` directive, for the shared caches such as the proxies (0 if absent)
+	SharedMaxAge time.Duration
+	ETag         string
+}
+
+// ParseCachePolicy returns the caching directives of the response with the given header. The unknown directives are ignored.
+func ParseCachePolicy(header http.Header) CachePolicy {
+	policy := CachePolicy{
+		ETag: header.Get("ETag"),
+	}
+	cacheControl := header.Values("Cache-Control")
+	if len(cacheControl) == 0 && strings.EqualFold(strings.TrimSpace(header.Get("Pragma")), "no-cache") {
+		policy.NoCache = true
+		return policy
+	}
+	for _, value := range cacheControl {
+		for _, directive := range strings.Split(value, ",") {
+			name, arg, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			switch strings.ToLower(name) {
+			case "no-store":
+				policy.NoStore = true
+			case "no-cache":
+				policy.NoCache = true
+			case "private":
+				policy.Private = true
+			case "public":
+				policy.Public = true
+			case "max-age":
+				policy.MaxAge = parseSeconds(arg)
+			case "s-maxage":
+				policy.SharedMaxAge = parseSeconds(arg)
+			}
+		}
+	}
+	return policy
+}
+
+func parseSeconds(arg string) time.Duration {
+	seconds, err := strconv.Atoi(strings.Trim(arg, `"`))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// Cacheable returns `true` if a cache is allowed to store the response and to serve it again without revalidating it with
+// the server first, ie: if a stale response could be served for a while
+func (p CachePolicy) Cacheable() bool {
+	if p.NoStore || p.NoCache {
+		return false
+	}
+	return p.Public || p.MaxAge > 0 || p.SharedMaxAge > 0
+}
+
+func (p CachePolicy) String() string {
+	return fmt.Sprintf("no-store=%t no-cache=%t private=%t public=%t max-age=%s s-maxage=%s etag=%q",
+		p.NoStore, p.NoCache, p.Private, p.Public, p.MaxAge, p.SharedMaxAge, p.ETag)
+}
+
+// NewCacheBustingTransport returns a transport which sends the requests with the given transport (or the default one if nil),
+// after asking the caches between the client and the server to revalidate their responses (with the `Cache-Control: no-cache`
+// and `Pragma: no-cache` headers), and after adding a unique `_` query parameter to the GET requests, so that the responses
+// are never served from a cache which ignores these headers
+func NewCacheBustingTransport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &cacheBustingTransport{
+		next: next,
+	}
+}
+
+type cacheBustingTransport struct {
+	next    http.RoundTripper
+	counter int64
+}
+
+func (c *cacheBustingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Cache-Control", "no-cache")
+	req.Header.Set("Pragma", "no-cache")
+	if req.Method == http.MethodGet {
+		query := req.URL.Query()
+		query.Set("_", fmt.Sprintf("%d-%d", time.Now().UnixNano(), atomic.AddInt64(&c.counter, 1)))
+		req.URL.RawQuery = query.Encode()
+	}
+	return c.next.RoundTrip(req)
+}
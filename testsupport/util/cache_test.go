@@ -0,0 +1,115 @@
+package util_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCachePolicy(t *testing.T) {
+
+	header := func(kv ...string) http.Header {
+		h := http.Header{}
+		for i := 0; i < len(kv); i += 2 {
+			h.Add(kv[i], kv[i+1])
+		}
+		return h
+	}
+
+	t.Run("no caching headers", func(t *testing.T) {
+		// when
+		policy := util.ParseCachePolicy(header())
+
+		// then
+		assert.Equal(t, util.CachePolicy{}, policy)
+		assert.False(t, policy.Cacheable())
+	})
+
+	t.Run("no-cache and private", func(t *testing.T) {
+		// when
+		policy := util.ParseCachePolicy(header("Cache-Control", "no-cache, private", "ETag", `W/"123"`))
+
+		// then
+		assert.Equal(t, util.CachePolicy{NoCache: true, Private: true, ETag: `W/"123"`}, policy)
+		assert.False(t, policy.Cacheable())
+	})
+
+	t.Run("max-age", func(t *testing.T) {
+		// when
+		policy := util.ParseCachePolicy(header("Cache-Control", "Public", "Cache-Control", "max-age=60, s-maxage=\"120\""))
+
+		// then
+		assert.Equal(t, util.CachePolicy{Public: true, MaxAge: time.Minute, SharedMaxAge: 2 * time.Minute}, policy)
+		assert.True(t, policy.Cacheable())
+	})
+
+	t.Run("max-age with no-store", func(t *testing.T) {
+		// when
+		policy := util.ParseCachePolicy(header("Cache-Control", "max-age=60,no-store"))
+
+		// then
+		assert.True(t, policy.NoStore)
+		assert.False(t, policy.Cacheable())
+	})
+
+	t.Run("invalid max-age", func(t *testing.T) {
+		// when
+		policy := util.ParseCachePolicy(header("Cache-Control", "max-age=-1, unknown=foo"))
+
+		// then
+		assert.Equal(t, util.CachePolicy{}, policy)
+	})
+
+	t.Run("pragma without cache-control", func(t *testing.T) {
+		// when
+		policy := util.ParseCachePolicy(header("Pragma", "no-cache"))
+
+		// then
+		assert.True(t, policy.NoCache)
+	})
+}
+
+func TestCacheBustingTransport(t *testing.T) {
+	// given
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	client := &http.Client{
+		Transport: util.NewCacheBustingTransport(server.Client().Transport),
+	}
+	send := func(method string) {
+		req, err := http.NewRequest(method, server.URL+"/api/v1/signup?foo=bar", nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		// the request of the caller is not modified
+		assert.Empty(t, req.Header.Get("Cache-Control"))
+		assert.Equal(t, "foo=bar", req.URL.RawQuery)
+	}
+
+	// when
+	send(http.MethodGet)
+	send(http.MethodGet)
+	send(http.MethodPost)
+
+	// then
+	require.Len(t, requests, 3)
+	for _, r := range requests {
+		assert.Equal(t, "no-cache", r.Header.Get("Cache-Control"))
+		assert.Equal(t, "no-cache", r.Header.Get("Pragma"))
+		assert.Equal(t, "bar", r.URL.Query().Get("foo"))
+	}
+	assert.NotEmpty(t, requests[0].URL.Query().Get("_"))
+	assert.NotEqual(t, requests[0].URL.Query().Get("_"), requests[1].URL.Query().Get("_"))
+	assert.Empty(t, requests[2].URL.Query().Get("_"))
+}
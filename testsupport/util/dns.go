@@ -0,0 +1,86 @@
+package util
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ParseDNSOverrides parses the given comma-separated list of `host=ip` entries (eg: `registration-service-toolchain-host-operator.apps.example.com=10.0.0.12`),
+// ie, the host→IP overrides used by the test HTTP clients. This allows verifying the routes before the external DNS records are propagated,
+// or against clusters whose ingress DNS is only resolvable internally.
+func ParseDNSOverrides(value string) (map[string]string, error) {
+	overrides := map[string]string{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		host, ip, found := strings.Cut(entry, "=")
+		host, ip = strings.TrimSpace(host), strings.TrimSpace(ip)
+		if !found || host == "" || net.ParseIP(ip) == nil {
+			return nil, fmt.Errorf("invalid DNS override '%s': expected 'host=ip'", entry)
+		}
+		overrides[strings.ToLower(host)] = ip
+	}
+	return overrides, nil
+}
+
+// DialContextWithDNSOverrides returns a `DialContext` func which connects to the IP of the overridden hosts instead of resolving them,
+// and which falls back to the regular resolution for all other hosts.
+func DialContextWithDNSOverrides(overrides map[string]string) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		if ip, found := overrides[strings.ToLower(host)]; found {
+			addr = net.JoinHostPort(ip, port)
+		}
+		return dialer.DialContext(ctx, network, addr)
+	}
+}
+
+// dnsOverrides returns the host→IP overrides applied by the transports returned by NewTransport (see SetDNSOverrides)
+var dnsOverrides = func() map[string]string {
+	return nil
+}
+
+// SetDNSOverrides sets the func which returns the host→IP overrides applied by the transports returned by NewTransport, ie, the overrides
+// of the current environment of the tests (see `wait.CurrentEnvironment`), which this package can't depend on. The func is called
+// on every new connection, so that the transports created before the environment is loaded (eg: in package vars) also apply them.
+func SetDNSOverrides(overrides func() map[string]string) {
+	dnsOverrides = overrides
+}
+
+// NewInsecureTransport returns a new transport which skips the verification of the server certificates, and which applies the
+// DNS overrides and the proxy env vars, if any (see NewTransport).
+func NewInsecureTransport() *http.Transport {
+	return NewTransport(&tls.Config{
+		InsecureSkipVerify: true, // nolint:gosec
+	})
+}
+
+// NewTransport returns a new transport with the given TLS config, which applies the DNS overrides of the environment (see SetDNSOverrides),
+// if any, and which sends the requests via the proxy set in the `HTTPS_PROXY` (or `HTTP_PROXY`) env var, if any, except
+// for the hosts of the `NO_PROXY` env var (eg: when the tests run behind a corporate proxy). Note that the DNS overrides only apply
+// to the proxy itself when the requests are sent via a proxy, since the proxy resolves the hosts of the requests.
+func NewTransport(tlsConfig *tls.Config) *http.Transport {
+	return &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: tlsConfig,
+		// the idle connections of the transports which are reused across the waits are eventually closed
+		IdleConnTimeout: 90 * time.Second,
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return DialContextWithDNSOverrides(dnsOverrides())(ctx, network, addr)
+		},
+	}
+}
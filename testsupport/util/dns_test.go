@@ -0,0 +1,109 @@
+package util_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDNSOverrides(t *testing.T) {
+
+	t.Run("valid", func(t *testing.T) {
+		// when
+		overrides, err := util.ParseDNSOverrides(" api.Example.com=10.0.0.1, ,console.example.com = ::1")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"api.example.com":     "10.0.0.1",
+			"console.example.com": "::1",
+		}, overrides)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// when
+		overrides, err := util.ParseDNSOverrides("")
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, overrides)
+	})
+
+	for name, value := range map[string]string{
+		"missing ip":   "api.example.com",
+		"missing host": "=10.0.0.1",
+		"invalid ip":   "api.example.com=10.0.0",
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			_, err := util.ParseDNSOverrides(value)
+
+			// then
+			require.EqualError(t, err, "invalid DNS override '"+value+"': expected 'host=ip'")
+		})
+	}
+}
+
+func TestDialContextWithDNSOverrides(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	_, port, err := net.SplitHostPort(serverURL.Host)
+	require.NoError(t, err)
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: util.DialContextWithDNSOverrides(map[string]string{"route.e2e.invalid": "127.0.0.1"}),
+		},
+	}
+
+	// when
+	resp, err := client.Get("http://route.e2e.invalid:" + port + "/")
+
+	// then
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewTransportWithDNSOverrides(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	_, port, err := net.SplitHostPort(serverURL.Host)
+	require.NoError(t, err)
+	// the transport is created before the overrides are set, eg: in a package var
+	transport := util.NewTransport(nil)
+	transport.Proxy = nil // the test server is local
+	client := &http.Client{
+		Transport: transport,
+	}
+	util.SetDNSOverrides(func() map[string]string {
+		return map[string]string{"route.e2e.invalid": "127.0.0.1"}
+	})
+	defer util.SetDNSOverrides(func() map[string]string {
+		return nil
+	})
+
+	// when
+	resp, err := client.Get("http://route.e2e.invalid:" + port + "/")
+
+	// then
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
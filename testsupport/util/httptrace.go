@@ -0,0 +1,116 @@
+package util
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// RequestTimings the timings of the different phases of an HTTP request. The durations of the phases which did not occur
+// (eg: DNS lookup and TLS handshake when a connection was reused) are zero
+type RequestTimings struct {
+	URL          string
+	DNS          time.Duration
+	Connect      time.Duration
+	TLSHandshake time.Duration
+	FirstByte    time.Duration
+	Total        time.Duration
+	ReusedConn   bool
+}
+
+func (r RequestTimings) String() string {
+	return fmt.Sprintf("%s: dns=%s connect=%s tls=%s first-byte=%s total=%s reused-conn=%t", r.URL, r.DNS, r.Connect, r.TLSHandshake, r.FirstByte, r.Total, r.ReusedConn)
+}
+
+// DoTraced sends the given request with the given client, and returns the response along with the timings of the request.
+// If the request fails, then the returned error includes the timings, so that the failure can be attributed to the DNS lookup,
+// the connection, the TLS handshake or the backend.
+func DoTraced(client *http.Client, req *http.Request) (*http.Response, RequestTimings, error) {
+	tracer := &requestTracer{
+		timings: RequestTimings{
+			URL: req.URL.String(),
+		},
+		start: time.Now(),
+	}
+	resp, err := client.Do(req.WithContext(httptrace.WithClientTrace(req.Context(), tracer.clientTrace())))
+	timings := tracer.done()
+	if err != nil {
+		return nil, timings, tracedError{err: err, timings: timings}
+	}
+	return resp, timings, nil
+}
+
+// requestTracer records the timings of a single request. The trace hooks may be called from the goroutines of the transport
+// (eg: when dialing), hence the lock
+type requestTracer struct {
+	sync.Mutex
+	timings                                 RequestTimings
+	start, dnsStart, connectStart, tlsStart time.Time
+}
+
+func (r *requestTracer) record(f func()) {
+	r.Lock()
+	defer r.Unlock()
+	f()
+}
+
+func (r *requestTracer) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			r.record(func() { r.dnsStart = time.Now() })
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			r.record(func() { r.timings.DNS = time.Since(r.dnsStart) })
+		},
+		ConnectStart: func(string, string) {
+			r.record(func() { r.connectStart = time.Now() })
+		},
+		ConnectDone: func(string, string, error) {
+			r.record(func() { r.timings.Connect = time.Since(r.connectStart) })
+		},
+		TLSHandshakeStart: func() {
+			r.record(func() { r.tlsStart = time.Now() })
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			r.record(func() { r.timings.TLSHandshake = time.Since(r.tlsStart) })
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			r.record(func() { r.timings.ReusedConn = info.Reused })
+		},
+		GotFirstResponseByte: func() {
+			r.record(func() { r.timings.FirstByte = time.Since(r.start) })
+		},
+	}
+}
+
+// done returns the timings of the request, which is complete
+func (r *requestTracer) done() RequestTimings {
+	r.Lock()
+	defer r.Unlock()
+	r.timings.Total = time.Since(r.start)
+	return r.timings
+}
+
+// tracedError an error which includes the timings of the failed request
+type tracedError struct {
+	err     error
+	timings RequestTimings
+}
+
+func (e tracedError) Error() string {
+	return fmt.Sprintf("%s (%s)", e.err.Error(), e.timings)
+}
+
+func (e tracedError) Unwrap() error {
+	return e.err
+}
+
+// Timeout is needed for the error to still be reported as a timeout when it wraps a `url.Error` caused by a timeout
+func (e tracedError) Timeout() bool {
+	var timeoutErr interface{ Timeout() bool }
+	return errors.As(e.err, &timeoutErr) && timeoutErr.Timeout()
+}
@@ -0,0 +1,118 @@
+package util_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoTraced(t *testing.T) {
+
+	send := func(t *testing.T, client *http.Client, url string) (*http.Response, util.RequestTimings, error) {
+		req, err := http.NewRequest("GET", url, nil)
+		require.NoError(t, err)
+		resp, timings, err := util.DoTraced(client, req)
+		if resp != nil {
+			_, _ = io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+		}
+		return resp, timings, err
+	}
+
+	t.Run("success", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		client := server.Client()
+
+		// when
+		resp, first, err := send(t, client, server.URL+"/status")
+		require.NoError(t, err)
+		_, second, err := send(t, client, server.URL+"/status")
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, server.URL+"/status", first.URL)
+		assert.False(t, first.ReusedConn)
+		assert.Positive(t, first.Connect)
+		assert.Positive(t, first.FirstByte)
+		assert.GreaterOrEqual(t, first.Total, first.FirstByte)
+		// the timings are recorded per request
+		assert.True(t, second.ReusedConn)
+		assert.Zero(t, second.Connect)
+	})
+
+	t.Run("TLS handshake", func(t *testing.T) {
+		// given
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		// when
+		_, timings, err := send(t, server.Client(), server.URL)
+
+		// then
+		require.NoError(t, err)
+		assert.Positive(t, timings.TLSHandshake)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		// given
+		done := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-done
+		}))
+		defer server.Close()
+		defer close(done)
+		client := server.Client()
+		client.Timeout = 50 * time.Millisecond
+
+		// when
+		_, timings, err := send(t, client, server.URL+"/slow")
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Client.Timeout exceeded")
+		assert.Contains(t, err.Error(), "("+timings.String()+")")
+		assert.GreaterOrEqual(t, timings.Total, 50*time.Millisecond)
+		assert.Zero(t, timings.FirstByte)
+		// still reported as a timeout
+		urlErr := &url.Error{}
+		require.True(t, errors.As(err, &urlErr))
+		assert.True(t, urlErr.Timeout())
+		var timeoutErr interface{ Timeout() bool }
+		require.True(t, errors.As(err, &timeoutErr))
+		assert.True(t, timeoutErr.Timeout())
+	})
+
+	t.Run("connection refused", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		serverURL := server.URL
+		server.Close()
+
+		// when
+		_, timings, err := send(t, http.DefaultClient, serverURL)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), serverURL+": dns=")
+		assert.Equal(t, serverURL, timings.URL)
+		var timeoutErr interface{ Timeout() bool }
+		require.True(t, errors.As(err, &timeoutErr))
+		assert.False(t, timeoutErr.Timeout())
+	})
+}
@@ -1,8 +1,6 @@
 package util
 
 import (
-	"os"
-
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/clientcmd/api"
@@ -10,8 +8,20 @@ import (
 
 const EnvDisableKubeClientTLSVerify string = "DISABLE_KUBE_CLIENT_TLS_VERIFY"
 
+// kubeClientTLSVerifyDisabled returns whether the certificates of the API servers are not verified (see SetKubeClientTLSVerifyDisabled)
+var kubeClientTLSVerifyDisabled = func() bool {
+	return false
+}
+
+// SetKubeClientTLSVerifyDisabled sets the func which returns whether the configs returned by BuildKubernetesRESTConfig skip the verification
+// of the certificates of the API servers, ie, the setting of the current environment of the tests (see `wait.CurrentEnvironment`),
+// which this package can't depend on.
+func SetKubeClientTLSVerifyDisabled(disabled func() bool) {
+	kubeClientTLSVerifyDisabled = disabled
+}
+
 func BuildKubernetesRESTConfig(apiConfig api.Config) (*rest.Config, error) {
-	if os.Getenv(EnvDisableKubeClientTLSVerify) == "true" {
+	if kubeClientTLSVerifyDisabled() {
 		apiConfig = setInsecureSkipTLSVerify(apiConfig)
 	}
 
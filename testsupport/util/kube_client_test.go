@@ -0,0 +1,60 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestBuildKubernetesRESTConfig(t *testing.T) {
+	// given
+	apiConfig := func() api.Config {
+		return api.Config{
+			Clusters: map[string]*api.Cluster{
+				"host": {
+					Server:                   "https://api.host.example.com:6443",
+					CertificateAuthorityData: []byte("ca"),
+				},
+			},
+			AuthInfos: map[string]*api.AuthInfo{
+				"admin": {Token: "token"},
+			},
+			Contexts: map[string]*api.Context{
+				"host": {Cluster: "host", AuthInfo: "admin"},
+			},
+			CurrentContext: "host",
+		}
+	}
+
+	t.Run("verified by default", func(t *testing.T) {
+		// when
+		config, err := util.BuildKubernetesRESTConfig(apiConfig())
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, config.Insecure)
+		assert.Equal(t, []byte("ca"), config.CAData)
+	})
+
+	t.Run("not verified", func(t *testing.T) {
+		// given
+		util.SetKubeClientTLSVerifyDisabled(func() bool {
+			return true
+		})
+		defer util.SetKubeClientTLSVerifyDisabled(func() bool {
+			return false
+		})
+
+		// when
+		config, err := util.BuildKubernetesRESTConfig(apiConfig())
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, config.Insecure)
+		assert.Empty(t, config.CAData)
+	})
+}
@@ -0,0 +1,127 @@
+package util
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// CertPoolWith returns a copy of the system cert pool (or an empty pool if it is not available) with the certificates of the given
+// PEM bundles, eg: the CA of the ingress of the cluster. Returns an error if one of the bundles does not contain any certificate.
+func CertPoolWith(bundles ...[]byte) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	for i, bundle := range bundles {
+		if !pool.AppendCertsFromPEM(bundle) {
+			return nil, fmt.Errorf("no certificate in the CA bundle #%d", i+1)
+		}
+	}
+	return pool, nil
+}
+
+// VerifyingTLSConfig returns a TLS config which verifies the certificate of the server against the given CAs, and against the given
+// DNS name instead of the host of the request if it is not empty, eg: the name of the service behind a passthrough route, whose serving
+// certificate is not issued for the host of the route (the host of the route is still sent with SNI, so that the router can route
+// the connection)
+func VerifyingTLSConfig(roots *x509.CertPool, dnsName string) *tls.Config {
+	if dnsName == "" {
+		return &tls.Config{
+			RootCAs:    roots,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+	return &tls.Config{
+		// the verification is done in VerifyConnection, against the given DNS name
+		InsecureSkipVerify: true, // nolint:gosec
+		MinVersion:         tls.VersionTLS12,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return errors.New("no certificate presented by the server")
+			}
+			intermediates := x509.NewCertPool()
+			for _, cert := range cs.PeerCertificates[1:] {
+				intermediates.AddCert(cert)
+			}
+			_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+				Roots:         roots,
+				Intermediates: intermediates,
+				DNSName:       dnsName,
+			})
+			return err
+		},
+	}
+}
+
+// caBundle returns the path of the PEM bundle of additional CAs trusted by the transports returned by NewVerifyingTransport (see SetCABundle)
+var caBundle = func() string {
+	return ""
+}
+
+// SetCABundle sets the func which returns the path of the PEM bundle of additional CAs trusted by the transports returned by
+// NewVerifyingTransport, ie, the CA bundle of the routes of the current environment of the tests (see `wait.CurrentEnvironment`),
+// which this package can't depend on.
+func SetCABundle(path func() string) {
+	caBundle = path
+}
+
+// NewVerifyingTransport returns a new transport which verifies the certificates of the servers against the CAs of the system and
+// the CA bundle of the environment, if any (see SetCABundle), and which applies the DNS overrides and the proxy env vars (see NewTransport).
+// The CA bundle is read when the first request is sent, so that the transports created before the environment is loaded (eg: in package vars)
+// also trust it.
+func NewVerifyingTransport() http.RoundTripper {
+	return &verifyingTransport{}
+}
+
+type verifyingTransport struct {
+	lock      sync.Mutex
+	transport *http.Transport
+}
+
+func (t *verifyingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport, err := t.getTransport()
+	if err != nil {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, err
+	}
+	return transport.RoundTrip(req)
+}
+
+// getTransport returns the underlying transport, which is created with the CAs of the system and of the CA bundle on the first call
+func (t *verifyingTransport) getTransport() (*http.Transport, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.transport != nil {
+		return t.transport, nil
+	}
+	var bundles [][]byte
+	if path := caBundle(); path != "" {
+		bundle, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the CA bundle: %w", err)
+		}
+		bundles = append(bundles, bundle)
+	}
+	roots, err := CertPoolWith(bundles...)
+	if err != nil {
+		return nil, err
+	}
+	t.transport = NewTransport(VerifyingTLSConfig(roots, ""))
+	return t.transport, nil
+}
+
+// CloseIdleConnections closes the idle connections of the underlying transport, if it was created
+func (t *verifyingTransport) CloseIdleConnections() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.transport != nil {
+		t.transport.CloseIdleConnections()
+	}
+}
@@ -0,0 +1,219 @@
+package util_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertPoolWith(t *testing.T) {
+
+	t.Run("valid bundle", func(t *testing.T) {
+		// given
+		ca, _ := newCA(t)
+
+		// when
+		pool, err := util.CertPoolWith(ca)
+
+		// then
+		require.NoError(t, err)
+		assert.NotNil(t, pool)
+	})
+
+	t.Run("invalid bundle", func(t *testing.T) {
+		// when
+		_, err := util.CertPoolWith([]byte("not a certificate"))
+
+		// then
+		require.EqualError(t, err, "no certificate in the CA bundle #1")
+	})
+}
+
+func TestVerifyingTLSConfig(t *testing.T) {
+	// given
+	caPEM, caCert := newCA(t)
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	ts.TLS = &tls.Config{
+		Certificates: []tls.Certificate{newServingCert(t, caCert, "my-service.my-namespace.svc")},
+		MinVersion:   tls.VersionTLS12,
+	}
+	ts.StartTLS()
+	defer ts.Close()
+	roots, err := util.CertPoolWith(caPEM)
+	require.NoError(t, err)
+
+	t.Run("against the given DNS name", func(t *testing.T) {
+		// given
+		client := &http.Client{Transport: util.NewTransport(util.VerifyingTLSConfig(roots, "my-service.my-namespace.svc"))}
+
+		// when
+		resp, err := client.Get(ts.URL)
+
+		// then
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("against another DNS name", func(t *testing.T) {
+		// given
+		client := &http.Client{Transport: util.NewTransport(util.VerifyingTLSConfig(roots, "other-service.my-namespace.svc"))}
+
+		// when
+		_, err := client.Get(ts.URL) // nolint:bodyclose
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "certificate is valid for my-service.my-namespace.svc, not other-service.my-namespace.svc")
+	})
+
+	t.Run("against the host of the request", func(t *testing.T) {
+		// given
+		client := &http.Client{Transport: util.NewTransport(util.VerifyingTLSConfig(roots, ""))}
+
+		// when
+		_, err := client.Get(ts.URL) // nolint:bodyclose
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "x509: cannot validate certificate for 127.0.0.1")
+	})
+
+	t.Run("unknown authority", func(t *testing.T) {
+		// given
+		otherCA, _ := newCA(t)
+		otherRoots, err := util.CertPoolWith(otherCA)
+		require.NoError(t, err)
+		client := &http.Client{Transport: util.NewTransport(util.VerifyingTLSConfig(otherRoots, "my-service.my-namespace.svc"))}
+
+		// when
+		_, err = client.Get(ts.URL) // nolint:bodyclose
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "certificate signed by unknown authority")
+	})
+}
+
+func TestNewVerifyingTransport(t *testing.T) {
+	// given
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+	bundle := filepath.Join(t.TempDir(), "ca-bundle.crt")
+	require.NoError(t, os.WriteFile(bundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}), 0600))
+	setCABundle := func(t *testing.T, path string) {
+		util.SetCABundle(func() string {
+			return path
+		})
+		t.Cleanup(func() {
+			util.SetCABundle(func() string {
+				return ""
+			})
+		})
+	}
+
+	t.Run("unknown authority", func(t *testing.T) {
+		// given
+		client := &http.Client{Transport: util.NewVerifyingTransport()}
+
+		// when
+		_, err := client.Get(ts.URL) // nolint:bodyclose
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "certificate signed by unknown authority")
+	})
+
+	t.Run("trusted with the CA bundle", func(t *testing.T) {
+		// given
+		client := &http.Client{Transport: util.NewVerifyingTransport()}
+		// the CA bundle is read when the first request is sent
+		setCABundle(t, bundle)
+
+		// when
+		resp, err := client.Get(ts.URL)
+
+		// then
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("missing CA bundle", func(t *testing.T) {
+		// given
+		setCABundle(t, filepath.Join(t.TempDir(), "missing.crt"))
+		client := &http.Client{Transport: util.NewVerifyingTransport()}
+
+		// when
+		_, err := client.Get(ts.URL) // nolint:bodyclose
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to read the CA bundle")
+	})
+}
+
+// newCA returns the PEM and the certificate (with its key) of a new self-signed CA
+func newCA(t *testing.T) ([]byte, tls.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "e2e-test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+		Leaf:        cert,
+	}
+}
+
+// newServingCert returns a new serving certificate for the given DNS name, issued by the given CA
+func newServingCert(t *testing.T, ca tls.Certificate, dnsName string) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.Leaf, &key.PublicKey, ca.PrivateKey)
+	require.NoError(t, err)
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}
+}
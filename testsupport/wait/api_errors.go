@@ -0,0 +1,132 @@
+package wait
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// APIErrors the number of API errors of each category returned by the API server, which are usually hidden by the retries
+// of the clients, the controllers and the waits, but reveal a noisy behavior of the operators or of the tests
+// (eg: concurrent updates of the same resources, or requests being throttled)
+type APIErrors struct {
+	// Conflicts the number of `409 Conflict` responses
+	Conflicts int
+	// TooManyRequests the number of `429 Too Many Requests` responses
+	TooManyRequests int
+	// ServerErrors the number of `5xx` responses
+	ServerErrors int
+}
+
+// Exceeding returns the categories of API errors whose number exceeds the one in the given budget (or an empty slice if none)
+func (e APIErrors) Exceeding(budget APIErrors) []string {
+	var exceeding []string
+	if e.Conflicts > budget.Conflicts {
+		exceeding = append(exceeding, fmt.Sprintf("%d conflicts (budget: %d)", e.Conflicts, budget.Conflicts))
+	}
+	if e.TooManyRequests > budget.TooManyRequests {
+		exceeding = append(exceeding, fmt.Sprintf("%d too many requests (budget: %d)", e.TooManyRequests, budget.TooManyRequests))
+	}
+	if e.ServerErrors > budget.ServerErrors {
+		exceeding = append(exceeding, fmt.Sprintf("%d server errors (budget: %d)", e.ServerErrors, budget.ServerErrors))
+	}
+	return exceeding
+}
+
+// apiErrorCounter counts the API errors in the responses of the API server
+type apiErrorCounter struct {
+	sync.Mutex
+	errors APIErrors
+}
+
+func (c *apiErrorCounter) record(statusCode int) {
+	c.Lock()
+	defer c.Unlock()
+	switch {
+	case statusCode == http.StatusConflict:
+		c.errors.Conflicts++
+	case statusCode == http.StatusTooManyRequests:
+		c.errors.TooManyRequests++
+	case statusCode >= http.StatusInternalServerError:
+		c.errors.ServerErrors++
+	}
+}
+
+func (c *apiErrorCounter) get() APIErrors {
+	c.Lock()
+	defer c.Unlock()
+	return c.errors
+}
+
+// apiErrorCountingTransport a RoundTripper which records the status code of each response in the counter
+type apiErrorCountingTransport struct {
+	counter *apiErrorCounter
+	next    http.RoundTripper
+}
+
+func (t *apiErrorCountingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err == nil {
+		t.counter.record(resp.StatusCode)
+	}
+	return resp, err
+}
+
+// WithAPIErrorBudget returns a new Awaitility whose client counts the API errors returned by the API server (see APIErrors), and which
+// fails the given test at its end if their number exceeds the given budget. Only the requests of the returned Awaitility (and of its copies)
+// are counted, so that the errors are attributed to the given test even when other tests run in parallel.
+func (a *Awaitility) WithAPIErrorBudget(t T, budget APIErrors) *Awaitility {
+	result := a.copy()
+	counter := &apiErrorCounter{}
+	config := rest.CopyConfig(a.RestConfig)
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &apiErrorCountingTransport{
+			counter: counter,
+			next:    rt,
+		}
+	})
+	cl, err := client.New(config, client.Options{
+		Scheme: a.Client.Scheme(),
+		Mapper: a.Client.RESTMapper(),
+	})
+	require.NoError(t, err)
+	result.Client = cl
+	result.RestConfig = config
+	result.apiErrors = counter
+	t.Cleanup(func() {
+		observed := counter.get()
+		result.logf(t, "API errors observed during the test: %+v", observed)
+		assert.Empty(t, observed.Exceeding(budget), "too many API errors returned by the API server of the '%s' cluster", result.LogLabel())
+	})
+	return result
+}
+
+// ObservedAPIErrors returns the number of API errors observed so far by the client of the Awaitility,
+// if it was created with WithAPIErrorBudget (otherwise, the API errors are not counted)
+func (a *Awaitility) ObservedAPIErrors() APIErrors {
+	if a.apiErrors == nil {
+		return APIErrors{}
+	}
+	return a.apiErrors.get()
+}
+
+// WithAPIErrorBudget returns a new HostAwaitility whose client counts the API errors returned by the API server,
+// and which fails the given test at its end if their number exceeds the given budget (see Awaitility.WithAPIErrorBudget)
+func (a *HostAwaitility) WithAPIErrorBudget(t T, budget APIErrors) *HostAwaitility {
+	result := *a
+	result.Awaitility = a.Awaitility.WithAPIErrorBudget(t, budget)
+	return &result
+}
+
+// WithAPIErrorBudget returns a new MemberAwaitility whose client counts the API errors returned by the API server,
+// and which fails the given test at its end if their number exceeds the given budget (see Awaitility.WithAPIErrorBudget)
+func (a *MemberAwaitility) WithAPIErrorBudget(t T, budget APIErrors) *MemberAwaitility {
+	result := *a
+	result.Awaitility = a.Awaitility.WithAPIErrorBudget(t, budget)
+	return &result
+}
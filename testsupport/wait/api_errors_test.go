@@ -0,0 +1,75 @@
+package wait_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestAPIErrorsExceeding(t *testing.T) {
+	// given
+	budget := wait.APIErrors{Conflicts: 2, TooManyRequests: 1}
+
+	t.Run("within budget", func(t *testing.T) {
+		assert.Empty(t, wait.APIErrors{Conflicts: 2, TooManyRequests: 1}.Exceeding(budget))
+		assert.Empty(t, wait.APIErrors{}.Exceeding(budget))
+	})
+
+	t.Run("exceeding budget", func(t *testing.T) {
+		assert.Equal(t, []string{"3 conflicts (budget: 2)", "1 server errors (budget: 0)"},
+			wait.APIErrors{Conflicts: 3, TooManyRequests: 1, ServerErrors: 1}.Exceeding(budget))
+	})
+}
+
+func TestWithAPIErrorBudget(t *testing.T) {
+	// given
+	// an API server which returns the status code given in the name of the requested ConfigMap
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var code int
+		_, _ = fmt.Sscanf(r.URL.Path, "/api/v1/namespaces/test/configmaps/status-%d", &code)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		if code == http.StatusOK {
+			_, _ = w.Write([]byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"status-200","namespace":"test"}}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"apiVersion":"v1","kind":"Status","status":"Failure","code":%d}`, code)
+	}))
+	defer apiServer.Close()
+	config := &rest.Config{Host: apiServer.URL}
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	cl, err := client.New(config, client.Options{Scheme: scheme.Scheme, Mapper: mapper})
+	require.NoError(t, err)
+	a := &wait.Awaitility{
+		Client:     cl,
+		RestConfig: config,
+		Namespace:  "test",
+	}
+
+	// when
+	counting := a.WithAPIErrorBudget(t, wait.APIErrors{Conflicts: 2, TooManyRequests: 1, ServerErrors: 2})
+	for _, code := range []int{http.StatusOK, http.StatusConflict, http.StatusConflict, http.StatusTooManyRequests,
+		http.StatusNotFound, http.StatusInternalServerError, http.StatusServiceUnavailable} {
+		_ = counting.Client.Get(context.TODO(), types.NamespacedName{Namespace: "test", Name: fmt.Sprintf("status-%d", code)}, &corev1.ConfigMap{})
+	}
+	// also, a request of the original Awaitility, which is not counted
+	_ = a.Client.Get(context.TODO(), types.NamespacedName{Namespace: "test", Name: "status-409"}, &corev1.ConfigMap{})
+
+	// then
+	assert.Equal(t, wait.APIErrors{Conflicts: 2, TooManyRequests: 1, ServerErrors: 2}, counting.ObservedAPIErrors())
+	assert.Equal(t, wait.APIErrors{}, a.ObservedAPIErrors())
+}
@@ -2,13 +2,14 @@ package wait
 
 import (
 	"context"
-	"crypto/tls"
 	"errors"
 	"fmt"
+	"io"
+	"math"
 	"net/http"
 	"net/url"
 	"strings"
-	"testing"
+	"sync"
 	"time"
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
@@ -16,22 +17,25 @@ import (
 	"github.com/codeready-toolchain/toolchain-common/pkg/status"
 	"github.com/codeready-toolchain/toolchain-common/pkg/test"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/cleanup"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/httpclient"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+	testutil "github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
 
+	"github.com/davecgh/go-spew/spew"
 	routev1 "github.com/openshift/api/route/v1"
-	"github.com/redhat-cop/operator-utils/pkg/util"
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
-	"k8s.io/kubectl/pkg/util/podutils"
 	k8smetrics "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -42,19 +46,41 @@ const (
 	MemberNsVar2                     = "MEMBER_NS_2"
 	HostNsVar                        = "HOST_NS"
 	RegistrationServiceVar           = "REGISTRATION_SERVICE_NS"
+	HostOperatorImageVar             = "HOST_OPERATOR_IMAGE"
+	MemberOperatorImageVar           = "MEMBER_OPERATOR_IMAGE"
 	ToolchainClusterConditionTimeout = 180 * time.Second
 )
 
+// Awaitility waits for the resources of a cluster. It is safe for concurrent use once it is initialized: the options only apply
+// to copies (see WithRetryOptions), and the state shared by an Awaitility and its copies (eg: the baselines of the metrics
+// captured by InitMetrics, the counters of the API errors, the telemetry of the waits or the narrative of the test) is guarded by a lock.
 type Awaitility struct {
-	Client         client.Client
-	RestConfig     *rest.Config
-	ClusterName    string
-	Namespace      string
-	Type           cluster.Type
-	RetryInterval  time.Duration
-	Timeout        time.Duration
-	MetricsURL     string
-	baselineValues map[string]float64
+	Client          client.Client
+	RestConfig      *rest.Config
+	ClusterName     string
+	Namespace       string
+	Type            cluster.Type
+	RetryInterval   time.Duration
+	Timeout         time.Duration
+	MetricsClient   *metrics.Client
+	baselines       *metricBaselines
+	ctx             context.Context
+	useWatch        bool
+	strategy        Strategy
+	clock           clock.Clock
+	stability       *stability
+	within          time.Duration
+	tolerance       float64
+	maxScrapeErrors int
+	insecureRoutes  bool
+	tokenProvider   httpclient.TokenProvider
+	httpTransport   *http.Transport
+	transports      *transportCache
+	clientset       kubernetes.Interface
+	apiErrors       *apiErrorCounter
+	telemetry       *WaitTelemetry
+	narrative       *Narrative
+	metricArtifacts *metricArtifacts
 }
 
 func (a *Awaitility) GetClient() client.Client {
@@ -82,18 +108,264 @@ func (a *Awaitility) WithRetryOptions(options ...RetryOption) *Awaitility {
 	return result
 }
 
+// WithContext returns a new Awaitility whose waits stop as soon as the given context is done (eg: when it is cancelled
+// because the surrounding scenario failed), instead of blocking until their timeout elapses. The requests to the API server
+// made by the waits (and by the other helpers which read resources) are also sent with this context, so that a cancelled
+// wait does not block on a slow request.
+func (a *Awaitility) WithContext(ctx context.Context) *Awaitility {
+	result := a.copy()
+	result.ctx = ctx
+	return result
+}
+
+// getContext returns the context of the Awaitility (see WithContext), or a background context if there is none
+func (a *Awaitility) getContext() context.Context {
+	if a.ctx == nil {
+		return context.Background()
+	}
+	return a.ctx
+}
+
+// ForNamespace returns a new Awaitility whose waiters look up the resources in the given namespace (eg: a user namespace such as
+// `johnsmith-dev`) instead of the namespace of the operator, with the same client, logging and retry options.
+// Note that the waiters of the HostAwaitility and MemberAwaitility are not available on the returned Awaitility, since they
+// expect the resources of the operators in their own namespace.
+func (a *Awaitility) ForNamespace(ns string) *Awaitility {
+	result := a.copy()
+	result.Namespace = ns
+	return result
+}
+
+// poll is like `wait.Poll`, but it also stops when the context of the Awaitility is done (see WithContext).
+// The delays between two evaluations of the condition are computed by the Strategy of the Awaitility from the given interval
+// (see FixedInterval, Backoff and ImmediateFirst).
+// All the waiters poll through this func (or through `pollOnEvents` when they support watches, see UseWatch), so that the cancellation,
+// the timeouts, the stability of the criteria, the logging of the outcome and the telemetry apply to all of them.
+// The given test is used to report the outcome of the poll when the wait steps are logged as JSON (see LogFormatVar), it can be nil
+// when no test is available.
+func (a *Awaitility) poll(t T, interval, timeout time.Duration, condition wait.ConditionFunc) error {
+	return a.pollWithin(t, interval, a.timeoutOf(timeout), condition)
+}
+
+// timeoutOf returns the timeout configured with Within if any, or the given timeout of the waiter otherwise
+func (a *Awaitility) timeoutOf(timeout time.Duration) time.Duration {
+	if a.within > 0 {
+		return a.within
+	}
+	return timeout
+}
+
+// pollWithin is like `poll`, but with the given timeout regardless of the timeout configured with Within
+func (a *Awaitility) pollWithin(t T, interval, timeout time.Duration, condition wait.ConditionFunc) error {
+	return a.pollWithDelays(t, timeout, a.getStrategy().delays(interval), condition)
+}
+
+// pollWithDelays is the polling core of the Awaitility: it waits for the next delay before each evaluation of the condition,
+// until the condition is met or returns an error, or until the timeout elapses on the clock of the Awaitility (see UseClock).
+// Returns an ErrTimeout wrapping `wait.ErrWaitTimeout` after the timeout, or the error of the context of the Awaitility (eg: `context.Canceled`)
+// if it is done before (see WithContext), so that a cancelled wait is not mistaken for a timeout.
+// The timeout is shortened if the given test would be over before its end, in which case the ErrTimeout wraps ErrTestDeadlineImminent instead.
+// The errors of the condition returned by the API server are wrapped in an ErrAPI.
+// There is a single timer pending on the clock while waiting, which allows a fake clock to be stepped deterministically.
+func (a *Awaitility) pollWithDelays(t T, timeout time.Duration, nextDelay func() time.Duration, condition wait.ConditionFunc) (err error) {
+	ctx := a.getContext()
+	clk := a.getClock()
+	start := clk.Now()
+	attempts := 0
+	waitTimeout := timeout
+	defer func() {
+		err = typedWaitError(err, waitTimeout)
+		a.logWaitOutcome(t, attempts, clk.Since(start), err)
+	}()
+	condition = a.stable(condition)
+	timeout, shortened := testDeadlineTimeout(t, timeout)
+	deadline := start.Add(timeout)
+	for {
+		remaining := deadline.Sub(clk.Now())
+		if remaining <= 0 {
+			if shortened {
+				return a.testDeadlineImminent(t, waitTimeout)
+			}
+			return wait.ErrWaitTimeout
+		}
+		delay := nextDelay()
+		if delay > remaining {
+			delay = remaining
+		}
+		timer := clk.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C():
+		}
+		if delay == remaining {
+			if shortened {
+				return a.testDeadlineImminent(t, waitTimeout)
+			}
+			return wait.ErrWaitTimeout
+		}
+		attempts++
+		if done, err := condition(); err != nil || done {
+			return err
+		}
+	}
+}
+
+func (a *Awaitility) getClock() clock.Clock {
+	if a.clock == nil {
+		return clock.RealClock{}
+	}
+	return a.clock
+}
+
+// Poll is like `wait.Poll` with the retry interval and the timeout of the Awaitility, but it also stops when the context
+// of the Awaitility is done (see WithContext). Use WithRetryOptions to poll with another interval or timeout.
+func (a *Awaitility) Poll(t T, condition wait.ConditionFunc) error {
+	return a.poll(t, a.RetryInterval, a.Timeout, condition)
+}
+
 // RetryOption is some configuration that modifies options for an Awaitility.
 type RetryOption interface {
 	apply(*Awaitility)
 }
 
-// RetryInterval an option to configure the RetryInterval
+// RetryInterval an option to configure the RetryInterval, at which the waits poll (see FixedInterval)
 type RetryInterval time.Duration
 
 var _ RetryOption = RetryInterval(0)
 
 func (o RetryInterval) apply(a *Awaitility) {
 	a.RetryInterval = time.Duration(o)
+	a.strategy = nil
+}
+
+// UseClock returns an option to measure the retry intervals and the timeouts of the polls with the given clock instead of
+// the real one, so that the waiters can be unit-tested with a fake clock (see the `waittest` package)
+func UseClock(c clock.Clock) RetryOption {
+	return clockOption{c}
+}
+
+type clockOption struct {
+	clock clock.Clock
+}
+
+var _ RetryOption = clockOption{}
+
+func (o clockOption) apply(a *Awaitility) {
+	a.clock = o.clock
+}
+
+// Within returns an option to set the timeout of the waits to exactly the given duration. Unlike TimeoutOption, it also overrides
+// the longer timeouts of the waiters which usually take longer (eg: 6 times the timeout of the Awaitility for WaitForDeploymentToGetReady,
+// or ToolchainClusterConditionTimeout for WaitForToolchainClusterWithCondition), so that a single wait can be tuned. Given to a
+// waiter (directly, or with WaitWith or DeploymentWaitWith), it applies to a single call without copying the Awaitility, eg:
+//
+//	memberAwait.WaitForDeploymentToGetReady(t, name, 1, wait.DeploymentWaitWith(wait.Within(30*time.Second), wait.RetryInterval(time.Second)))
+//	hostAwait.WaitForToolchainClusterWithCondition(t, "member", namespace, wait.ReadyToolchainCluster, wait.Within(time.Minute))
+//	hostAwait.WaitForMasterUserRecord(t, name, wait.UntilMasterUserRecordHasConditions(conditions...), wait.WaitWith[wait.MasterUserRecordWaitCriterion](wait.Within(time.Minute)))
+func Within(timeout time.Duration) RetryOption {
+	return within(timeout)
+}
+
+type within time.Duration
+
+var _ RetryOption = within(0)
+
+func (o within) apply(a *Awaitility) {
+	a.within = time.Duration(o)
+}
+
+// WaitWith returns a criterion which applies the given RetryOptions to a single call of the waiter it is given to, for the waiters
+// whose last arguments are their criteria (or the options of their request, for WaitForRouteToBeAvailable). The criterion itself always
+// matches. The other waiters accept the RetryOptions as their last arguments, eg:
+//
+//	hostAwait.WaitForUserSignup(t, name, wait.UntilUserSignupHasCompliantUsername(), wait.WaitWith[wait.UserSignupWaitCriterion](wait.Within(time.Minute)))
+//	hostAwait.WaitForRouteToBeAvailable(t, ns, name, "/", wait.WaitWith[wait.RouteRequestOption](wait.RetryInterval(time.Second)))
+//	memberAwait.WaitForSecret(t, name, wait.Within(time.Minute))
+//
+// Only WaitForMetricDelta, WaitForMetricBaseline and WaitForMetricInRange, whose last arguments are the labels of the metric,
+// do not accept per-call options: their waits are tuned with WithRetryOptions.
+func WaitWith[C retryOptionsCarrier[C]](options ...RetryOption) C {
+	var c C
+	return c.withRetryOptions(options)
+}
+
+// retryOptionsCarrier a criterion (or an option) which can carry the RetryOptions of a single wait (see WaitWith)
+type retryOptionsCarrier[C any] interface {
+	withRetryOptions(options []RetryOption) C
+}
+
+// retryOptionsOf returns the RetryOptions carried by the given criteria (see WaitWith)
+func retryOptionsOf[C interface{ retryOptions() []RetryOption }](criteria []C) []RetryOption {
+	var options []RetryOption
+	for _, c := range criteria {
+		options = append(options, c.retryOptions()...)
+	}
+	return options
+}
+
+// WithTolerance returns an option to consider that a metric has reached the expected value when it is within the given epsilon of it
+// (see WaitUntiltMetricHasValue, WaitUntilMetricHasValueOrMore and WaitUntilMetricHasValueOrLess), eg: for the gauges which are also
+// affected by the unrelated activity on a shared cluster
+func WithTolerance(epsilon float64) RetryOption {
+	return tolerance(epsilon)
+}
+
+type tolerance float64
+
+var _ RetryOption = tolerance(0)
+
+func (o tolerance) apply(a *Awaitility) {
+	a.tolerance = math.Abs(float64(o))
+}
+
+// Stable returns an option to consider the criteria of the waits as met only once they have been met by the given number
+// of consecutive evaluations, so that a transiently met criterion (eg: a Deployment briefly reporting that it is ready during a rollout)
+// does not end the wait. Note that with UseWatch, the criteria are only evaluated when the watched object changes (or after the resync interval).
+func Stable(polls int) RetryOption {
+	return stability{polls: polls}
+}
+
+// StableFor returns an option to consider the criteria of the waits as met only once they have been continuously met for at least
+// the given duration (see Stable)
+func StableFor(d time.Duration) RetryOption {
+	return stability{duration: d}
+}
+
+type stability struct {
+	polls    int
+	duration time.Duration
+}
+
+var _ RetryOption = stability{}
+
+func (o stability) apply(a *Awaitility) {
+	a.stability = &o
+}
+
+// stable returns a condition which is met when the given condition has been met by the consecutive evaluations configured
+// with Stable or StableFor, if any
+func (a *Awaitility) stable(condition wait.ConditionFunc) wait.ConditionFunc {
+	if a.stability == nil {
+		return condition
+	}
+	s := *a.stability
+	clk := a.getClock()
+	consecutive := 0
+	var since time.Time
+	return func() (bool, error) {
+		done, err := condition()
+		if err != nil || !done {
+			consecutive = 0
+			return false, err
+		}
+		consecutive++
+		if consecutive == 1 {
+			since = clk.Now()
+		}
+		return consecutive >= s.polls && clk.Since(since) >= s.duration, nil
+	}
 }
 
 // TimeoutOption an option to configure the Timeout
@@ -106,39 +378,64 @@ func (o TimeoutOption) apply(a *Awaitility) {
 }
 
 // WaitForMetricDelta waits for the metric value to reach the adjusted value. The adjusted value is the delta value combined with the baseline value.
-func (a *Awaitility) WaitForMetricDelta(t *testing.T, family string, delta float64, labels ...string) {
+func (a *Awaitility) WaitForMetricDelta(t T, family string, delta float64, labels ...string) {
+	err := a.TryWaitForMetricDelta(t, family, delta, labels...)
+	require.NoError(t, err)
+}
+
+// TryWaitForMetricDelta is like WaitForMetricDelta, but it returns an error instead of failing the test
+// if the metric does not reach the adjusted value
+func (a *Awaitility) TryWaitForMetricDelta(t T, family string, delta float64, labels ...string) error {
 	// The delta is relative to the starting value, eg. If there are 3 usersignups when a test is started and we are waiting
 	// for 2 more usersignups to be created (delta is +2) then the actual metric value (adjustedValue) we're waiting for is 5
-	key := a.baselineKey(t, family, labels...)
-	adjustedValue := a.baselineValues[key] + delta
-	a.WaitUntiltMetricHasValue(t, family, adjustedValue, labels...)
+	if err := checkLabelPairs(labels); err != nil {
+		return err
+	}
+	adjustedValue := a.baselines.get(family, labels...) + delta
+	return a.TryWaitUntilMetricHasValue(t, family, adjustedValue, labels...)
 }
 
 // WaitForMetricBaseline waits for the metric value to reach the baseline value back (to be used during the cleanup)
-func (a *Awaitility) WaitForMetricBaseline(t *testing.T, family string, labels ...string) {
-	t.Log("waiting until host metrics reached their baseline again...")
-	key := a.baselineKey(t, family, labels...)
-	a.WaitUntiltMetricHasValue(t, family, a.baselineValues[key], labels...)
+func (a *Awaitility) WaitForMetricBaseline(t T, family string, labels ...string) {
+	err := a.TryWaitForMetricBaseline(t, family, labels...)
+	require.NoError(t, err)
+}
+
+// TryWaitForMetricBaseline is like WaitForMetricBaseline, but it returns an error instead of failing the test
+// if the metric does not reach its baseline value back
+func (a *Awaitility) TryWaitForMetricBaseline(t T, family string, labels ...string) error {
+	a.logf(t, "waiting until the '%s' metric of the '%s' cluster reached its baseline again...", family, a.LogLabel())
+	if err := checkLabelPairs(labels); err != nil {
+		return err
+	}
+	return a.TryWaitUntilMetricHasValue(t, family, a.baselines.get(family, labels...), labels...)
+}
+
+// requireLabelPairs fails the test if the given labels are not pairs of labels and values
+func requireLabelPairs(t T, labelAndValues []string) {
+	if err := checkLabelPairs(labelAndValues); err != nil {
+		t.Fatal(err.Error())
+	}
 }
 
-// generates a key to retain the baseline metric value, by joining the metric name and its labels.
-// Note: there are probably more sophisticated ways to combine the name and the labels, but for now
-// this simple concatenation should be enough to make the keys unique
-func (a *Awaitility) baselineKey(t *testing.T, name string, labelAndValues ...string) string {
+// checkLabelPairs returns an error if the given labels are not pairs of labels and values
+func checkLabelPairs(labelAndValues []string) error {
 	if len(labelAndValues)%2 != 0 {
-		t.Fatal("`labelAndValues` must be pairs of labels and values")
+		return fmt.Errorf("`labelAndValues` must be pairs of labels and values")
 	}
-	return strings.Join(append([]string{name}, labelAndValues...), ",")
+	return nil
 }
 
 // WaitForService waits until there's a service with the given name in the current namespace
-func (a *Awaitility) WaitForService(t *testing.T, name string) (corev1.Service, error) {
-	t.Logf("waiting for Service '%s' in namespace '%s'", name, a.Namespace)
+func (a *Awaitility) WaitForService(t T, name string, options ...RetryOption) (corev1.Service, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(options...)
+	a.logf(t, "waiting for Service '%s' in namespace '%s'", name, a.Namespace)
 	var metricsSvc *corev1.Service
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		metricsSvc = &corev1.Service{}
 		// retrieve the metrics service from the namespace
-		err = a.Client.Get(context.TODO(),
+		err = a.Client.Get(a.getContext(),
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -157,15 +454,17 @@ func (a *Awaitility) WaitForService(t *testing.T, name string) (corev1.Service,
 
 // WaitForToolchainClusterWithCondition waits until there is a ToolchainCluster representing a operator of the given type
 // and running in the given expected namespace. If the given condition is not nil, then it also checks
-// if the CR has the ClusterCondition
-func (a *Awaitility) WaitForToolchainClusterWithCondition(t *testing.T, clusterType cluster.Type, namespace string, condition *toolchainv1alpha1.ToolchainClusterCondition) (toolchainv1alpha1.ToolchainCluster, error) {
-	t.Logf("waiting for ToolchainCluster for cluster type '%s' in namespace '%s'", clusterType, namespace)
+// if the CR has the ClusterCondition. The given options only apply to this wait (eg: Within).
+func (a *Awaitility) WaitForToolchainClusterWithCondition(t T, clusterType cluster.Type, namespace string, condition *toolchainv1alpha1.ToolchainClusterCondition, options ...RetryOption) (toolchainv1alpha1.ToolchainCluster, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(options...)
+	a.logf(t, "waiting for ToolchainCluster for cluster type '%s' in namespace '%s'", clusterType, namespace)
 	timeout := a.Timeout
 	if condition != nil {
 		timeout = ToolchainClusterConditionTimeout
 	}
 	var c toolchainv1alpha1.ToolchainCluster
-	err := wait.Poll(a.RetryInterval, timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, timeout, func() (done bool, err error) {
 		var ready bool
 		if c, ready, err = a.GetToolchainCluster(t, clusterType, namespace, condition); ready {
 			return true, nil
@@ -176,17 +475,19 @@ func (a *Awaitility) WaitForToolchainClusterWithCondition(t *testing.T, clusterT
 }
 
 // WaitForNamedToolchainClusterWithCondition waits until there is a ToolchainCluster with the given name
-// and with the given ClusterCondition (if it the condition is nil, then it skips this check)
-func (a *Awaitility) WaitForNamedToolchainClusterWithCondition(t *testing.T, name string, condition *toolchainv1alpha1.ToolchainClusterCondition) (toolchainv1alpha1.ToolchainCluster, error) {
-	t.Logf("waiting for ToolchainCluster '%s' in namespace '%s' to have condition '%v'", name, a.Namespace, condition)
+// and with the given ClusterCondition (if it the condition is nil, then it skips this check). The given options only apply to this wait (eg: Within).
+func (a *Awaitility) WaitForNamedToolchainClusterWithCondition(t T, name string, condition *toolchainv1alpha1.ToolchainClusterCondition, options ...RetryOption) (toolchainv1alpha1.ToolchainCluster, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(options...)
+	a.logf(t, "waiting for ToolchainCluster '%s' in namespace '%s' to have condition '%v'", name, a.Namespace, condition)
 	timeout := a.Timeout
 	if condition != nil {
 		timeout = ToolchainClusterConditionTimeout
 	}
 	c := toolchainv1alpha1.ToolchainCluster{}
-	err := wait.Poll(a.RetryInterval, timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, timeout, func() (done bool, err error) {
 		c = toolchainv1alpha1.ToolchainCluster{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, &c); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, &c); err != nil {
 			return false, err
 		}
 		if containsClusterCondition(c.Status.Conditions, condition) {
@@ -200,16 +501,16 @@ func (a *Awaitility) WaitForNamedToolchainClusterWithCondition(t *testing.T, nam
 // GetToolchainCluster retrieves and returns a ToolchainCluster representing a operator of the given type
 // and running in the given expected namespace. If the given condition is not nil, then it also checks
 // if the CR has the ClusterCondition
-func (a *Awaitility) GetToolchainCluster(t *testing.T, clusterType cluster.Type, namespace string, condition *toolchainv1alpha1.ToolchainClusterCondition) (toolchainv1alpha1.ToolchainCluster, bool, error) {
+func (a *Awaitility) GetToolchainCluster(t T, clusterType cluster.Type, namespace string, condition *toolchainv1alpha1.ToolchainClusterCondition) (toolchainv1alpha1.ToolchainCluster, bool, error) {
 	clusters := &toolchainv1alpha1.ToolchainClusterList{}
-	if err := a.Client.List(context.TODO(), clusters, client.InNamespace(a.Namespace), client.MatchingLabels{
+	if err := a.Client.List(a.getContext(), clusters, client.InNamespace(a.Namespace), client.MatchingLabels{
 		"namespace": namespace,
 		"type":      string(clusterType),
 	}); err != nil {
 		return toolchainv1alpha1.ToolchainCluster{}, false, err
 	}
 	if len(clusters.Items) == 0 {
-		t.Logf("no toolchaincluster resource with expected labels: namespace='%s', type='%s'", namespace, string(clusterType))
+		a.logf(t, "no toolchaincluster resource with expected labels: namespace='%s', type='%s'", namespace, string(clusterType))
 	}
 	// assume there is zero or 1 match only
 	for _, cl := range clusters.Items {
@@ -220,6 +521,35 @@ func (a *Awaitility) GetToolchainCluster(t *testing.T, clusterType cluster.Type,
 	return toolchainv1alpha1.ToolchainCluster{}, false, nil
 }
 
+// WaitUntilToolchainClusterCanAccess waits until the service account referenced by the given ToolchainCluster
+// is able to perform a canary API call (listing the ToolchainClusters in the given namespace) on the cluster
+// the ToolchainCluster points to
+func (a *Awaitility) WaitUntilToolchainClusterCanAccess(t T, toolchainCluster *toolchainv1alpha1.ToolchainCluster, namespace string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting until ToolchainCluster '%s' in namespace '%s' can access namespace '%s' on the remote cluster", toolchainCluster.Name, a.Namespace, namespace)
+	clusterConfig, err := cluster.NewClusterConfig(a.Client, toolchainCluster, 6*time.Second)
+	if err != nil {
+		return err
+	}
+	remoteClient, err := client.New(clusterConfig.RestConfig, client.Options{
+		Scheme: a.Client.Scheme(),
+	})
+	if err != nil {
+		return err
+	}
+	var lastErr error
+	err = a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		if lastErr = remoteClient.List(a.getContext(), &toolchainv1alpha1.ToolchainClusterList{}, client.InNamespace(namespace)); lastErr != nil {
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil && lastErr != nil {
+		a.logf(t, "canary call with the service account of ToolchainCluster '%s' failed: %s", toolchainCluster.Name, lastErr.Error())
+	}
+	return err
+}
+
 func containsClusterCondition(conditions []toolchainv1alpha1.ToolchainClusterCondition, contains *toolchainv1alpha1.ToolchainClusterCondition) bool {
 	if contains == nil {
 		return true
@@ -232,56 +562,72 @@ func containsClusterCondition(conditions []toolchainv1alpha1.ToolchainClusterCon
 	return false
 }
 
-// SetupRouteForService if needed, creates a route for the given service (with the same namespace/name)
+// SetupRouteForService if needed, creates a route for the given service (with the same namespace/name), configured with the given
+// options (see RouteOption). If the route already exists and options are given, then the route is updated to match them.
 // It waits until the route is available (or returns an error) by first checking the resource status
-// and then making a call to the given endpoint
-func (a *Awaitility) SetupRouteForService(t *testing.T, serviceName, endpoint string) (routev1.Route, error) {
-	t.Logf("setting up route for service '%s' with endpoint '%s'", serviceName, endpoint)
+// and then making a call to the given endpoint.
+// If the Route API is not available (eg: on a kind or minikube cluster), then an ingress is created instead (see WaitForIngressToBeAvailable).
+func (a *Awaitility) SetupRouteForService(t T, serviceName, endpoint string, opts ...RouteOption) (Endpoint, error) {
+	a.logf(t, "setting up route for service '%s' with endpoint '%s'", serviceName, endpoint)
 	service, err := a.WaitForService(t, serviceName)
 	if err != nil {
-		return routev1.Route{}, err
+		return Endpoint{}, err
 	}
+	config := newRouteConfig(opts...)
 
 	// now, create the route for the service (if needed)
 	route := routev1.Route{}
-	if err := a.Client.Get(context.TODO(), types.NamespacedName{
+	if err := a.Client.Get(a.getContext(), types.NamespacedName{
 		Namespace: service.Namespace,
 		Name:      service.Name,
 	}, &route); err != nil {
-		require.True(t, apierrors.IsNotFound(err), "failed to get route to access the '%s' service: %s", service.Name, err.Error())
+		if IsRouteAPIUnavailable(err) {
+			return a.setupIngressForService(t, service.Namespace, service.Name, endpoint, config)
+		}
+		if !apierrors.IsNotFound(err) {
+			return Endpoint{}, fmt.Errorf("failed to get route to access the '%s' service: %w", service.Name, err)
+		}
 		route = routev1.Route{
 			ObjectMeta: metav1.ObjectMeta{
 				Namespace: service.Namespace,
 				Name:      service.Name,
 			},
 			Spec: routev1.RouteSpec{
-				Port: &routev1.RoutePort{
-					TargetPort: intstr.FromString("https"),
-				},
-				TLS: &routev1.TLSConfig{
-					Termination: routev1.TLSTerminationPassthrough,
-				},
 				To: routev1.RouteTargetReference{
 					Kind: service.Kind,
 					Name: service.Name,
 				},
 			},
 		}
+		config.applyToRoute(&route)
 		if err = a.Client.Create(context.TODO(), &route); err != nil {
-			return route, err
+			return routeEndpoint(route), err
+		}
+	} else if len(opts) > 0 && config.applyToRoute(&route) {
+		a.logf(t, "updating route '%s' in namespace '%s' to match the given options", route.Name, route.Namespace)
+		if err = a.Client.Update(context.TODO(), &route); err != nil {
+			return routeEndpoint(route), err
 		}
 	}
-	return a.WaitForRouteToBeAvailable(t, route.Namespace, route.Name, endpoint)
+	route, err = a.WaitForRouteToBeAvailable(t, route.Namespace, route.Name, endpoint)
+	return routeEndpoint(route), err
 }
 
 // WaitForRouteToBeAvailable waits until the given route is available, ie, it has an Ingress with a host configured
-// and the endpoint is reachable (with a `200 OK` status response)
-func (a *Awaitility) WaitForRouteToBeAvailable(t *testing.T, ns, name, endpoint string) (routev1.Route, error) {
-	t.Logf("waiting for route '%s' in namespace '%s'", name, ns)
+// and the endpoint is reachable (with a `200 OK` status response to a `GET` request by default, see RouteRequestOption for other requests
+// and expected responses). The certificate served on the route is verified (see RouteTransport), unless the Awaitility was configured
+// with InsecureRoutes.
+func (a *Awaitility) WaitForRouteToBeAvailable(t T, ns, name, endpoint string, opts ...RouteRequestOption) (routev1.Route, error) {
+	recordWaiter(t)
+	req := newRouteRequest(opts...)
+	a = a.WithRetryOptions(req.retryOptions...)
+	a.logf(t, "waiting for route '%s' in namespace '%s'", name, ns)
 	route := routev1.Route{}
+	var lastTimings *testutil.RequestTimings
+	var lastResponse string
 	// retrieve the route for the registration service
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		if err = a.Client.Get(context.TODO(),
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		if err = a.Client.Get(a.getContext(),
 			types.NamespacedName{
 				Namespace: ns,
 				Name:      name,
@@ -295,31 +641,24 @@ func (a *Awaitility) WaitForRouteToBeAvailable(t *testing.T, ns, name, endpoint
 		if len(route.Status.Ingress) == 0 || route.Status.Ingress[0].Host == "" {
 			return false, nil
 		}
-		// verify that the endpoint gives a `200 OK` response on a GET request
-		client := http.Client{
-			Timeout: time.Duration(5 * time.Second), // because sometimes the network connection may be a bit slow
+		// verify that the endpoint gives the expected response
+		transport, err := a.RouteTransport(route)
+		if err != nil {
+			return false, err
+		}
+		opts := []httpclient.Option{
+			httpclient.WithTimeout(5 * time.Second), // because sometimes the network connection may be a bit slow
+			httpclient.WithTransport(transport),
 		}
-		var request *http.Request
-
 		if route.Spec.TLS != nil {
-			client.Transport = &http.Transport{
-				TLSClientConfig: &tls.Config{
-					InsecureSkipVerify: true, // nolint:gosec
-				},
-			}
-			request, err = http.NewRequest("GET", "https://"+route.Status.Ingress[0].Host+endpoint, nil)
-			if err != nil {
-				return false, err
-			}
-			request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", a.RestConfig.BearerToken))
-
-		} else {
-			request, err = http.NewRequest("GET", "http://"+route.Status.Ingress[0].Host+endpoint, nil)
-			if err != nil {
-				return false, err
-			}
+			opts = append(opts, httpclient.WithTokenProvider(a.TokenProvider()))
+		}
+		request, err := req.newHTTPRequest(routeURL(route, endpoint))
+		if err != nil {
+			return false, err
 		}
-		resp, err := client.Do(request)
+		resp, timings, err := testutil.DoTraced(httpclient.New(opts...), request)
+		lastTimings = &timings
 		urlError := &url.Error{}
 		if errors.As(err, &urlError) && urlError.Timeout() {
 			// keep waiting if there was a timeout: the endpoint is not available yet (pod is still re-starting)
@@ -330,93 +669,255 @@ func (a *Awaitility) WaitForRouteToBeAvailable(t *testing.T, ns, name, endpoint
 		defer func() {
 			_ = resp.Body.Close()
 		}()
-
-		if resp.StatusCode != http.StatusOK {
-			return false, nil
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return false, nil // nolint:nilerr
 		}
-		return true, nil
+		lastResponse = resp.Status
+		if len(body) > 0 {
+			lastResponse = fmt.Sprintf("%s: %s", resp.Status, body)
+		}
+		return req.expect(resp.StatusCode, body), nil
 	})
+	if err != nil && lastTimings != nil {
+		a.logf(t, "last request to route '%s': %s", name, lastTimings)
+		a.logf(t, "last response from route '%s' (expected %s): %s", name, req.summary, lastResponse)
+	}
 	return route, err
 }
 
+// NewMetricsClient returns a client for the metrics exposed via the route with the given name (or via the ingress with the same name
+// when the Route API is not available, see SetupRouteForService). The host is looked-up on demand and the bearer token is obtained with the given function. When the route is unavailable
+// (eg: it does not exist on a non-OpenShift cluster, or the test is modifying it), the metrics are scraped via a port-forward to
+// a pod of the service with the same name as the route (see PortForwardEndpoint)
+func (a *Awaitility) NewMetricsClient(routeName string, tokenFunc metrics.TokenFunc) *metrics.Client {
+	return metrics.NewClient(func() (string, error) {
+		return a.getEndpointHost(routeName)
+	}, tokenFunc, metrics.WithFallbackEndpoint(a.PortForwardEndpoint(routeName)), metrics.WithTransport(a.newInsecureTransport()))
+}
+
 // GetMetricValue gets the value of the metric with the given family and label key-value pair
 // fails if the metric with the given labelAndValues does not exist
-func (a *Awaitility) GetMetricValue(t *testing.T, family string, labelAndValues ...string) float64 {
-	value, err := metrics.GetMetricValue(a.RestConfig, a.MetricsURL, family, labelAndValues)
+func (a *Awaitility) GetMetricValue(t T, family string, labelAndValues ...string) float64 {
+	value, err := a.TryGetMetricValue(family, labelAndValues...)
 	require.NoError(t, err)
 	return value
 }
 
+// TryGetMetricValue is like GetMetricValue, but it returns an error instead of failing the test
+// if the metric can't be retrieved
+func (a *Awaitility) TryGetMetricValue(family string, labelAndValues ...string) (float64, error) {
+	if err := checkLabelPairs(labelAndValues); err != nil {
+		return 0, err
+	}
+	return a.MetricsClient.GetMetricValue(family, labelAndValues)
+}
+
 // GetMetricValue gets the value of the metric with the given family and label key-value pair
 // fails if the metric with the given labelAndValues does not exist
-func (a *Awaitility) GetMetricLabels(t *testing.T, family string) []map[string]*string {
-	labels, err := metrics.GetMetricLabels(a.RestConfig, a.MetricsURL, family)
+func (a *Awaitility) GetMetricLabels(t T, family string) []map[string]*string {
+	labels, err := a.MetricsClient.GetMetricLabels(family)
 	require.NoError(t, err)
 	return labels
 }
 
 // GetMetricValue gets the value of the metric with the given family and label key-value pair
 // return 0 if the metric with the given labelAndValues does not exist
-func (a *Awaitility) GetMetricValueOrZero(t *testing.T, family string, labelAndValues ...string) float64 {
+func (a *Awaitility) GetMetricValueOrZero(t T, family string, labelAndValues ...string) float64 {
 	if len(labelAndValues)%2 != 0 {
 		t.Fatal("`labelAndValues` must be pairs of labels and values")
 	}
-	if value, err := metrics.GetMetricValue(a.RestConfig, a.MetricsURL, family, labelAndValues); err == nil {
+	if value, err := a.MetricsClient.GetMetricValue(family, labelAndValues); err == nil {
 		return value
 	}
 	return 0
 }
 
 // WaitUntiltMetricHasValue asserts that the exposed metric with the given family
-// and label key-value pair reaches the expected value
-func (a *Awaitility) WaitUntiltMetricHasValue(t *testing.T, family string, expectedValue float64, labels ...string) {
-	t.Logf("waiting for metric '%s{%v}' to reach '%v'", family, labels, expectedValue)
+// and label key-value pair reaches the expected value (within the tolerance, see WithTolerance)
+func (a *Awaitility) WaitUntiltMetricHasValue(t T, family string, expectedValue float64, labels ...string) {
+	err := a.TryWaitUntilMetricHasValue(t, family, expectedValue, labels...)
+	require.NoError(t, err)
+}
+
+// TryWaitUntilMetricHasValue is like WaitUntiltMetricHasValue, but it returns an error instead of failing the test
+// if the metric does not reach the expected value
+func (a *Awaitility) TryWaitUntilMetricHasValue(t T, family string, expectedValue float64, labels ...string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting for metric '%s{%v}' to reach '%v'%s", family, labels, expectedValue, a.toleranceString())
 	var value float64
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		value, err = metrics.GetMetricValue(a.RestConfig, a.MetricsURL, family, labels)
+	scrapes := a.newScrapeErrors()
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		value, err = a.MetricsClient.GetMetricValue(family, labels)
+		if scrapeErr := scrapes.check(err); scrapeErr != nil {
+			return false, scrapeErr
+		}
 		// if error occurred, ignore and return `false` to keep waiting (may be due to endpoint temporarily unavailable)
 		// unless the expected value is `0`, in which case the metric is bot exposed (value==0 and err!= nil), but it's fine too.
-		return (value == expectedValue && err == nil) || (expectedValue == 0 && value == 0), nil
+		return (math.Abs(value-expectedValue) <= a.tolerance && err == nil) || (math.Abs(expectedValue) <= a.tolerance && value == 0), nil
 	})
-	require.NoError(t, err, "waited for metric '%s{%v}' to reach '%v'. Current value: %v", family, labels, expectedValue, value)
+	if err != nil {
+		a.exportMetrics(t, "failure")
+		return fmt.Errorf("waited for metric '%s{%v}' to reach '%v'%s. Current value: %v: %w", family, labels, expectedValue, a.toleranceString(), value, err)
+	}
+	return nil
 }
 
 // WaitUntilMetricHasValueOrMore waits until the exposed metric with the given family
-// and label key-value pair has reached the expected value (or more)
-func (a *Awaitility) WaitUntilMetricHasValueOrMore(t *testing.T, family string, expectedValue float64, labels ...string) error {
-	t.Logf("waiting for metric '%s{%v}' to reach '%v' or more", family, labels, expectedValue)
+// and label key-value pair has reached the expected value (or more, within the tolerance, see WithTolerance)
+func (a *Awaitility) WaitUntilMetricHasValueOrMore(t T, family string, expectedValue float64, labels ...string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting for metric '%s{%v}' to reach '%v' or more%s", family, labels, expectedValue, a.toleranceString())
 	var value float64
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		value, err = metrics.GetMetricValue(a.RestConfig, a.MetricsURL, family, labels)
+	scrapes := a.newScrapeErrors()
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		value, err = a.MetricsClient.GetMetricValue(family, labels)
+		if scrapeErr := scrapes.check(err); scrapeErr != nil {
+			return false, scrapeErr
+		}
 		// if error occurred, return `false` to keep waiting (may be due to endpoint temporarily unavailable)
-		return value >= expectedValue && err == nil, nil
+		return value >= expectedValue-a.tolerance && err == nil, nil
 	})
 	if err != nil {
-		t.Logf("waited for metric '%s{%v}' to reach '%v' or more. Current value: %v", family, labels, expectedValue, value)
+		a.exportMetrics(t, "failure")
+		a.logf(t, "waited for metric '%s{%v}' to reach '%v' or more%s. Current value: %v", family, labels, expectedValue, a.toleranceString(), value)
 	}
 	return err
 }
 
 // WaitUntilMetricHasValueOrLess waits until the exposed metric with the given family
-// and label key-value pair has reached the expected value (or less)
-func (a *Awaitility) WaitUntilMetricHasValueOrLess(t *testing.T, family string, expectedValue float64, labels ...string) error {
-	t.Logf("waiting for metric '%s{%v}' to reach '%v' or less", family, labels, expectedValue)
+// and label key-value pair has reached the expected value (or less, within the tolerance, see WithTolerance)
+func (a *Awaitility) WaitUntilMetricHasValueOrLess(t T, family string, expectedValue float64, labels ...string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting for metric '%s{%v}' to reach '%v' or less%s", family, labels, expectedValue, a.toleranceString())
 	var value float64
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		value, err = metrics.GetMetricValue(a.RestConfig, a.MetricsURL, family, labels)
+	scrapes := a.newScrapeErrors()
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		value, err = a.MetricsClient.GetMetricValue(family, labels)
+		if scrapeErr := scrapes.check(err); scrapeErr != nil {
+			return false, scrapeErr
+		}
 		// if error occurred, return `false` to keep waiting (may be due to endpoint temporarily unavailable)
-		return value <= expectedValue && err == nil, nil
+		return value <= expectedValue+a.tolerance && err == nil, nil
 	})
 	if err != nil {
-		t.Logf("waited for metric '%s{%v}' to reach '%v' or less. Current value: %v", family, labels, expectedValue, value)
+		a.exportMetrics(t, "failure")
+		a.logf(t, "waited for metric '%s{%v}' to reach '%v' or less%s. Current value: %v", family, labels, expectedValue, a.toleranceString(), value)
 	}
 	return err
 }
 
+// WaitForMetricInRange waits until the exposed metric with the given family and label key-value pair has a value between
+// the given min and max (inclusive), eg: for the gauges which are also affected by the unrelated activity on a shared cluster
+func (a *Awaitility) WaitForMetricInRange(t T, family string, minValue, maxValue float64, labels ...string) error {
+	recordWaiter(t)
+	if minValue > maxValue {
+		return fmt.Errorf("invalid range of values for metric '%s{%v}': [%v, %v]", family, labels, minValue, maxValue)
+	}
+	a.logf(t, "waiting for metric '%s{%v}' to be in [%v, %v]", family, labels, minValue, maxValue)
+	var value float64
+	scrapes := a.newScrapeErrors()
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		value, err = a.MetricsClient.GetMetricValue(family, labels)
+		if scrapeErr := scrapes.check(err); scrapeErr != nil {
+			return false, scrapeErr
+		}
+		// if error occurred, return `false` to keep waiting (may be due to endpoint temporarily unavailable)
+		return value >= minValue && value <= maxValue && err == nil, nil
+	})
+	if err != nil {
+		a.exportMetrics(t, "failure")
+		return fmt.Errorf("waited for metric '%s{%v}' to be in [%v, %v]. Current value: %v: %w", family, labels, minValue, maxValue, value, err)
+	}
+	return nil
+}
+
+// SumMetricAcrossLabels returns the sums of the values of all the series of the given metric family grouped by the values of the given
+// labels (see metrics.SumBy), eg: the number of users per domain regardless of their activations. Fails if the metrics can't be scraped.
+func (a *Awaitility) SumMetricAcrossLabels(t T, family string, groupBy ...string) []metrics.Series {
+	sums, err := a.TrySumMetricAcrossLabels(family, groupBy...)
+	require.NoError(t, err)
+	return sums
+}
+
+// TrySumMetricAcrossLabels is like SumMetricAcrossLabels, but it returns an error instead of failing the test
+// if the metrics can't be scraped
+func (a *Awaitility) TrySumMetricAcrossLabels(family string, groupBy ...string) ([]metrics.Series, error) {
+	return a.MetricsClient.SumMetricAcrossLabels(family, groupBy...)
+}
+
+// WaitUntilMetricSumHasValue waits until the sum of the values of all the series of the given metric family which have the given label
+// key-value pairs (among others) reaches the expected value (within the tolerance, see WithTolerance), eg: the number of users of a domain
+// across all the activations, without having to enumerate the label values. The sum is `0` when there is no such series.
+//
+//	hostAwait.WaitUntilMetricSumHasValue(t, wait.UsersPerActivationsAndDomainMetric, 3, "domain", "external")
+func (a *Awaitility) WaitUntilMetricSumHasValue(t T, family string, expectedValue float64, labels ...string) error {
+	recordWaiter(t)
+	requireLabelPairs(t, labels)
+	a.logf(t, "waiting for the sum of metric '%s{%v}' to reach '%v'%s", family, labels, expectedValue, a.toleranceString())
+	var series []metrics.Series
+	scrapes := a.newScrapeErrors()
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		series, err = a.MetricsClient.GetSeries(family, labels)
+		if scrapeErr := scrapes.check(err); scrapeErr != nil {
+			return false, scrapeErr
+		}
+		// if error occurred, return `false` to keep waiting (may be due to endpoint temporarily unavailable)
+		return err == nil && math.Abs(metrics.Sum(series)-expectedValue) <= a.tolerance, nil
+	})
+	if err != nil {
+		a.exportMetrics(t, "failure")
+		return fmt.Errorf("waited for the sum of metric '%s{%v}' to reach '%v'%s. Current sum: %v of %s: %w", family, labels, expectedValue,
+			a.toleranceString(), metrics.Sum(series), seriesString(series), err)
+	}
+	return nil
+}
+
+// WaitUntilMetricDisappears waits until the given metric family is no longer exposed or, when label key-value pairs are given,
+// until none of its series has these labels (among others), eg: to verify that the series of a deleted object are cleaned up.
+// Unlike a wait for the value `0`, it does not succeed as long as the series is exposed with a value of `0`.
+func (a *Awaitility) WaitUntilMetricDisappears(t T, family string, labels ...string) error {
+	recordWaiter(t)
+	requireLabelPairs(t, labels)
+	a.logf(t, "waiting for metric '%s{%v}' to disappear", family, labels)
+	var series []metrics.Series
+	scrapes := a.newScrapeErrors()
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		series, err = a.MetricsClient.GetSeries(family, labels)
+		if scrapeErr := scrapes.check(err); scrapeErr != nil {
+			return false, scrapeErr
+		}
+		// if error occurred, return `false` to keep waiting (may be due to endpoint temporarily unavailable)
+		return len(series) == 0 && err == nil, nil
+	})
+	if err != nil {
+		a.exportMetrics(t, "failure")
+		return fmt.Errorf("waited for metric '%s{%v}' to disappear. Remaining series: %s: %w", family, labels, seriesString(series), err)
+	}
+	return nil
+}
+
+// seriesString returns the given series as `{labels}=value` pairs, to be logged
+func seriesString(series []metrics.Series) string {
+	values := make([]string, 0, len(series))
+	for _, s := range series {
+		values = append(values, fmt.Sprintf("%s=%v", s.LabelsString(), s.Value))
+	}
+	return "[" + strings.Join(values, ", ") + "]"
+}
+
+// toleranceString returns the tolerance of the metric waits, to be appended to the log messages (see WithTolerance)
+func (a *Awaitility) toleranceString() string {
+	if a.tolerance == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (±%v)", a.tolerance)
+}
+
 // DeletePods deletes the pods matching the given criteria
 func (a *Awaitility) DeletePods(criteria ...client.ListOption) error {
 	pods := corev1.PodList{}
-	err := a.Client.List(context.TODO(), &pods, criteria...)
+	err := a.Client.List(a.getContext(), &pods, criteria...)
 	if err != nil {
 		return err
 	}
@@ -428,12 +929,21 @@ func (a *Awaitility) DeletePods(criteria ...client.ListOption) error {
 	return nil
 }
 
-// GetMemoryUsage retrieves the memory usage (in KB) of a given the pod
+// GetMemoryUsage retrieves the memory usage (in KB) of the `manager` container of the given pod from the `metrics.k8s.io` API,
+// or from Prometheus if the `metrics.k8s.io` API is not available (see CheckPodMetricsAvailability).
+// Returns an error wrapping ErrPodMetricsUnavailable if neither of them is available.
 func (a *Awaitility) GetMemoryUsage(podname, ns string) (int64, error) {
+	if err := a.CheckPodMetricsAvailability(); err != nil {
+		usage, promErr := a.getMemoryUsageFromPrometheus(podname, ns)
+		if promErr != nil {
+			return -1, fmt.Errorf("%w: %s, and the fallback to Prometheus failed: %s", ErrPodMetricsUnavailable, err.Error(), promErr.Error())
+		}
+		return usage, nil
+	}
 	var containerMetrics k8smetrics.ContainerMetrics
-	if err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	if err := a.poll(nil, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		podMetrics := k8smetrics.PodMetrics{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{
 			Namespace: ns,
 			Name:      podname,
 		}, &podMetrics); err != nil && !apierrors.IsNotFound(err) {
@@ -455,92 +965,376 @@ func (a *Awaitility) GetMemoryUsage(podname, ns string) (int64, error) {
 
 // CreateNamespace creates a namespace with the given name and waits until it gets active
 // it also adds a deletion of the namespace at the end of the test
-func (a *Awaitility) CreateNamespace(t *testing.T, name string) {
+func (a *Awaitility) CreateNamespace(t T, name string) {
+	err := a.TryCreateNamespace(t, name)
+	require.NoError(t, err)
+}
+
+// TryCreateNamespace is like CreateNamespace, but it returns an error instead of failing the test
+// if the namespace can't be created or does not become active
+func (a *Awaitility) TryCreateNamespace(t T, name string) error {
 	ns := &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: name,
 		},
 	}
-	err := a.Client.Create(context.TODO(), ns)
-	require.NoError(t, err)
-	err = wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	if err := a.Client.Create(context.TODO(), ns); err != nil {
+		return err
+	}
+	t.Cleanup(func() {
+		if err := a.Client.Delete(context.TODO(), ns); err != nil && !apierrors.IsNotFound(err) {
+			require.NoError(t, err)
+		}
+	})
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		ns := &corev1.Namespace{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name}, ns); err != nil && apierrors.IsNotFound(err) {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: name}, ns); err != nil && apierrors.IsNotFound(err) {
 			return false, nil
 		} else if err != nil {
 			return false, err
 		}
 		return ns.Status.Phase == corev1.NamespaceActive, nil
 	})
-	require.NoError(t, err)
-	t.Cleanup(func() {
-		if err := a.Client.Delete(context.TODO(), ns); err != nil && !apierrors.IsNotFound(err) {
-			require.NoError(t, err)
-		}
-	})
 }
 
 // WaitForDeploymentToGetReady waits until the deployment with the given name is ready together with the given number of replicas
-func (a *Awaitility) WaitForDeploymentToGetReady(t *testing.T, name string, replicas int, criteria ...DeploymentCriteria) *appsv1.Deployment {
-	t.Logf("waiting until deployment '%s' in namespace '%s' is ready", name, a.Namespace)
+// and matches the given criteria (see DeploymentWaitWith to tune this wait)
+func (a *Awaitility) WaitForDeploymentToGetReady(t T, name string, replicas int, options ...DeploymentWaitOption) *appsv1.Deployment {
+	deployment, err := a.TryWaitForDeploymentToGetReady(t, name, replicas, options...)
+	require.NoError(t, err)
+	return deployment
+}
+
+// TryWaitForDeploymentToGetReady is like WaitForDeploymentToGetReady, but it returns an error instead of failing the test
+// if the deployment does not get ready
+func (a *Awaitility) TryWaitForDeploymentToGetReady(t T, name string, replicas int, options ...DeploymentWaitOption) (*appsv1.Deployment, error) {
+	recordWaiter(t)
+	w := &deploymentWait{}
+	for _, option := range options {
+		option.applyToDeploymentWait(w)
+	}
+	a = a.WithRetryOptions(w.retryOptions...)
+	a.logf(t, "waiting until deployment '%s' in namespace '%s' is ready", name, a.Namespace)
 	deployment := &appsv1.Deployment{}
-	err := wait.Poll(a.RetryInterval, 6*a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, 6*a.Timeout, func() (done bool, err error) {
 		deploymentConditions := status.GetDeploymentStatusConditions(a.Client, name, a.Namespace)
 		if err := status.ValidateComponentConditionReady(deploymentConditions...); err != nil {
 			return false, nil // nolint:nilerr
 		}
 		deployment = &appsv1.Deployment{}
-		require.NoError(t, a.Client.Get(context.TODO(), test.NamespacedName(a.Namespace, name), deployment))
-		if int(deployment.Status.AvailableReplicas) != replicas {
-			return false, nil
+		if err := a.Client.Get(a.getContext(), test.NamespacedName(a.Namespace, name), deployment); err != nil {
+			return false, err
 		}
-		pods := &corev1.PodList{}
-		require.NoError(t, a.Client.List(context.TODO(), pods, client.InNamespace(a.Namespace), client.MatchingLabels(deployment.Spec.Selector.MatchLabels)))
-		if len(pods.Items) != replicas {
+		if int(deployment.Status.AvailableReplicas) != replicas {
 			return false, nil
 		}
-		for _, pod := range pods.Items { // nolint
-			if util.IsBeingDeleted(&pod) || !podutils.IsPodReady(&pod) {
-				return false, nil
-			}
+		if ready, err := a.podsReady(deployment.Spec.Selector, replicas); err != nil || !ready {
+			return false, err
 		}
-		for _, criteriaMatch := range criteria {
+		for _, criteriaMatch := range w.criteria {
 			if !criteriaMatch(deployment) {
 				return false, nil
 			}
 		}
 		return true, nil
 	})
+	return deployment, err
+}
+
+// ScaleDeployment sets the number of replicas of the deployment with the given name in the current namespace
+// If it fails with an error (for example if the object has been modified) then it retrieves the latest version and tries again
+// Returns the updated Deployment
+func (a *Awaitility) ScaleDeployment(t T, name string, replicas int32) (*appsv1.Deployment, error) {
+	a.logf(t, "scaling deployment '%s' in namespace '%s' to %d replica(s)", name, a.Namespace, replicas)
+	return Update(t, a, test.NamespacedName(a.Namespace, name), func(d *appsv1.Deployment) {
+		d.Spec.Replicas = &replicas
+	})
+}
+
+// WaitUntilDeploymentPodsDeleted waits until all the pods of the given deployment are deleted (ie, not found)
+func (a *Awaitility) WaitUntilDeploymentPodsDeleted(t T, deployment *appsv1.Deployment) error {
+	recordWaiter(t)
+	a.logf(t, "waiting until pods of deployment '%s' in namespace '%s' are deleted", deployment.Name, a.Namespace)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		pods := &corev1.PodList{}
+		if err := a.Client.List(a.getContext(), pods, client.InNamespace(a.Namespace), client.MatchingLabels(deployment.Spec.Selector.MatchLabels)); err != nil {
+			return false, err
+		}
+		return len(pods.Items) == 0, nil
+	})
+}
+
+// DeploymentHoldsLeaderElectionLease returns `true` if one of the pods of the given deployment currently holds
+// a leader election Lease in the current namespace
+func (a *Awaitility) DeploymentHoldsLeaderElectionLease(deployment *appsv1.Deployment) (bool, error) {
+	pods := &corev1.PodList{}
+	if err := a.Client.List(a.getContext(), pods, client.InNamespace(a.Namespace), client.MatchingLabels(deployment.Spec.Selector.MatchLabels)); err != nil {
+		return false, err
+	}
+	leases := &coordinationv1.LeaseList{}
+	if err := a.Client.List(a.getContext(), leases, client.InNamespace(a.Namespace)); err != nil {
+		return false, err
+	}
+	for _, lease := range leases.Items {
+		if lease.Spec.HolderIdentity == nil {
+			continue
+		}
+		for _, pod := range pods.Items {
+			// the holder identity is in the form of `<hostname>_<uuid>`, where the hostname is the name of the pod
+			if strings.HasPrefix(*lease.Spec.HolderIdentity, pod.Name+"_") {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// WaitUntilDeploymentHoldsLeaderElectionLease waits until one of the pods of the given deployment holds
+// a leader election Lease in the current namespace
+func (a *Awaitility) WaitUntilDeploymentHoldsLeaderElectionLease(t T, deployment *appsv1.Deployment) error {
+	recordWaiter(t)
+	a.logf(t, "waiting until a pod of deployment '%s' in namespace '%s' is elected as leader", deployment.Name, a.Namespace)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		return a.DeploymentHoldsLeaderElectionLease(deployment)
+	})
+}
+
+// PauseDeployment scales the deployment with the given name down to zero and waits until all its pods are terminated, eg: to stop
+// an operator from reconciling while the test sets up a state which the operator must find when it starts again (migration, adoption, etc.)
+// Returns the func which resumes the deployment: it scales the deployment back to its original number of replicas, and waits until
+// the deployment is ready again (and until it holds the leader election Lease again, if it did before the pause).
+// The deployment is resumed at the end of the test if the func was not called before.
+func (a *Awaitility) PauseDeployment(t T, name string) (resume func()) {
+	resume, err := a.TryPauseDeployment(t, name)
 	require.NoError(t, err)
-	return deployment
+	return resume
 }
 
+// TryPauseDeployment is like PauseDeployment, but it returns an error instead of failing the test if the deployment can't be paused.
+// The returned func still fails the test if the deployment can't be resumed. If an error is returned after the deployment was scaled down,
+// the deployment is resumed at the end of the test.
+func (a *Awaitility) TryPauseDeployment(t T, name string) (resume func(), err error) {
+	a.logf(t, "pausing deployment '%s' in namespace '%s'", name, a.Namespace)
+	deployment := &appsv1.Deployment{}
+	if err := a.Client.Get(a.getContext(), test.NamespacedName(a.Namespace, name), deployment); err != nil {
+		return nil, err
+	}
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	leaderElection, err := a.DeploymentHoldsLeaderElectionLease(deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	var once sync.Once
+	resume = func() {
+		once.Do(func() {
+			a.logf(t, "resuming deployment '%s' in namespace '%s'", name, a.Namespace)
+			deployment, err := a.ScaleDeployment(t, name, replicas)
+			require.NoError(t, err)
+			// make sure that the status of the deployment no longer reflects the scale down
+			_, err = WaitForReconciled(t, a, deployment)
+			require.NoError(t, err)
+			// wait until the deployment is ready again, and stays ready (it may briefly report that it is ready
+			// before a freshly started pod crashes)
+			deployment = a.WithRetryOptions(Stable(3)).WaitForDeploymentToGetReady(t, name, int(replicas))
+			if leaderElection {
+				err = a.WaitUntilDeploymentHoldsLeaderElectionLease(t, deployment)
+				require.NoError(t, err)
+			}
+		})
+	}
+	t.Cleanup(resume)
+
+	// scale down and wait until all pods are gone
+	deployment, err = a.ScaleDeployment(t, name, 0)
+	if err != nil {
+		return nil, err
+	}
+	deployment, err = WaitForReconciled(t, a, deployment)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.WaitUntilDeploymentPodsDeleted(t, deployment); err != nil {
+		return nil, err
+	}
+	return resume, nil
+}
+
+// DeploymentWaitOption an option of WaitForDeploymentToGetReady: a DeploymentCriteria, or the RetryOptions of the wait (see DeploymentWaitWith)
+type DeploymentWaitOption interface {
+	applyToDeploymentWait(w *deploymentWait)
+}
+
+type deploymentWait struct {
+	criteria     []DeploymentCriteria
+	retryOptions []RetryOption
+}
+
+// DeploymentWaitWith returns an option to apply the given RetryOptions to a single call of WaitForDeploymentToGetReady, without copying
+// the Awaitility with WithRetryOptions, eg:
+//
+//	memberAwait.WaitForDeploymentToGetReady(t, name, 1, wait.DeploymentHasReplicas(1), wait.DeploymentWaitWith(wait.Within(30*time.Second)))
+func DeploymentWaitWith(options ...RetryOption) DeploymentWaitOption {
+	return deploymentRetryOptions(options)
+}
+
+type deploymentRetryOptions []RetryOption
+
+var _ DeploymentWaitOption = deploymentRetryOptions(nil)
+
+func (o deploymentRetryOptions) applyToDeploymentWait(w *deploymentWait) {
+	w.retryOptions = append(w.retryOptions, o...)
+}
+
+// DeploymentCriteria a criterion which must be matched by a Deployment when waiting until it is ready
 type DeploymentCriteria func(*appsv1.Deployment) bool
 
+var _ DeploymentWaitOption = DeploymentCriteria(nil)
+
+func (c DeploymentCriteria) applyToDeploymentWait(w *deploymentWait) {
+	w.criteria = append(w.criteria, c)
+}
+
+// DeploymentHasContainerWithImage returns `true` if the Deployment has a container with the given name and image
 func DeploymentHasContainerWithImage(containerName, image string) DeploymentCriteria {
 	return func(deployment *appsv1.Deployment) bool {
-		for _, container := range deployment.Spec.Template.Spec.Containers {
-			if container.Name == containerName && container.Image == image {
+		return podTemplateHasContainerWithImage(deployment.Spec.Template, containerName, image)
+	}
+}
+
+// DeploymentHasContainerWithEnv returns `true` if the Deployment has a container with the given name, with the given env var
+func DeploymentHasContainerWithEnv(containerName, envName, envValue string) DeploymentCriteria {
+	return func(deployment *appsv1.Deployment) bool {
+		return podTemplateHasContainerWithEnv(deployment.Spec.Template, containerName, envName, envValue)
+	}
+}
+
+// DeploymentHasContainerWithoutEnv returns `true` if the Deployment has a container with the given name, without the given env var
+// (eg: once a setting was removed from the ToolchainConfig)
+func DeploymentHasContainerWithoutEnv(containerName, envName string) DeploymentCriteria {
+	return func(deployment *appsv1.Deployment) bool {
+		container, found := podTemplateContainer(deployment.Spec.Template, containerName)
+		if !found {
+			return false
+		}
+		for _, env := range container.Env {
+			if env.Name == envName {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// DeploymentHasContainerWithResourceRequest returns `true` if the Deployment has a container with the given name, which requests
+// the given quantity of the given resource (eg: `DeploymentHasContainerWithResourceRequest("manager", corev1.ResourceMemory, "64Mi")`)
+func DeploymentHasContainerWithResourceRequest(containerName string, resourceName corev1.ResourceName, quantity string) DeploymentCriteria {
+	return func(deployment *appsv1.Deployment) bool {
+		container, found := podTemplateContainer(deployment.Spec.Template, containerName)
+		return found && hasQuantity(container.Resources.Requests, resourceName, quantity)
+	}
+}
+
+// DeploymentHasContainerWithResourceLimit returns `true` if the Deployment has a container with the given name, which is limited
+// to the given quantity of the given resource (eg: `DeploymentHasContainerWithResourceLimit("manager", corev1.ResourceCPU, "500m")`)
+func DeploymentHasContainerWithResourceLimit(containerName string, resourceName corev1.ResourceName, quantity string) DeploymentCriteria {
+	return func(deployment *appsv1.Deployment) bool {
+		container, found := podTemplateContainer(deployment.Spec.Template, containerName)
+		return found && hasQuantity(container.Resources.Limits, resourceName, quantity)
+	}
+}
+
+// DeploymentHasMountedSecret returns `true` if the Deployment has a container with the given name, which mounts a volume of
+// the Secret with the given name (possibly as a source of a projected volume)
+func DeploymentHasMountedSecret(containerName, secretName string) DeploymentCriteria {
+	return func(deployment *appsv1.Deployment) bool {
+		return podTemplateHasMountedVolume(deployment.Spec.Template, containerName, func(volume corev1.Volume) bool {
+			if volume.Secret != nil && volume.Secret.SecretName == secretName {
 				return true
 			}
+			if volume.Projected != nil {
+				for _, source := range volume.Projected.Sources {
+					if source.Secret != nil && source.Secret.Name == secretName {
+						return true
+					}
+				}
+			}
+			return false
+		})
+	}
+}
+
+// DeploymentHasMountedConfigMap returns `true` if the Deployment has a container with the given name, which mounts a volume of
+// the ConfigMap with the given name (possibly as a source of a projected volume)
+func DeploymentHasMountedConfigMap(containerName, configMapName string) DeploymentCriteria {
+	return func(deployment *appsv1.Deployment) bool {
+		return podTemplateHasMountedVolume(deployment.Spec.Template, containerName, func(volume corev1.Volume) bool {
+			if volume.ConfigMap != nil && volume.ConfigMap.Name == configMapName {
+				return true
+			}
+			if volume.Projected != nil {
+				for _, source := range volume.Projected.Sources {
+					if source.ConfigMap != nil && source.ConfigMap.Name == configMapName {
+						return true
+					}
+				}
+			}
+			return false
+		})
+	}
+}
+
+// DeploymentHasReplicas returns `true` if the spec of the Deployment has the given number of replicas
+// (a Deployment without replicas in its spec has 1 replica)
+func DeploymentHasReplicas(replicas int32) DeploymentCriteria {
+	return func(deployment *appsv1.Deployment) bool {
+		if deployment.Spec.Replicas == nil {
+			return replicas == 1
 		}
-		return false
+		return *deployment.Spec.Replicas == replicas
 	}
 }
 
 // ToolchainClusterWaitCriterion a struct to compare with an expected ToolchainCluster CR
 type ToolchainClusterWaitCriterion struct {
 	Match func(toolchainCluster *toolchainv1alpha1.ToolchainCluster) bool
+	// Diff describes the differences between the expected and the actual ToolchainCluster (optional)
+	Diff func(toolchainCluster *toolchainv1alpha1.ToolchainCluster) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[ToolchainClusterWaitCriterion] = ToolchainClusterWaitCriterion{}
+
+func (ToolchainClusterWaitCriterion) withRetryOptions(options []RetryOption) ToolchainClusterWaitCriterion {
+	return ToolchainClusterWaitCriterion{
+		Match: func(*toolchainv1alpha1.ToolchainCluster) bool {
+			return true
+		},
+		Diff: func(*toolchainv1alpha1.ToolchainCluster) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c ToolchainClusterWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 // WaitForToolchainCluster waits until there is a ToolchainCluster CR available with the given list of criteria
-func (a *Awaitility) WaitForToolchainCluster(t *testing.T, criteria ...ToolchainClusterWaitCriterion) (*toolchainv1alpha1.ToolchainCluster, error) {
-	t.Logf("waiting for toolchaincluster in namespace '%s' to match criteria", a.Namespace)
+func (a *Awaitility) WaitForToolchainCluster(t T, criteria ...ToolchainClusterWaitCriterion) (*toolchainv1alpha1.ToolchainCluster, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for toolchaincluster in namespace '%s' to match criteria", a.Namespace)
 	var clusters *toolchainv1alpha1.ToolchainClusterList
 	var cl *toolchainv1alpha1.ToolchainCluster
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		clusters = &toolchainv1alpha1.ToolchainClusterList{}
-		if err := a.Client.List(context.TODO(), clusters, client.InNamespace(a.Namespace)); err != nil {
+		if err := a.Client.List(a.getContext(), clusters, client.InNamespace(a.Namespace)); err != nil {
 			return false, err
 		}
 		for _, obj := range clusters.Items {
@@ -552,6 +1346,10 @@ func (a *Awaitility) WaitForToolchainCluster(t *testing.T, criteria ...Toolchain
 		}
 		return false, nil
 	})
+	// no match found, print the diffs
+	if err != nil {
+		a.printToolchainClusterWaitCriterionDiffs(t, clusters, criteria...)
+	}
 	return cl, err
 }
 
@@ -564,12 +1362,43 @@ func matchToolchainClusterWaitCriterion(actual *toolchainv1alpha1.ToolchainClust
 	return true
 }
 
+// printToolchainClusterWaitCriterionDiffs prints the criteria which are not matched by each of the given ToolchainClusters
+func (a *Awaitility) printToolchainClusterWaitCriterionDiffs(t T, actual *toolchainv1alpha1.ToolchainClusterList, criteria ...ToolchainClusterWaitCriterion) {
+	buf := &strings.Builder{}
+	if actual == nil || len(actual.Items) == 0 {
+		buf.WriteString(fmt.Sprintf("failed to find any ToolchainCluster in namespace '%s'\n", a.Namespace))
+		a.log(t, buf.String())
+		return
+	}
+	buf.WriteString("failed to find ToolchainCluster with matching criteria:\n")
+	for i := range actual.Items {
+		tc := &actual.Items[i]
+		buf.WriteString("----\n")
+		buf.WriteString(fmt.Sprintf("ToolchainCluster '%s':\n", tc.Name))
+		for j, c := range criteria {
+			if c.Match(tc) {
+				continue
+			}
+			if c.Diff == nil {
+				buf.WriteString(fmt.Sprintf("criterion #%d did not match\n", j+1))
+				continue
+			}
+			buf.WriteString(c.Diff(tc))
+			buf.WriteString("\n")
+		}
+	}
+	a.log(t, buf.String())
+}
+
 // UntilToolchainClusterHasName checks if ToolchainCluster has given name
 func UntilToolchainClusterHasName(expectedName string) ToolchainClusterWaitCriterion {
 	return ToolchainClusterWaitCriterion{
 		Match: func(actual *toolchainv1alpha1.ToolchainCluster) bool {
 			return actual.Name == expectedName
 		},
+		Diff: func(actual *toolchainv1alpha1.ToolchainCluster) string {
+			return fmt.Sprintf("expected name to match:\n%s", Diff(expectedName, actual.Name))
+		},
 	}
 }
 
@@ -579,6 +1408,9 @@ func UntilToolchainClusterHasCondition(expected toolchainv1alpha1.ToolchainClust
 		Match: func(actual *toolchainv1alpha1.ToolchainCluster) bool {
 			return containsClusterCondition(actual.Status.Conditions, &expected)
 		},
+		Diff: func(actual *toolchainv1alpha1.ToolchainCluster) string {
+			return fmt.Sprintf("expected conditions to contain condition of type '%s' with status '%s':\n%s", expected.Type, expected.Status, spew.Sdump(actual.Status.Conditions))
+		},
 	}
 }
 
@@ -593,6 +1425,9 @@ func UntilToolchainClusterHasLabels(expected client.MatchingLabels) ToolchainClu
 			}
 			return true
 		},
+		Diff: func(actual *toolchainv1alpha1.ToolchainCluster) string {
+			return fmt.Sprintf("expected labels to contain:\n%s", Diff(map[string]string(expected), actual.Labels))
+		},
 	}
 }
 
@@ -605,32 +1440,21 @@ func UntilToolchainClusterHasNoTenantLabel() ToolchainClusterWaitCriterion {
 			}
 			return true
 		},
+		Diff: func(actual *toolchainv1alpha1.ToolchainCluster) string {
+			return fmt.Sprintf("expected no '%s' label:\n%s", cluster.RoleLabel(cluster.Tenant), spew.Sdump(actual.Labels))
+		},
 	}
 }
 
 // UpdateToolchainCluster tries to update the Spec of the given ToolchainCluster
 // If it fails with an error (for example if the object has been modified) then it retrieves the latest version and tries again
 // Returns the updated ToolchainCluster
-func (a *Awaitility) UpdateToolchainCluster(t *testing.T, toolchainClusterName string, modifyToolchainCluster func(s *toolchainv1alpha1.ToolchainCluster)) (*toolchainv1alpha1.ToolchainCluster, error) {
-	var tc *toolchainv1alpha1.ToolchainCluster
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		newToolchainCluster := &toolchainv1alpha1.ToolchainCluster{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: toolchainClusterName}, newToolchainCluster); err != nil {
-			return true, err
-		}
-		modifyToolchainCluster(newToolchainCluster)
-		if err := a.Client.Update(context.TODO(), newToolchainCluster); err != nil {
-			t.Logf("error updating ToolchainCluster '%s': %s. Will retry again...", toolchainClusterName, err.Error())
-			return false, nil
-		}
-		tc = newToolchainCluster
-		return true, nil
-	})
-	return tc, err
+func (a *Awaitility) UpdateToolchainCluster(t T, toolchainClusterName string, modifyToolchainCluster func(s *toolchainv1alpha1.ToolchainCluster)) (*toolchainv1alpha1.ToolchainCluster, error) {
+	return Update(t, a, types.NamespacedName{Namespace: a.Namespace, Name: toolchainClusterName}, modifyToolchainCluster)
 }
 
 // CreateWithCleanup creates the given object via client.Client.Create() and schedules the cleanup of the object at the end of the current test
-func (a *Awaitility) CreateWithCleanup(t *testing.T, obj client.Object, opts ...client.CreateOption) error {
+func (a *Awaitility) CreateWithCleanup(t T, obj client.Object, opts ...client.CreateOption) error {
 	if err := a.Client.Create(context.TODO(), obj, opts...); err != nil {
 		return err
 	}
@@ -639,12 +1463,12 @@ func (a *Awaitility) CreateWithCleanup(t *testing.T, obj client.Object, opts ...
 }
 
 // Clean triggers cleanup of all resources that were marked to be cleaned before that
-func (a *Awaitility) Clean(t *testing.T) {
+func (a *Awaitility) Clean(t T) {
 	cleanup.ExecuteAllCleanTasks(t)
 }
 
-func (a *Awaitility) listAndPrint(t *testing.T, resourceKind, namespace string, list client.ObjectList, additionalOptions ...client.ListOption) {
-	t.Logf(a.listAndReturnContent(resourceKind, namespace, list, additionalOptions...))
+func (a *Awaitility) listAndPrint(t T, resourceKind, namespace string, list client.ObjectList, additionalOptions ...client.ListOption) {
+	a.logf(t, a.listAndReturnContent(resourceKind, namespace, list, additionalOptions...))
 }
 
 func (a *Awaitility) listAndReturnContent(resourceKind, namespace string, list client.ObjectList, additionalOptions ...client.ListOption) string {
@@ -652,7 +1476,7 @@ func (a *Awaitility) listAndReturnContent(resourceKind, namespace string, list c
 	if a.Namespace != "" {
 		listOptions = append(additionalOptions, client.InNamespace(namespace))
 	}
-	if err := a.Client.List(context.TODO(), list, listOptions...); err != nil {
+	if err := a.Client.List(a.getContext(), list, listOptions...); err != nil {
 		return fmt.Sprintf("unable to list %s: %s", resourceKind, err)
 	}
 	content, _ := StringifyObjects(list)
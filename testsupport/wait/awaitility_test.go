@@ -0,0 +1,550 @@
+package wait_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait/waittest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8swait "k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestBackoff(t *testing.T) {
+
+	newAwaitility := func(options ...wait.RetryOption) *wait.Awaitility {
+		a := &wait.Awaitility{
+			RetryInterval: 5 * time.Millisecond,
+			Timeout:       200 * time.Millisecond,
+		}
+		return a.WithRetryOptions(options...)
+	}
+
+	// pollUntil polls with the given awaitility until the condition was evaluated the given number of times,
+	// and returns the delays between the evaluations
+	pollUntil := func(a *wait.Awaitility, calls int) ([]time.Duration, error) {
+		var delays []time.Duration
+		last := time.Now()
+		err := a.Poll(t, func() (bool, error) {
+			delays = append(delays, time.Since(last))
+			last = time.Now()
+			return len(delays) == calls, nil
+		})
+		return delays, err
+	}
+
+	t.Run("delays increase until the max", func(t *testing.T) {
+		// given
+		a := newAwaitility(wait.Backoff(10*time.Millisecond, 2, 40*time.Millisecond, 0))
+
+		// when
+		delays, err := pollUntil(a, 4)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, delays, 4)
+		for i, min := range []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 40 * time.Millisecond, 40 * time.Millisecond} {
+			assert.GreaterOrEqual(t, delays[i], min, fmt.Sprintf("delay #%d", i))
+		}
+	})
+
+	t.Run("jitter increases the delays", func(t *testing.T) {
+		// given
+		a := newAwaitility(wait.Backoff(10*time.Millisecond, 1, 10*time.Millisecond, 1))
+
+		// when
+		delays, err := pollUntil(a, 3)
+
+		// then
+		require.NoError(t, err)
+		for i, delay := range delays {
+			assert.GreaterOrEqual(t, delay, 10*time.Millisecond, fmt.Sprintf("delay #%d", i))
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		// given
+		a := newAwaitility(wait.Backoff(10*time.Millisecond, 2, time.Second, 0))
+
+		// when
+		delays, err := pollUntil(a, 100)
+
+		// then
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		// 10ms + 20ms + 40ms + 80ms elapsed before the 200ms timeout, instead of 40 polls with the fixed 5ms interval
+		assert.Len(t, delays, 4)
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		a := newAwaitility(wait.Backoff(10*time.Millisecond, 2, time.Second, 0)).WithContext(ctx)
+
+		// when
+		delays, err := pollUntil(a, 1)
+
+		// then
+		require.ErrorIs(t, err, context.Canceled)
+		assert.False(t, wait.IsTimeout(err))
+		assert.Empty(t, delays)
+	})
+
+	t.Run("retry interval replaces the backoff", func(t *testing.T) {
+		// given
+		a := newAwaitility(wait.Backoff(time.Second, 2, time.Minute, 0), wait.RetryInterval(time.Millisecond))
+
+		// when
+		delays, err := pollUntil(a, 3)
+
+		// then
+		require.NoError(t, err)
+		for i, delay := range delays {
+			assert.Less(t, delay, time.Second, fmt.Sprintf("delay #%d", i))
+		}
+	})
+}
+
+func TestStable(t *testing.T) {
+
+	// condition returns a condition which returns the given results in sequence, and then `true`
+	condition := func(results ...bool) (k8swait.ConditionFunc, *int) {
+		evaluations := 0
+		return func() (bool, error) {
+			evaluations++
+			if evaluations <= len(results) {
+				return results[evaluations-1], nil
+			}
+			return true, nil
+		}, &evaluations
+	}
+
+	t.Run("consecutive polls", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t)
+		a := h.Awaitility.WithRetryOptions(wait.Stable(3))
+		cond, evaluations := condition(true, false, true, true, false, true)
+
+		// when
+		elapsed, err := h.Run(func() error {
+			return a.Poll(t, cond)
+		})
+
+		// then
+		require.NoError(t, err)
+		// met by the 6th, 7th and 8th evaluations
+		assert.Equal(t, 8, *evaluations)
+		assert.Equal(t, 8*wait.DefaultRetryInterval, elapsed)
+	})
+
+	t.Run("duration", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t)
+		a := h.Awaitility.WithRetryOptions(wait.StableFor(time.Second))
+		cond, _ := condition(true, false)
+
+		// when
+		elapsed, err := h.Run(func() error {
+			return a.Poll(t, cond)
+		})
+
+		// then
+		require.NoError(t, err)
+		// met continuously since the 3rd evaluation
+		assert.Equal(t, 3*wait.DefaultRetryInterval+time.Second, elapsed)
+	})
+
+	t.Run("never stable", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t)
+		a := h.Awaitility.WithRetryOptions(wait.Stable(2), wait.TimeoutOption(time.Second))
+		flapping := false
+		cond := func() (bool, error) {
+			flapping = !flapping
+			return flapping, nil
+		}
+
+		// when
+		_, err := h.Run(func() error {
+			return a.Poll(t, cond)
+		})
+
+		// then
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+	})
+}
+
+func TestWithin(t *testing.T) {
+
+	toolchainCluster := &toolchainv1alpha1.ToolchainCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "member-cluster",
+		},
+	}
+
+	t.Run("waiter with a longer timeout", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t, toolchainCluster.DeepCopy())
+
+		// when
+		elapsed, err := h.Run(func() error {
+			_, err := h.Awaitility.WaitForNamedToolchainClusterWithCondition(t, "member-cluster", wait.ReadyToolchainCluster)
+			return err
+		})
+
+		// then
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.Equal(t, wait.ToolchainClusterConditionTimeout, elapsed)
+	})
+
+	t.Run("timeout option does not override the longer timeout", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t, toolchainCluster.DeepCopy())
+		a := h.Awaitility.WithRetryOptions(wait.TimeoutOption(5 * time.Second))
+
+		// when
+		elapsed, err := h.Run(func() error {
+			_, err := a.WaitForNamedToolchainClusterWithCondition(t, "member-cluster", wait.ReadyToolchainCluster)
+			return err
+		})
+
+		// then
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.Equal(t, wait.ToolchainClusterConditionTimeout, elapsed)
+	})
+
+	t.Run("within overrides the longer timeout", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t, toolchainCluster.DeepCopy())
+		a := h.Awaitility.WithRetryOptions(wait.Within(5*time.Second), wait.RetryInterval(time.Second))
+		var steps int
+
+		// when
+		elapsed, err := h.Run(func() error {
+			_, err := a.WaitForNamedToolchainClusterWithCondition(t, "member-cluster", wait.ReadyToolchainCluster)
+			return err
+		}, func(time.Duration) {
+			steps++
+		})
+
+		// then
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.Equal(t, 5*time.Second, elapsed)
+		assert.Equal(t, 5, steps)
+	})
+
+	t.Run("within as a per-call option", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t, toolchainCluster.DeepCopy())
+
+		// when
+		elapsed, err := h.Run(func() error {
+			_, err := h.Awaitility.WaitForNamedToolchainClusterWithCondition(t, "member-cluster", wait.ReadyToolchainCluster, wait.Within(5*time.Second))
+			return err
+		})
+
+		// then
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.Equal(t, 5*time.Second, elapsed)
+		// the awaitility itself is unchanged
+		elapsed, err = h.Run(func() error {
+			_, err := h.Awaitility.WaitForNamedToolchainClusterWithCondition(t, "member-cluster", wait.ReadyToolchainCluster)
+			return err
+		})
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.Equal(t, wait.ToolchainClusterConditionTimeout, elapsed)
+	})
+
+	t.Run("within as a per-call option of the deployment wait", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t)
+
+		// when
+		elapsed, err := h.Run(func() error {
+			_, err := h.Awaitility.TryWaitForDeploymentToGetReady(t, "unknown", 1, wait.DeploymentHasReplicas(1), wait.DeploymentWaitWith(wait.Within(5*time.Second)))
+			return err
+		})
+
+		// then
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.Equal(t, 5*time.Second, elapsed)
+	})
+
+	t.Run("within as a per-call option of a waiter without criteria", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t)
+
+		// when
+		elapsed, err := h.Run(func() error {
+			_, err := h.Awaitility.WaitForService(t, "unknown", wait.Within(5*time.Second))
+			return err
+		})
+
+		// then
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.Equal(t, 5*time.Second, elapsed)
+	})
+
+	t.Run("within as a per-call criterion of a waiter with criteria", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t, toolchainCluster.DeepCopy())
+
+		// when
+		elapsed, err := h.Run(func() error {
+			_, err := h.Awaitility.WaitForToolchainCluster(t,
+				wait.UntilToolchainClusterHasName("other-cluster"),
+				wait.WaitWith[wait.ToolchainClusterWaitCriterion](wait.Within(5*time.Second)))
+			return err
+		})
+
+		// then
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.Equal(t, 5*time.Second, elapsed)
+	})
+
+	t.Run("criterion with per-call options always matches", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t, toolchainCluster.DeepCopy())
+
+		// when
+		_, err := h.Run(func() error {
+			_, err := h.Awaitility.WaitForToolchainCluster(t,
+				wait.UntilToolchainClusterHasName("member-cluster"),
+				wait.WaitWith[wait.ToolchainClusterWaitCriterion](wait.Within(5*time.Second)))
+			return err
+		})
+
+		// then
+		require.NoError(t, err)
+	})
+}
+
+func TestTestDeadline(t *testing.T) {
+	deadline, ok := t.Deadline()
+	if !ok {
+		t.Skip("the test has no deadline (`-timeout 0`)")
+	}
+
+	t.Run("wait shortened to not outlive the test", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t)
+		a := h.Awaitility.WithRetryOptions(wait.Within(24*time.Hour), wait.RetryInterval(time.Minute))
+		start := time.Now()
+
+		// when
+		elapsed, err := h.Run(func() error {
+			return a.Poll(t, func() (bool, error) {
+				return false, nil
+			})
+		})
+
+		// then
+		require.ErrorIs(t, err, wait.ErrTestDeadlineImminent)
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.LessOrEqual(t, elapsed, deadline.Sub(start)-wait.TestDeadlineMargin)
+	})
+
+	t.Run("wait not shortened", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t)
+		a := h.Awaitility.WithRetryOptions(wait.Within(5 * time.Second))
+
+		// when
+		elapsed, err := h.Run(func() error {
+			return a.Poll(t, func() (bool, error) {
+				return false, nil
+			})
+		})
+
+		// then
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.NotErrorIs(t, err, wait.ErrTestDeadlineImminent)
+		assert.Equal(t, 5*time.Second, elapsed)
+	})
+}
+
+func TestTryHelpers(t *testing.T) {
+
+	t.Run("namespace not active", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t)
+		a := h.Awaitility.WithRetryOptions(wait.TimeoutOption(time.Second))
+
+		// when
+		_, err := h.Run(func() error {
+			return a.TryCreateNamespace(t, "inactive")
+		})
+
+		// then
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.False(t, t.Failed())
+	})
+
+	t.Run("deployment not found", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t)
+		a := h.Awaitility.WithRetryOptions(wait.TimeoutOption(time.Second))
+
+		// when
+		_, err := h.Run(func() error {
+			_, err := a.TryWaitForDeploymentToGetReady(t, "unknown", 1)
+			return err
+		})
+
+		// then
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.False(t, t.Failed())
+	})
+
+	t.Run("deployment to pause not found", func(t *testing.T) {
+		// given
+		a := &wait.Awaitility{
+			Client:    test.NewFakeClient(t),
+			Namespace: "test",
+		}
+
+		// when
+		resume, err := a.TryPauseDeployment(t, "unknown")
+
+		// then
+		require.Error(t, err)
+		assert.Nil(t, resume)
+		assert.False(t, t.Failed())
+	})
+
+	t.Run("metric labels not in pairs", func(t *testing.T) {
+		// given
+		a := &wait.Awaitility{
+			Client:    test.NewFakeClient(t),
+			Namespace: "test",
+		}
+
+		// when
+		err := a.TryWaitForMetricDelta(t, "sandbox_user_signups_total", 1, "domain")
+
+		// then
+		require.EqualError(t, err, "`labelAndValues` must be pairs of labels and values")
+		assert.False(t, t.Failed())
+	})
+
+	t.Run("toolchainconfig to patch not found", func(t *testing.T) {
+		// given
+		hostAwait := wait.NewHostAwaitility(nil, test.NewFakeClient(t), "toolchain-host-operator", "toolchain-host-operator")
+
+		// when
+		err := hostAwait.TryPatchToolchainConfig(t, `{"spec":{}}`)
+
+		// then
+		require.Error(t, err)
+		assert.False(t, t.Failed())
+	})
+
+	t.Run("toolchainconfig created", func(t *testing.T) {
+		// given
+		hostAwait := wait.NewHostAwaitility(nil, test.NewFakeClient(t), "toolchain-host-operator", "toolchain-host-operator")
+
+		// when
+		err := hostAwait.TryUpdateToolchainConfig(t)
+
+		// then
+		require.NoError(t, err)
+		config, err := hostAwait.TryGetToolchainConfig()
+		require.NoError(t, err)
+		assert.NotNil(t, config)
+	})
+
+	t.Run("member metrics not set up", func(t *testing.T) {
+		// given
+		memberAwait := wait.NewMemberAwaitility(nil, test.NewFakeClient(t), "toolchain-member-operator", "member-1")
+
+		// when
+		err := memberAwait.TryInitMetrics(t)
+
+		// then
+		require.EqualError(t, err, "the metrics of the member operator in namespace 'toolchain-member-operator' are not set up")
+		assert.False(t, t.Failed())
+	})
+}
+
+func TestForNamespace(t *testing.T) {
+	// given
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "johnsmith-dev",
+			Name:      "my-service",
+		},
+	}
+	h := waittest.NewHarness(t, service)
+	a := h.Awaitility.WithRetryOptions(wait.TimeoutOption(time.Second))
+
+	t.Run("found in the given namespace", func(t *testing.T) {
+		// when
+		userNsAwait := a.ForNamespace("johnsmith-dev")
+		_, err := h.Run(func() error {
+			_, err := userNsAwait.WaitForService(t, "my-service")
+			return err
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "johnsmith-dev", userNsAwait.Namespace)
+		assert.Equal(t, time.Second, userNsAwait.Timeout)
+	})
+
+	t.Run("original awaitility not modified", func(t *testing.T) {
+		// when
+		_ = a.ForNamespace("johnsmith-dev")
+		_, err := h.Run(func() error {
+			_, err := a.WaitForService(t, "my-service")
+			return err
+		})
+
+		// then
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.Equal(t, "test", a.Namespace)
+	})
+}
+
+// blockingClient a client whose reads block until their context is done
+type blockingClient struct {
+	client.Client
+}
+
+func (c blockingClient) Get(ctx context.Context, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestWithContext(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	a := &wait.Awaitility{
+		Client:        blockingClient{Client: test.NewFakeClient(t)},
+		Namespace:     "test",
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       time.Minute,
+	}
+	a = a.WithContext(ctx)
+	time.AfterFunc(100*time.Millisecond, cancel)
+	start := time.Now()
+
+	// when
+	_, err := wait.WaitForObject[*corev1.ConfigMap](t, a, types.NamespacedName{Namespace: "test", Name: "config"})
+
+	// then the request which was blocked when the context was cancelled returned
+	require.ErrorIs(t, err, context.Canceled)
+	assert.False(t, wait.IsTimeout(err))
+	assert.Less(t, time.Since(start), 10*time.Second)
+}
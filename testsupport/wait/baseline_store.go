@@ -0,0 +1,207 @@
+package wait
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// MetricBaselinesVar the name of the env var which enables the persistence of the named baselines of the metrics (see
+	// CaptureOrRestoreMetricsBaseline), so that the tests run by several `go test` invocations (eg: the shards of the CI) share them:
+	// `file` to persist them in the artifact dir (or in the temp dir), `configmap` to persist them in a ConfigMap in the namespace of
+	// each operator. Requires TestRunIDVar.
+	MetricBaselinesVar = "E2E_METRIC_BASELINES"
+	// TestRunIDVar the name of the env var with the ID of the run of the tests (eg: the ID of the CI job), which keys the persisted
+	// baselines of the metrics (see MetricBaselinesVar)
+	TestRunIDVar = "E2E_TEST_RUN_ID"
+)
+
+// MetricBaselineStore persists the baselines of the metrics, so that they can be restored by another `go test` invocation
+type MetricBaselineStore interface {
+	// Save persists the given baseline (in the text format of Prometheus) with the given key
+	Save(key string, baseline []byte) error
+	// Load returns the baseline persisted with the given key, or `false` if there is none
+	Load(key string) ([]byte, bool, error)
+}
+
+// FileBaselineStore persists the baselines of the metrics of a run of the tests as files in a dir, eg: for the `go test` invocations
+// run on the same host
+type FileBaselineStore struct {
+	dir   string
+	runID string
+}
+
+var _ MetricBaselineStore = &FileBaselineStore{}
+
+// NewFileBaselineStore returns a new FileBaselineStore which persists the baselines of the run with the given ID in the given dir
+func NewFileBaselineStore(dir, runID string) *FileBaselineStore {
+	return &FileBaselineStore{
+		dir:   dir,
+		runID: runID,
+	}
+}
+
+func (s *FileBaselineStore) path(key string) string {
+	return filepath.Join(s.dir, fmt.Sprintf("metric-baseline-%s-%s.prom", sanitizeBaselineKey(s.runID), key))
+}
+
+// Save writes the given baseline in a file of the dir of the store
+func (s *FileBaselineStore) Save(key string, baseline []byte) error {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(s.path(key), baseline, 0o600)
+}
+
+// Load reads the baseline from the file of the dir of the store, if it exists
+func (s *FileBaselineStore) Load(key string) ([]byte, bool, error) {
+	baseline, err := os.ReadFile(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	return baseline, err == nil, err
+}
+
+// ConfigMapBaselineStore persists the baselines of the metrics of a run of the tests in a ConfigMap (one key per baseline), eg: for
+// the `go test` invocations run on different hosts against the same clusters. Note that a ConfigMap can't exceed 1 MiB.
+type ConfigMapBaselineStore struct {
+	client client.Client
+	key    types.NamespacedName
+}
+
+var _ MetricBaselineStore = &ConfigMapBaselineStore{}
+
+// NewConfigMapBaselineStore returns a new ConfigMapBaselineStore which persists the baselines of the run with the given ID in the
+// `e2e-metric-baselines-<run ID>` ConfigMap of the given namespace
+func NewConfigMapBaselineStore(cl client.Client, namespace, runID string) *ConfigMapBaselineStore {
+	return &ConfigMapBaselineStore{
+		client: cl,
+		key: types.NamespacedName{
+			Namespace: namespace,
+			Name:      "e2e-metric-baselines-" + invalidNameChars.ReplaceAllString(strings.ToLower(runID), "-"),
+		},
+	}
+}
+
+// Save sets the given baseline in the ConfigMap of the store, which is created if needed
+func (s *ConfigMapBaselineStore) Save(key string, baseline []byte) error {
+	cm := &corev1.ConfigMap{}
+	if err := s.client.Get(context.TODO(), s.key, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: s.key.Namespace,
+				Name:      s.key.Name,
+			},
+			Data: map[string]string{key: string(baseline)},
+		}
+		return s.client.Create(context.TODO(), cm)
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[key] = string(baseline)
+	return s.client.Update(context.TODO(), cm)
+}
+
+// Load returns the baseline set in the ConfigMap of the store, if any
+func (s *ConfigMapBaselineStore) Load(key string) ([]byte, bool, error) {
+	cm := &corev1.ConfigMap{}
+	if err := s.client.Get(context.TODO(), s.key, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	baseline, found := cm.Data[key]
+	return []byte(baseline), found, nil
+}
+
+var (
+	invalidBaselineKeyChars = regexp.MustCompile(`[^-._a-zA-Z0-9]+`)
+	invalidNameChars        = regexp.MustCompile(`[^-.a-z0-9]+`)
+)
+
+// sanitizeBaselineKey replaces the chars which are not allowed in the keys of a ConfigMap (nor in a file name)
+func sanitizeBaselineKey(key string) string {
+	return invalidBaselineKeyChars.ReplaceAllString(key, "-")
+}
+
+// MetricBaselineStoreFromEnvironment returns the store of the baselines of the metrics configured with MetricBaselinesVar and TestRunIDVar
+// (for the ConfigMap store, in the namespace of the Awaitility), or nil if the persistence of the baselines is disabled
+func (a *Awaitility) MetricBaselineStoreFromEnvironment() MetricBaselineStore {
+	env := CurrentEnvironment()
+	switch env.MetricBaselines {
+	case "file":
+		dir := env.ArtifactDir
+		if dir == "" {
+			dir = os.TempDir()
+		}
+		return NewFileBaselineStore(dir, env.TestRunID)
+	case "configmap":
+		return NewConfigMapBaselineStore(a.Client, a.Namespace, env.TestRunID)
+	default:
+		return nil
+	}
+}
+
+// CaptureOrRestoreMetricsBaseline restores the baseline of the metrics with the given name (eg: `suite`) from the given store if it was
+// persisted by a previous `go test` invocation of the same run, or else captures it (see CaptureMetricsBaseline) and persists it, so that
+// the delta assertions of all the invocations are relative to the same baseline. The key of the baseline in the store also contains the
+// type and the name of the cluster of the Awaitility. The baseline is only captured when the store is nil (see
+// MetricBaselineStoreFromEnvironment).
+//
+//	hostAwait.CaptureOrRestoreMetricsBaseline(t, hostAwait.MetricBaselineStoreFromEnvironment(), "suite")
+func (a *Awaitility) CaptureOrRestoreMetricsBaseline(t T, store MetricBaselineStore, name string) {
+	if store == nil {
+		a.CaptureMetricsBaseline(t)
+		return
+	}
+	key := a.baselineKey(name)
+	body, found, err := store.Load(key)
+	require.NoError(t, err, "unable to load the baseline '%s' of the metrics", key)
+	if found {
+		snapshot, err := metrics.ParseSnapshot(body)
+		require.NoError(t, err, "unable to parse the baseline '%s' of the metrics", key)
+		if a.baselines == nil {
+			a.baselines = &metricBaselines{}
+		}
+		a.baselines.set(snapshot)
+		a.logf(t, "restored the baseline '%s' of %d metric families", key, len(snapshot.Families()))
+		return
+	}
+	a.CaptureMetricsBaseline(t)
+	out := &bytes.Buffer{}
+	require.NoError(t, a.baselines.getSnapshot().WriteText(out))
+	require.NoError(t, store.Save(key, out.Bytes()), "unable to persist the baseline '%s' of the metrics", key)
+	a.logf(t, "persisted the baseline '%s' of the metrics", key)
+}
+
+// baselineKey returns the key of the baseline with the given name in a MetricBaselineStore, eg: `member.member-cluster.suite`
+func (a *Awaitility) baselineKey(name string) string {
+	var parts []string
+	if a.Type != "" {
+		parts = append(parts, string(a.Type))
+	}
+	if a.ClusterName != "" {
+		parts = append(parts, a.ClusterName)
+	}
+	return sanitizeBaselineKey(strings.Join(append(parts, name), "."))
+}
@@ -0,0 +1,108 @@
+package wait_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestMetricBaselineStores(t *testing.T) {
+	stores := map[string]func(t *testing.T) wait.MetricBaselineStore{
+		"file": func(t *testing.T) wait.MetricBaselineStore {
+			return wait.NewFileBaselineStore(t.TempDir(), "pr-1234/1")
+		},
+		"configmap": func(t *testing.T) wait.MetricBaselineStore {
+			return wait.NewConfigMapBaselineStore(test.NewFakeClient(t), "toolchain-host-operator", "pr-1234/1")
+		},
+	}
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			// given
+			store := newStore(t)
+
+			// when
+			_, found, err := store.Load("host.suite")
+
+			// then
+			require.NoError(t, err)
+			assert.False(t, found)
+
+			// when
+			require.NoError(t, store.Save("host.suite", []byte("sandbox_user_signups_total 10\n")))
+			require.NoError(t, store.Save("member.member-1.suite", []byte("sandbox_member_operator_version 1\n")))
+			baseline, found, err := store.Load("host.suite")
+
+			// then
+			require.NoError(t, err)
+			assert.True(t, found)
+			assert.Equal(t, "sandbox_user_signups_total 10\n", string(baseline))
+		})
+	}
+
+	t.Run("configmap name", func(t *testing.T) {
+		// given
+		cl := test.NewFakeClient(t)
+		store := wait.NewConfigMapBaselineStore(cl, "toolchain-host-operator", "PR_1234")
+
+		// when
+		require.NoError(t, store.Save("host.suite", []byte("sandbox_user_signups_total 10\n")))
+
+		// then
+		cm := &corev1.ConfigMap{}
+		require.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: "toolchain-host-operator", Name: "e2e-metric-baselines-pr-1234"}, cm))
+		assert.Contains(t, cm.Data, "host.suite")
+	})
+}
+
+func TestCaptureOrRestoreMetricsBaseline(t *testing.T) {
+	// given
+	var signups atomic.Int64
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "# TYPE sandbox_user_signups_total counter\nsandbox_user_signups_total %d\n", signups.Load())
+	}))
+	defer ts.Close()
+	newHostAwaitility := func() *wait.HostAwaitility {
+		hostAwait := wait.NewHostAwaitility(nil, test.NewFakeClient(t), "toolchain-host-operator", "toolchain-host-operator")
+		hostAwait.MetricsClient = metrics.NewClient(metrics.StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), metrics.StaticToken("token"), metrics.WithCacheTTL(0))
+		return hostAwait
+	}
+	store := wait.NewFileBaselineStore(t.TempDir(), "pr-1234")
+
+	// when
+	// the first `go test` invocation captures and persists the baseline
+	signups.Store(10)
+	first := newHostAwaitility()
+	first.CaptureOrRestoreMetricsBaseline(t, store, "suite")
+	// the second one restores it, even though the metric changed in the meantime
+	signups.Store(12)
+	second := newHostAwaitility()
+	second.CaptureOrRestoreMetricsBaseline(t, store, "suite")
+
+	// then
+	second.WithRetryOptions(wait.RetryInterval(10*time.Millisecond), wait.TimeoutOption(100*time.Millisecond)).
+		WaitForMetricDelta(t, wait.UserSignupsMetric, 2)
+
+	t.Run("without store", func(t *testing.T) {
+		// when
+		third := newHostAwaitility()
+		third.CaptureOrRestoreMetricsBaseline(t, nil, "suite")
+
+		// then
+		third.WithRetryOptions(wait.RetryInterval(10*time.Millisecond), wait.TimeoutOption(100*time.Millisecond)).
+			WaitForMetricDelta(t, wait.UserSignupsMetric, 0)
+	})
+}
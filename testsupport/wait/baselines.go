@@ -0,0 +1,109 @@
+package wait
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+
+	"github.com/stretchr/testify/require"
+)
+
+// metricBaselines the baseline values of the metrics captured by CaptureMetricsBaseline (eg: via InitMetrics).
+// The baselines are shared by an Awaitility and all its copies (see WithRetryOptions), which may wait for the metrics
+// in parallel goroutines while the baselines are captured again, hence they are guarded by a lock.
+type metricBaselines struct {
+	sync.RWMutex
+	snapshot metrics.Snapshot
+}
+
+// get returns the baseline value of the metric with the given family and labels, or 0 if no baseline was captured
+func (b *metricBaselines) get(family string, labelAndValues ...string) float64 {
+	if b == nil {
+		return 0
+	}
+	b.RLock()
+	defer b.RUnlock()
+	return b.snapshot.Value(family, labelAndValues...)
+}
+
+// getSnapshot returns the snapshot of all the metrics captured as the baseline
+func (b *metricBaselines) getSnapshot() metrics.Snapshot {
+	if b == nil {
+		return metrics.Snapshot{}
+	}
+	b.RLock()
+	defer b.RUnlock()
+	return b.snapshot
+}
+
+// set replaces all the baseline values with the ones of the given snapshot
+func (b *metricBaselines) set(snapshot metrics.Snapshot) {
+	b.Lock()
+	defer b.Unlock()
+	b.snapshot = snapshot
+}
+
+// CaptureMetricsBaseline captures the values of all the series of all the metric families exposed by the operator, which are then
+// used as the baseline values by WaitForMetricDelta, WaitForMetricBaseline and AssertMetricsBackToBaseline.
+// The baselines are shared by the Awaitility and all its copies (see WithRetryOptions).
+func (a *Awaitility) CaptureMetricsBaseline(t T) {
+	err := a.TryCaptureMetricsBaseline(t)
+	require.NoError(t, err)
+}
+
+// TryCaptureMetricsBaseline is like CaptureMetricsBaseline, but it returns an error instead of failing the test
+// if the metrics can't be scraped
+func (a *Awaitility) TryCaptureMetricsBaseline(t T) error {
+	snapshot, err := a.MetricsClient.Snapshot()
+	if err != nil {
+		return fmt.Errorf("unable to capture the baseline of the metrics: %w", err)
+	}
+	if a.baselines == nil {
+		// only when the Awaitility was not created by NewHostAwaitility or NewMemberAwaitility, in which case
+		// the baselines must be captured before the Awaitility is shared
+		a.baselines = &metricBaselines{}
+	}
+	a.baselines.set(snapshot)
+	a.logf(t, "captured the baseline of %d metric families", len(snapshot.Families()))
+	return nil
+}
+
+// AssertMetricsBackToBaseline waits until all the series of the given metric families have their baseline values back (see
+// CaptureMetricsBaseline), and fails the test with the series which don't otherwise. The series which did not exist when the
+// baseline was captured must have a value of 0. Meant to be called during the cleanup of the tests, eg:
+//
+//	t.Cleanup(func() {
+//		hostAwait.AssertMetricsBackToBaseline(t, wait.SpacesMetric, wait.UsersPerActivationsAndDomainMetric)
+//	})
+func (a *Awaitility) AssertMetricsBackToBaseline(t T, families ...string) {
+	t.Helper()
+	if len(families) == 0 {
+		// some metrics always change (eg: the CPU time of the process), so comparing all the families would never succeed
+		t.Fatal("at least one metric family must be given")
+	}
+	a.logf(t, "waiting until metrics %v reached their baseline again...", families)
+	baseline := a.baselines.getSnapshot()
+	var deltas []metrics.SeriesDelta
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		current, err := a.MetricsClient.Snapshot()
+		if err != nil {
+			a.logf(t, "unable to scrape the metrics: %s", err.Error())
+			return false, nil
+		}
+		deltas = baseline.Diff(current, families...)
+		return len(deltas) == 0, nil
+	})
+	if err != nil {
+		a.exportMetrics(t, "failure")
+	}
+	if err != nil && len(deltas) > 0 {
+		msg := &strings.Builder{}
+		for _, d := range deltas {
+			fmt.Fprintf(msg, "\n%s%s: baseline=%v, actual=%v", d.Family, d.LabelsString(), d.Before, d.After)
+		}
+		err = fmt.Errorf("%w: the following series are not back to their baseline:%s", err, msg.String())
+	}
+	require.NoError(t, err)
+}
@@ -0,0 +1,132 @@
+package wait_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestMetricBaselinesConcurrency verifies that the baselines of the metrics can be captured again while other goroutines
+// wait for the metrics with the same Awaitility (run with `make test-race` to detect the data races)
+func TestMetricBaselinesConcurrency(t *testing.T) {
+	// given
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "# TYPE sandbox_member_operator_version gauge\nsandbox_member_operator_version 1\n")
+	}))
+	defer ts.Close()
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "toolchain-member-operator",
+			Name:      "member-operator-controller-manager",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"control-plane": "controller-manager"},
+				},
+			},
+		},
+	}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "toolchain-member-operator",
+			Name:      "member-operator-metrics-service",
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"control-plane": "controller-manager"},
+			Ports:    []corev1.ServicePort{{Name: "https", Port: 8443}},
+		},
+	}
+	memberAwait := wait.NewMemberAwaitility(nil, test.NewFakeClient(t, deployment, service), "toolchain-member-operator", "member-cluster")
+	memberAwait.MetricsClient = metrics.NewClient(metrics.StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), metrics.StaticToken("token"))
+	memberAwait.InitMetrics(t)
+	// a copy shares the baselines of the Awaitility it was created from
+	a := memberAwait.WithRetryOptions(wait.RetryInterval(10*time.Millisecond), wait.TimeoutOption(time.Second))
+
+	// when
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			a.WaitForMetricDelta(t, wait.MemberOperatorVersionMetric, 0)
+		}()
+		go func() {
+			defer wg.Done()
+			memberAwait.InitMetrics(t)
+		}()
+	}
+
+	// then
+	wg.Wait()
+}
+
+func TestAssertMetricsBackToBaseline(t *testing.T) {
+	// given
+	var spaces atomic.Value
+	spaces.Store(3)
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "# TYPE sandbox_spaces_current gauge\nsandbox_spaces_current{cluster_name=\"member-1\"} %d\n", spaces.Load())
+		fmt.Fprint(w, "# TYPE sandbox_user_signups_total counter\nsandbox_user_signups_total 10\n")
+	}))
+	defer ts.Close()
+	newAwaitility := func(t *testing.T) *wait.Awaitility {
+		a := &wait.Awaitility{
+			Client:        test.NewFakeClient(t),
+			Namespace:     "toolchain-host-operator",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       200 * time.Millisecond,
+			MetricsClient: metrics.NewClient(metrics.StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), metrics.StaticToken("token")),
+		}
+		a.CaptureMetricsBaseline(t)
+		return a
+	}
+
+	t.Run("back to baseline", func(t *testing.T) {
+		// given
+		spaces.Store(3)
+		a := newAwaitility(t)
+		spaces.Store(5)
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			spaces.Store(3)
+		}()
+
+		// when
+		a.AssertMetricsBackToBaseline(t, wait.SpacesMetric, wait.UserSignupsMetric)
+
+		// then
+		a.WaitForMetricDelta(t, wait.SpacesMetric, 0, "cluster_name", "member-1")
+	})
+
+	t.Run("not back to baseline", func(t *testing.T) {
+		// given
+		spaces.Store(3)
+		a := newAwaitility(t)
+		spaces.Store(5)
+		out := &strings.Builder{}
+
+		// when
+		ok := wait.RunStandalone(t.Name(), out, func(st wait.T) {
+			a.AssertMetricsBackToBaseline(st, wait.SpacesMetric)
+		})
+
+		// then
+		assert.False(t, ok)
+		assert.Contains(t, out.String(), `sandbox_spaces_current{cluster_name="member-1"}: baseline=3, actual=5`)
+	})
+}
@@ -0,0 +1,112 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CachedReadsVar the name of the env var which enables the cached reads of the hot resources (when set to `true`), see WithCachedReads
+const CachedReadsVar = "E2E_CACHED_READS"
+
+// CachedReadsEnabled returns `true` if the reads of the hot resources should be served by a cache
+func CachedReadsEnabled() bool {
+	return CurrentEnvironment().CachedReads
+}
+
+// HotObjects the types of the objects which are read over and over by the waits, and whose reads are served by the cache
+// when no other type is specified (see WithCachedReads)
+func HotObjects() []client.Object {
+	return []client.Object{
+		&toolchainv1alpha1.ToolchainStatus{},
+		&toolchainv1alpha1.ToolchainConfig{},
+		&appsv1.Deployment{},
+	}
+}
+
+// WithCachedReads returns a new Awaitility whose client reads the objects of the given types (or the HotObjects if none is specified)
+// in the namespace of the Awaitility from a shared informer cache, instead of getting them from the API server on each attempt of the waits.
+// The informers are started on the first read of each type, and stopped when the given context is done.
+// Since the cache is eventually consistent, the objects read after an update may be stale: this is fine for the waits, which re-read the objects
+// until they match, but an update based on a stale object is rejected with a conflict.
+func (a *Awaitility) WithCachedReads(ctx context.Context, objs ...client.Object) (*Awaitility, error) {
+	c, err := cache.New(a.RestConfig, cache.Options{
+		Scheme:    a.Client.Scheme(),
+		Mapper:    a.Client.RESTMapper(),
+		Namespace: a.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		_ = c.Start(ctx)
+	}()
+	if !c.WaitForCacheSync(ctx) {
+		return nil, fmt.Errorf("unable to start the cache of the '%s' cluster", a.LogLabel())
+	}
+	return a.WithCachedReader(c, objs...), nil
+}
+
+// WithCachedReader returns a new Awaitility whose client reads the objects of the given types (or the HotObjects if none is specified)
+// in the namespace of the Awaitility from the given reader (eg: a cache), and all the other objects from the API server
+func (a *Awaitility) WithCachedReader(reader client.Reader, objs ...client.Object) *Awaitility {
+	if len(objs) == 0 {
+		objs = HotObjects()
+	}
+	cached := make(map[reflect.Type]bool, len(objs))
+	for _, obj := range objs {
+		cached[reflect.TypeOf(obj)] = true
+	}
+	result := a.copy()
+	result.Client = &cachedReadClient{
+		Client:    a.Client,
+		reader:    reader,
+		namespace: a.Namespace,
+		cached:    cached,
+	}
+	return result
+}
+
+// WithCachedReads returns a new HostAwaitility whose client reads the objects of the given types from a cache (see Awaitility.WithCachedReads)
+func (a *HostAwaitility) WithCachedReads(ctx context.Context, objs ...client.Object) (*HostAwaitility, error) {
+	cached, err := a.Awaitility.WithCachedReads(ctx, objs...)
+	if err != nil {
+		return nil, err
+	}
+	result := *a
+	result.Awaitility = cached
+	return &result, nil
+}
+
+// WithCachedReads returns a new MemberAwaitility whose client reads the objects of the given types from a cache (see Awaitility.WithCachedReads)
+func (a *MemberAwaitility) WithCachedReads(ctx context.Context, objs ...client.Object) (*MemberAwaitility, error) {
+	cached, err := a.Awaitility.WithCachedReads(ctx, objs...)
+	if err != nil {
+		return nil, err
+	}
+	result := *a
+	result.Awaitility = cached
+	return &result, nil
+}
+
+// cachedReadClient a client which gets the objects of the cached types in the given namespace from the reader,
+// and delegates all the other calls to the underlying client
+type cachedReadClient struct {
+	client.Client
+	reader    client.Reader
+	namespace string
+	cached    map[reflect.Type]bool
+}
+
+func (c *cachedReadClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if key.Namespace == c.namespace && c.cached[reflect.TypeOf(obj)] {
+		return c.reader.Get(ctx, key, obj, opts...)
+	}
+	return c.Client.Get(ctx, key, obj, opts...)
+}
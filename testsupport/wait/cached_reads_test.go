@@ -0,0 +1,89 @@
+package wait_test
+
+import (
+	"context"
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestWithCachedReader(t *testing.T) {
+	// given
+	deployment := func(replicas int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "host-operator-controller-manager",
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+			},
+		}
+	}
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "config",
+		},
+	}
+	otherDeployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "other",
+			Name:      "host-operator-controller-manager",
+		},
+	}
+	// the cache only has a (stale) copy of the deployment
+	cache := test.NewFakeClient(t, deployment(1))
+	a := &wait.Awaitility{
+		Client:    test.NewFakeClient(t, deployment(2), configMap, otherDeployment),
+		Namespace: "test",
+	}
+
+	t.Run("hot objects", func(t *testing.T) {
+		// when
+		cached := a.WithCachedReader(cache)
+
+		// then
+		actual := &appsv1.Deployment{}
+		err := cached.Client.Get(context.TODO(), types.NamespacedName{Namespace: "test", Name: "host-operator-controller-manager"}, actual)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), *actual.Spec.Replicas)
+
+		t.Run("not cached in other namespaces", func(t *testing.T) {
+			err := cached.Client.Get(context.TODO(), types.NamespacedName{Namespace: "other", Name: "host-operator-controller-manager"}, &appsv1.Deployment{})
+			require.NoError(t, err)
+		})
+
+		t.Run("other types not cached", func(t *testing.T) {
+			err := cached.Client.Get(context.TODO(), types.NamespacedName{Namespace: "test", Name: "config"}, &corev1.ConfigMap{})
+			require.NoError(t, err)
+		})
+
+		t.Run("original awaitility not cached", func(t *testing.T) {
+			actual := &appsv1.Deployment{}
+			err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: "test", Name: "host-operator-controller-manager"}, actual)
+			require.NoError(t, err)
+			assert.Equal(t, int32(2), *actual.Spec.Replicas)
+		})
+	})
+
+	t.Run("given types", func(t *testing.T) {
+		// when
+		cached := a.WithCachedReader(cache, &toolchainv1alpha1.ToolchainStatus{})
+
+		// then
+		actual := &appsv1.Deployment{}
+		err := cached.Client.Get(context.TODO(), types.NamespacedName{Namespace: "test", Name: "host-operator-controller-manager"}, actual)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), *actual.Spec.Replicas)
+	})
+}
@@ -0,0 +1,185 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// dependent an object which is (transitively) owned by another object, via its `ownerReferences`
+type dependent struct {
+	kind string
+	obj  client.Object
+}
+
+func (d dependent) String() string {
+	if d.obj.GetNamespace() == "" {
+		return fmt.Sprintf("%s '%s'", d.kind, d.obj.GetName())
+	}
+	return fmt.Sprintf("%s '%s/%s'", d.kind, d.obj.GetNamespace(), d.obj.GetName())
+}
+
+// DeleteAndWaitForCascadingDeletion deletes the given parent object and waits until it is gone along with all the objects which
+// it owns, directly or transitively. The dependent objects are discovered before the deletion, by looking up the `ownerReferences`
+// of the objects of the given list types (in the namespace of the parent if it is namespaced), eg: `&appsv1.ReplicaSetList{}` and
+// `&corev1.PodList{}` for a Deployment.
+// Returns an error which lists the surviving objects if they were not all garbage collected before the timeout.
+func (a *Awaitility) DeleteAndWaitForCascadingDeletion(t T, parent client.Object, dependentTypes ...client.ObjectList) error {
+	recordWaiter(t)
+	if len(dependentTypes) == 0 {
+		return fmt.Errorf("no type of dependent objects specified for the deletion of '%s'", parent.GetName())
+	}
+	// reload the parent, so its UID is known when looking for its dependents
+	if err := a.Client.Get(a.getContext(), client.ObjectKeyFromObject(parent), parent); err != nil {
+		return err
+	}
+	dependents, err := a.listDependents(parent, dependentTypes)
+	if err != nil {
+		return err
+	}
+	a.logf(t, "deleting '%s' and waiting for its %d dependent object(s) to be garbage collected", parent.GetName(), len(dependents))
+	if err := a.Client.Delete(context.TODO(), parent, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	var survivors []string
+	err = a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		survivors = nil
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: parent.GetNamespace(), Name: parent.GetName()}, parent); err == nil {
+			survivors = append(survivors, fmt.Sprintf("parent '%s'", parent.GetName()))
+		} else if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		for _, d := range dependents {
+			obj := d.obj.DeepCopyObject().(client.Object)
+			err := a.Client.Get(a.getContext(), client.ObjectKeyFromObject(d.obj), obj)
+			if apierrors.IsNotFound(err) {
+				continue
+			} else if err != nil {
+				return false, err
+			}
+			// an object with the same name but another UID was recreated after the deletion (eg: by an operator)
+			if obj.GetUID() != d.obj.GetUID() {
+				continue
+			}
+			survivors = append(survivors, d.String())
+		}
+		return len(survivors) == 0, nil
+	})
+	if err != nil {
+		return fmt.Errorf("%d object(s) were not garbage collected after the deletion of '%s': %s: %w", len(survivors), parent.GetName(), strings.Join(survivors, ", "), err)
+	}
+	return nil
+}
+
+// WaitUntilObjectDeleted waits until the given object is gone (ie, the API server returns a `NotFound` error when fetching it).
+// The timeout can be configured with the `TimeoutOption` retry option, and the object is watched instead of polled when the
+// Awaitility was configured with `UseWatch`. If the object is still terminating after the timeout, the returned error
+// lists its remaining finalizers.
+func (a *Awaitility) WaitUntilObjectDeleted(t T, obj client.Object) error {
+	recordWaiter(t)
+	gvk, err := apiutil.GVKForObject(obj, a.Client.Scheme())
+	if err != nil {
+		return err
+	}
+	a.logf(t, "waiting until %s '%s' in namespace '%s' is deleted", gvk.Kind, obj.GetName(), obj.GetNamespace())
+	key := client.ObjectKeyFromObject(obj)
+	current := obj.DeepCopyObject().(client.Object)
+	var deletionTimestamp *metav1.Time
+	var finalizers []string
+	condition := func() (done bool, err error) {
+		if err := a.Client.Get(a.getContext(), key, current); err != nil {
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		deletionTimestamp = current.GetDeletionTimestamp()
+		finalizers = current.GetFinalizers()
+		return false, nil
+	}
+	if list := a.newListFor(gvk); list != nil {
+		err = a.pollOnObjectEvents(t, list, key.Namespace, key.Name, a.RetryInterval, a.Timeout, condition)
+	} else {
+		err = a.poll(t, a.RetryInterval, a.Timeout, condition)
+	}
+	if err != nil && deletionTimestamp != nil {
+		a.logf(t, "%s '%s' is stuck terminating since %s with the remaining finalizers: %v", gvk.Kind, key.Name, deletionTimestamp.Format(time.RFC3339), finalizers)
+		return fmt.Errorf("%s '%s' was not deleted: it has been terminating since %s with the remaining finalizers %v: %w", gvk.Kind, key.Name, deletionTimestamp.Format(time.RFC3339), finalizers, err)
+	} else if err != nil {
+		return fmt.Errorf("%s '%s' was not deleted: %w", gvk.Kind, key.Name, err)
+	}
+	return nil
+}
+
+// newListFor returns a new, empty list for the objects of the given kind, or nil if the list kind is not registered in the scheme
+func (a *Awaitility) newListFor(gvk schema.GroupVersionKind) client.ObjectList {
+	obj, err := a.Client.Scheme().New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err != nil {
+		return nil
+	}
+	list, _ := obj.(client.ObjectList)
+	return list
+}
+
+// listDependents returns all the objects of the given list types which are owned by the given parent object, directly or transitively
+func (a *Awaitility) listDependents(parent client.Object, dependentTypes []client.ObjectList) ([]dependent, error) {
+	var candidates []dependent
+	for _, list := range dependentTypes {
+		// a namespaced object can only own objects in the same namespace
+		if err := a.Client.List(a.getContext(), list, client.InNamespace(parent.GetNamespace())); err != nil {
+			return nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok {
+				return nil, fmt.Errorf("unexpected item of type %T in %T", item, list)
+			}
+			gvk, err := apiutil.GVKForObject(obj, a.Client.Scheme())
+			if err != nil {
+				return nil, err
+			}
+			candidates = append(candidates, dependent{kind: gvk.Kind, obj: obj})
+		}
+	}
+	return dependentsOf(parent.GetUID(), candidates), nil
+}
+
+// dependentsOf returns the candidates which are owned by the object with the given UID, directly or transitively
+func dependentsOf(uid types.UID, candidates []dependent) []dependent {
+	owners := map[types.UID]bool{uid: true}
+	var dependents []dependent
+	found := map[types.UID]bool{}
+	for {
+		added := false
+		for _, c := range candidates {
+			if found[c.obj.GetUID()] {
+				continue
+			}
+			for _, ref := range c.obj.GetOwnerReferences() {
+				if owners[ref.UID] {
+					dependents = append(dependents, c)
+					found[c.obj.GetUID()] = true
+					owners[c.obj.GetUID()] = true
+					added = true
+					break
+				}
+			}
+		}
+		if !added {
+			return dependents
+		}
+	}
+}
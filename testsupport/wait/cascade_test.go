@@ -0,0 +1,199 @@
+package wait_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeleteAndWaitForCascadingDeletion(t *testing.T) {
+
+	ownedBy := func(owner metav1.Object) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{APIVersion: "v1", Kind: "ConfigMap", Name: owner.GetName(), UID: owner.GetUID()}}
+	}
+	newObjects := func() (*corev1.ConfigMap, *corev1.ConfigMap, *corev1.Secret, *corev1.ConfigMap) {
+		parent := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "parent", UID: "parent-uid"},
+		}
+		child := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "child", UID: "child-uid", OwnerReferences: ownedBy(parent)},
+		}
+		grandChild := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "grand-child", UID: "grand-child-uid", OwnerReferences: ownedBy(child)},
+		}
+		unrelated := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "unrelated", UID: "unrelated-uid"},
+		}
+		return parent, child, grandChild, unrelated
+	}
+
+	t.Run("dependents garbage collected", func(t *testing.T) {
+		// given
+		parent, child, grandChild, unrelated := newObjects()
+		cl := test.NewFakeClient(t, parent, child, grandChild, unrelated)
+		a := &wait.Awaitility{
+			Client:        cl,
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       time.Second,
+		}
+		// the fake client has no garbage collector
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			_ = cl.Delete(context.TODO(), child.DeepCopy())
+			_ = cl.Delete(context.TODO(), grandChild.DeepCopy())
+		}()
+
+		// when
+		err := a.DeleteAndWaitForCascadingDeletion(t, parent, &corev1.ConfigMapList{}, &corev1.SecretList{})
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("dependents not garbage collected", func(t *testing.T) {
+		// given
+		parent, child, grandChild, unrelated := newObjects()
+		a := &wait.Awaitility{
+			Client:        test.NewFakeClient(t, parent, child, grandChild, unrelated),
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+
+		// when
+		err := a.DeleteAndWaitForCascadingDeletion(t, parent, &corev1.ConfigMapList{}, &corev1.SecretList{})
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "2 object(s) were not garbage collected after the deletion of 'parent': ConfigMap 'test/child', Secret 'test/grand-child'")
+		assert.NotContains(t, err.Error(), "unrelated")
+	})
+
+	t.Run("only dependents of the given types", func(t *testing.T) {
+		// given
+		parent, child, grandChild, unrelated := newObjects()
+		cl := test.NewFakeClient(t, parent, child, grandChild, unrelated)
+		a := &wait.Awaitility{
+			Client:        cl,
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       time.Second,
+		}
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			_ = cl.Delete(context.TODO(), child.DeepCopy())
+		}()
+
+		// when
+		err := a.DeleteAndWaitForCascadingDeletion(t, parent, &corev1.ConfigMapList{})
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("no dependent type", func(t *testing.T) {
+		// given
+		parent, _, _, _ := newObjects()
+		a := &wait.Awaitility{
+			Client: test.NewFakeClient(t, parent),
+		}
+
+		// when
+		err := a.DeleteAndWaitForCascadingDeletion(t, parent)
+
+		// then
+		require.EqualError(t, err, "no type of dependent objects specified for the deletion of 'parent'")
+	})
+}
+
+func TestWaitUntilObjectDeleted(t *testing.T) {
+
+	newConfigMap := func(name string, finalizers ...string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  "test",
+				Name:       name,
+				Finalizers: finalizers,
+			},
+		}
+	}
+
+	t.Run("already deleted", func(t *testing.T) {
+		// given
+		a := &wait.Awaitility{
+			Client:        test.NewFakeClient(t),
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+
+		// when
+		err := a.WaitUntilObjectDeleted(t, newConfigMap("config"))
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("deleted while waiting", func(t *testing.T) {
+		// given
+		cm := newConfigMap("config")
+		cl := test.NewFakeClient(t, cm)
+		a := &wait.Awaitility{
+			Client:        cl,
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       time.Second,
+		}
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			_ = cl.Delete(context.TODO(), cm.DeepCopy())
+		}()
+
+		// when
+		err := a.WaitUntilObjectDeleted(t, cm)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("stuck terminating", func(t *testing.T) {
+		// given
+		cm := newConfigMap("config", "toolchain.dev.openshift.com/finalizer")
+		cl := test.NewFakeClient(t, cm)
+		require.NoError(t, cl.Delete(context.TODO(), cm.DeepCopy()))
+		a := &wait.Awaitility{
+			Client:        cl,
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+
+		// when
+		err := a.WaitUntilObjectDeleted(t, cm)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ConfigMap 'config' was not deleted: it has been terminating since")
+		assert.Contains(t, err.Error(), "toolchain.dev.openshift.com/finalizer")
+	})
+
+	t.Run("not terminating", func(t *testing.T) {
+		// given
+		cm := newConfigMap("config")
+		a := &wait.Awaitility{
+			Client:        test.NewFakeClient(t, cm),
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+
+		// when
+		err := a.WaitUntilObjectDeleted(t, cm)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ConfigMap 'config' was not deleted: timed out waiting for the condition")
+	})
+}
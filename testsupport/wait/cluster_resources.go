@@ -0,0 +1,144 @@
+package wait
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// WaitForClusterResources waits until all the given expected objects (eg: the roles, service accounts and network configuration
+// templated onto a cluster when it is registered) exist in the cluster, and their content matches the expected one: all the fields
+// set in the expected objects (except their `metadata`, apart from the labels and annotations) must have the same value in the actual objects,
+// other fields are ignored.
+// If the resources don't match before the timeout, then the presence/content report of each expected object is printed,
+// and the returned error contains the differences of the mismatching objects.
+func (a *Awaitility) WaitForClusterResources(t T, expected ...client.Object) error {
+	recordWaiter(t)
+	a.logf(t, "waiting for %d cluster resource(s) to match the expected manifests", len(expected))
+	var report, mismatches []string
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		report = make([]string, 0, len(expected))
+		mismatches = nil
+		for _, obj := range expected {
+			diffs, err := a.clusterResourceDiffs(obj)
+			if err != nil {
+				return false, err
+			}
+			id := a.clusterResourceID(obj)
+			if len(diffs) == 0 {
+				report = append(report, fmt.Sprintf("  [ok] %s", id))
+				continue
+			}
+			mismatch := fmt.Sprintf("%s:\n    %s", id, strings.Join(diffs, "\n    "))
+			mismatches = append(mismatches, mismatch)
+			report = append(report, "  [mismatch] "+mismatch)
+		}
+		return len(mismatches) == 0, nil
+	})
+	if err != nil {
+		a.logf(t, "cluster resources not matching the expected manifests:\n%s", strings.Join(report, "\n"))
+		if len(mismatches) > 0 {
+			return fmt.Errorf("%d cluster resource(s) not matching the expected manifests: %s: %w", len(mismatches), strings.Join(mismatches, "\n"), err)
+		}
+	}
+	return err
+}
+
+func (a *Awaitility) clusterResourceID(obj client.Object) string {
+	kind := reflect.TypeOf(obj).Elem().Name()
+	if gvk, err := apiutil.GVKForObject(obj, a.Client.Scheme()); err == nil {
+		kind = gvk.Kind
+	}
+	if obj.GetNamespace() == "" {
+		return fmt.Sprintf("%s '%s'", kind, obj.GetName())
+	}
+	return fmt.Sprintf("%s '%s/%s'", kind, obj.GetNamespace(), obj.GetName())
+}
+
+// clusterResourceDiffs returns the differences between the given expected object and the actual one in the cluster
+func (a *Awaitility) clusterResourceDiffs(expected client.Object) ([]string, error) {
+	actual := expected.DeepCopyObject().(client.Object)
+	if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: expected.GetNamespace(), Name: expected.GetName()}, actual); err != nil {
+		if apierrors.IsNotFound(err) {
+			return []string{"not found"}, nil
+		}
+		return nil, err
+	}
+	expectedContent, err := manifestContent(expected)
+	if err != nil {
+		return nil, err
+	}
+	actualContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(actual)
+	if err != nil {
+		return nil, err
+	}
+	return subsetDiffs("", expectedContent, actualContent), nil
+}
+
+// manifestContent returns the content of the given object which must be compared, ie, without the `status` and with only the labels
+// and annotations of the `metadata`
+func manifestContent(obj client.Object) (map[string]interface{}, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	delete(content, "status")
+	delete(content, "apiVersion")
+	delete(content, "kind")
+	metadata := map[string]interface{}{}
+	if m, ok := content["metadata"].(map[string]interface{}); ok {
+		for _, key := range []string{"labels", "annotations"} {
+			if v, found := m[key]; found {
+				metadata[key] = v
+			}
+		}
+	}
+	content["metadata"] = metadata
+	return content, nil
+}
+
+// subsetDiffs returns the paths of the values which are set in `expected` but differ in `actual`
+func subsetDiffs(path string, expected, actual interface{}) []string {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected an object but got '%v'", pathOrRoot(path), actual)}
+		}
+		var diffs []string
+		for key, value := range e {
+			diffs = append(diffs, subsetDiffs(path+"."+key, value, a[key])...)
+		}
+		sort.Strings(diffs)
+		return diffs
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(e) {
+			return []string{fmt.Sprintf("%s: expected '%v' but got '%v'", pathOrRoot(path), expected, actual)}
+		}
+		var diffs []string
+		for i := range e {
+			diffs = append(diffs, subsetDiffs(fmt.Sprintf("%s[%d]", path, i), e[i], a[i])...)
+		}
+		return diffs
+	default:
+		if !reflect.DeepEqual(expected, actual) {
+			return []string{fmt.Sprintf("%s: expected '%v' but got '%v'", pathOrRoot(path), expected, actual)}
+		}
+		return nil
+	}
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "."
+	}
+	return path
+}
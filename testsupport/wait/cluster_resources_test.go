@@ -0,0 +1,113 @@
+package wait_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWaitForClusterResources(t *testing.T) {
+
+	sa := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "test",
+			Name:        "namespace-manager",
+			UID:         "sa-uid",
+			Labels:      map[string]string{"toolchain.dev.openshift.com/space": "john", "other": "label"},
+			Annotations: map[string]string{"should": "stay"},
+		},
+		Secrets: []corev1.ObjectReference{{Name: "namespace-manager-token"}},
+	}
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "viewer",
+		},
+		Rules: []rbacv1.PolicyRule{
+			{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}},
+		},
+	}
+	newAwaitility := func() *wait.Awaitility {
+		return &wait.Awaitility{
+			Client:        test.NewFakeClient(t, sa.DeepCopy(), role.DeepCopy()),
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+	}
+
+	t.Run("matching", func(t *testing.T) {
+		// given
+		a := newAwaitility()
+
+		// when only a subset of the fields is expected, and the rest of the metadata is ignored
+		err := a.WaitForClusterResources(t,
+			&corev1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:       "test",
+					Name:            "namespace-manager",
+					UID:             "other-uid",
+					ResourceVersion: "123",
+					Labels:          map[string]string{"toolchain.dev.openshift.com/space": "john"},
+				},
+			},
+			&rbacv1.Role{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "viewer",
+				},
+				Rules: []rbacv1.PolicyRule{
+					{Resources: []string{"pods"}, Verbs: []string{"get"}},
+				},
+			})
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("mismatching", func(t *testing.T) {
+		// given
+		a := newAwaitility()
+
+		// when
+		err := a.WaitForClusterResources(t,
+			&corev1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "test",
+					Name:        "namespace-manager",
+					Labels:      map[string]string{"toolchain.dev.openshift.com/space": "jane"},
+					Annotations: map[string]string{"should": "stay"},
+				},
+			},
+			&rbacv1.Role{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "viewer",
+				},
+				Rules: []rbacv1.PolicyRule{
+					{Resources: []string{"pods"}, Verbs: []string{"get", "list"}},
+				},
+			},
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "missing",
+				},
+			})
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "3 cluster resource(s) not matching the expected manifests")
+		assert.Contains(t, err.Error(), "ServiceAccount 'test/namespace-manager':\n    .metadata.labels.toolchain.dev.openshift.com/space: expected 'jane' but got 'john'")
+		assert.Contains(t, err.Error(), "Role 'test/viewer':\n    .rules[0].verbs: expected '[get list]' but got '[get]'")
+		assert.Contains(t, err.Error(), "ConfigMap 'test/missing':\n    not found")
+		assert.NotContains(t, err.Error(), "should")
+	})
+}
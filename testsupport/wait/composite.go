@@ -0,0 +1,72 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// WaitFunc a wait which stops as soon as the given context is done, ie: which waits with an Awaitility configured with this context
+// (see WithContext). The wait must return its error instead of failing the test (eg: with `require`), since it runs in its own goroutine.
+type WaitFunc func(ctx context.Context) error
+
+// WaitForAll runs the given waits concurrently, and returns once all of them are done.
+// If a wait fails, then the other ones are cancelled, and the error of the failed wait is returned.
+func WaitForAll(t T, waits ...WaitFunc) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	results := runConcurrently(ctx, waits)
+	var failed error
+	for range waits {
+		r := <-results
+		if r.err != nil && failed == nil {
+			failed = fmt.Errorf("wait #%d failed: %w", r.index, r.err)
+			t.Logf("%s, cancelling the other waits", failed)
+			cancel()
+		}
+	}
+	return failed
+}
+
+// WaitForAny runs the given waits concurrently, and returns the index of the first wait which succeeds, once the other ones are cancelled.
+// If all the waits fail, then their errors are returned.
+func WaitForAny(t T, waits ...WaitFunc) (int, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	results := runConcurrently(ctx, waits)
+	succeeded := -1
+	var failed error
+	for range waits {
+		r := <-results
+		switch {
+		case succeeded >= 0:
+			// ignore the results of the cancelled waits
+		case r.err == nil:
+			succeeded = r.index
+			t.Logf("wait #%d succeeded, cancelling the other waits", r.index)
+			cancel()
+		default:
+			failed = multierror.Append(failed, fmt.Errorf("wait #%d failed: %w", r.index, r.err))
+		}
+	}
+	if succeeded >= 0 {
+		return succeeded, nil
+	}
+	return -1, failed
+}
+
+type indexedWaitResult struct {
+	index int
+	err   error
+}
+
+func runConcurrently(ctx context.Context, waits []WaitFunc) <-chan indexedWaitResult {
+	results := make(chan indexedWaitResult, len(waits))
+	for i, w := range waits {
+		go func(index int, w WaitFunc) {
+			results <- indexedWaitResult{index: index, err: w(ctx)}
+		}(i, w)
+	}
+	return results
+}
@@ -0,0 +1,108 @@
+package wait_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// succeedAfter returns a wait which succeeds after the given delay, unless its context is done before
+func succeedAfter(delay time.Duration) wait.WaitFunc {
+	return func(ctx context.Context) error {
+		select {
+		case <-time.After(delay):
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// failAfter returns a wait which fails with the given error after the given delay
+func failAfter(delay time.Duration, err error) wait.WaitFunc {
+	return func(ctx context.Context) error {
+		time.Sleep(delay)
+		return err
+	}
+}
+
+func TestWaitForAll(t *testing.T) {
+
+	t.Run("all succeed", func(t *testing.T) {
+		// given
+		start := time.Now()
+
+		// when
+		err := wait.WaitForAll(t, succeedAfter(50*time.Millisecond), succeedAfter(50*time.Millisecond), succeedAfter(50*time.Millisecond))
+
+		// then
+		require.NoError(t, err)
+		// the waits ran concurrently
+		assert.Less(t, time.Since(start), 140*time.Millisecond)
+	})
+
+	t.Run("one fails and the others are cancelled", func(t *testing.T) {
+		// given
+		start := time.Now()
+		var cancelled error
+		slow := func(ctx context.Context) error {
+			cancelled = succeedAfter(time.Minute)(ctx)
+			return cancelled
+		}
+
+		// when
+		err := wait.WaitForAll(t, slow, failAfter(10*time.Millisecond, errors.New("mock error")))
+
+		// then
+		require.EqualError(t, err, "wait #1 failed: mock error")
+		assert.ErrorIs(t, cancelled, context.Canceled)
+		assert.Less(t, time.Since(start), time.Minute)
+	})
+
+	t.Run("no waits", func(t *testing.T) {
+		// when
+		err := wait.WaitForAll(t)
+
+		// then
+		require.NoError(t, err)
+	})
+}
+
+func TestWaitForAny(t *testing.T) {
+
+	t.Run("first success is returned and the others are cancelled", func(t *testing.T) {
+		// given
+		start := time.Now()
+		var cancelled error
+		slow := func(ctx context.Context) error {
+			cancelled = succeedAfter(time.Minute)(ctx)
+			return cancelled
+		}
+
+		// when
+		index, err := wait.WaitForAny(t, slow, failAfter(0, errors.New("mock error")), succeedAfter(20*time.Millisecond))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 2, index)
+		assert.ErrorIs(t, cancelled, context.Canceled)
+		assert.Less(t, time.Since(start), time.Minute)
+	})
+
+	t.Run("all fail", func(t *testing.T) {
+		// when
+		index, err := wait.WaitForAny(t, failAfter(0, errors.New("first error")), failAfter(20*time.Millisecond, errors.New("second error")))
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, -1, index)
+		assert.Contains(t, err.Error(), "wait #0 failed: first error")
+		assert.Contains(t, err.Error(), "wait #1 failed: second error")
+	})
+}
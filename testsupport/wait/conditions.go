@@ -60,6 +60,14 @@ func Provisioned() toolchainv1alpha1.Condition {
 	}
 }
 
+// Ready returns a `Ready` condition with the `True` status, regardless of its reason (see UntilHasConditions)
+func Ready() toolchainv1alpha1.Condition {
+	return toolchainv1alpha1.Condition{
+		Type:   toolchainv1alpha1.ConditionReady,
+		Status: corev1.ConditionTrue,
+	}
+}
+
 func PendingApproval() []toolchainv1alpha1.Condition {
 	return []toolchainv1alpha1.Condition{
 		{
@@ -0,0 +1,202 @@
+package wait
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// WaiterCoverageVar the name of the env var which enables the recording of the waiters used by each test (when set to `true`)
+const WaiterCoverageVar = "E2E_WAITER_COVERAGE"
+
+// waiterCoverage the names of the tests which used each waiter, indexed by waiter name (eg: `(*HostAwaitility).WaitForSpace`)
+var waiterCoverage = struct {
+	sync.Mutex
+	tests map[string]map[string]bool
+}{
+	tests: map[string]map[string]bool{},
+}
+
+// WaiterCoverageEnabled returns `true` if the recording of the waiters used by each test is enabled
+func WaiterCoverageEnabled() bool {
+	return CurrentEnvironment().WaiterCoverage
+}
+
+// waitPackage the path of this package, used to find the waiters in the call stack
+var waitPackage = reflect.TypeOf(Awaitility{}).PkgPath()
+
+// recordWaiter records that a waiter was used by the given test (only if the coverage is enabled).
+// It is meant to be called by the "leaf" waiters, ie, the ones which do the actual waiting: the recorded waiter is the
+// outermost waiter in the call stack, ie, the one which was called by the test, so that a waiter which delegates to
+// another waiter is recorded once, under its own name.
+func recordWaiter(t T) {
+	if !WaiterCoverageEnabled() {
+		return
+	}
+	name := outermostWaiter()
+	if name == "" {
+		return
+	}
+	// only keep the name of the top-level test, to keep the report readable
+	testName := strings.SplitN(t.Name(), "/", 2)[0]
+	waiterCoverage.Lock()
+	defer waiterCoverage.Unlock()
+	if waiterCoverage.tests[name] == nil {
+		waiterCoverage.tests[name] = map[string]bool{}
+	}
+	waiterCoverage.tests[name][testName] = true
+}
+
+// outermostWaiter returns the name of the outermost waiter in the call stack, ie, the one which was called by the test
+// (or an empty string if there is none)
+func outermostWaiter() string {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	name := ""
+	for {
+		frame, more := frames.Next()
+		if isWaiter(frame.Function) {
+			name = waiterName(frame.Function)
+		}
+		if !more {
+			break
+		}
+	}
+	return name
+}
+
+// isWaiter returns `true` if the given func name is the name of an exported func or method of this package which contains `Wait`,
+// or of one of the waiters whose name does not contain `Wait` (see waiterFuncs and waiterMethods).
+// The closures such as the conditions of the polls are excluded.
+func isWaiter(funcName string) bool {
+	if !strings.HasPrefix(funcName, waitPackage+".") {
+		return false
+	}
+	name := waiterName(funcName)
+	if knownWaiters[name] {
+		return true
+	}
+	name = name[strings.LastIndex(name, ".")+1:]
+	return strings.Contains(name, "Wait") && unicode.IsUpper([]rune(name)[0])
+}
+
+// waiterName trims the package path and the type parameters (if any) from the given func name, eg:
+// `github.com/codeready-toolchain/toolchain-e2e/testsupport/wait.(*HostAwaitility).WaitForSpace` becomes `(*HostAwaitility).WaitForSpace`
+// and `github.com/codeready-toolchain/toolchain-e2e/testsupport/wait.WaitForObject[...]` becomes `WaitForObject`
+func waiterName(funcName string) string {
+	funcName = strings.TrimSuffix(funcName, "[...]")
+	funcName = funcName[strings.LastIndex(funcName, "/")+1:]
+	return strings.TrimPrefix(funcName, "wait.")
+}
+
+// waiterFuncs the waiters which are funcs instead of methods (and thus, which can't be listed via reflection)
+var waiterFuncs = []string{
+	"DeleteSingleton",
+	"NeverAppears",
+	"WaitForObject",
+	"WaitForObjectMatchingYAML",
+	"WaitForObjectsMatching",
+	"WaitForReconciled",
+	"WaitForRecreatedObject",
+}
+
+// waiterMethods the waiters which are methods whose name does not contain `Wait` (and thus, which are not listed via reflection)
+var waiterMethods = []string{
+	"(*MemberAwaitility).DiscoverMetricsService",
+}
+
+// knownWaiters the waiters which are not recognized by their name
+var knownWaiters = func() map[string]bool {
+	known := map[string]bool{}
+	for _, name := range append(append([]string{}, waiterFuncs...), waiterMethods...) {
+		known[name] = true
+	}
+	return known
+}()
+
+// allWaiters returns the names of all the waiters of the Awaitility, HostAwaitility, MemberAwaitility and Awaitilities types,
+// along with the waiter funcs and the waiters which are not recognized by their name
+func allWaiters() []string {
+	names := append(append([]string{}, waiterFuncs...), waiterMethods...)
+	awaitilityType := reflect.TypeOf(&Awaitility{})
+	for _, typ := range []reflect.Type{awaitilityType, reflect.TypeOf(&HostAwaitility{}), reflect.TypeOf(&MemberAwaitility{}), reflect.TypeOf(Awaitilities{})} {
+		for i := 0; i < typ.NumMethod(); i++ {
+			m := typ.Method(i)
+			if !strings.Contains(m.Name, "Wait") {
+				continue
+			}
+			// methods promoted from the embedded Awaitility are reported under the Awaitility type (unlike the methods which shadow them with another signature)
+			if am, found := awaitilityType.MethodByName(m.Name); found && typ != awaitilityType && sameSignature(am.Type, m.Type) {
+				continue
+			}
+			if typ.Kind() == reflect.Pointer {
+				names = append(names, fmt.Sprintf("(*%s).%s", typ.Elem().Name(), m.Name))
+			} else {
+				names = append(names, fmt.Sprintf("%s.%s", typ.Name(), m.Name))
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sameSignature returns `true` if the given method types have the same params (except their receiver) and results
+func sameSignature(m1, m2 reflect.Type) bool {
+	if m1.NumIn() != m2.NumIn() || m1.NumOut() != m2.NumOut() || m1.IsVariadic() != m2.IsVariadic() {
+		return false
+	}
+	for i := 1; i < m1.NumIn(); i++ {
+		if m1.In(i) != m2.In(i) {
+			return false
+		}
+	}
+	for i := 0; i < m1.NumOut(); i++ {
+		if m1.Out(i) != m2.Out(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// WriteWaiterCoverageReport writes the number of tests which used each waiter during the test suite,
+// followed by the waiters which were not used by any test
+func WriteWaiterCoverageReport(out io.Writer) error {
+	waiterCoverage.Lock()
+	defer waiterCoverage.Unlock()
+	// the waiters which were recorded at runtime are also reported, in case they are missing from the known waiters
+	names := allWaiters()
+	listed := map[string]bool{}
+	for _, name := range names {
+		listed[name] = true
+	}
+	for name := range waiterCoverage.tests {
+		if !listed[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var used, unused []string
+	for _, name := range names {
+		if tests, found := waiterCoverage.tests[name]; found {
+			used = append(used, fmt.Sprintf("  %s: %d test(s)", name, len(tests)))
+		} else {
+			unused = append(unused, fmt.Sprintf("  %s", name))
+		}
+	}
+	report := &strings.Builder{}
+	report.WriteString(fmt.Sprintf("waiters used during the test suite (%d):\n", len(used)))
+	for _, u := range used {
+		report.WriteString(u + "\n")
+	}
+	report.WriteString(fmt.Sprintf("waiters not used by any test (%d):\n", len(unused)))
+	for _, u := range unused {
+		report.WriteString(u + "\n")
+	}
+	_, err := io.WriteString(out, report.String())
+	return err
+}
@@ -0,0 +1,134 @@
+package wait_test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestWriteWaiterCoverageReport(t *testing.T) {
+	// given
+	wait.OverrideEnvironment(t, func(env *wait.Environment) {
+		env.WaiterCoverage = true
+	})
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "host-operator-metrics-service",
+		},
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "config",
+		},
+	}
+	hostAwait := &wait.HostAwaitility{
+		Awaitility: &wait.Awaitility{
+			Client:        test.NewFakeClient(t, svc, cm),
+			Namespace:     "test",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		},
+	}
+
+	// when
+	hostAwait.WaitForMetricsService(t) // delegates to `WaitForService`
+	_, err := wait.WaitForObject[*corev1.ConfigMap](t, hostAwait.Awaitility, types.NamespacedName{Namespace: "test", Name: "config"})
+	require.NoError(t, err)
+	report := &strings.Builder{}
+	err = wait.WriteWaiterCoverageReport(report)
+
+	// then
+	require.NoError(t, err)
+	sections := strings.Split(report.String(), "waiters not used by any test")
+	require.Len(t, sections, 2)
+	used, unused := sections[0], sections[1]
+	assert.Contains(t, used, "  (*HostAwaitility).WaitForMetricsService: 1 test(s)\n")
+	assert.Contains(t, used, "  WaitForObject: 1 test(s)\n")
+	// the waiter which was only called by another waiter is not recorded
+	assert.Contains(t, unused, "  (*Awaitility).WaitForService\n")
+	// the other waiters are listed, including the ones whose name does not start with `Wait`
+	assert.Contains(t, unused, "  (*HostAwaitility).WaitForSpace\n")
+	assert.Contains(t, unused, "  (*MemberAwaitility).WaitForNSTmplSet\n")
+	assert.Contains(t, unused, "  (*Awaitility).DeleteAndWaitForCascadingDeletion\n")
+	assert.Contains(t, unused, "  NeverAppears\n")
+	assert.Contains(t, unused, "  (*MemberAwaitility).DiscoverMetricsService\n")
+	assert.Contains(t, unused, "  Awaitilities.WaitUntilHostMetricMatchesMembers\n")
+	// the waiters which shadow a waiter of the embedded Awaitility with another signature are listed too
+	assert.Contains(t, unused, "  (*HostAwaitility).WaitForOperatorImage\n")
+	// the waiters promoted from the embedded Awaitility are not listed twice
+	assert.NotContains(t, report.String(), "(*HostAwaitility).WaitForService")
+}
+
+func TestWaiterCoverageReportListsAllRecordedWaiters(t *testing.T) {
+	// given
+	report := &strings.Builder{}
+	require.NoError(t, wait.WriteWaiterCoverageReport(report))
+	pkgs, err := parser.ParseDir(token.NewFileSet(), ".", func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+	require.Contains(t, pkgs, "wait")
+
+	// when
+	var recorders []string
+	for _, file := range pkgs["wait"].Files {
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil && callsRecordWaiter(fn.Body) {
+				recorders = append(recorders, funcDeclName(fn))
+			}
+		}
+	}
+
+	// then
+	require.NotEmpty(t, recorders)
+	for _, name := range recorders {
+		listed := strings.Contains(report.String(), "\n  "+name+"\n") || strings.Contains(report.String(), "\n  "+name+": ")
+		assert.True(t, listed, "waiter '%s' calls `recordWaiter` but is not listed in the coverage report", name)
+	}
+}
+
+// callsRecordWaiter returns `true` if the given func body contains a call to `recordWaiter`
+func callsRecordWaiter(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == "recordWaiter" {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// funcDeclName returns the name of the given func as listed in the coverage report, eg: `(*HostAwaitility).WaitForSpace`,
+// `Awaitilities.WaitUntilHostMetricMatchesMembers` or `WaitForObject`
+func funcDeclName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+	switch recv := fn.Recv.List[0].Type.(type) {
+	case *ast.StarExpr:
+		return fmt.Sprintf("(*%s).%s", recv.X.(*ast.Ident).Name, fn.Name.Name)
+	case *ast.Ident:
+		return fmt.Sprintf("%s.%s", recv.Name, fn.Name.Name)
+	default:
+		return fn.Name.Name
+	}
+}
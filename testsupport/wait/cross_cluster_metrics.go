@@ -0,0 +1,111 @@
+package wait
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MemberCountFunc returns a count computed on the given member cluster, which is compared to the value of a metric of the host operator
+// (see WaitUntilHostMetricMatchesMembers), eg: the number of NSTemplateSets provisioned on the member
+type MemberCountFunc func(member *MemberAwaitility) (float64, error)
+
+// NSTemplateSetCount returns the number of NSTemplateSets in the namespace of the member operator, ie: the number of Spaces provisioned
+// on the member cluster, as counted by SpacesMetric
+func NSTemplateSetCount(member *MemberAwaitility) (float64, error) {
+	return countOf(member, &toolchainv1alpha1.NSTemplateSetList{})
+}
+
+// UserAccountCount returns the number of UserAccounts in the namespace of the member operator, ie: the number of users provisioned
+// on the member cluster
+func UserAccountCount(member *MemberAwaitility) (float64, error) {
+	return countOf(member, &toolchainv1alpha1.UserAccountList{})
+}
+
+func countOf(member *MemberAwaitility, list client.ObjectList) (float64, error) {
+	if err := member.Client.List(member.getContext(), list, client.InNamespace(member.Namespace)); err != nil {
+		return -1, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return -1, err
+	}
+	return float64(len(items)), nil
+}
+
+// WaitUntilHostMetricMatchesMembers waits until the value of the given metric of the host operator for each member cluster (ie: its series
+// with the `cluster_name` label of the member) is equal to the count computed on that member with the given func (within the tolerance
+// of the HostAwaitility, see WithTolerance), eg: to verify that the number of Spaces counted by the host operator is in sync with the
+// NSTemplateSets provisioned on the members. A missing series is considered as `0`.
+//
+//	err := awaitilities.WaitUntilHostMetricMatchesMembers(t, wait.SpacesMetric, wait.NSTemplateSetCount)
+func (a Awaitilities) WaitUntilHostMetricMatchesMembers(t T, family string, count MemberCountFunc) error {
+	hostAwait := a.Host()
+	recordWaiter(t)
+	hostAwait.logf(t, "waiting for metric '%s' to match the counts of the member clusters", family)
+	var mismatches []string
+	err := hostAwait.poll(t, hostAwait.RetryInterval, hostAwait.Timeout, func() (done bool, err error) {
+		mismatches = nil
+		for _, member := range a.AllMembers() {
+			expected, err := count(member)
+			if err != nil {
+				return false, err
+			}
+			series, err := hostAwait.MetricsClient.GetSeries(family, []string{"cluster_name", member.ClusterName})
+			if err != nil {
+				// keep waiting, the endpoint may be temporarily unavailable
+				mismatches = append(mismatches, fmt.Sprintf("%s: %s", member.ClusterName, err.Error()))
+				continue
+			}
+			if actual := metrics.Sum(series); math.Abs(actual-expected) > hostAwait.tolerance {
+				mismatches = append(mismatches, fmt.Sprintf("%s: metric=%v, member=%v", member.ClusterName, actual, expected))
+			}
+		}
+		return len(mismatches) == 0, nil
+	})
+	if err != nil {
+		hostAwait.exportMetrics(t, "failure")
+		return fmt.Errorf("waited for metric '%s' to match the counts of the member clusters%s. Mismatches: [%s]: %w", family,
+			hostAwait.toleranceString(), strings.Join(mismatches, ", "), err)
+	}
+	return nil
+}
+
+// WaitUntilHostMetricTotalMatchesMembers waits until the sum of the values of all the series of the given metric of the host operator is
+// equal to the sum of the counts computed on all the member clusters with the given func (within the tolerance of the HostAwaitility,
+// see WithTolerance), eg: to verify that the number of MasterUserRecords counted by the host operator across all the domains is in sync
+// with the UserAccounts provisioned on the members.
+//
+//	err := awaitilities.WaitUntilHostMetricTotalMatchesMembers(t, wait.MasterUserRecordsPerDomainMetric, wait.UserAccountCount)
+func (a Awaitilities) WaitUntilHostMetricTotalMatchesMembers(t T, family string, count MemberCountFunc) error {
+	hostAwait := a.Host()
+	recordWaiter(t)
+	hostAwait.logf(t, "waiting for the sum of metric '%s' to match the total of the member clusters", family)
+	var actual, expected float64
+	err := hostAwait.poll(t, hostAwait.RetryInterval, hostAwait.Timeout, func() (done bool, err error) {
+		expected = 0
+		for _, member := range a.AllMembers() {
+			c, err := count(member)
+			if err != nil {
+				return false, err
+			}
+			expected += c
+		}
+		series, err := hostAwait.MetricsClient.GetSeries(family, []string{})
+		// if error occurred, return `false` to keep waiting (may be due to endpoint temporarily unavailable)
+		actual = metrics.Sum(series)
+		return err == nil && math.Abs(actual-expected) <= hostAwait.tolerance, nil
+	})
+	if err != nil {
+		hostAwait.exportMetrics(t, "failure")
+		return fmt.Errorf("waited for the sum of metric '%s' to match the total of the member clusters%s. Metric: %v, members: %v: %w", family,
+			hostAwait.toleranceString(), actual, expected, err)
+	}
+	return nil
+}
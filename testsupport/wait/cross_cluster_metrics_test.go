@@ -0,0 +1,88 @@
+package wait_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestHostMetricMatchesMembers(t *testing.T) {
+	// given
+	var member1Spaces atomic.Int64
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "# TYPE sandbox_spaces_current gauge\n")
+		fmt.Fprintf(w, "sandbox_spaces_current{cluster_name=\"member-1\"} %d\n", member1Spaces.Load())
+		fmt.Fprint(w, "sandbox_spaces_current{cluster_name=\"member-2\"} 1\n")
+	}))
+	defer ts.Close()
+	nsTemplateSet := func(name string) client.Object {
+		return &toolchainv1alpha1.NSTemplateSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "toolchain-member-operator", Name: name},
+		}
+	}
+	hostAwait := wait.NewHostAwaitility(nil, test.NewFakeClient(t), "toolchain-host-operator", "toolchain-host-operator")
+	hostAwait.MetricsClient = metrics.NewClient(metrics.StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), metrics.StaticToken("token"), metrics.WithCacheTTL(0))
+	hostAwait = hostAwait.WithRetryOptions(wait.RetryInterval(10*time.Millisecond), wait.TimeoutOption(100*time.Millisecond))
+	member1 := wait.NewMemberAwaitility(nil, test.NewFakeClient(t, nsTemplateSet("john"), nsTemplateSet("jane")), "toolchain-member-operator", "member-1")
+	member2 := wait.NewMemberAwaitility(nil, test.NewFakeClient(t, nsTemplateSet("jack")), "toolchain-member-operator", "member-2")
+	awaitilities := wait.NewAwaitilities(hostAwait, member1, member2)
+
+	t.Run("per member", func(t *testing.T) {
+		t.Run("in sync", func(t *testing.T) {
+			member1Spaces.Store(2)
+			require.NoError(t, awaitilities.WaitUntilHostMetricMatchesMembers(t, wait.SpacesMetric, wait.NSTemplateSetCount))
+		})
+
+		t.Run("out of sync", func(t *testing.T) {
+			// given
+			member1Spaces.Store(3)
+
+			// when
+			err := awaitilities.WaitUntilHostMetricMatchesMembers(t, wait.SpacesMetric, wait.NSTemplateSetCount)
+
+			// then
+			require.Error(t, err)
+			assert.True(t, wait.IsTimeout(err))
+			assert.Contains(t, err.Error(), "waited for metric 'sandbox_spaces_current' to match the counts of the member clusters. Mismatches: [member-1: metric=3, member=2]")
+		})
+	})
+
+	t.Run("total", func(t *testing.T) {
+		t.Run("in sync", func(t *testing.T) {
+			member1Spaces.Store(2)
+			require.NoError(t, awaitilities.WaitUntilHostMetricTotalMatchesMembers(t, wait.SpacesMetric, wait.NSTemplateSetCount))
+		})
+
+		t.Run("out of sync", func(t *testing.T) {
+			// given
+			member1Spaces.Store(1)
+
+			// when
+			err := awaitilities.WaitUntilHostMetricTotalMatchesMembers(t, wait.SpacesMetric, wait.NSTemplateSetCount)
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "waited for the sum of metric 'sandbox_spaces_current' to match the total of the member clusters. Metric: 2, members: 3")
+		})
+	})
+
+	t.Run("user accounts", func(t *testing.T) {
+		count, err := wait.UserAccountCount(member1)
+		require.NoError(t, err)
+		assert.Equal(t, float64(0), count)
+	})
+}
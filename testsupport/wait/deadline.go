@@ -0,0 +1,54 @@
+package wait
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// TestDeadlineMargin the time kept before the deadline of the test (see the `-timeout` flag of `go test`) when a wait is shortened
+// so that it does not outlive the test: this leaves enough time for the test to report the failure and to clean up its resources,
+// instead of being killed by the panic of the test binary
+const TestDeadlineMargin = 30 * time.Second
+
+// ErrTestDeadlineImminent the error returned by the waits which gave up before their timeout, because the deadline of the test is imminent.
+// It wraps `wait.ErrWaitTimeout`, so that the callers which expect a timeout still match it with `errors.Is`.
+var ErrTestDeadlineImminent = fmt.Errorf("test deadline imminent: %w", wait.ErrWaitTimeout)
+
+// testDeadlineTimeout returns the given timeout of a wait, or the time left until the deadline of the given test (minus TestDeadlineMargin)
+// if the test would be over before the end of the timeout. In the latter case, also returns `true`.
+func testDeadlineTimeout(t T, timeout time.Duration) (time.Duration, bool) {
+	if t == nil {
+		return timeout, false
+	}
+	deadline, ok := t.Deadline()
+	if !ok {
+		return timeout, false
+	}
+	left := time.Until(deadline) - TestDeadlineMargin
+	if left >= timeout {
+		return timeout, false
+	}
+	if left < 0 {
+		left = 0
+	}
+	return left, true
+}
+
+// testDeadlineImminent logs why the wait gave up before the given timeout, along with the stacks of all the goroutines
+// (which is what the panic of the test binary would have shown), and returns ErrTestDeadlineImminent
+func (a *Awaitility) testDeadlineImminent(t T, timeout time.Duration) error {
+	t.Helper()
+	deadline, _ := t.Deadline()
+	waiter := outermostWaiter()
+	if waiter == "" {
+		waiter = "wait"
+	}
+	stacks := make([]byte, 1<<20)
+	stacks = stacks[:runtime.Stack(stacks, true)]
+	a.logf(t, "test deadline imminent: %s gave up before its timeout of %s, since the test must end by %s (with a margin of %s)\ngoroutines:\n%s",
+		waiter, timeout, deadline.Format(time.RFC3339), TestDeadlineMargin, stacks)
+	return ErrTestDeadlineImminent
+}
@@ -0,0 +1,203 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/httpclient"
+
+	routev1 "github.com/openshift/api/route/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// EndpointKind the kind of resource which exposes a service outside of the cluster
+type EndpointKind string
+
+const (
+	// RouteEndpoint an OpenShift Route
+	RouteEndpoint EndpointKind = "Route"
+	// IngressEndpoint a `networking.k8s.io/v1` Ingress, when the Route API is not available (eg: on a kind or minikube cluster)
+	IngressEndpoint EndpointKind = "Ingress"
+)
+
+// Endpoint a service exposed outside of the cluster by a Route, or by an Ingress when the Route API is not available (see SetupRouteForService)
+type Endpoint struct {
+	Kind      EndpointKind
+	Namespace string
+	Name      string
+	// Host the host (and optional port) on which the service is exposed
+	Host string
+	// TLS whether the service is exposed over HTTPS
+	TLS bool
+	// Route the route which exposes the service, if the endpoint is a RouteEndpoint
+	Route *routev1.Route
+}
+
+// URL returns the URL of the given path on the host of the endpoint
+func (e Endpoint) URL(path string) string {
+	if e.TLS {
+		return "https://" + e.Host + path
+	}
+	return "http://" + e.Host + path
+}
+
+// routeEndpoint returns the Endpoint of the given route
+func routeEndpoint(route routev1.Route) Endpoint {
+	host := ""
+	if len(route.Status.Ingress) > 0 {
+		host = route.Status.Ingress[0].Host
+	}
+	return Endpoint{
+		Kind:      RouteEndpoint,
+		Namespace: route.Namespace,
+		Name:      route.Name,
+		Host:      host,
+		TLS:       route.Spec.TLS != nil,
+		Route:     &route,
+	}
+}
+
+// ingressEndpoint returns the Endpoint of the given ingress, on the address of its load balancer (the ingress controller serves it
+// over HTTPS, with its default certificate)
+func ingressEndpoint(ingress networkingv1.Ingress) Endpoint {
+	host := ""
+	if len(ingress.Status.LoadBalancer.Ingress) > 0 {
+		if host = ingress.Status.LoadBalancer.Ingress[0].Hostname; host == "" {
+			host = ingress.Status.LoadBalancer.Ingress[0].IP
+		}
+	}
+	return Endpoint{
+		Kind:      IngressEndpoint,
+		Namespace: ingress.Namespace,
+		Name:      ingress.Name,
+		Host:      host,
+		TLS:       true,
+	}
+}
+
+// IsRouteAPIUnavailable returns `true` if the given error was returned because the Route API is not available on the cluster
+// (eg: a kind or minikube cluster), or not registered in the scheme of the client
+func IsRouteAPIUnavailable(err error) bool {
+	return meta.IsNoMatchError(err) || runtime.IsNotRegisteredError(err)
+}
+
+// setupIngressForService if needed, creates an ingress for the given service (with the same namespace/name), which forwards the requests
+// to the target port of the service of the given route config (`https` by default) on its host, if any, and waits until it is available
+// (see WaitForIngressToBeAvailable)
+func (a *Awaitility) setupIngressForService(t T, namespace, serviceName, endpoint string, config *routeConfig) (Endpoint, error) {
+	a.logf(t, "the Route API is not available, setting up ingress for service '%s' with endpoint '%s'", serviceName, endpoint)
+	ingress := networkingv1.Ingress{}
+	if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: namespace, Name: serviceName}, &ingress); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return Endpoint{}, fmt.Errorf("failed to get ingress to access the '%s' service: %w", serviceName, err)
+		}
+		pathType := networkingv1.PathTypePrefix
+		ingress = networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   namespace,
+				Name:        serviceName,
+				Annotations: config.ingressAnnotations(),
+			},
+			Spec: networkingv1.IngressSpec{
+				Rules: []networkingv1.IngressRule{
+					{
+						Host: config.host,
+						IngressRuleValue: networkingv1.IngressRuleValue{
+							HTTP: &networkingv1.HTTPIngressRuleValue{
+								Paths: []networkingv1.HTTPIngressPath{
+									{
+										Path:     endpoint,
+										PathType: &pathType,
+										Backend: networkingv1.IngressBackend{
+											Service: &networkingv1.IngressServiceBackend{
+												Name: serviceName,
+												Port: config.ingressBackendPort(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		if err := a.Client.Create(context.TODO(), &ingress); err != nil {
+			return Endpoint{}, err
+		}
+	}
+	return a.WaitForIngressToBeAvailable(t, namespace, serviceName, endpoint)
+}
+
+// WaitForIngressToBeAvailable waits until the given ingress is available, ie, its load balancer has an address and the endpoint
+// is reachable on it (with a `200 OK` status response). The certificate served by the ingress controller is not verified, since
+// it is usually its self-signed default certificate.
+func (a *Awaitility) WaitForIngressToBeAvailable(t T, ns, name, endpoint string) (Endpoint, error) {
+	recordWaiter(t)
+	a.logf(t, "waiting for ingress '%s' in namespace '%s'", name, ns)
+	var result Endpoint
+	client := httpclient.New(httpclient.WithTimeout(5*time.Second), httpclient.WithTransport(a.newInsecureTransport()),
+		httpclient.WithTokenProvider(a.TokenProvider()))
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		ingress := networkingv1.Ingress{}
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: ns, Name: name}, &ingress); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		result = ingressEndpoint(ingress)
+		if result.Host == "" {
+			return false, nil
+		}
+		request, err := http.NewRequest("GET", result.URL(endpoint), nil)
+		if err != nil {
+			return false, err
+		}
+		resp, err := client.Do(request)
+		urlError := &url.Error{}
+		if errors.As(err, &urlError) && urlError.Timeout() {
+			// keep waiting if there was a timeout: the endpoint is not available yet (pod is still re-starting)
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		return resp.StatusCode == http.StatusOK, nil
+	})
+	return result, err
+}
+
+// getEndpointHost returns the host of the route with the given name in the namespace of the Awaitility or, if the Route API is not
+// available, the host of the ingress with the same name
+func (a *Awaitility) getEndpointHost(name string) (string, error) {
+	route := routev1.Route{}
+	err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, &route)
+	if IsRouteAPIUnavailable(err) {
+		ingress := networkingv1.Ingress{}
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, &ingress); err != nil {
+			return "", err
+		}
+		if e := ingressEndpoint(ingress); e.Host != "" {
+			return e.Host, nil
+		}
+		return "", fmt.Errorf("ingress '%s' in namespace '%s' has no load balancer address", name, a.Namespace)
+	} else if err != nil {
+		return "", err
+	}
+	if e := routeEndpoint(route); e.Host != "" {
+		return e.Host, nil
+	}
+	return "", fmt.Errorf("route '%s' in namespace '%s' has no ingress host", name, a.Namespace)
+}
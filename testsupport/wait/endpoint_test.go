@@ -0,0 +1,110 @@
+package wait_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSetupRouteForServiceWithoutRouteAPI(t *testing.T) {
+	// given
+	s := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, networkingv1.AddToScheme(s))
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "# TYPE sandbox_user_signups_total counter\nsandbox_user_signups_total 10\n")
+	}))
+	defer server.Close()
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "toolchain-host-operator",
+			Name:      "host-operator-metrics-service",
+		},
+	}
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "toolchain-host-operator",
+			Name:      "host-operator-metrics-service",
+		},
+		Status: networkingv1.IngressStatus{
+			LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+				Ingress: []networkingv1.IngressLoadBalancerIngress{
+					{Hostname: strings.TrimPrefix(server.URL, "https://")},
+				},
+			},
+		},
+	}
+	newAwaitility := func(objs ...runtime.Object) *wait.Awaitility {
+		return &wait.Awaitility{
+			Client:        fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build(),
+			Namespace:     "toolchain-host-operator",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+	}
+
+	t.Run("ingress available", func(t *testing.T) {
+		// given
+		a := newAwaitility(service, ingress)
+
+		// when
+		endpoint, err := a.SetupRouteForService(t, "host-operator-metrics-service", "/metrics")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, wait.IngressEndpoint, endpoint.Kind)
+		assert.Equal(t, server.URL+"/metrics", endpoint.URL("/metrics"))
+		assert.Nil(t, endpoint.Route)
+
+		t.Run("metrics scraped via the ingress", func(t *testing.T) {
+			// when
+			value, err := a.NewMetricsClient("host-operator-metrics-service", metrics.StaticToken("token")).GetMetricValue(wait.UserSignupsMetric, []string{})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, float64(10), value)
+		})
+	})
+
+	t.Run("ingress created", func(t *testing.T) {
+		// given
+		a := newAwaitility(service)
+
+		// when
+		_, err := a.SetupRouteForService(t, "host-operator-metrics-service", "/metrics")
+
+		// then
+		// the ingress has no load balancer address
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+		created := &networkingv1.Ingress{}
+		require.NoError(t, a.Client.Get(context.TODO(), types.NamespacedName{Namespace: "toolchain-host-operator", Name: "host-operator-metrics-service"}, created))
+		require.Len(t, created.Spec.Rules, 1)
+		path := created.Spec.Rules[0].HTTP.Paths[0]
+		assert.Equal(t, "/metrics", path.Path)
+		assert.Equal(t, "host-operator-metrics-service", path.Backend.Service.Name)
+		assert.Equal(t, "https", path.Backend.Service.Port.Name)
+		assert.Equal(t, "HTTPS", created.Annotations["nginx.ingress.kubernetes.io/backend-protocol"])
+	})
+}
+
+func TestEndpointURL(t *testing.T) {
+	assert.Equal(t, "https://api.example.com/proxyhealth", wait.Endpoint{Host: "api.example.com", TLS: true}.URL("/proxyhealth"))
+	assert.Equal(t, "http://api.example.com/proxyhealth", wait.Endpoint{Host: "api.example.com"}.URL("/proxyhealth"))
+}
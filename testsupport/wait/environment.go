@@ -0,0 +1,300 @@
+package wait
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	testutil "github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
+)
+
+func init() {
+	// the transports of the `util` package apply the DNS overrides and trust the CA bundle of the current environment,
+	// which they can't read themselves
+	testutil.SetDNSOverrides(func() map[string]string {
+		return CurrentEnvironment().DNSOverrides
+	})
+	testutil.SetCABundle(func() string {
+		return CurrentEnvironment().RouteCABundle
+	})
+	testutil.SetKubeClientTLSVerifyDisabled(func() bool {
+		return CurrentEnvironment().DisableKubeClientTLSVerify
+	})
+}
+
+const (
+	// ArtifactDirVar the name of the env var with the dir in which the reports of the tests are written (set by the CI)
+	ArtifactDirVar = "ARTIFACT_DIR"
+	// TimeoutVar the name of the env var which overrides the default timeout of the Awaitilities (eg: `3m`)
+	TimeoutVar = "E2E_TIMEOUT"
+	// RetryIntervalVar the name of the env var which overrides the default retry interval of the Awaitilities (eg: `200ms`)
+	RetryIntervalVar = "E2E_RETRY_INTERVAL"
+	// PodMetricsRequiredVar the name of the env var which makes the tests which need the memory usage of the pods fail instead of
+	// being skipped when the metrics of the pods are not available (when set to `true`)
+	PodMetricsRequiredVar = "E2E_REQUIRE_POD_METRICS"
+	// DNSOverridesVar the name of the env var with the host→IP overrides used by the HTTP clients of the tests, as a comma-separated list
+	// of `host=ip` entries (see `util.ParseDNSOverrides`)
+	DNSOverridesVar = "E2E_DNS_OVERRIDES"
+	// DisableKubeClientTLSVerifyVar the name of the env var which disables the verification of the certificates of the API servers
+	// by the clients of the tests (when set to `true`)
+	DisableKubeClientTLSVerifyVar = testutil.EnvDisableKubeClientTLSVerify
+	// WorkingDirVar the name of the env var with the working dir of the tests, which tells if the tests run in parallel (ie, in the
+	// `parallel` package)
+	WorkingDirVar = "PWD"
+)
+
+// Environment the configuration of the tests, which is given with env vars (see LoadEnvironment), or programmatically (eg: by the
+// setup tool, see SetEnvironment). It is loaded once, and the current environment is read with CurrentEnvironment.
+type Environment struct {
+	// HostNs the namespace of the host operator (see HostNsVar)
+	HostNs string
+	// MemberNs the namespace of the first member operator (see MemberNsVar)
+	MemberNs string
+	// MemberNs2 the namespace of the second member operator (see MemberNsVar2)
+	MemberNs2 string
+	// RegistrationServiceNs the namespace of the registration service (see RegistrationServiceVar)
+	RegistrationServiceNs string
+	// HostOperatorImage the image of the new version of the host operator, when upgrading the operators (see HostOperatorImageVar)
+	HostOperatorImage string
+	// MemberOperatorImage the image of the new version of the member operator, when upgrading the operators (see MemberOperatorImageVar)
+	MemberOperatorImage string
+	// ArtifactDir the dir in which the reports are written (see ArtifactDirVar), or empty if the reports are written in the temp dir
+	ArtifactDir string
+	// Timeout the timeout of the Awaitilities (see TimeoutVar)
+	Timeout time.Duration
+	// RetryInterval the retry interval of the Awaitilities (see RetryIntervalVar)
+	RetryInterval time.Duration
+	// ClientRateLimits the rate limits of the clients to the API servers (see ClientQPSVar and ClientBurstVar)
+	ClientRateLimits ClientRateLimits
+	// LogFormat the format of the log lines of the Awaitilities: `text` or `json` (see LogFormatVar)
+	LogFormat string
+	// LogColors whether the labels prefixing the log lines are colored (see LogColorsVar)
+	LogColors bool
+	// WaitTelemetry whether the duration of the waits of each test is recorded (see WaitTelemetryVar)
+	WaitTelemetry bool
+	// Narrative whether the major steps of each test are recorded (see NarrativeVar)
+	Narrative bool
+	// CachedReads whether the reads of the hot resources are served from a cache (see CachedReadsVar)
+	CachedReads bool
+	// WaiterCoverage whether the waiters used by each test are recorded (see WaiterCoverageVar)
+	WaiterCoverage bool
+	// MetricDeltas whether the metrics which changed during each test are reported (see MetricDeltasVar)
+	MetricDeltas bool
+	// MetricArtifacts whether the metrics scraped during each test are exported in the artifact dir (see MetricArtifactsVar)
+	MetricArtifacts bool
+	// MetricBaselines where the named baselines of the metrics are persisted: `file`, `configmap`, or empty if they are not persisted
+	// (see MetricBaselinesVar)
+	MetricBaselines string
+	// TestRunID the ID of the run of the tests, which keys the persisted baselines of the metrics (see TestRunIDVar)
+	TestRunID string
+	// RouteCABundle the path of a PEM bundle of additional CAs trusted when verifying the certificates served on the routes,
+	// and by the HTTP clients of the tests (see RouteCABundleVar)
+	RouteCABundle string
+	// PodMetricsRequired whether the tests which need the metrics of the pods fail when they are not available (see PodMetricsRequiredVar)
+	PodMetricsRequired bool
+	// DNSOverrides the host→IP overrides used by the HTTP clients of the tests, by lowercase host (see DNSOverridesVar)
+	DNSOverrides map[string]string
+	// DisableKubeClientTLSVerify whether the certificates of the API servers are not verified (see DisableKubeClientTLSVerifyVar)
+	DisableKubeClientTLSVerify bool
+	// WorkingDir the working dir of the tests (see WorkingDirVar)
+	WorkingDir string
+}
+
+// DefaultEnvironment returns the environment with the default values, ie, without any namespace
+func DefaultEnvironment() Environment {
+	return Environment{
+		Timeout:       DefaultTimeout,
+		RetryInterval: DefaultRetryInterval,
+		LogFormat:     "text",
+	}
+}
+
+// LoadEnvironment loads the environment from the env vars, with the default values for the ones which are not set.
+// Returns an error listing all the env vars with an invalid value, along with the environment in which they were left to
+// their default value
+func LoadEnvironment() (Environment, error) {
+	env := DefaultEnvironment()
+	env.HostNs = os.Getenv(HostNsVar)
+	env.MemberNs = os.Getenv(MemberNsVar)
+	env.MemberNs2 = os.Getenv(MemberNsVar2)
+	env.RegistrationServiceNs = os.Getenv(RegistrationServiceVar)
+	env.HostOperatorImage = os.Getenv(HostOperatorImageVar)
+	env.MemberOperatorImage = os.Getenv(MemberOperatorImageVar)
+	env.ArtifactDir = os.Getenv(ArtifactDirVar)
+	env.TestRunID = os.Getenv(TestRunIDVar)
+	env.RouteCABundle = os.Getenv(RouteCABundleVar)
+	env.WorkingDir = os.Getenv(WorkingDirVar)
+
+	var msgs []string
+	if v := os.Getenv(ClientQPSVar); v != "" {
+		if qps, err := strconv.ParseFloat(v, 32); err != nil || qps <= 0 {
+			msgs = append(msgs, fmt.Sprintf("invalid value of the '%s' env var: '%s' (expected a positive number)", ClientQPSVar, v))
+		} else {
+			env.ClientRateLimits.QPS = float32(qps)
+		}
+	}
+	if v := os.Getenv(ClientBurstVar); v != "" {
+		if burst, err := strconv.Atoi(v); err != nil || burst <= 0 {
+			msgs = append(msgs, fmt.Sprintf("invalid value of the '%s' env var: '%s' (expected a positive integer)", ClientBurstVar, v))
+		} else {
+			env.ClientRateLimits.Burst = burst
+		}
+	}
+	if v := os.Getenv(DNSOverridesVar); v != "" {
+		if overrides, err := testutil.ParseDNSOverrides(v); err != nil {
+			msgs = append(msgs, fmt.Sprintf("invalid value of the '%s' env var: %s", DNSOverridesVar, err))
+		} else {
+			env.DNSOverrides = overrides
+		}
+	}
+	for name, d := range map[string]*time.Duration{
+		TimeoutVar:       &env.Timeout,
+		RetryIntervalVar: &env.RetryInterval,
+	} {
+		if err := lookupDuration(name, d); err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+	for name, b := range map[string]*bool{
+		LogColorsVar:                  &env.LogColors,
+		WaitTelemetryVar:              &env.WaitTelemetry,
+		NarrativeVar:                  &env.Narrative,
+		CachedReadsVar:                &env.CachedReads,
+		WaiterCoverageVar:             &env.WaiterCoverage,
+		MetricDeltasVar:               &env.MetricDeltas,
+		MetricArtifactsVar:            &env.MetricArtifacts,
+		PodMetricsRequiredVar:         &env.PodMetricsRequired,
+		DisableKubeClientTLSVerifyVar: &env.DisableKubeClientTLSVerify,
+	} {
+		if err := lookupBool(name, b); err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+	switch v := os.Getenv(LogFormatVar); v {
+	case "":
+	case "text", "json":
+		env.LogFormat = v
+	default:
+		msgs = append(msgs, fmt.Sprintf("invalid value of the '%s' env var: '%s' (expected 'text' or 'json')", LogFormatVar, v))
+	}
+	switch v := os.Getenv(MetricBaselinesVar); v {
+	case "":
+	case "file", "configmap":
+		if env.TestRunID == "" {
+			msgs = append(msgs, fmt.Sprintf("the '%s' env var is required when the '%s' env var is set", TestRunIDVar, MetricBaselinesVar))
+			break
+		}
+		env.MetricBaselines = v
+	default:
+		msgs = append(msgs, fmt.Sprintf("invalid value of the '%s' env var: '%s' (expected 'file' or 'configmap')", MetricBaselinesVar, v))
+	}
+	if len(msgs) > 0 {
+		// the order of the iterations over the maps is random
+		sort.Strings(msgs)
+		return env, fmt.Errorf("invalid environment: %s", strings.Join(msgs, "; "))
+	}
+	return env, nil
+}
+
+func lookupDuration(name string, d *time.Duration) error {
+	v := os.Getenv(name)
+	if v == "" {
+		return nil
+	}
+	parsed, err := time.ParseDuration(v)
+	if err != nil || parsed <= 0 {
+		return fmt.Errorf("invalid value of the '%s' env var: '%s' (expected a positive duration)", name, v)
+	}
+	*d = parsed
+	return nil
+}
+
+func lookupBool(name string, b *bool) error {
+	v := os.Getenv(name)
+	if v == "" {
+		return nil
+	}
+	parsed, err := strconv.ParseBool(v)
+	if err != nil {
+		return fmt.Errorf("invalid value of the '%s' env var: '%s' (expected 'true' or 'false')", name, v)
+	}
+	*b = parsed
+	return nil
+}
+
+// Validate checks that the environment contains the namespaces of all the components, which are needed by the e2e tests
+// (but not by the setup tool), including the namespace of the second member operator and the one of the registration service,
+// even if the tests of a package do not use them
+func (e Environment) Validate() error {
+	var missing []string
+	for name, ns := range map[string]string{
+		HostNsVar:              e.HostNs,
+		MemberNsVar:            e.MemberNs,
+		MemberNsVar2:           e.MemberNs2,
+		RegistrationServiceVar: e.RegistrationServiceNs,
+	} {
+		if ns == "" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("missing namespaces in the environment: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+var (
+	environment       Environment
+	environmentLoaded bool
+	environmentLock   sync.RWMutex
+)
+
+// CurrentEnvironment returns the current environment: the one given to SetEnvironment, or else the one loaded from the env vars on
+// the first call (in which case the env vars with an invalid value are ignored: use LoadEnvironment first to report them)
+func CurrentEnvironment() Environment {
+	environmentLock.RLock()
+	if environmentLoaded {
+		defer environmentLock.RUnlock()
+		return environment
+	}
+	environmentLock.RUnlock()
+
+	environmentLock.Lock()
+	defer environmentLock.Unlock()
+	if !environmentLoaded {
+		environment, _ = LoadEnvironment()
+		environmentLoaded = true
+	}
+	return environment
+}
+
+// SetEnvironment sets the current environment, eg: the one loaded and validated in the `TestMain` func (see RunSuite in the
+// `testsupport` package), or the one configured programmatically by the setup tool
+func SetEnvironment(env Environment) {
+	environmentLock.Lock()
+	defer environmentLock.Unlock()
+	environment = env
+	environmentLoaded = true
+}
+
+// OverrideEnvironment modifies the current environment with the given func until the end of the given test, eg:
+//
+//	wait.OverrideEnvironment(t, func(env *wait.Environment) {
+//		env.WaiterCoverage = true
+//	})
+//
+// Since the environment is shared by all the tests of the package, it must not be used by the tests which run in parallel.
+func OverrideEnvironment(t T, modify func(env *Environment)) {
+	previous := CurrentEnvironment()
+	env := previous
+	modify(&env)
+	SetEnvironment(env)
+	t.Cleanup(func() {
+		SetEnvironment(previous)
+	})
+}
@@ -0,0 +1,185 @@
+package wait_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadEnvironment(t *testing.T) {
+	allVars := []string{wait.HostNsVar, wait.MemberNsVar, wait.MemberNsVar2, wait.RegistrationServiceVar, wait.ArtifactDirVar,
+		wait.TimeoutVar, wait.RetryIntervalVar, wait.ClientQPSVar, wait.ClientBurstVar, wait.LogFormatVar, wait.LogColorsVar,
+		wait.WaitTelemetryVar, wait.NarrativeVar, wait.CachedReadsVar, wait.WaiterCoverageVar, wait.MetricDeltasVar, wait.MetricArtifactsVar,
+		wait.PodMetricsRequiredVar, wait.MetricBaselinesVar, wait.TestRunIDVar, wait.RouteCABundleVar, wait.DNSOverridesVar,
+		wait.DisableKubeClientTLSVerifyVar, wait.WorkingDirVar}
+	unsetAll := func(t *testing.T) {
+		for _, name := range allVars {
+			t.Setenv(name, "")
+		}
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		// given
+		unsetAll(t)
+
+		// when
+		env, err := wait.LoadEnvironment()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, wait.DefaultEnvironment(), env)
+		assert.Equal(t, wait.DefaultTimeout, env.Timeout)
+		assert.Equal(t, wait.DefaultRetryInterval, env.RetryInterval)
+		assert.Equal(t, "text", env.LogFormat)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		// given
+		unsetAll(t)
+		t.Setenv(wait.HostNsVar, "toolchain-host-operator")
+		t.Setenv(wait.MemberNsVar, "toolchain-member-operator")
+		t.Setenv(wait.MemberNsVar2, "toolchain-member2-operator")
+		t.Setenv(wait.RegistrationServiceVar, "toolchain-host-operator")
+		t.Setenv(wait.ArtifactDirVar, "/tmp/artifacts")
+		t.Setenv(wait.TimeoutVar, "3m")
+		t.Setenv(wait.RetryIntervalVar, "200ms")
+		t.Setenv(wait.ClientQPSVar, "50.5")
+		t.Setenv(wait.ClientBurstVar, "100")
+		t.Setenv(wait.DNSOverridesVar, "Console.apps.example.com=10.0.0.12")
+		t.Setenv(wait.LogFormatVar, "json")
+		t.Setenv(wait.WaitTelemetryVar, "true")
+		t.Setenv(wait.WaiterCoverageVar, "true")
+		t.Setenv(wait.MetricBaselinesVar, "configmap")
+		t.Setenv(wait.TestRunIDVar, "pr-1234")
+		t.Setenv(wait.DisableKubeClientTLSVerifyVar, "true")
+		t.Setenv(wait.WorkingDirVar, "/go/src/toolchain-e2e/test/e2e/parallel")
+
+		// when
+		env, err := wait.LoadEnvironment()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, wait.Environment{
+			HostNs:                     "toolchain-host-operator",
+			MemberNs:                   "toolchain-member-operator",
+			MemberNs2:                  "toolchain-member2-operator",
+			RegistrationServiceNs:      "toolchain-host-operator",
+			ArtifactDir:                "/tmp/artifacts",
+			Timeout:                    3 * time.Minute,
+			RetryInterval:              200 * time.Millisecond,
+			ClientRateLimits:           wait.ClientRateLimits{QPS: 50.5, Burst: 100},
+			LogFormat:                  "json",
+			WaitTelemetry:              true,
+			WaiterCoverage:             true,
+			MetricBaselines:            "configmap",
+			TestRunID:                  "pr-1234",
+			DNSOverrides:               map[string]string{"console.apps.example.com": "10.0.0.12"},
+			DisableKubeClientTLSVerify: true,
+			WorkingDir:                 "/go/src/toolchain-e2e/test/e2e/parallel",
+		}, env)
+		assert.NoError(t, env.Validate())
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		// given
+		unsetAll(t)
+		t.Setenv(wait.TimeoutVar, "forever")
+		t.Setenv(wait.NarrativeVar, "yes please")
+		t.Setenv(wait.LogFormatVar, "xml")
+		t.Setenv(wait.CachedReadsVar, "true")
+
+		// when
+		env, err := wait.LoadEnvironment()
+
+		// then
+		require.EqualError(t, err, "invalid environment: "+
+			"invalid value of the 'E2E_LOG_FORMAT' env var: 'xml' (expected 'text' or 'json'); "+
+			"invalid value of the 'E2E_NARRATIVE' env var: 'yes please' (expected 'true' or 'false'); "+
+			"invalid value of the 'E2E_TIMEOUT' env var: 'forever' (expected a positive duration)")
+		// the invalid values are left to their default value
+		assert.Equal(t, wait.DefaultTimeout, env.Timeout)
+		assert.Equal(t, "text", env.LogFormat)
+		assert.True(t, env.CachedReads)
+	})
+
+	t.Run("invalid rate limits and DNS overrides", func(t *testing.T) {
+		for name, vars := range map[string]map[string]string{
+			"qps not a number":    {wait.ClientQPSVar: "fast"},
+			"negative qps":        {wait.ClientQPSVar: "-1"},
+			"burst not a number":  {wait.ClientBurstVar: "10.5"},
+			"zero burst":          {wait.ClientBurstVar: "0"},
+			"dns override no ip":  {wait.DNSOverridesVar: "console.apps.example.com"},
+			"dns override bad ip": {wait.DNSOverridesVar: "console.apps.example.com=10.0.0"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// given
+				unsetAll(t)
+				for k, v := range vars {
+					t.Setenv(k, v)
+				}
+
+				// when
+				env, err := wait.LoadEnvironment()
+
+				// then
+				require.Error(t, err)
+				for k := range vars {
+					assert.Contains(t, err.Error(), "invalid value of the '"+k+"' env var: ")
+				}
+				assert.Equal(t, wait.ClientRateLimits{}, env.ClientRateLimits)
+				assert.Empty(t, env.DNSOverrides)
+			})
+		}
+	})
+
+	t.Run("metric baselines without run ID", func(t *testing.T) {
+		// given
+		unsetAll(t)
+		t.Setenv(wait.MetricBaselinesVar, "file")
+
+		// when
+		env, err := wait.LoadEnvironment()
+
+		// then
+		require.EqualError(t, err, "invalid environment: the 'E2E_TEST_RUN_ID' env var is required when the 'E2E_METRIC_BASELINES' env var is set")
+		assert.Empty(t, env.MetricBaselines)
+	})
+}
+
+func TestValidateEnvironment(t *testing.T) {
+	// given
+	env := wait.DefaultEnvironment()
+	env.HostNs = "toolchain-host-operator"
+	env.MemberNs = "toolchain-member-operator"
+
+	// when
+	err := env.Validate()
+
+	// then
+	require.EqualError(t, err, "missing namespaces in the environment: MEMBER_NS_2, REGISTRATION_SERVICE_NS")
+}
+
+func TestOverrideEnvironment(t *testing.T) {
+	// given
+	previous := wait.CurrentEnvironment()
+
+	t.Run("override", func(t *testing.T) {
+		// when
+		wait.OverrideEnvironment(t, func(env *wait.Environment) {
+			env.Narrative = !previous.Narrative
+			env.Timeout = time.Second
+		})
+
+		// then
+		assert.Equal(t, !previous.Narrative, wait.CurrentEnvironment().Narrative)
+		assert.Equal(t, !previous.Narrative, wait.NarrativeEnabled())
+		assert.Equal(t, time.Second, wait.CurrentEnvironment().Timeout)
+	})
+
+	// then
+	assert.Equal(t, previous, wait.CurrentEnvironment())
+}
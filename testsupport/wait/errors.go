@@ -0,0 +1,98 @@
+package wait
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// ErrTimeout the error returned by the waits whose condition was not met before their timeout. It wraps `wait.ErrWaitTimeout`
+// (or ErrTestDeadlineImminent if the wait was shortened to not outlive the test), so that the callers which expect a timeout still
+// match it with `errors.Is`. The waits which stop because the context of the Awaitility is done return the error of the context instead
+// (see WithContext).
+type ErrTimeout struct {
+	// Timeout the timeout of the wait
+	Timeout time.Duration
+	// LastState the last observed state of the awaited object, when the waiter provides it (eg: WaitForObject)
+	LastState string
+	cause     error
+}
+
+func (e *ErrTimeout) Error() string {
+	return fmt.Sprintf("%s (after %s)", e.cause.Error(), e.Timeout)
+}
+
+func (e *ErrTimeout) Unwrap() error {
+	return e.cause
+}
+
+// ErrAPI the error returned by the waits whose condition failed because of an error returned by the API server (eg: `403 Forbidden`),
+// as opposed to a timeout. It wraps the error of the API server, so that the predicates of the `k8s.io/apimachinery/pkg/api/errors`
+// package (eg: `IsForbidden`) still match it.
+type ErrAPI struct {
+	Cause error
+}
+
+func (e *ErrAPI) Error() string {
+	return e.Cause.Error()
+}
+
+func (e *ErrAPI) Unwrap() error {
+	return e.Cause
+}
+
+// IsTimeout returns `true` if the given error is returned by a wait which timed out
+func IsTimeout(err error) bool {
+	return errors.Is(err, wait.ErrWaitTimeout)
+}
+
+// IsAPIError returns `true` if the given error is returned by a wait which failed because of an error returned by the API server
+func IsAPIError(err error) bool {
+	var apiErr *ErrAPI
+	return errors.As(err, &apiErr)
+}
+
+// LastState returns the last observed state of the awaited object if the given error is returned by a wait which timed out,
+// and whose waiter provides it (otherwise, an empty string)
+func LastState(err error) string {
+	var timeoutErr *ErrTimeout
+	if errors.As(err, &timeoutErr) {
+		return timeoutErr.LastState
+	}
+	return ""
+}
+
+// typedWaitError returns the given error of a wait with the given timeout as an ErrTimeout or an ErrAPI, if it is not already one of them.
+// The other errors (eg: the errors of the conditions which are not returned by the API server) are returned as-is.
+func typedWaitError(err error, timeout time.Duration) error {
+	var timeoutErr *ErrTimeout
+	var apiErr *ErrAPI
+	var status apierrors.APIStatus
+	switch {
+	case err == nil, errors.As(err, &timeoutErr), errors.As(err, &apiErr):
+		return err
+	case errors.Is(err, wait.ErrWaitTimeout):
+		return &ErrTimeout{
+			Timeout: timeout,
+			cause:   err,
+		}
+	case errors.As(err, &status):
+		return &ErrAPI{
+			Cause: err,
+		}
+	default:
+		return err
+	}
+}
+
+// withLastState sets the given last observed state of the awaited object on the given error if it is an ErrTimeout
+func withLastState(err error, lastState string) error {
+	var timeoutErr *ErrTimeout
+	if errors.As(err, &timeoutErr) {
+		timeoutErr.LastState = lastState
+	}
+	return err
+}
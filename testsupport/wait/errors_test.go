@@ -0,0 +1,88 @@
+package wait_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait/waittest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8swait "k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestTypedWaitErrors(t *testing.T) {
+
+	t.Run("timeout", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t)
+		a := h.Awaitility.WithRetryOptions(wait.TimeoutOption(time.Second))
+
+		// when
+		_, err := h.Run(func() error {
+			return a.Poll(t, func() (bool, error) {
+				return false, nil
+			})
+		})
+
+		// then
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.True(t, wait.IsTimeout(err))
+		assert.False(t, wait.IsAPIError(err))
+		var timeoutErr *wait.ErrTimeout
+		require.ErrorAs(t, err, &timeoutErr)
+		assert.Equal(t, time.Second, timeoutErr.Timeout)
+		assert.Equal(t, "timed out waiting for the condition (after 1s)", err.Error())
+		assert.Empty(t, wait.LastState(err))
+	})
+
+	t.Run("API error", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t)
+		forbidden := apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "config", errors.New("mock error"))
+
+		// when
+		_, err := h.Run(func() error {
+			return h.Awaitility.Poll(t, func() (bool, error) {
+				return false, forbidden
+			})
+		})
+
+		// then
+		assert.True(t, wait.IsAPIError(err))
+		assert.False(t, wait.IsTimeout(err))
+		assert.True(t, apierrors.IsForbidden(err))
+		var apiErr *wait.ErrAPI
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, forbidden, apiErr.Cause)
+		assert.Equal(t, forbidden.Error(), err.Error())
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t)
+		mockErr := errors.New("mock error")
+
+		// when
+		_, err := h.Run(func() error {
+			return h.Awaitility.Poll(t, func() (bool, error) {
+				return false, mockErr
+			})
+		})
+
+		// then
+		assert.Equal(t, mockErr, err)
+		assert.False(t, wait.IsTimeout(err))
+		assert.False(t, wait.IsAPIError(err))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.False(t, wait.IsTimeout(nil))
+		assert.False(t, wait.IsAPIError(nil))
+		assert.Empty(t, wait.LastState(nil))
+	})
+}
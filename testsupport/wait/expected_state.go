@@ -0,0 +1,223 @@
+package wait
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ExpectedState the expected fields of an object, parsed from a YAML or JSON snippet (see ParseExpectedState)
+type ExpectedState struct {
+	fields map[string]interface{}
+}
+
+// ParseExpectedState parses the given YAML or JSON snippet of the expected fields of an object, eg:
+//
+//	spec:
+//	  replicas: 2
+//	status:
+//	  readyReplicas: 2
+//
+// The fields at the given JSONPath-style paths are removed from the expected ones, so that the snippet can contain fields whose value
+// can't be known in advance (eg: when the snippet is a fixture copied from a live object). A path is a sequence of `.field` or `['field']`
+// (for the fields which contain dots, eg: the labels), and of `[*]` or `[index]` for the items of a list, eg:
+// `.status.conditions[*].lastTransitionTime` or `.metadata.labels['toolchain.dev.openshift.com/owner']`
+func ParseExpectedState(snippet []byte, ignores ...string) (ExpectedState, error) {
+	fields := map[string]interface{}{}
+	if err := yaml.Unmarshal(snippet, &fields); err != nil {
+		return ExpectedState{}, fmt.Errorf("unable to parse the expected state: %w", err)
+	}
+	for _, ignore := range ignores {
+		path, err := parseFieldPath(ignore)
+		if err != nil {
+			return ExpectedState{}, err
+		}
+		removeField(fields, path)
+	}
+	return ExpectedState{
+		fields: fields,
+	}, nil
+}
+
+// UntilObjectMatchesState returns a `Criterion` which checks that the object contains all the fields of the given expected state,
+// with the same values: the object may have other fields, and the lists of the object may have other items (in any order)
+// as long as each expected item matches a distinct item of the object
+func UntilObjectMatchesState[T client.Object](expected ExpectedState) Criterion[T] {
+	return Criterion[T]{
+		Match: func(actual T) bool {
+			return len(expected.mismatches(actual)) == 0
+		},
+		Diff: func(actual T) string {
+			return fmt.Sprintf("expected %s '%s' to match the expected state:\n%s", objectKind[T](), actual.GetName(), strings.Join(expected.mismatches(actual), "\n"))
+		},
+	}
+}
+
+// WaitForObjectMatchingYAML waits until the object of type `T` with the given key contains all the fields of the given
+// YAML or JSON snippet, except the ones at the given paths (see ParseExpectedState and UntilObjectMatchesState), eg:
+//
+//	fixture, err := os.ReadFile("testdata/registration-service.yaml")
+//	require.NoError(t, err)
+//	_, err = wait.WaitForObjectMatchingYAML[*appsv1.Deployment](t, hostAwait.Awaitility, key, fixture, ".metadata.annotations")
+func WaitForObjectMatchingYAML[T client.Object](t testingT, a *Awaitility, key types.NamespacedName, snippet []byte, ignores ...string) (T, error) {
+	expected, err := ParseExpectedState(snippet, ignores...)
+	require.NoError(t, err)
+	return WaitForObject(t, a, key, UntilObjectMatchesState[T](expected))
+}
+
+// mismatches returns the fields of the given object which don't match the expected state (or an empty slice if they all match)
+func (s ExpectedState) mismatches(obj client.Object) []string {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	actual := map[string]interface{}{}
+	if err := json.Unmarshal(data, &actual); err != nil {
+		return []string{err.Error()}
+	}
+	return compareFields("", s.fields, actual)
+}
+
+// compareFields returns the mismatches between the expected and the actual value at the given path
+func compareFields(path string, expected, actual interface{}) []string {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected an object, actual: %v", pathOrRoot(path), actual)}
+		}
+		keys := make([]string, 0, len(e))
+		for k := range e {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var mismatches []string
+		for _, k := range keys {
+			mismatches = append(mismatches, compareFields(fieldPath(path, k), e[k], a[k])...)
+		}
+		return mismatches
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected a list, actual: %v", pathOrRoot(path), actual)}
+		}
+		var mismatches []string
+		matched := make([]bool, len(a))
+	items:
+		for i, item := range e {
+			for j := range a {
+				if !matched[j] && len(compareFields("", item, a[j])) == 0 {
+					matched[j] = true
+					continue items
+				}
+			}
+			mismatches = append(mismatches, fmt.Sprintf("%s[%d]: no matching item for %v, actual: %v", path, i, item, a))
+		}
+		return mismatches
+	case nil:
+		// a null value in the snippet means that the field must not be set
+		if actual != nil {
+			return []string{fmt.Sprintf("%s: expected no value, actual: %v", pathOrRoot(path), actual)}
+		}
+		return nil
+	default:
+		if !reflect.DeepEqual(expected, actual) {
+			return []string{fmt.Sprintf("%s: expected %v, actual: %v", pathOrRoot(path), expected, actual)}
+		}
+		return nil
+	}
+}
+
+func fieldPath(path, field string) string {
+	if strings.Contains(field, ".") {
+		return fmt.Sprintf("%s['%s']", path, field)
+	}
+	return path + "." + field
+}
+
+// fieldPathElement an element of a path: the name of a field, or the index of an item in a list (`-1` for all the items)
+type fieldPathElement struct {
+	field string
+	index int
+}
+
+// parseFieldPath parses a JSONPath-style path (see ParseExpectedState)
+func parseFieldPath(path string) ([]fieldPathElement, error) {
+	var elements []fieldPathElement
+	rest := path
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, "['"):
+			end := strings.Index(rest, "']")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path '%s': missing closing \"']\"", path)
+			}
+			elements = append(elements, fieldPathElement{field: rest[2:end]})
+			rest = rest[end+2:]
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path '%s': missing closing ']'", path)
+			}
+			index := -1
+			if rest[1:end] != "*" {
+				i, err := strconv.Atoi(rest[1:end])
+				if err != nil || i < 0 {
+					return nil, fmt.Errorf("invalid path '%s': invalid index '%s'", path, rest[1:end])
+				}
+				index = i
+			}
+			elements = append(elements, fieldPathElement{index: index})
+			rest = rest[end+1:]
+		case strings.HasPrefix(rest, "."):
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid path '%s': empty field name", path)
+			}
+			elements = append(elements, fieldPathElement{field: rest[1 : end+1]})
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("invalid path '%s': expected '.' or '[' at '%s'", path, rest)
+		}
+	}
+	if len(elements) == 0 {
+		return nil, fmt.Errorf("invalid path '%s': no field", path)
+	}
+	return elements, nil
+}
+
+// removeField removes the field(s) at the given path from the given value, if any
+func removeField(value interface{}, path []fieldPathElement) {
+	head, last := path[0], len(path) == 1
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if head.field == "" {
+			return
+		}
+		if last {
+			delete(v, head.field)
+			return
+		}
+		removeField(v[head.field], path[1:])
+	case []interface{}:
+		if head.field != "" || last { // the items of a list can't be removed, only their fields
+			return
+		}
+		for i, item := range v {
+			if head.index < 0 || head.index == i {
+				removeField(item, path[1:])
+			}
+		}
+	}
+}
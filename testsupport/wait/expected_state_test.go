@@ -0,0 +1,141 @@
+package wait_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestUntilObjectMatchesState(t *testing.T) {
+	// given
+	replicas := int32(2)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "toolchain-host-operator",
+			Name:      "registration-service",
+			Labels: map[string]string{
+				"toolchain.dev.openshift.com/provider": "codeready-toolchain",
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "sidecar", Image: "sidecar:latest"},
+						{Name: "registration-service", Image: "quay.io/codeready-toolchain/registration-service:123abc"},
+					},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{
+			ReadyReplicas: 2,
+		},
+	}
+	const snippet = `
+metadata:
+  labels:
+    toolchain.dev.openshift.com/provider: codeready-toolchain
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: registration-service
+        image: quay.io/codeready-toolchain/registration-service:latest
+status:
+  readyReplicas: 2
+  unavailableReplicas: null
+`
+
+	t.Run("match with ignored fields", func(t *testing.T) {
+		// given
+		expected, err := wait.ParseExpectedState([]byte(snippet), ".spec.template.spec.containers[*].image")
+		require.NoError(t, err)
+
+		// when
+		match := wait.UntilObjectMatchesState[*appsv1.Deployment](expected).Match(deployment)
+
+		// then
+		assert.True(t, match)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		// given
+		expected, err := wait.ParseExpectedState([]byte(snippet))
+		require.NoError(t, err)
+		criterion := wait.UntilObjectMatchesState[*appsv1.Deployment](expected)
+
+		// when
+		match := criterion.Match(deployment)
+
+		// then
+		assert.False(t, match)
+		assert.Contains(t, criterion.Diff(deployment), "expected Deployment 'registration-service' to match the expected state:\n.spec.template.spec.containers[0]: no matching item for ")
+	})
+
+	t.Run("mismatching fields", func(t *testing.T) {
+		// given
+		expected, err := wait.ParseExpectedState([]byte(`
+metadata:
+  labels:
+    toolchain.dev.openshift.com/provider: someone-else
+spec:
+  replicas: 3
+`))
+		require.NoError(t, err)
+
+		// when
+		diff := wait.UntilObjectMatchesState[*appsv1.Deployment](expected).Diff(deployment)
+
+		// then
+		assert.Equal(t, `expected Deployment 'registration-service' to match the expected state:
+.metadata.labels['toolchain.dev.openshift.com/provider']: expected someone-else, actual: codeready-toolchain
+.spec.replicas: expected 3, actual: 2`, diff)
+	})
+
+	t.Run("invalid snippet", func(t *testing.T) {
+		_, err := wait.ParseExpectedState([]byte("spec: [replicas"))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid ignored path", func(t *testing.T) {
+		_, err := wait.ParseExpectedState([]byte(snippet), ".spec.template.spec.containers[first].image")
+		require.EqualError(t, err, "invalid path '.spec.template.spec.containers[first].image': invalid index 'first'")
+	})
+
+	t.Run("wait for object matching YAML", func(t *testing.T) {
+		// given
+		a := &wait.Awaitility{
+			Client:        test.NewFakeClient(t, deployment),
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+		key := types.NamespacedName{Namespace: "toolchain-host-operator", Name: "registration-service"}
+
+		// when
+		actual, err := wait.WaitForObjectMatchingYAML[*appsv1.Deployment](t, a, key, []byte(snippet), ".spec.template.spec.containers[*].image")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "registration-service", actual.Name)
+
+		t.Run("timeout", func(t *testing.T) {
+			// when
+			_, err := wait.WaitForObjectMatchingYAML[*appsv1.Deployment](t, a, key, []byte("spec:\n  replicas: 3\n"))
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, wait.LastState(err), ".spec.replicas: expected 3, actual: 2")
+		})
+	})
+}
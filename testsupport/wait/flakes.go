@@ -0,0 +1,72 @@
+package wait
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// flake a failed attempt of a wait block which succeeded after being retried (or which ultimately failed)
+type flake struct {
+	test    string
+	attempt int
+	err     error
+	time    time.Time
+}
+
+var flakes = struct {
+	sync.Mutex
+	items []flake
+}{}
+
+// Retryable runs the given wait block and retries it (up to `n` attempts in total) as long as it returns an error.
+// Each failed attempt is recorded as a flake, so that it shows in the flake report at the end of the test suite
+// (see WriteFlakeReport) instead of being silently masked.
+// Returns the error of the last attempt, or `nil` if one of the attempts succeeded. Returns an error without running
+// the block if `n` is lower than 1, so that a wrong number of attempts does not pass silently.
+func Retryable(t T, n int, f func() error) error {
+	if n < 1 {
+		return fmt.Errorf("invalid number of attempts of the wait block: %d (must be at least 1)", n)
+	}
+	var err error
+	for attempt := 1; attempt <= n; attempt++ {
+		if err = f(); err == nil {
+			if attempt > 1 {
+				t.Logf("wait block succeeded after %d attempts", attempt)
+			}
+			return nil
+		}
+		t.Logf("attempt %d/%d of wait block failed: %s", attempt, n, err.Error())
+		flakes.Lock()
+		flakes.items = append(flakes.items, flake{
+			test:    t.Name(),
+			attempt: attempt,
+			err:     err,
+			time:    time.Now(),
+		})
+		flakes.Unlock()
+	}
+	return err
+}
+
+// WriteFlakeReport writes the failed attempts of all the wait blocks which were retried during the test suite, grouped by test
+func WriteFlakeReport(out io.Writer) error {
+	flakes.Lock()
+	defer flakes.Unlock()
+	report := &strings.Builder{}
+	report.WriteString(fmt.Sprintf("failed attempts of retried wait blocks (%d):\n", len(flakes.items)))
+	for _, f := range flakes.items {
+		report.WriteString(fmt.Sprintf("  %s [%s] attempt #%d: %s\n", f.test, f.time.Format(time.RFC3339), f.attempt, f.err.Error()))
+	}
+	_, err := io.WriteString(out, report.String())
+	return err
+}
+
+// HasFlakes returns `true` if at least one attempt of a retried wait block failed during the test suite
+func HasFlakes() bool {
+	flakes.Lock()
+	defer flakes.Unlock()
+	return len(flakes.items) > 0
+}
@@ -0,0 +1,83 @@
+package wait_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryable(t *testing.T) {
+
+	t.Run("success after flake", func(t *testing.T) {
+		// given
+		attempts := 0
+
+		// when
+		err := wait.Retryable(t, 3, func() error {
+			attempts++
+			if attempts == 1 {
+				return fmt.Errorf("mock error")
+			}
+			return nil
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 2, attempts)
+		assert.True(t, wait.HasFlakes())
+		flakes := flakesOf(t, t.Name())
+		require.Len(t, flakes, 1)
+		assert.Contains(t, flakes[0], "attempt #1: mock error")
+	})
+
+	t.Run("failure after all attempts", func(t *testing.T) {
+		// given
+		attempts := 0
+
+		// when
+		err := wait.Retryable(t, 3, func() error {
+			attempts++
+			return fmt.Errorf("mock error %d", attempts)
+		})
+
+		// then
+		require.EqualError(t, err, "mock error 3")
+		assert.Equal(t, 3, attempts)
+		flakes := flakesOf(t, t.Name())
+		require.Len(t, flakes, 3)
+		assert.Contains(t, flakes[2], "attempt #3: mock error 3")
+	})
+
+	t.Run("no attempt", func(t *testing.T) {
+		// given
+		attempts := 0
+
+		// when
+		err := wait.Retryable(t, 0, func() error {
+			attempts++
+			return nil
+		})
+
+		// then
+		require.EqualError(t, err, "invalid number of attempts of the wait block: 0 (must be at least 1)")
+		assert.Zero(t, attempts)
+		assert.Empty(t, flakesOf(t, t.Name()))
+	})
+}
+
+// flakesOf returns the lines of the flake report for the given test, regardless of the flakes recorded by the other tests
+func flakesOf(t *testing.T, testName string) []string {
+	report := &strings.Builder{}
+	require.NoError(t, wait.WriteFlakeReport(report))
+	var lines []string
+	for _, line := range strings.Split(report.String(), "\n") {
+		if strings.HasPrefix(line, "  "+testName+" ") {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
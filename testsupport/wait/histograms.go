@@ -0,0 +1,66 @@
+package wait
+
+import (
+	"fmt"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ReconcileTimeMetric the histogram of the duration of the reconciles, by controller (eg: `usersignup`)
+const ReconcileTimeMetric = "controller_runtime_reconcile_time_seconds"
+
+// GetHistogram returns the observations of the given histogram family (summed over all its series with the given label key-value pairs,
+// see metrics.Client.GetHistogram) which were made since the baseline of the metrics was captured (see CaptureMetricsBaseline),
+// or all the observations if no baseline was captured. Fails if the histogram does not exist.
+func (a *Awaitility) GetHistogram(t T, family string, labelAndValues ...string) metrics.Histogram {
+	h, err := a.TryGetHistogram(family, labelAndValues...)
+	require.NoError(t, err)
+	return h
+}
+
+// TryGetHistogram is like GetHistogram, but it returns an error instead of failing the test
+// if the histogram can't be retrieved
+func (a *Awaitility) TryGetHistogram(family string, labelAndValues ...string) (metrics.Histogram, error) {
+	if err := checkLabelPairs(labelAndValues); err != nil {
+		return metrics.Histogram{}, err
+	}
+	h, err := a.MetricsClient.GetHistogram(family, labelAndValues)
+	if err != nil {
+		return metrics.Histogram{}, err
+	}
+	before, err := a.baselines.getSnapshot().Histogram(family, labelAndValues...)
+	if err != nil {
+		return metrics.Histogram{}, err
+	}
+	return h.Since(before), nil
+}
+
+// AssertHistogramQuantileBelow asserts that the given quantile (eg: `0.99`) of the observations of the given histogram family since
+// the baseline of the metrics was captured (see GetHistogram) is at most the given value, eg: to verify that the reconciles of a
+// controller or the requests to the registration service meet their SLO during the test
+//
+//	hostAwait.AssertHistogramQuantileBelow(t, wait.ReconcileTimeMetric, 0.99, 5, "controller", "usersignup")
+func (a *Awaitility) AssertHistogramQuantileBelow(t T, family string, q, maxValue float64, labelAndValues ...string) {
+	err := a.TryAssertHistogramQuantileBelow(t, family, q, maxValue, labelAndValues...)
+	require.NoError(t, err)
+}
+
+// TryAssertHistogramQuantileBelow is like AssertHistogramQuantileBelow, but it returns an error instead of failing the test
+// if the quantile is above the given value (or if it can't be calculated)
+func (a *Awaitility) TryAssertHistogramQuantileBelow(t T, family string, q, maxValue float64, labelAndValues ...string) error {
+	h, err := a.TryGetHistogram(family, labelAndValues...)
+	if err != nil {
+		return err
+	}
+	value, err := h.Quantile(q)
+	if err != nil {
+		return fmt.Errorf("unable to calculate the quantile %v of histogram '%s{%v}': %w", q, family, labelAndValues, err)
+	}
+	a.logf(t, "quantile %v of histogram '%s{%v}': %v (%d observations, mean: %v)", q, family, labelAndValues, value, h.SampleCount, h.Mean())
+	if value > maxValue {
+		return fmt.Errorf("quantile %v of histogram '%s{%v}' is above %v: %v", q, family, labelAndValues, maxValue, value)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package wait_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssertHistogramQuantileBelow(t *testing.T) {
+	// given
+	var slow atomic.Int64
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "# TYPE controller_runtime_reconcile_time_seconds histogram\n")
+		fmt.Fprint(w, "controller_runtime_reconcile_time_seconds_bucket{controller=\"usersignup\",le=\"1\"} 10\n")
+		fmt.Fprintf(w, "controller_runtime_reconcile_time_seconds_bucket{controller=\"usersignup\",le=\"10\"} %d\n", 10+slow.Load())
+		fmt.Fprintf(w, "controller_runtime_reconcile_time_seconds_bucket{controller=\"usersignup\",le=\"+Inf\"} %d\n", 10+slow.Load())
+		fmt.Fprintf(w, "controller_runtime_reconcile_time_seconds_sum{controller=\"usersignup\"} %d\n", 5+9*slow.Load())
+		fmt.Fprintf(w, "controller_runtime_reconcile_time_seconds_count{controller=\"usersignup\"} %d\n", 10+slow.Load())
+	}))
+	defer ts.Close()
+	newAwaitility := func() *wait.Awaitility {
+		return &wait.Awaitility{
+			Client:        test.NewFakeClient(t),
+			Namespace:     "toolchain-host-operator",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+			MetricsClient: metrics.NewClient(metrics.StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), metrics.StaticToken("token"), metrics.WithCacheTTL(0)),
+		}
+	}
+
+	t.Run("without baseline", func(t *testing.T) {
+		// given
+		slow.Store(0)
+		a := newAwaitility()
+
+		// when
+		h := a.GetHistogram(t, wait.ReconcileTimeMetric, "controller", "usersignup")
+
+		// then
+		assert.Equal(t, uint64(10), h.SampleCount)
+		a.AssertHistogramQuantileBelow(t, wait.ReconcileTimeMetric, 0.99, 1, "controller", "usersignup")
+	})
+
+	t.Run("since baseline", func(t *testing.T) {
+		// given
+		slow.Store(0)
+		a := newAwaitility()
+		a.CaptureMetricsBaseline(t)
+		slow.Store(2)
+
+		// when
+		h := a.GetHistogram(t, wait.ReconcileTimeMetric, "controller", "usersignup")
+
+		// then
+		// only the 2 slow reconciles since the baseline
+		assert.Equal(t, uint64(2), h.SampleCount)
+		assert.InDelta(t, 9, h.Mean(), 0.0001)
+		out := &strings.Builder{}
+		ok := wait.RunStandalone(t.Name(), out, func(st wait.T) {
+			a.AssertHistogramQuantileBelow(st, wait.ReconcileTimeMetric, 0.99, 5, "controller", "usersignup")
+		})
+		assert.False(t, ok)
+		assert.Contains(t, out.String(), "quantile 0.99 of histogram 'controller_runtime_reconcile_time_seconds{[controller usersignup]}' is above 5")
+	})
+
+	t.Run("try variants", func(t *testing.T) {
+		// given
+		slow.Store(0)
+		a := newAwaitility()
+		a.CaptureMetricsBaseline(t)
+		slow.Store(2)
+
+		// when
+		err := a.TryAssertHistogramQuantileBelow(t, wait.ReconcileTimeMetric, 0.99, 5, "controller", "usersignup")
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "quantile 0.99 of histogram 'controller_runtime_reconcile_time_seconds{[controller usersignup]}' is above 5")
+		_, err = a.TryGetHistogram(wait.ReconcileTimeMetric, "controller", "unknown")
+		require.Error(t, err)
+		assert.False(t, t.Failed())
+	})
+
+	t.Run("unknown histogram", func(t *testing.T) {
+		// given
+		a := newAwaitility()
+
+		// when
+		ok := wait.RunStandalone(t.Name(), &strings.Builder{}, func(st wait.T) {
+			a.GetHistogram(st, wait.ReconcileTimeMetric, "controller", "unknown")
+		})
+
+		// then
+		require.False(t, ok)
+	})
+}
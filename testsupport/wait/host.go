@@ -4,11 +4,11 @@ import (
 	"context"
 	"fmt"
 	"hash/crc32"
+	"net/http"
 	"reflect"
 	"regexp"
-	"strconv"
+	"sort"
 	"strings"
-	"testing"
 	"time"
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
@@ -18,6 +18,7 @@ import (
 	"github.com/codeready-toolchain/toolchain-common/pkg/spacebinding"
 	"github.com/codeready-toolchain/toolchain-common/pkg/test"
 	testconfig "github.com/codeready-toolchain/toolchain-common/pkg/test/config"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/httpclient"
 	testutil "github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
 
 	"github.com/davecgh/go-spew/spew"
@@ -29,7 +30,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/kubectl/pkg/scheme"
@@ -52,8 +52,10 @@ func NewHostAwaitility(cfg *rest.Config, cl client.Client, ns string, registrati
 			RestConfig:    cfg,
 			Namespace:     ns,
 			Type:          cluster.Host,
-			RetryInterval: DefaultRetryInterval,
-			Timeout:       DefaultTimeout,
+			RetryInterval: CurrentEnvironment().RetryInterval,
+			Timeout:       CurrentEnvironment().Timeout,
+			baselines:     &metricBaselines{},
+			transports:    newTransportCache(),
 		},
 		RegistrationServiceNs: registrationServiceNs,
 	}
@@ -69,11 +71,34 @@ func (a *HostAwaitility) WithRetryOptions(options ...RetryOption) *HostAwaitilit
 	}
 }
 
+// WithContext returns a new HostAwaitility whose waits stop as soon as the given context is done
+func (a *HostAwaitility) WithContext(ctx context.Context) *HostAwaitility {
+	result := *a
+	result.Awaitility = a.Awaitility.WithContext(ctx)
+	return &result
+}
+
+// PauseReconciliation stops the host operator until the returned func is called (or until the end of the test), so that the test can set up
+// a state which the host operator finds when it starts again (see Awaitility.PauseDeployment)
+func (a *HostAwaitility) PauseReconciliation(t T) (resume func()) {
+	return a.PauseDeployment(t, "host-operator-controller-manager")
+}
+
 // WaitForMetricsService verifies that there is a service called `host-operator-metrics-service`
 // in the host namespace.
-func (a *HostAwaitility) WaitForMetricsService(t *testing.T) {
-	_, err := a.WaitForService(t, "host-operator-metrics-service")
-	require.NoError(t, err, "failed while waiting for 'host-operator-metrics-service' service")
+func (a *HostAwaitility) WaitForMetricsService(t T, options ...RetryOption) {
+	err := a.TryWaitForMetricsService(t, options...)
+	require.NoError(t, err)
+}
+
+// TryWaitForMetricsService is like WaitForMetricsService, but it returns an error instead of failing the test
+// if the service is not found
+func (a *HostAwaitility) TryWaitForMetricsService(t T, options ...RetryOption) error {
+	a = a.WithRetryOptions(options...)
+	if _, err := a.WaitForService(t, "host-operator-metrics-service"); err != nil {
+		return fmt.Errorf("failed while waiting for 'host-operator-metrics-service' service: %w", err)
+	}
+	return nil
 }
 
 // metric constants
@@ -96,57 +121,41 @@ const (
 )
 
 // InitMetricsAssertion waits for any pending usersignups and then initialized the metrics assertion helper with baseline values
-func (a *HostAwaitility) InitMetrics(t *testing.T, memberClusterNames ...string) {
-	// Wait for pending usersignup deletions before capturing baseline values so that test assertions are stable
-	err := a.WaitForTestResourcesCleanup(t, 10*time.Second)
+func (a *HostAwaitility) InitMetrics(t T) {
+	err := a.TryInitMetrics(t)
 	require.NoError(t, err)
+}
+
+// TryInitMetrics is like InitMetrics, but it returns an error instead of failing the test
+// if the metrics can't be initialized
+func (a *HostAwaitility) TryInitMetrics(t T) error {
+	// Wait for pending usersignup deletions before capturing baseline values so that test assertions are stable
+	if err := a.WaitForTestResourcesCleanup(t, 10*time.Second); err != nil {
+		return err
+	}
 
 	// wait for toolchainstatus metrics to be updated
-	_, err = a.WaitForToolchainStatus(t,
+	if _, err := a.WaitForToolchainStatus(t,
 		UntilToolchainStatusHasConditions(ToolchainStatusReadyAndUnreadyNotificationNotCreated()...),
-		UntilToolchainStatusUpdatedAfter(time.Now()))
-	require.NoError(t, err)
-
-	a.WaitForMetricsService(t)
-	// Capture baseline values
-	a.baselineValues = make(map[string]float64)
-	a.baselineValues[UserSignupsMetric] = a.GetMetricValue(t, UserSignupsMetric)
-	a.baselineValues[UserSignupsApprovedMetric] = a.GetMetricValue(t, UserSignupsApprovedMetric)
-	a.baselineValues[UserSignupsDeactivatedMetric] = a.GetMetricValue(t, UserSignupsDeactivatedMetric)
-	a.baselineValues[UserSignupsAutoDeactivatedMetric] = a.GetMetricValue(t, UserSignupsAutoDeactivatedMetric)
-	a.baselineValues[UserSignupsBannedMetric] = a.GetMetricValue(t, UserSignupsBannedMetric)
-	a.baselineValues[UserSignupVerificationRequiredMetric] = a.GetMetricValue(t, UserSignupVerificationRequiredMetric)
-	a.baselineValues[HostOperatorVersionMetric] = a.GetMetricValue(t, HostOperatorVersionMetric)
-	for _, name := range memberClusterNames { // sum of gauge value of all member clusters
-		spacesKey := a.baselineKey(t, SpacesMetric, "cluster_name", name)
-		a.baselineValues[spacesKey] += a.GetMetricValue(t, SpacesMetric, "cluster_name", name)
-	}
-	// capture `sandbox_users_per_activations_and_domain` with "activations" from `1` to `10` and `internal`/`external` domains
-	for i := 1; i <= 10; i++ {
-		for _, domain := range []string{"internal", "external"} {
-			key := a.baselineKey(t, UsersPerActivationsAndDomainMetric, "activations", strconv.Itoa(i), "domain", domain)
-			a.baselineValues[key] = a.GetMetricValueOrZero(t, UsersPerActivationsAndDomainMetric, "activations", strconv.Itoa(i), "domain", domain)
-		}
-	}
-	for _, domain := range []string{"internal", "external"} {
-		key := a.baselineKey(t, MasterUserRecordsPerDomainMetric, "domain", domain)
-		a.baselineValues[key] = a.GetMetricValueOrZero(t, MasterUserRecordsPerDomainMetric, "domain", domain)
-	}
-	for _, approvalMethod := range []string{"automatic", "manual"} {
-		key := a.baselineKey(t, UserSignupsApprovedWithMethodMetric, "method", approvalMethod)
-		a.baselineValues[key] = a.GetMetricValueOrZero(t, UserSignupsApprovedWithMethodMetric, "method", approvalMethod)
+		UntilToolchainStatusUpdatedAfter(time.Now())); err != nil {
+		return err
 	}
 
-	t.Logf("captured baselines:\n%s", spew.Sdump(a.baselineValues))
+	if err := a.TryWaitForMetricsService(t); err != nil {
+		return err
+	}
+	return a.TryCaptureMetricsBaseline(t)
 }
 
 // WaitForMasterUserRecord waits until there is a MasterUserRecord available with the given name and the optional conditions
-func (a *HostAwaitility) WaitForMasterUserRecord(t *testing.T, name string, criteria ...MasterUserRecordWaitCriterion) (*toolchainv1alpha1.MasterUserRecord, error) {
-	t.Logf("waiting for MasterUserRecord '%s' in namespace '%s' to match criteria", name, a.Namespace)
+func (a *HostAwaitility) WaitForMasterUserRecord(t T, name string, criteria ...MasterUserRecordWaitCriterion) (*toolchainv1alpha1.MasterUserRecord, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for MasterUserRecord '%s' in namespace '%s' to match criteria", name, a.Namespace)
 	var mur *toolchainv1alpha1.MasterUserRecord
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.pollOnEvents(t, &toolchainv1alpha1.MasterUserRecordList{}, name, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.MasterUserRecord{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -164,7 +173,7 @@ func (a *HostAwaitility) WaitForMasterUserRecord(t *testing.T, name string, crit
 
 func (a *HostAwaitility) GetMasterUserRecord(name string) (*toolchainv1alpha1.MasterUserRecord, error) {
 	mur := &toolchainv1alpha1.MasterUserRecord{}
-	if err := a.Client.Get(context.TODO(), test.NamespacedName(a.Namespace, name), mur); err != nil {
+	if err := a.Client.Get(a.getContext(), test.NamespacedName(a.Namespace, name), mur); err != nil {
 		return nil, err
 	}
 	return mur, nil
@@ -173,113 +182,73 @@ func (a *HostAwaitility) GetMasterUserRecord(name string) (*toolchainv1alpha1.Ma
 // UpdateMasterUserRecordSpec tries to update the Spec of the given MasterUserRecord
 // If it fails with an error (for example if the object has been modified) then it retrieves the latest version and and tries again
 // Returns the updated MasterUserRecord
-func (a *HostAwaitility) UpdateMasterUserRecordSpec(t *testing.T, murName string, modifyMur func(mur *toolchainv1alpha1.MasterUserRecord)) (*toolchainv1alpha1.MasterUserRecord, error) {
+func (a *HostAwaitility) UpdateMasterUserRecordSpec(t T, murName string, modifyMur func(mur *toolchainv1alpha1.MasterUserRecord)) (*toolchainv1alpha1.MasterUserRecord, error) {
 	return a.UpdateMasterUserRecord(t, false, murName, modifyMur)
 }
 
 // UpdateMasterUserRecordStatus tries to update the Status of the given MasterUserRecord
 // If it fails with an error (for example if the object has been modified) then it retrieves the latest version and and tries again
 // Returns the updated MasterUserRecord
-func (a *HostAwaitility) UpdateMasterUserRecordStatus(t *testing.T, murName string, modifyMur func(mur *toolchainv1alpha1.MasterUserRecord)) (*toolchainv1alpha1.MasterUserRecord, error) {
+func (a *HostAwaitility) UpdateMasterUserRecordStatus(t T, murName string, modifyMur func(mur *toolchainv1alpha1.MasterUserRecord)) (*toolchainv1alpha1.MasterUserRecord, error) {
 	return a.UpdateMasterUserRecord(t, true, murName, modifyMur)
 }
 
 // UpdateMasterUserRecord tries to update the Spec or the Status of the given MasterUserRecord
 // If it fails with an error (for example if the object has been modified) then it retrieves the latest version and and tries again
 // Returns the updated MasterUserRecord
-func (a *HostAwaitility) UpdateMasterUserRecord(t *testing.T, status bool, murName string, modifyMur func(mur *toolchainv1alpha1.MasterUserRecord)) (*toolchainv1alpha1.MasterUserRecord, error) {
-	var m *toolchainv1alpha1.MasterUserRecord
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		freshMur := &toolchainv1alpha1.MasterUserRecord{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: murName}, freshMur); err != nil {
-			return true, err
-		}
-
-		modifyMur(freshMur)
-		if status {
-			// Update status
-			if err := a.Client.Status().Update(context.TODO(), freshMur); err != nil {
-				t.Logf("error updating MasterUserRecord.Status '%s': %s. Will retry again...", murName, err.Error())
-				return false, nil
-			}
-		} else if err := a.Client.Update(context.TODO(), freshMur); err != nil {
-			t.Logf("error updating MasterUserRecord.Spec '%s': %s. Will retry again...", murName, err.Error())
-			return false, nil
-		}
-		m = freshMur
-		return true, nil
-	})
-	return m, err
+func (a *HostAwaitility) UpdateMasterUserRecord(t T, status bool, murName string, modifyMur func(mur *toolchainv1alpha1.MasterUserRecord)) (*toolchainv1alpha1.MasterUserRecord, error) {
+	var opts []UpdateOption
+	if status {
+		opts = append(opts, UpdateStatus())
+	}
+	return Update(t, a.Awaitility, types.NamespacedName{Namespace: a.Namespace, Name: murName}, modifyMur, opts...)
 }
 
 // UpdateUserSignup tries to update the Spec of the given UserSignup
 // If it fails with an error (for example if the object has been modified) then it retrieves the latest version and tries again
 // Returns the updated UserSignup
-func (a *HostAwaitility) UpdateUserSignup(t *testing.T, userSignupName string, modifyUserSignup func(us *toolchainv1alpha1.UserSignup)) (*toolchainv1alpha1.UserSignup, error) {
-	var userSignup *toolchainv1alpha1.UserSignup
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		freshUserSignup := &toolchainv1alpha1.UserSignup{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: userSignupName}, freshUserSignup); err != nil {
-			return true, err
-		}
-
-		modifyUserSignup(freshUserSignup)
-		if err := a.Client.Update(context.TODO(), freshUserSignup); err != nil {
-			t.Logf("error updating UserSignup '%s': %s. Will retry again...", userSignupName, err.Error())
-			return false, nil
-		}
-		userSignup = freshUserSignup
-		return true, nil
-	})
-	return userSignup, err
+func (a *HostAwaitility) UpdateUserSignup(t T, userSignupName string, modifyUserSignup func(us *toolchainv1alpha1.UserSignup)) (*toolchainv1alpha1.UserSignup, error) {
+	return Update(t, a.Awaitility, types.NamespacedName{Namespace: a.Namespace, Name: userSignupName}, modifyUserSignup)
 }
 
 // UpdateSpace tries to update the Spec of the given Space
 // If it fails with an error (for example if the object has been modified) then it retrieves the latest version and tries again
 // Returns the updated Space
-func (a *HostAwaitility) UpdateSpace(t *testing.T, spaceName string, modifySpace func(s *toolchainv1alpha1.Space)) (*toolchainv1alpha1.Space, error) {
-	var s *toolchainv1alpha1.Space
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		freshSpace := &toolchainv1alpha1.Space{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: spaceName}, freshSpace); err != nil {
-			return true, err
-		}
-		modifySpace(freshSpace)
-		if err := a.Client.Update(context.TODO(), freshSpace); err != nil {
-			t.Logf("error updating Space '%s': %s. Will retry again...", spaceName, err.Error())
-			return false, nil
-		}
-		s = freshSpace
-		return true, nil
-	})
-	return s, err
+func (a *HostAwaitility) UpdateSpace(t T, spaceName string, modifySpace func(s *toolchainv1alpha1.Space)) (*toolchainv1alpha1.Space, error) {
+	return Update(t, a.Awaitility, types.NamespacedName{Namespace: a.Namespace, Name: spaceName}, modifySpace)
 }
 
 // UpdateSpaceBinding tries to update the Spec of the given SpaceBinding
 // If it fails with an error (for example if the object has been modified) then it retrieves the latest version and tries again
 // Returns the updated SpaceBinding
-func (a *HostAwaitility) UpdateSpaceBinding(t *testing.T, spaceBindingName string, modifySpaceBinding func(s *toolchainv1alpha1.SpaceBinding)) (*toolchainv1alpha1.SpaceBinding, error) {
-	var s *toolchainv1alpha1.SpaceBinding
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		freshSpaceBinding := &toolchainv1alpha1.SpaceBinding{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: spaceBindingName}, freshSpaceBinding); err != nil {
-			return true, err
-		}
-		modifySpaceBinding(freshSpaceBinding)
-		if err := a.Client.Update(context.TODO(), freshSpaceBinding); err != nil {
-			t.Logf("error updating SpaceBinding '%s': %s. Will retry again...", spaceBindingName, err.Error())
-			return false, nil
-		}
-		s = freshSpaceBinding
-		return true, nil
-	})
-	return s, err
+func (a *HostAwaitility) UpdateSpaceBinding(t T, spaceBindingName string, modifySpaceBinding func(s *toolchainv1alpha1.SpaceBinding)) (*toolchainv1alpha1.SpaceBinding, error) {
+	return Update(t, a.Awaitility, types.NamespacedName{Namespace: a.Namespace, Name: spaceBindingName}, modifySpaceBinding)
 }
 
 // MasterUserRecordWaitCriterion a struct to compare with an expected MasterUserRecord
 type MasterUserRecordWaitCriterion struct {
 	Match func(*toolchainv1alpha1.MasterUserRecord) bool
 	Diff  func(*toolchainv1alpha1.MasterUserRecord) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[MasterUserRecordWaitCriterion] = MasterUserRecordWaitCriterion{}
+
+func (MasterUserRecordWaitCriterion) withRetryOptions(options []RetryOption) MasterUserRecordWaitCriterion {
+	return MasterUserRecordWaitCriterion{
+		Match: func(*toolchainv1alpha1.MasterUserRecord) bool {
+			return true
+		},
+		Diff: func(*toolchainv1alpha1.MasterUserRecord) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c MasterUserRecordWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchMasterUserRecordWaitCriterion(actual *toolchainv1alpha1.MasterUserRecord, criteria ...MasterUserRecordWaitCriterion) bool {
@@ -291,7 +260,7 @@ func matchMasterUserRecordWaitCriterion(actual *toolchainv1alpha1.MasterUserReco
 	return true
 }
 
-func (a *HostAwaitility) printMasterUserRecordWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.MasterUserRecord, criteria ...MasterUserRecordWaitCriterion) {
+func (a *HostAwaitility) printMasterUserRecordWaitCriterionDiffs(t T, actual *toolchainv1alpha1.MasterUserRecord, criteria ...MasterUserRecordWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find MasterUserRecord\n")
@@ -315,7 +284,7 @@ func (a *HostAwaitility) printMasterUserRecordWaitCriterionDiffs(t *testing.T, a
 	a.listAndPrint(t, "MasterUserRecords", a.Namespace, &toolchainv1alpha1.MasterUserRecordList{})
 	a.listAndPrint(t, "Spaces", a.Namespace, &toolchainv1alpha1.SpaceList{})
 
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // UntilMasterUserRecordIsBeingDeleted checks if MasterUserRecord has Deletion Timestamp
@@ -452,6 +421,26 @@ func UntilMasterUserRecordHasNoTierHashLabel() MasterUserRecordWaitCriterion {
 type UserSignupWaitCriterion struct {
 	Match func(*toolchainv1alpha1.UserSignup) bool
 	Diff  func(*toolchainv1alpha1.UserSignup) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[UserSignupWaitCriterion] = UserSignupWaitCriterion{}
+
+func (UserSignupWaitCriterion) withRetryOptions(options []RetryOption) UserSignupWaitCriterion {
+	return UserSignupWaitCriterion{
+		Match: func(*toolchainv1alpha1.UserSignup) bool {
+			return true
+		},
+		Diff: func(*toolchainv1alpha1.UserSignup) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c UserSignupWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchUserSignupWaitCriterion(actual *toolchainv1alpha1.UserSignup, criteria ...UserSignupWaitCriterion) bool {
@@ -463,7 +452,7 @@ func matchUserSignupWaitCriterion(actual *toolchainv1alpha1.UserSignup, criteria
 	return true
 }
 
-func (a *HostAwaitility) printUserSignupWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.UserSignup, criteria ...UserSignupWaitCriterion) {
+func (a *HostAwaitility) printUserSignupWaitCriterionDiffs(t T, actual *toolchainv1alpha1.UserSignup, criteria ...UserSignupWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find UserSignup\n")
@@ -484,7 +473,7 @@ func (a *HostAwaitility) printUserSignupWaitCriterionDiffs(t *testing.T, actual
 	// include also all UserSignups in the host namespace, to help troubleshooting
 	a.listAndPrint(t, "UserSignups", a.Namespace, &toolchainv1alpha1.UserSignupList{})
 
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // UntilUserSignupIsBeingDeleted returns a `UserSignupWaitCriterion` which checks that the given
@@ -592,12 +581,14 @@ func UntilUserSignupHasCompliantUsername() UserSignupWaitCriterion {
 }
 
 // WaitForTestResourcesCleanup waits for all UserSignup, MasterUserRecord, Space, SpaceBinding, NSTemplateSet and Namespace deletions to complete
-func (a *HostAwaitility) WaitForTestResourcesCleanup(t *testing.T, initialDelay time.Duration) error {
-	t.Logf("waiting for resource cleanup")
+func (a *HostAwaitility) WaitForTestResourcesCleanup(t T, initialDelay time.Duration, options ...RetryOption) error {
+	recordWaiter(t)
+	a = a.WithRetryOptions(options...)
+	a.logf(t, "waiting for resource cleanup")
 	time.Sleep(initialDelay)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		usList := &toolchainv1alpha1.UserSignupList{}
-		if err := a.Client.List(context.TODO(), usList, client.InNamespace(a.Namespace)); err != nil {
+		if err := a.Client.List(a.getContext(), usList, client.InNamespace(a.Namespace)); err != nil {
 			return false, err
 		}
 		for _, us := range usList.Items {
@@ -607,7 +598,7 @@ func (a *HostAwaitility) WaitForTestResourcesCleanup(t *testing.T, initialDelay
 		}
 
 		murList := &toolchainv1alpha1.MasterUserRecordList{}
-		if err := a.Client.List(context.TODO(), murList, client.InNamespace(a.Namespace)); err != nil {
+		if err := a.Client.List(a.getContext(), murList, client.InNamespace(a.Namespace)); err != nil {
 			return false, err
 		}
 		for _, mur := range murList.Items {
@@ -617,7 +608,7 @@ func (a *HostAwaitility) WaitForTestResourcesCleanup(t *testing.T, initialDelay
 		}
 
 		spaceBindingList := &toolchainv1alpha1.SpaceBindingList{}
-		if err := a.Client.List(context.TODO(), spaceBindingList, client.InNamespace(a.Namespace)); err != nil {
+		if err := a.Client.List(a.getContext(), spaceBindingList, client.InNamespace(a.Namespace)); err != nil {
 			return false, err
 		}
 		for _, spaceBinding := range spaceBindingList.Items {
@@ -627,7 +618,7 @@ func (a *HostAwaitility) WaitForTestResourcesCleanup(t *testing.T, initialDelay
 		}
 
 		spaceList := &toolchainv1alpha1.SpaceList{}
-		if err := a.Client.List(context.TODO(), spaceList, client.InNamespace(a.Namespace)); err != nil {
+		if err := a.Client.List(a.getContext(), spaceList, client.InNamespace(a.Namespace)); err != nil {
 			return false, err
 		}
 		for _, space := range spaceList.Items {
@@ -637,7 +628,7 @@ func (a *HostAwaitility) WaitForTestResourcesCleanup(t *testing.T, initialDelay
 		}
 
 		nsTemplateSetList := &toolchainv1alpha1.NSTemplateSetList{}
-		if err := a.Client.List(context.TODO(), nsTemplateSetList); err != nil {
+		if err := a.Client.List(a.getContext(), nsTemplateSetList); err != nil {
 			return false, err
 		}
 		for _, nsTemplateSet := range nsTemplateSetList.Items {
@@ -647,7 +638,7 @@ func (a *HostAwaitility) WaitForTestResourcesCleanup(t *testing.T, initialDelay
 		}
 
 		namespaceList := &corev1.NamespaceList{}
-		if err := a.Client.List(context.TODO(), namespaceList); err != nil {
+		if err := a.Client.List(a.getContext(), namespaceList); err != nil {
 			return false, err
 		}
 		for _, namespace := range namespaceList.Items {
@@ -660,12 +651,14 @@ func (a *HostAwaitility) WaitForTestResourcesCleanup(t *testing.T, initialDelay
 }
 
 // WaitForUserSignup waits until there is a UserSignup available with the given name and set of status conditions
-func (a *HostAwaitility) WaitForUserSignup(t *testing.T, name string, criteria ...UserSignupWaitCriterion) (*toolchainv1alpha1.UserSignup, error) {
-	t.Logf("waiting for UserSignup '%s' in namespace '%s' to match criteria", name, a.Namespace)
+func (a *HostAwaitility) WaitForUserSignup(t T, name string, criteria ...UserSignupWaitCriterion) (*toolchainv1alpha1.UserSignup, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for UserSignup '%s' in namespace '%s' to match criteria", name, a.Namespace)
 	var userSignup *toolchainv1alpha1.UserSignup
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.pollOnEvents(t, &toolchainv1alpha1.UserSignupList{}, name, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.UserSignup{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -682,15 +675,17 @@ func (a *HostAwaitility) WaitForUserSignup(t *testing.T, name string, criteria .
 }
 
 // WaitForUserSignup waits until there is a UserSignup available with the given name and set of status conditions
-func (a *HostAwaitility) WaitForUserSignupByUserIDAndUsername(t *testing.T, userID, username string, criteria ...UserSignupWaitCriterion) (*toolchainv1alpha1.UserSignup, error) {
-	t.Logf("waiting for UserSignup '%s' or '%s' in namespace '%s' to match criteria", userID, username, a.Namespace)
+func (a *HostAwaitility) WaitForUserSignupByUserIDAndUsername(t T, userID, username string, criteria ...UserSignupWaitCriterion) (*toolchainv1alpha1.UserSignup, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for UserSignup '%s' or '%s' in namespace '%s' to match criteria", userID, username, a.Namespace)
 	encodedUsername := EncodeUserIdentifier(username)
 	var userSignup *toolchainv1alpha1.UserSignup
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.UserSignup{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: userID}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: userID}, obj); err != nil {
 			if errors.IsNotFound(err) {
-				if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: encodedUsername}, obj); err != nil {
+				if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: encodedUsername}, obj); err != nil {
 					if errors.IsNotFound(err) {
 						return false, nil
 					}
@@ -711,12 +706,20 @@ func (a *HostAwaitility) WaitForUserSignupByUserIDAndUsername(t *testing.T, user
 }
 
 // WaitAndVerifyThatUserSignupIsNotCreated waits and checks that the UserSignup is not created
-func (a *HostAwaitility) WaitAndVerifyThatUserSignupIsNotCreated(t *testing.T, name string) {
-	t.Logf("waiting and verifying that UserSignup '%s' in namespace '%s' is not created", name, a.Namespace)
+func (a *HostAwaitility) WaitAndVerifyThatUserSignupIsNotCreated(t T, name string) {
+	err := a.TryWaitAndVerifyThatUserSignupIsNotCreated(t, name)
+	require.NoError(t, err)
+}
+
+// TryWaitAndVerifyThatUserSignupIsNotCreated is like WaitAndVerifyThatUserSignupIsNotCreated, but it returns an error
+// instead of failing the test if the UserSignup is created
+func (a *HostAwaitility) TryWaitAndVerifyThatUserSignupIsNotCreated(t T, name string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting and verifying that UserSignup '%s' in namespace '%s' is not created", name, a.Namespace)
 	var userSignup *toolchainv1alpha1.UserSignup
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.UserSignup{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -726,18 +729,21 @@ func (a *HostAwaitility) WaitAndVerifyThatUserSignupIsNotCreated(t *testing.T, n
 		return true, nil
 	})
 	if err == nil {
-		require.Fail(t, fmt.Sprintf("UserSignup '%s' should not be created, but it was found: %v", name, userSignup))
+		return fmt.Errorf("UserSignup '%s' should not be created, but it was found: %v", name, userSignup)
 	}
+	return nil
 }
 
 // WaitForBannedUser waits until there is a BannedUser available with the given email
-func (a *HostAwaitility) WaitForBannedUser(t *testing.T, email string) (*toolchainv1alpha1.BannedUser, error) {
-	t.Logf("waiting for BannedUser for user '%s' in namespace '%s'", email, a.Namespace)
+func (a *HostAwaitility) WaitForBannedUser(t T, email string, options ...RetryOption) (*toolchainv1alpha1.BannedUser, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(options...)
+	a.logf(t, "waiting for BannedUser for user '%s' in namespace '%s'", email, a.Namespace)
 	var bannedUser *toolchainv1alpha1.BannedUser
 	labels := map[string]string{toolchainv1alpha1.BannedUserEmailHashLabelKey: hash.EncodeString(email)}
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		bannedUserList := &toolchainv1alpha1.BannedUserList{}
-		if err = a.Client.List(context.TODO(), bannedUserList, client.MatchingLabels(labels), client.InNamespace(a.Namespace)); err != nil {
+		if err = a.Client.List(a.getContext(), bannedUserList, client.MatchingLabels(labels), client.InNamespace(a.Namespace)); err != nil {
 			if len(bannedUserList.Items) == 0 {
 				return false, nil
 			}
@@ -748,16 +754,16 @@ func (a *HostAwaitility) WaitForBannedUser(t *testing.T, email string) (*toolcha
 	})
 	// log message if an error occurred
 	if err != nil {
-		t.Logf("failed to find Banned for email address '%s': %v", email, err)
+		a.logf(t, "failed to find Banned for email address '%s': %v", email, err)
 	}
 	return bannedUser, err
 }
 
 // DeleteToolchainStatus deletes the ToolchainStatus resource with the given name and in the host operator namespace
-func (a *HostAwaitility) DeleteToolchainStatus(t *testing.T, name string) error {
-	t.Logf("deleting ToolchainStatus '%s' in namespace '%s'", name, a.Namespace)
+func (a *HostAwaitility) DeleteToolchainStatus(t T, name string) error {
+	a.logf(t, "deleting ToolchainStatus '%s' in namespace '%s'", name, a.Namespace)
 	toolchainstatus := &toolchainv1alpha1.ToolchainStatus{}
-	if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, toolchainstatus); err != nil {
+	if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, toolchainstatus); err != nil {
 		if errors.IsNotFound(err) {
 			return nil
 		}
@@ -767,11 +773,12 @@ func (a *HostAwaitility) DeleteToolchainStatus(t *testing.T, name string) error
 }
 
 // WaitUntilBannedUserDeleted waits until the BannedUser with the given name is deleted (ie, not found)
-func (a *HostAwaitility) WaitUntilBannedUserDeleted(t *testing.T, name string) error {
-	t.Logf("waiting until BannedUser '%s' in namespace '%s' is deleted", name, a.Namespace)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *HostAwaitility) WaitUntilBannedUserDeleted(t T, name string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting until BannedUser '%s' in namespace '%s' is deleted", name, a.Namespace)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		user := &toolchainv1alpha1.BannedUser{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, user); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, user); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -782,11 +789,12 @@ func (a *HostAwaitility) WaitUntilBannedUserDeleted(t *testing.T, name string) e
 }
 
 // WaitUntilUserSignupDeleted waits until the UserSignup with the given name is deleted (ie, not found)
-func (a *HostAwaitility) WaitUntilUserSignupDeleted(t *testing.T, name string) error {
-	t.Logf("waiting until UserSignup '%s' in namespace '%s is deleted", name, a.Namespace)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *HostAwaitility) WaitUntilUserSignupDeleted(t T, name string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting until UserSignup '%s' in namespace '%s is deleted", name, a.Namespace)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		userSignup := &toolchainv1alpha1.UserSignup{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, userSignup); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, userSignup); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -797,11 +805,12 @@ func (a *HostAwaitility) WaitUntilUserSignupDeleted(t *testing.T, name string) e
 }
 
 // WaitUntilMasterUserRecordAndSpaceBindingsDeleted waits until the MUR with the given name and its associated SpaceBindings are deleted (ie, not found)
-func (a *HostAwaitility) WaitUntilMasterUserRecordAndSpaceBindingsDeleted(t *testing.T, name string) error {
-	t.Logf("waiting until MasterUserRecord '%s' in namespace '%s' is deleted", name, a.Namespace)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *HostAwaitility) WaitUntilMasterUserRecordAndSpaceBindingsDeleted(t T, name string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting until MasterUserRecord '%s' in namespace '%s' is deleted", name, a.Namespace)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		mur := &toolchainv1alpha1.MasterUserRecord{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, mur); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, mur); err != nil {
 			if errors.IsNotFound(err) {
 				// once the MUR is deleted, wait for the associated spacebindings to be deleted as well
 				if err := a.WaitUntilSpaceBindingsWithLabelDeleted(t, toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey, name); err != nil {
@@ -816,19 +825,15 @@ func (a *HostAwaitility) WaitUntilMasterUserRecordAndSpaceBindingsDeleted(t *tes
 }
 
 // CheckMasterUserRecordIsDeleted checks that the MUR with the given name is not present and won't be created in the next 2 seconds
-func (a *HostAwaitility) CheckMasterUserRecordIsDeleted(t *testing.T, name string) {
-	t.Logf("checking that MasterUserRecord '%s' in namespace '%s' is deleted", name, a.Namespace)
-	err := wait.Poll(a.RetryInterval, 2*time.Second, func() (done bool, err error) {
-		mur := &toolchainv1alpha1.MasterUserRecord{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, mur); err != nil {
-			if errors.IsNotFound(err) {
-				return false, nil
-			}
-			return false, err
-		}
-		return false, fmt.Errorf("the MasterUserRecord '%s' should not be present, but it is", name)
-	})
-	require.Equal(t, wait.ErrWaitTimeout, err)
+func (a *HostAwaitility) CheckMasterUserRecordIsDeleted(t T, name string) {
+	err := a.TryCheckMasterUserRecordIsDeleted(t, name)
+	require.NoError(t, err)
+}
+
+// TryCheckMasterUserRecordIsDeleted is like CheckMasterUserRecordIsDeleted, but it returns an error instead of failing the test
+// if the MUR is present
+func (a *HostAwaitility) TryCheckMasterUserRecordIsDeleted(t T, name string) error {
+	return NeverAppears[*toolchainv1alpha1.MasterUserRecord](t, a.Awaitility, types.NamespacedName{Namespace: a.Namespace, Name: name}, 2*time.Second)
 }
 
 func containsUserAccountStatus(uaStatuses []toolchainv1alpha1.UserAccountStatusEmbedded, uaStatus toolchainv1alpha1.UserAccountStatusEmbedded) bool {
@@ -842,12 +847,14 @@ func containsUserAccountStatus(uaStatuses []toolchainv1alpha1.UserAccountStatusE
 }
 
 // WaitForUserTier waits until an UserTier with the given name exists and matches any given criteria
-func (a *HostAwaitility) WaitForUserTier(t *testing.T, name string, criteria ...UserTierWaitCriterion) (*toolchainv1alpha1.UserTier, error) {
-	t.Logf("waiting until UserTier '%s' in namespace '%s' matches criteria", name, a.Namespace)
+func (a *HostAwaitility) WaitForUserTier(t T, name string, criteria ...UserTierWaitCriterion) (*toolchainv1alpha1.UserTier, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting until UserTier '%s' in namespace '%s' matches criteria", name, a.Namespace)
 	tier := &toolchainv1alpha1.UserTier{}
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.UserTier{}
-		err = a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj)
+		err = a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj)
 		if err != nil && !errors.IsNotFound(err) {
 			// return the error
 			return false, err
@@ -869,6 +876,26 @@ func (a *HostAwaitility) WaitForUserTier(t *testing.T, name string, criteria ...
 type UserTierWaitCriterion struct {
 	Match func(*toolchainv1alpha1.UserTier) bool
 	Diff  func(*toolchainv1alpha1.UserTier) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[UserTierWaitCriterion] = UserTierWaitCriterion{}
+
+func (UserTierWaitCriterion) withRetryOptions(options []RetryOption) UserTierWaitCriterion {
+	return UserTierWaitCriterion{
+		Match: func(*toolchainv1alpha1.UserTier) bool {
+			return true
+		},
+		Diff: func(*toolchainv1alpha1.UserTier) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c UserTierWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchUserTierWaitCriterion(actual *toolchainv1alpha1.UserTier, criteria ...UserTierWaitCriterion) bool {
@@ -881,7 +908,7 @@ func matchUserTierWaitCriterion(actual *toolchainv1alpha1.UserTier, criteria ...
 	return true
 }
 
-func (a *HostAwaitility) printUserTierWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.UserTier, criteria ...UserTierWaitCriterion) {
+func (a *HostAwaitility) printUserTierWaitCriterionDiffs(t T, actual *toolchainv1alpha1.UserTier, criteria ...UserTierWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find UserTier\n")
@@ -900,7 +927,7 @@ func (a *HostAwaitility) printUserTierWaitCriterionDiffs(t *testing.T, actual *t
 		}
 	}
 
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // UntilUserTierHasDeactivationTimeoutDays verify that the UserTier status.Updates has the specified number of entries
@@ -915,23 +942,25 @@ func UntilUserTierHasDeactivationTimeoutDays(expected int) UserTierWaitCriterion
 	}
 }
 
-func (a *HostAwaitility) WaitUntilBaseUserTierIsUpdated(t *testing.T) error {
+func (a *HostAwaitility) WaitUntilBaseUserTierIsUpdated(t T) error {
 	_, err := a.WaitForUserTier(t, "deactivate30", UntilUserTierHasDeactivationTimeoutDays(30))
 	return err
 }
 
-func (a *HostAwaitility) WaitUntilBaseNSTemplateTierIsUpdated(t *testing.T) error {
+func (a *HostAwaitility) WaitUntilBaseNSTemplateTierIsUpdated(t T) error {
 	_, err := a.WaitForNSTemplateTier(t, "base", UntilNSTemplateTierSpec(HasNoTemplateRefWithSuffix("-000000a")))
 	return err
 }
 
 // WaitForNSTemplateTier waits until an NSTemplateTier with the given name exists and matches the given conditions
-func (a *HostAwaitility) WaitForNSTemplateTier(t *testing.T, name string, criteria ...NSTemplateTierWaitCriterion) (*toolchainv1alpha1.NSTemplateTier, error) {
-	t.Logf("waiting until NSTemplateTier '%s' in namespace '%s' matches criteria", name, a.Namespace)
+func (a *HostAwaitility) WaitForNSTemplateTier(t T, name string, criteria ...NSTemplateTierWaitCriterion) (*toolchainv1alpha1.NSTemplateTier, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting until NSTemplateTier '%s' in namespace '%s' matches criteria", name, a.Namespace)
 	tier := &toolchainv1alpha1.NSTemplateTier{}
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.NSTemplateTier{}
-		err = a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj)
+		err = a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj)
 		if err != nil && !errors.IsNotFound(err) {
 			// return the error
 			return false, err
@@ -950,7 +979,8 @@ func (a *HostAwaitility) WaitForNSTemplateTier(t *testing.T, name string, criter
 }
 
 // WaitForNSTemplateTierAndCheckTemplates waits until an NSTemplateTier with the given name exists matching the given conditions and then it verifies that all expected templates exist
-func (a *HostAwaitility) WaitForNSTemplateTierAndCheckTemplates(t *testing.T, name string, criteria ...NSTemplateTierWaitCriterion) (*toolchainv1alpha1.NSTemplateTier, error) {
+func (a *HostAwaitility) WaitForNSTemplateTierAndCheckTemplates(t T, name string, criteria ...NSTemplateTierWaitCriterion) (*toolchainv1alpha1.NSTemplateTier, error) {
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
 	tier, err := a.WaitForNSTemplateTier(t, name, criteria...)
 	if err != nil {
 		return nil, err
@@ -979,12 +1009,14 @@ func (a *HostAwaitility) WaitForNSTemplateTierAndCheckTemplates(t *testing.T, na
 
 // WaitForTierTemplate waits until a TierTemplate with the given name exists
 // Returns an error if the resource did not exist (or something wrong happened)
-func (a *HostAwaitility) WaitForTierTemplate(t *testing.T, name string) (*toolchainv1alpha1.TierTemplate, error) { // nolint:unparam
+func (a *HostAwaitility) WaitForTierTemplate(t T, name string, options ...RetryOption) (*toolchainv1alpha1.TierTemplate, error) { // nolint:unparam
+	recordWaiter(t)
+	a = a.WithRetryOptions(options...)
 	tierTemplate := &toolchainv1alpha1.TierTemplate{}
-	t.Logf("waiting until TierTemplate '%s' exists in namespace '%s'...", name, a.Namespace)
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	a.logf(t, "waiting until TierTemplate '%s' exists in namespace '%s'...", name, a.Namespace)
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.TierTemplate{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -995,7 +1027,7 @@ func (a *HostAwaitility) WaitForTierTemplate(t *testing.T, name string) (*toolch
 	})
 	// log message if an error occurred
 	if err != nil {
-		t.Logf("failed to find TierTemplate '%s': %v", name, err)
+		a.logf(t, "failed to find TierTemplate '%s': %v", name, err)
 	}
 	return tierTemplate, err
 }
@@ -1004,6 +1036,26 @@ func (a *HostAwaitility) WaitForTierTemplate(t *testing.T, name string) (*toolch
 type NSTemplateTierWaitCriterion struct {
 	Match func(*toolchainv1alpha1.NSTemplateTier) bool
 	Diff  func(*toolchainv1alpha1.NSTemplateTier) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[NSTemplateTierWaitCriterion] = NSTemplateTierWaitCriterion{}
+
+func (NSTemplateTierWaitCriterion) withRetryOptions(options []RetryOption) NSTemplateTierWaitCriterion {
+	return NSTemplateTierWaitCriterion{
+		Match: func(*toolchainv1alpha1.NSTemplateTier) bool {
+			return true
+		},
+		Diff: func(*toolchainv1alpha1.NSTemplateTier) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c NSTemplateTierWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchNSTemplateTierWaitCriterion(actual *toolchainv1alpha1.NSTemplateTier, criteria ...NSTemplateTierWaitCriterion) bool {
@@ -1016,7 +1068,7 @@ func matchNSTemplateTierWaitCriterion(actual *toolchainv1alpha1.NSTemplateTier,
 	return true
 }
 
-func (a *HostAwaitility) printNSTemplateTierWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.NSTemplateTier, criteria ...NSTemplateTierWaitCriterion) {
+func (a *HostAwaitility) printNSTemplateTierWaitCriterionDiffs(t T, actual *toolchainv1alpha1.NSTemplateTier, criteria ...NSTemplateTierWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find NSTemplateTier\n")
@@ -1037,7 +1089,7 @@ func (a *HostAwaitility) printNSTemplateTierWaitCriterionDiffs(t *testing.T, act
 	// include also all NSTemplateTiers in the host namespace, to help troubleshooting
 	a.listAndPrint(t, "NSTemplateTiers", a.Namespace, &toolchainv1alpha1.NSTemplateTierList{})
 
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // NSTemplateTierSpecMatcher a struct to compare with an expected NSTemplateTierSpec
@@ -1107,6 +1159,26 @@ func HasClusterResourcesTemplateRef(expected string) NSTemplateTierSpecMatcher {
 type NotificationWaitCriterion struct {
 	Match func(toolchainv1alpha1.Notification) bool
 	Diff  func(toolchainv1alpha1.Notification) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[NotificationWaitCriterion] = NotificationWaitCriterion{}
+
+func (NotificationWaitCriterion) withRetryOptions(options []RetryOption) NotificationWaitCriterion {
+	return NotificationWaitCriterion{
+		Match: func(toolchainv1alpha1.Notification) bool {
+			return true
+		},
+		Diff: func(toolchainv1alpha1.Notification) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c NotificationWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchNotificationWaitCriterion(actual []toolchainv1alpha1.Notification, criteria ...NotificationWaitCriterion) bool {
@@ -1120,7 +1192,7 @@ func matchNotificationWaitCriterion(actual []toolchainv1alpha1.Notification, cri
 	return true
 }
 
-func (a *HostAwaitility) printNotificationWaitCriterionDiffs(t *testing.T, actual []toolchainv1alpha1.Notification, criteria ...NotificationWaitCriterion) {
+func (a *HostAwaitility) printNotificationWaitCriterionDiffs(t T, actual []toolchainv1alpha1.Notification, criteria ...NotificationWaitCriterion) {
 	buf := &strings.Builder{}
 	if len(actual) == 0 {
 		buf.WriteString("no notification found\n")
@@ -1145,18 +1217,20 @@ func (a *HostAwaitility) printNotificationWaitCriterionDiffs(t *testing.T, actua
 	// include also all Notifications in the host namespace, to help troubleshooting
 	a.listAndPrint(t, "Notifications", a.Namespace, &toolchainv1alpha1.NotificationList{})
 
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // WaitForNotifications waits until there is an expected number of Notifications available for the provided user and with the notification type and which match the conditions (if provided).
-func (a *HostAwaitility) WaitForNotifications(t *testing.T, username, notificationType string, numberOfNotifications int, criteria ...NotificationWaitCriterion) ([]toolchainv1alpha1.Notification, error) {
-	t.Logf("waiting for notifications to match criteria for user '%s'", username)
+func (a *HostAwaitility) WaitForNotifications(t T, username, notificationType string, numberOfNotifications int, criteria ...NotificationWaitCriterion) ([]toolchainv1alpha1.Notification, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for notifications to match criteria for user '%s'", username)
 	var notifications []toolchainv1alpha1.Notification
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		labels := map[string]string{toolchainv1alpha1.NotificationUserNameLabelKey: username, toolchainv1alpha1.NotificationTypeLabelKey: notificationType}
 		opts := client.MatchingLabels(labels)
 		notificationList := &toolchainv1alpha1.NotificationList{}
-		if err := a.Client.List(context.TODO(), notificationList, opts); err != nil {
+		if err := a.Client.List(a.getContext(), notificationList, opts); err != nil {
 			return false, err
 		}
 		notifications = notificationList.Items
@@ -1173,11 +1247,13 @@ func (a *HostAwaitility) WaitForNotifications(t *testing.T, username, notificati
 }
 
 // WaitForNotificationWithName waits until there is an expected Notifications available with the provided name and with the notification type and which match the conditions (if provided).
-func (a *HostAwaitility) WaitForNotificationWithName(t *testing.T, notificationName, notificationType string, criteria ...NotificationWaitCriterion) (toolchainv1alpha1.Notification, error) {
-	t.Logf("waiting for notification with name '%s'", notificationName)
+func (a *HostAwaitility) WaitForNotificationWithName(t T, notificationName, notificationType string, criteria ...NotificationWaitCriterion) (toolchainv1alpha1.Notification, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for notification with name '%s'", notificationName)
 	var notification toolchainv1alpha1.Notification
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: notificationName, Namespace: a.Namespace}, &notification); err != nil {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: notificationName, Namespace: a.Namespace}, &notification); err != nil {
 			return false, err
 		}
 		if typeFound, found := notification.GetLabels()[toolchainv1alpha1.NotificationTypeLabelKey]; !found {
@@ -1196,13 +1272,14 @@ func (a *HostAwaitility) WaitForNotificationWithName(t *testing.T, notificationN
 }
 
 // WaitUntilNotificationsDeleted waits until the Notification for the given user is deleted (ie, not found)
-func (a *HostAwaitility) WaitUntilNotificationsDeleted(t *testing.T, username, notificationType string) error {
-	t.Logf("waiting until notifications have been deleted for user '%s'", username)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *HostAwaitility) WaitUntilNotificationsDeleted(t T, username, notificationType string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting until notifications have been deleted for user '%s'", username)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		labels := map[string]string{toolchainv1alpha1.NotificationUserNameLabelKey: username, toolchainv1alpha1.NotificationTypeLabelKey: notificationType}
 		opts := client.MatchingLabels(labels)
 		notificationList := &toolchainv1alpha1.NotificationList{}
-		if err := a.Client.List(context.TODO(), notificationList, opts); err != nil {
+		if err := a.Client.List(a.getContext(), notificationList, opts); err != nil {
 			return false, err
 		}
 		return len(notificationList.Items) == 0, nil
@@ -1210,11 +1287,12 @@ func (a *HostAwaitility) WaitUntilNotificationsDeleted(t *testing.T, username, n
 }
 
 // WaitUntilNotificationWithNameDeleted waits until the Notification with the given name is deleted (ie, not found)
-func (a *HostAwaitility) WaitUntilNotificationWithNameDeleted(t *testing.T, notificationName string) error {
-	t.Logf("waiting for notification with name '%s' to get deleted", notificationName)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *HostAwaitility) WaitUntilNotificationWithNameDeleted(t T, notificationName string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting for notification with name '%s' to get deleted", notificationName)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		notification := &toolchainv1alpha1.Notification{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: notificationName, Namespace: a.Namespace}, notification); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: notificationName, Namespace: a.Namespace}, notification); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -1240,6 +1318,26 @@ func UntilNotificationHasConditions(expected ...toolchainv1alpha1.Condition) Not
 type ToolchainStatusWaitCriterion struct {
 	Match func(*toolchainv1alpha1.ToolchainStatus) bool
 	Diff  func(*toolchainv1alpha1.ToolchainStatus) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[ToolchainStatusWaitCriterion] = ToolchainStatusWaitCriterion{}
+
+func (ToolchainStatusWaitCriterion) withRetryOptions(options []RetryOption) ToolchainStatusWaitCriterion {
+	return ToolchainStatusWaitCriterion{
+		Match: func(*toolchainv1alpha1.ToolchainStatus) bool {
+			return true
+		},
+		Diff: func(*toolchainv1alpha1.ToolchainStatus) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c ToolchainStatusWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchToolchainStatusWaitCriterion(actual *toolchainv1alpha1.ToolchainStatus, criteria ...ToolchainStatusWaitCriterion) bool {
@@ -1251,7 +1349,7 @@ func matchToolchainStatusWaitCriterion(actual *toolchainv1alpha1.ToolchainStatus
 	return true
 }
 
-func (a *HostAwaitility) printToolchainStatusWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.ToolchainStatus, criteria ...ToolchainStatusWaitCriterion) {
+func (a *HostAwaitility) printToolchainStatusWaitCriterionDiffs(t T, actual *toolchainv1alpha1.ToolchainStatus, criteria ...ToolchainStatusWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find Toolchainstatus\n")
@@ -1272,7 +1370,7 @@ func (a *HostAwaitility) printToolchainStatusWaitCriterionDiffs(t *testing.T, ac
 	// include also all ToolchainStatuses in the host namespace, to help troubleshooting
 	a.listAndPrint(t, "ToolchainStatuses", a.Namespace, &toolchainv1alpha1.ToolchainStatusList{})
 
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // UntilToolchainStatusHasConditions returns a `ToolchainStatusWaitCriterion` which checks that the given
@@ -1355,6 +1453,19 @@ func UntilProxyURLIsPresent(proxyURL string) ToolchainStatusWaitCriterion {
 	}
 }
 
+// UntilHostRoutesAreReady returns a `ToolchainStatusWaitCriterion` which checks that the host routes section
+// of the ToolchainStatus has a `Ready=True` condition
+func UntilHostRoutesAreReady() ToolchainStatusWaitCriterion {
+	return ToolchainStatusWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.ToolchainStatus) bool {
+			return condition.IsTrue(actual.Status.HostRoutes.Conditions, toolchainv1alpha1.ConditionReady)
+		},
+		Diff: func(actual *toolchainv1alpha1.ToolchainStatus) string {
+			return fmt.Sprintf("expected host routes to be ready. Actual conditions: %s", spew.Sdump(actual.Status.HostRoutes.Conditions))
+		},
+	}
+}
+
 // UntilHasMurCount returns a `ToolchainStatusWaitCriterion` which checks that the given
 // ToolchainStatus has the given count of MasterUserRecords
 func UntilHasMurCount(domain string, expectedCount int) ToolchainStatusWaitCriterion {
@@ -1401,14 +1512,16 @@ func UntilHasSpaceCount(clusterName string, expectedCount int) ToolchainStatusWa
 }
 
 // WaitForToolchainStatus waits until the ToolchainStatus is available with the provided criteria, if any
-func (a *HostAwaitility) WaitForToolchainStatus(t *testing.T, criteria ...ToolchainStatusWaitCriterion) (*toolchainv1alpha1.ToolchainStatus, error) {
+func (a *HostAwaitility) WaitForToolchainStatus(t T, criteria ...ToolchainStatusWaitCriterion) (*toolchainv1alpha1.ToolchainStatus, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
 	// there should only be one toolchain status with the name toolchain-status
 	name := "toolchain-status"
 	toolchainStatus := &toolchainv1alpha1.ToolchainStatus{}
-	err := wait.Poll(a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.ToolchainStatus{}
 		// retrieve the toolchainstatus from the host namespace
-		err = a.Client.Get(context.TODO(),
+		err = a.Client.Get(a.getContext(),
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -1431,21 +1544,49 @@ func (a *HostAwaitility) WaitForToolchainStatus(t *testing.T, criteria ...Toolch
 }
 
 // GetToolchainConfig returns ToolchainConfig instance, nil if not found
-func (a *HostAwaitility) GetToolchainConfig(t *testing.T) *toolchainv1alpha1.ToolchainConfig {
+func (a *HostAwaitility) GetToolchainConfig(t T) *toolchainv1alpha1.ToolchainConfig {
+	config, err := a.TryGetToolchainConfig()
+	require.NoError(t, err)
+	return config
+}
+
+// TryGetToolchainConfig is like GetToolchainConfig, but it returns an error instead of failing the test
+// if the ToolchainConfig can't be retrieved
+func (a *HostAwaitility) TryGetToolchainConfig() (*toolchainv1alpha1.ToolchainConfig, error) {
 	config := &toolchainv1alpha1.ToolchainConfig{}
-	if err := a.Client.Get(context.TODO(), test.NamespacedName(a.Namespace, "config"), config); err != nil {
+	if err := a.Client.Get(a.getContext(), test.NamespacedName(a.Namespace, "config"), config); err != nil {
 		if errors.IsNotFound(err) {
-			return nil
+			return nil, nil
 		}
-		require.NoError(t, err)
+		return nil, err
 	}
-	return config
+	return config, nil
 }
 
 // ToolchainConfigWaitCriterion a struct to compare with an expected ToolchainConfig
 type ToolchainConfigWaitCriterion struct {
 	Match func(*toolchainv1alpha1.ToolchainConfig) bool
 	Diff  func(*toolchainv1alpha1.ToolchainConfig) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[ToolchainConfigWaitCriterion] = ToolchainConfigWaitCriterion{}
+
+func (ToolchainConfigWaitCriterion) withRetryOptions(options []RetryOption) ToolchainConfigWaitCriterion {
+	return ToolchainConfigWaitCriterion{
+		Match: func(*toolchainv1alpha1.ToolchainConfig) bool {
+			return true
+		},
+		Diff: func(*toolchainv1alpha1.ToolchainConfig) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c ToolchainConfigWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchToolchainConfigWaitCriterion(actual *toolchainv1alpha1.ToolchainConfig, criteria ...ToolchainConfigWaitCriterion) bool {
@@ -1457,7 +1598,7 @@ func matchToolchainConfigWaitCriterion(actual *toolchainv1alpha1.ToolchainConfig
 	return true
 }
 
-func (a *HostAwaitility) printToolchainConfigWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.ToolchainConfig, criteria ...ToolchainConfigWaitCriterion) {
+func (a *HostAwaitility) printToolchainConfigWaitCriterionDiffs(t T, actual *toolchainv1alpha1.ToolchainConfig, criteria ...ToolchainConfigWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find ToolchainConfig\n")
@@ -1478,7 +1619,7 @@ func (a *HostAwaitility) printToolchainConfigWaitCriterionDiffs(t *testing.T, ac
 	// include also all ToolchainConfigs in the host namespace, to help troubleshooting
 	a.listAndPrint(t, "ToolchainConfigs", a.Namespace, &toolchainv1alpha1.ToolchainConfigList{})
 
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 func UntilToolchainConfigHasSyncedStatus(expected toolchainv1alpha1.Condition) ToolchainConfigWaitCriterion {
@@ -1495,14 +1636,16 @@ func UntilToolchainConfigHasSyncedStatus(expected toolchainv1alpha1.Condition) T
 }
 
 // WaitForToolchainConfig waits until the ToolchainConfig is available with the provided criteria, if any
-func (a *HostAwaitility) WaitForToolchainConfig(t *testing.T, criteria ...ToolchainConfigWaitCriterion) (*toolchainv1alpha1.ToolchainConfig, error) {
+func (a *HostAwaitility) WaitForToolchainConfig(t T, criteria ...ToolchainConfigWaitCriterion) (*toolchainv1alpha1.ToolchainConfig, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
 	// there should only be one ToolchainConfig with the name "config"
 	name := "config"
 	var toolchainConfig *toolchainv1alpha1.ToolchainConfig
-	err := wait.Poll(a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.ToolchainConfig{}
 		// retrieve the ToolchainConfig from the host namespace
-		if err := a.Client.Get(context.TODO(),
+		if err := a.Client.Get(a.getContext(),
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -1526,10 +1669,20 @@ func (a *HostAwaitility) WaitForToolchainConfig(t *testing.T, criteria ...Toolch
 // UpdateToolchainConfig updates the current resource of the ToolchainConfig CR with the given options.
 // If there is no existing resource already, then it creates a new one.
 // At the end of the test it returns the resource back to the original value/state.
-func (a *HostAwaitility) UpdateToolchainConfig(t *testing.T, options ...testconfig.ToolchainConfigOption) {
+func (a *HostAwaitility) UpdateToolchainConfig(t T, options ...testconfig.ToolchainConfigOption) {
+	err := a.TryUpdateToolchainConfig(t, options...)
+	require.NoError(t, err)
+}
+
+// TryUpdateToolchainConfig is like UpdateToolchainConfig, but it returns an error instead of failing the test
+// if the ToolchainConfig can't be created or updated. The cleanup still fails the test if the ToolchainConfig can't be restored.
+func (a *HostAwaitility) TryUpdateToolchainConfig(t T, options ...testconfig.ToolchainConfigOption) error {
 	var originalConfig *toolchainv1alpha1.ToolchainConfig
 	// try to get the current ToolchainConfig
-	config := a.GetToolchainConfig(t)
+	config, err := a.TryGetToolchainConfig()
+	if err != nil {
+		return err
+	}
 	if config == nil {
 		// if it doesn't exist, then create a new one
 		config = &toolchainv1alpha1.ToolchainConfig{
@@ -1551,8 +1704,9 @@ func (a *HostAwaitility) UpdateToolchainConfig(t *testing.T, options ...testconf
 	// if it didn't exist before
 	if originalConfig == nil {
 		// then create a new one
-		err := a.Client.Create(context.TODO(), config)
-		require.NoError(t, err)
+		if err := a.Client.Create(context.TODO(), config); err != nil {
+			return err
+		}
 
 		// and as a cleanup function delete it at the end of the test
 		t.Cleanup(func() {
@@ -1561,12 +1715,13 @@ func (a *HostAwaitility) UpdateToolchainConfig(t *testing.T, options ...testconf
 				require.NoError(t, err)
 			}
 		})
-		return
+		return nil
 	}
 
 	// if the config did exist before the tests, then update it
-	err := a.updateToolchainConfigWithRetry(t, config)
-	require.NoError(t, err)
+	if err := a.updateToolchainConfigWithRetry(t, config); err != nil {
+		return err
+	}
 
 	// and as a cleanup function update it back to the original value
 	t.Cleanup(func() {
@@ -1584,20 +1739,38 @@ func (a *HostAwaitility) UpdateToolchainConfig(t *testing.T, options ...testconf
 			require.NoError(t, err)
 		}
 	})
+	return nil
+}
+
+// PatchToolchainConfig applies the given JSON merge patch on the ToolchainConfig, which allows to configure the settings
+// which are not (yet) part of the ToolchainConfig API used by the e2e tests
+func (a *HostAwaitility) PatchToolchainConfig(t T, patch string) {
+	err := a.TryPatchToolchainConfig(t, patch)
+	require.NoError(t, err)
+}
+
+// TryPatchToolchainConfig is like PatchToolchainConfig, but it returns an error instead of failing the test
+// if the ToolchainConfig can't be patched
+func (a *HostAwaitility) TryPatchToolchainConfig(t T, patch string) error {
+	config := &toolchainv1alpha1.ToolchainConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: a.Namespace,
+			Name:      "config",
+		},
+	}
+	if err := a.Client.Patch(context.TODO(), config, client.RawPatch(types.MergePatchType, []byte(patch))); err != nil {
+		return err
+	}
+	a.logf(t, "ToolchainConfig patched with: %s", patch)
+	return nil
 }
 
 // updateToolchainConfigWithRetry attempts to update the toolchainconfig, helpful because the toolchainconfig controller updates the toolchainconfig
 // resource periodically which can cause errors like `Operation cannot be fulfilled on toolchainconfigs.toolchain.dev.openshift.com "config": the object has been modified; please apply your changes to the latest version and try again`
 // in some cases. Retrying mitigates the potential for test flakiness due to this behaviour.
-func (a *HostAwaitility) updateToolchainConfigWithRetry(t *testing.T, updatedConfig *toolchainv1alpha1.ToolchainConfig) error {
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		config := a.GetToolchainConfig(t)
+func (a *HostAwaitility) updateToolchainConfigWithRetry(t T, updatedConfig *toolchainv1alpha1.ToolchainConfig) error {
+	_, err := Update(t, a.Awaitility, types.NamespacedName{Namespace: a.Namespace, Name: "config"}, func(config *toolchainv1alpha1.ToolchainConfig) {
 		config.Spec = updatedConfig.Spec
-		if err := a.Client.Update(context.TODO(), config); err != nil {
-			t.Logf("Retrying ToolchainConfig update due to error: %s", err.Error())
-			return false, nil
-		}
-		return true, nil
 	})
 	return err
 }
@@ -1605,7 +1778,7 @@ func (a *HostAwaitility) updateToolchainConfigWithRetry(t *testing.T, updatedCon
 // GetHostOperatorPod returns the pod running the host operator controllers
 func (a *HostAwaitility) GetHostOperatorPod() (corev1.Pod, error) {
 	pods := corev1.PodList{}
-	if err := a.Client.List(context.TODO(), &pods, client.InNamespace(a.Namespace), client.MatchingLabels{"control-plane": "controller-manager"}); err != nil {
+	if err := a.Client.List(a.getContext(), &pods, client.InNamespace(a.Namespace), client.MatchingLabels{"control-plane": "controller-manager"}); err != nil {
 		return corev1.Pod{}, err
 	}
 	if len(pods.Items) != 1 {
@@ -1615,7 +1788,7 @@ func (a *HostAwaitility) GetHostOperatorPod() (corev1.Pod, error) {
 }
 
 // CreateAPIProxyConfig creates a config for the proxy API using the given user token
-func (a *HostAwaitility) CreateAPIProxyConfig(t *testing.T, usertoken, proxyURL string) *rest.Config {
+func (a *HostAwaitility) CreateAPIProxyConfig(t T, usertoken, proxyURL string) *rest.Config {
 	apiConfig, err := clientcmd.NewDefaultClientConfigLoadingRules().Load()
 	require.NoError(t, err)
 
@@ -1629,8 +1802,18 @@ func (a *HostAwaitility) CreateAPIProxyConfig(t *testing.T, usertoken, proxyURL
 	}
 }
 
+// CreateAPIProxyConfigWithTokenProvider creates a config for the proxy API which authenticates the requests with the bearer tokens of
+// the given provider (eg: a httpclient.PasswordGrantTokenProvider of a user), so that the expired tokens are refreshed
+func (a *HostAwaitility) CreateAPIProxyConfigWithTokenProvider(t T, tokens httpclient.TokenProvider, proxyURL string) *rest.Config {
+	config := a.CreateAPIProxyConfig(t, "", proxyURL)
+	config.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		return httpclient.NewTokenTransport(rt, tokens)
+	}
+	return config
+}
+
 // CreateAPIProxyClient creates a client to the appstudio api proxy using the given user token
-func (a *HostAwaitility) CreateAPIProxyClient(t *testing.T, userToken, proxyURL string) (client.Client, error) {
+func (a *HostAwaitility) CreateAPIProxyClient(t T, userToken, proxyURL string) (client.Client, error) {
 	proxyKubeConfig := a.CreateAPIProxyConfig(t, userToken, proxyURL)
 
 	s := scheme.Scheme
@@ -1641,7 +1824,7 @@ func (a *HostAwaitility) CreateAPIProxyClient(t *testing.T, userToken, proxyURL
 	// updated yet and we try to create the client too quickly so retry to reduce flakiness.
 	var proxyCl client.Client
 	var initProxyClError error
-	waitErr := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	waitErr := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		proxyCl, initProxyClError = client.New(proxyKubeConfig, client.Options{Scheme: s})
 		return initProxyClError == nil, nil
 	})
@@ -1659,9 +1842,217 @@ func (a *HostAwaitility) PluginProxyURLWithWorkspaceContext(proxyPluginName, wor
 	return fmt.Sprintf("%s/plugins/%s/workspaces/%s", a.APIProxyURL, proxyPluginName, workspaceContext)
 }
 
+// WorkspacesWaitCriterion a struct to compare with the list of Workspaces returned by the proxy to a user
+type WorkspacesWaitCriterion struct {
+	Match func([]toolchainv1alpha1.Workspace) bool
+	Diff  func([]toolchainv1alpha1.Workspace) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[WorkspacesWaitCriterion] = WorkspacesWaitCriterion{}
+
+func (WorkspacesWaitCriterion) withRetryOptions(options []RetryOption) WorkspacesWaitCriterion {
+	return WorkspacesWaitCriterion{
+		Match: func([]toolchainv1alpha1.Workspace) bool {
+			return true
+		},
+		Diff: func([]toolchainv1alpha1.Workspace) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c WorkspacesWaitCriterion) retryOptions() []RetryOption {
+	return c.options
+}
+
+func matchWorkspacesWaitCriterion(actual []toolchainv1alpha1.Workspace, criteria ...WorkspacesWaitCriterion) bool {
+	for _, c := range criteria {
+		if !c.Match(actual) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *HostAwaitility) printWorkspacesWaitCriterionDiffs(t T, actual []toolchainv1alpha1.Workspace, criteria ...WorkspacesWaitCriterion) {
+	buf := &strings.Builder{}
+	buf.WriteString("failed to find Workspaces with matching criteria:\n")
+	buf.WriteString("----\n")
+	buf.WriteString("actual:\n")
+	for _, ws := range actual {
+		y, _ := StringifyObject(&ws) // nolint:gosec
+		buf.Write(y)
+	}
+	buf.WriteString("\n----\n")
+	buf.WriteString("diffs:\n")
+	for _, c := range criteria {
+		if !c.Match(actual) && c.Diff != nil {
+			buf.WriteString(c.Diff(actual))
+			buf.WriteString("\n")
+		}
+	}
+	// also include Spaces and SpaceBindings resources in the host namespace, to help troubleshooting
+	a.listAndPrint(t, "Spaces", a.Namespace, &toolchainv1alpha1.SpaceList{})
+	a.listAndPrint(t, "SpaceBindings", a.Namespace, &toolchainv1alpha1.SpaceBindingList{})
+	a.log(t, buf.String())
+}
+
+// WaitForWorkspaces waits until the list of Workspaces returned by the proxy to the user with the given token matches the given criteria
+func (a *HostAwaitility) WaitForWorkspaces(t T, userToken string, criteria ...WorkspacesWaitCriterion) ([]toolchainv1alpha1.Workspace, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for the list of workspaces returned by the proxy to match criteria")
+	proxyCl, err := a.CreateAPIProxyClient(t, userToken, a.APIProxyURL)
+	if err != nil {
+		return nil, err
+	}
+	var workspaces []toolchainv1alpha1.Workspace
+	err = a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		list := &toolchainv1alpha1.WorkspaceList{}
+		if err := proxyCl.List(a.getContext(), list); err != nil {
+			a.logf(t, "failed to list workspaces via the proxy: %s. Will retry again...", err.Error())
+			return false, nil
+		}
+		workspaces = list.Items
+		return matchWorkspacesWaitCriterion(workspaces, criteria...), nil
+	})
+	// no match found, print the diffs
+	if err != nil {
+		a.printWorkspacesWaitCriterionDiffs(t, workspaces, criteria...)
+	}
+	return workspaces, err
+}
+
+func workspaceNames(workspaces []toolchainv1alpha1.Workspace) []string {
+	names := make([]string, 0, len(workspaces))
+	for _, ws := range workspaces {
+		names = append(names, ws.Name)
+	}
+	return names
+}
+
+// sortedCopy returns a sorted copy of the given values, to compare lists regardless of the order of their items
+func sortedCopy(values []string) []string {
+	result := append([]string{}, values...)
+	sort.Strings(result)
+	return result
+}
+
+func findWorkspace(workspaces []toolchainv1alpha1.Workspace, name string) *toolchainv1alpha1.Workspace {
+	for i := range workspaces {
+		if workspaces[i].Name == name {
+			return &workspaces[i]
+		}
+	}
+	return nil
+}
+
+// UntilWorkspacesHaveNames returns a `WorkspacesWaitCriterion` which checks that the list
+// contains exactly the Workspaces with the given names (in any order)
+func UntilWorkspacesHaveNames(expected ...string) WorkspacesWaitCriterion {
+	sortedNames := func(names []string) []string {
+		result := append([]string{}, names...)
+		sort.Strings(result)
+		return result
+	}
+	return WorkspacesWaitCriterion{
+		Match: func(actual []toolchainv1alpha1.Workspace) bool {
+			return reflect.DeepEqual(sortedNames(expected), sortedNames(workspaceNames(actual)))
+		},
+		Diff: func(actual []toolchainv1alpha1.Workspace) string {
+			return fmt.Sprintf("expected workspace names to match: %s", Diff(sortedNames(expected), sortedNames(workspaceNames(actual))))
+		},
+	}
+}
+
+// UntilWorkspacesContain returns a `WorkspacesWaitCriterion` which checks that the list
+// contains the Workspace with the given name
+func UntilWorkspacesContain(name string) WorkspacesWaitCriterion {
+	return WorkspacesWaitCriterion{
+		Match: func(actual []toolchainv1alpha1.Workspace) bool {
+			return findWorkspace(actual, name) != nil
+		},
+		Diff: func(actual []toolchainv1alpha1.Workspace) string {
+			return fmt.Sprintf("expected workspace '%s' to be listed. Actual: %v", name, workspaceNames(actual))
+		},
+	}
+}
+
+// UntilWorkspacesDoNotContain returns a `WorkspacesWaitCriterion` which checks that the list
+// does not contain the Workspace with the given name
+func UntilWorkspacesDoNotContain(name string) WorkspacesWaitCriterion {
+	return WorkspacesWaitCriterion{
+		Match: func(actual []toolchainv1alpha1.Workspace) bool {
+			return findWorkspace(actual, name) == nil
+		},
+		Diff: func(actual []toolchainv1alpha1.Workspace) string {
+			return fmt.Sprintf("expected workspace '%s' not to be listed. Actual: %v", name, workspaceNames(actual))
+		},
+	}
+}
+
+// UntilWorkspaceHasRole returns a `WorkspacesWaitCriterion` which checks that the list
+// contains the Workspace with the given name, in which the user has the given role
+func UntilWorkspaceHasRole(name, role string) WorkspacesWaitCriterion {
+	return WorkspacesWaitCriterion{
+		Match: func(actual []toolchainv1alpha1.Workspace) bool {
+			ws := findWorkspace(actual, name)
+			return ws != nil && ws.Status.Role == role
+		},
+		Diff: func(actual []toolchainv1alpha1.Workspace) string {
+			ws := findWorkspace(actual, name)
+			if ws == nil {
+				return fmt.Sprintf("expected workspace '%s' to be listed. Actual: %v", name, workspaceNames(actual))
+			}
+			return fmt.Sprintf("expected role in workspace '%s' to match: %s", name, Diff(role, ws.Status.Role))
+		},
+	}
+}
+
+// UntilWorkspaceHasAvailableRoles returns a `WorkspacesWaitCriterion` which checks that the list
+// contains the Workspace with the given name, with exactly the given available roles (in any order)
+func UntilWorkspaceHasAvailableRoles(name string, roles ...string) WorkspacesWaitCriterion {
+	return WorkspacesWaitCriterion{
+		Match: func(actual []toolchainv1alpha1.Workspace) bool {
+			ws := findWorkspace(actual, name)
+			return ws != nil && reflect.DeepEqual(sortedCopy(roles), sortedCopy(ws.Status.AvailableRoles))
+		},
+		Diff: func(actual []toolchainv1alpha1.Workspace) string {
+			ws := findWorkspace(actual, name)
+			if ws == nil {
+				return fmt.Sprintf("expected workspace '%s' to be listed. Actual: %v", name, workspaceNames(actual))
+			}
+			return fmt.Sprintf("expected available roles in workspace '%s' to match: %s", name, Diff(sortedCopy(roles), sortedCopy(ws.Status.AvailableRoles)))
+		},
+	}
+}
+
 type SpaceWaitCriterion struct {
 	Match func(*toolchainv1alpha1.Space) bool
 	Diff  func(*toolchainv1alpha1.Space) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[SpaceWaitCriterion] = SpaceWaitCriterion{}
+
+func (SpaceWaitCriterion) withRetryOptions(options []RetryOption) SpaceWaitCriterion {
+	return SpaceWaitCriterion{
+		Match: func(*toolchainv1alpha1.Space) bool {
+			return true
+		},
+		Diff: func(*toolchainv1alpha1.Space) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c SpaceWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchSpaceWaitCriterion(actual *toolchainv1alpha1.Space, criteria ...SpaceWaitCriterion) bool {
@@ -1674,13 +2065,15 @@ func matchSpaceWaitCriterion(actual *toolchainv1alpha1.Space, criteria ...SpaceW
 }
 
 // WaitForSpace waits until the Space with the given name is available with the provided criteria, if any
-func (a *HostAwaitility) WaitForSpace(t *testing.T, name string, criteria ...SpaceWaitCriterion) (*toolchainv1alpha1.Space, error) {
-	t.Logf("waiting for Space '%s' with matching criteria", name)
+func (a *HostAwaitility) WaitForSpace(t T, name string, criteria ...SpaceWaitCriterion) (*toolchainv1alpha1.Space, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for Space '%s' with matching criteria", name)
 	var space *toolchainv1alpha1.Space
-	err := wait.Poll(a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
+	err := a.pollOnEvents(t, &toolchainv1alpha1.SpaceList{}, name, a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.Space{}
 		// retrieve the Space from the host namespace
-		if err := a.Client.Get(context.TODO(),
+		if err := a.Client.Get(a.getContext(),
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -1701,12 +2094,14 @@ func (a *HostAwaitility) WaitForSpace(t *testing.T, name string, criteria ...Spa
 	return space, err
 }
 
-func (a *HostAwaitility) WaitForProxyPlugin(t *testing.T, name string) (*toolchainv1alpha1.ProxyPlugin, error) {
-	t.Logf("waiting for ProxyPlugin %q", name)
+func (a *HostAwaitility) WaitForProxyPlugin(t T, name string, options ...RetryOption) (*toolchainv1alpha1.ProxyPlugin, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(options...)
+	a.logf(t, "waiting for ProxyPlugin %q", name)
 	var proxyPlugin *toolchainv1alpha1.ProxyPlugin
-	err := wait.Poll(a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.ProxyPlugin{}
-		if err = a.Client.Get(context.TODO(),
+		if err = a.Client.Get(a.getContext(),
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -1723,7 +2118,7 @@ func (a *HostAwaitility) WaitForProxyPlugin(t *testing.T, name string) (*toolcha
 	return proxyPlugin, err
 }
 
-func (a *HostAwaitility) printSpaceWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.Space, criteria ...SpaceWaitCriterion) {
+func (a *HostAwaitility) printSpaceWaitCriterionDiffs(t T, actual *toolchainv1alpha1.Space, criteria ...SpaceWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find Space\n")
@@ -1744,7 +2139,7 @@ func (a *HostAwaitility) printSpaceWaitCriterionDiffs(t *testing.T, actual *tool
 	}
 	// also include Spaces resources in the host namespace, to help troubleshooting
 	a.listAndPrint(t, "Spaces", a.Namespace, &toolchainv1alpha1.SpaceList{})
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // UntilSpaceIsBeingDeleted checks if Space has Deletion Timestamp
@@ -1931,12 +2326,13 @@ func UntilSpaceHasStatusTargetCluster(expected string) SpaceWaitCriterion {
 }
 
 // WaitUntilSpaceAndSpaceBindingsDeleted waits until the Space with the given name and its associated SpaceBindings are deleted (ie, not found)
-func (a *HostAwaitility) WaitUntilSpaceAndSpaceBindingsDeleted(t *testing.T, name string) error {
-	t.Logf("waiting until Space '%s' in namespace '%s' is deleted", name, a.Namespace)
+func (a *HostAwaitility) WaitUntilSpaceAndSpaceBindingsDeleted(t T, name string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting until Space '%s' in namespace '%s' is deleted", name, a.Namespace)
 	var s *toolchainv1alpha1.Space
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.Space{}
-		if err := a.Client.Get(context.TODO(),
+		if err := a.Client.Get(a.getContext(),
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -1955,7 +2351,7 @@ func (a *HostAwaitility) WaitUntilSpaceAndSpaceBindingsDeleted(t *testing.T, nam
 	})
 	if err != nil {
 		y, _ := yaml.Marshal(s)
-		t.Logf("Space '%s' was not deleted as expected: %s", name, y)
+		a.logf(t, "Space '%s' was not deleted as expected: %s", name, y)
 		return err
 	}
 	return nil
@@ -1963,9 +2359,9 @@ func (a *HostAwaitility) WaitUntilSpaceAndSpaceBindingsDeleted(t *testing.T, nam
 
 // WaitUntilSpaceBindingDeleted waits until the SpaceBinding with the given name is deleted (ie, not found)
 func (a *HostAwaitility) WaitUntilSpaceBindingDeleted(name string) error {
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	return a.poll(nil, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		mur := &toolchainv1alpha1.SpaceBinding{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, mur); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, mur); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -1976,14 +2372,15 @@ func (a *HostAwaitility) WaitUntilSpaceBindingDeleted(name string) error {
 }
 
 // WaitUntilSpaceBindingsWithLabelDeleted waits until there are no SpaceBindings listed using the given labels
-func (a *HostAwaitility) WaitUntilSpaceBindingsWithLabelDeleted(t *testing.T, key, value string) error {
+func (a *HostAwaitility) WaitUntilSpaceBindingsWithLabelDeleted(t T, key, value string) error {
+	recordWaiter(t)
 	labels := map[string]string{key: value}
-	t.Logf("waiting until SpaceBindings with labels '%v' in namespace '%s' are deleted", labels, a.Namespace)
+	a.logf(t, "waiting until SpaceBindings with labels '%v' in namespace '%s' are deleted", labels, a.Namespace)
 	var spaceBindingList *toolchainv1alpha1.SpaceBindingList
-	err := wait.Poll(a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
 		// retrieve the SpaceBinding from the host namespace
 		spaceBindingList = &toolchainv1alpha1.SpaceBindingList{}
-		if err = a.Client.List(context.TODO(), spaceBindingList, client.MatchingLabels(labels), client.InNamespace(a.Namespace)); err != nil {
+		if err = a.Client.List(a.getContext(), spaceBindingList, client.MatchingLabels(labels), client.InNamespace(a.Namespace)); err != nil {
 			return false, err
 		}
 		return len(spaceBindingList.Items) == 0, nil
@@ -2004,6 +2401,26 @@ func (a *HostAwaitility) WaitUntilSpaceBindingsWithLabelDeleted(t *testing.T, ke
 type SpaceBindingWaitCriterion struct {
 	Match func(*toolchainv1alpha1.SpaceBinding) bool
 	Diff  func(*toolchainv1alpha1.SpaceBinding) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[SpaceBindingWaitCriterion] = SpaceBindingWaitCriterion{}
+
+func (SpaceBindingWaitCriterion) withRetryOptions(options []RetryOption) SpaceBindingWaitCriterion {
+	return SpaceBindingWaitCriterion{
+		Match: func(*toolchainv1alpha1.SpaceBinding) bool {
+			return true
+		},
+		Diff: func(*toolchainv1alpha1.SpaceBinding) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c SpaceBindingWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchSpaceBindingWaitCriterion(actual *toolchainv1alpha1.SpaceBinding, criteria ...SpaceBindingWaitCriterion) bool {
@@ -2016,7 +2433,9 @@ func matchSpaceBindingWaitCriterion(actual *toolchainv1alpha1.SpaceBinding, crit
 }
 
 // WaitForSubSpace waits until the space provisioned by a SpaceRequest is available with the provided criteria, if any
-func (a *HostAwaitility) WaitForSubSpace(t *testing.T, spaceRequestName, spaceRequestNamespace, parentSpaceName string, criteria ...SpaceWaitCriterion) (*toolchainv1alpha1.Space, error) {
+func (a *HostAwaitility) WaitForSubSpace(t T, spaceRequestName, spaceRequestNamespace, parentSpaceName string, criteria ...SpaceWaitCriterion) (*toolchainv1alpha1.Space, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
 	var subSpace *toolchainv1alpha1.Space
 	labels := map[string]string{
 		toolchainv1alpha1.SpaceRequestLabelKey:          spaceRequestName,
@@ -2024,10 +2443,10 @@ func (a *HostAwaitility) WaitForSubSpace(t *testing.T, spaceRequestName, spaceRe
 		toolchainv1alpha1.ParentSpaceLabelKey:           parentSpaceName,
 	}
 
-	err := wait.Poll(a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
 		// retrieve the subSpace from the host namespace
 		spaceList := &toolchainv1alpha1.SpaceList{}
-		if err = a.Client.List(context.TODO(), spaceList, client.MatchingLabels(labels), client.InNamespace(a.Namespace)); err != nil {
+		if err = a.Client.List(a.getContext(), spaceList, client.MatchingLabels(labels), client.InNamespace(a.Namespace)); err != nil {
 			return false, err
 		}
 		if len(spaceList.Items) == 0 {
@@ -2047,10 +2466,12 @@ func (a *HostAwaitility) WaitForSubSpace(t *testing.T, spaceRequestName, spaceRe
 }
 
 // WaitForSpaceBinding waits until the SpaceBinding with the given MUR and Space names is available with the provided criteria, if any
-func (a *HostAwaitility) WaitForSpaceBinding(t *testing.T, murName, spaceName string, criteria ...SpaceBindingWaitCriterion) (*toolchainv1alpha1.SpaceBinding, error) {
+func (a *HostAwaitility) WaitForSpaceBinding(t T, murName, spaceName string, criteria ...SpaceBindingWaitCriterion) (*toolchainv1alpha1.SpaceBinding, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
 	var spaceBinding *toolchainv1alpha1.SpaceBinding
 
-	err := wait.Poll(a.RetryInterval, 2*a.Timeout, func() (bool, error) {
+	err := a.poll(t, a.RetryInterval, 2*a.Timeout, func() (bool, error) {
 		// retrieve the SpaceBinding from the host namespace
 		var err error
 		if spaceBinding, err = a.GetSpaceBindingByListing(murName, spaceName); err != nil {
@@ -2076,7 +2497,7 @@ func (a *HostAwaitility) GetSpaceBindingByListing(murName, spaceName string) (*t
 	}
 
 	spaceBindingList := &toolchainv1alpha1.SpaceBindingList{}
-	if err := a.Client.List(context.TODO(), spaceBindingList, client.MatchingLabels(labels), client.InNamespace(a.Namespace)); err != nil {
+	if err := a.Client.List(a.getContext(), spaceBindingList, client.MatchingLabels(labels), client.InNamespace(a.Namespace)); err != nil {
 		return nil, err
 	}
 	if len(spaceBindingList.Items) == 0 {
@@ -2088,7 +2509,7 @@ func (a *HostAwaitility) GetSpaceBindingByListing(murName, spaceName string) (*t
 	return &spaceBindingList.Items[0], nil
 }
 
-func (a *HostAwaitility) printSpaceBindingWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.SpaceBinding, criteria ...SpaceBindingWaitCriterion) {
+func (a *HostAwaitility) printSpaceBindingWaitCriterionDiffs(t T, actual *toolchainv1alpha1.SpaceBinding, criteria ...SpaceBindingWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find SpaceBinding\n")
@@ -2109,12 +2530,12 @@ func (a *HostAwaitility) printSpaceBindingWaitCriterionDiffs(t *testing.T, actua
 	}
 	// also include SpaceBindings resources in the host namespace, to help troubleshooting
 	a.listAndPrint(t, "SpaceBindings", a.Namespace, &toolchainv1alpha1.SpaceBindingList{})
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 func (a *HostAwaitility) ListSpaceBindings(spaceName string) ([]toolchainv1alpha1.SpaceBinding, error) {
 	bindings := &toolchainv1alpha1.SpaceBindingList{}
-	if err := a.Client.List(context.TODO(), bindings, client.InNamespace(a.Namespace), client.MatchingLabels{
+	if err := a.Client.List(a.getContext(), bindings, client.InNamespace(a.Namespace), client.MatchingLabels{
 		toolchainv1alpha1.SpaceBindingSpaceLabelKey: spaceName,
 	}); err != nil {
 		return nil, err
@@ -2206,6 +2627,26 @@ func UntilSpaceBindingHasLabel(key, value string) SpaceBindingWaitCriterion {
 type SocialEventWaitCriterion struct {
 	Match func(*toolchainv1alpha1.SocialEvent) bool
 	Diff  func(*toolchainv1alpha1.SocialEvent) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[SocialEventWaitCriterion] = SocialEventWaitCriterion{}
+
+func (SocialEventWaitCriterion) withRetryOptions(options []RetryOption) SocialEventWaitCriterion {
+	return SocialEventWaitCriterion{
+		Match: func(*toolchainv1alpha1.SocialEvent) bool {
+			return true
+		},
+		Diff: func(*toolchainv1alpha1.SocialEvent) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c SocialEventWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchSocialEventWaitCriterion(actual *toolchainv1alpha1.SocialEvent, criteria ...SocialEventWaitCriterion) bool {
@@ -2217,13 +2658,15 @@ func matchSocialEventWaitCriterion(actual *toolchainv1alpha1.SocialEvent, criter
 	return true
 }
 
-func (a *HostAwaitility) WaitForSocialEvent(t *testing.T, name string, criteria ...SocialEventWaitCriterion) (*toolchainv1alpha1.SocialEvent, error) {
-	t.Logf("waiting for SocialEvent '%s' in namespace '%s' to match criteria", name, a.Namespace)
+func (a *HostAwaitility) WaitForSocialEvent(t T, name string, criteria ...SocialEventWaitCriterion) (*toolchainv1alpha1.SocialEvent, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for SocialEvent '%s' in namespace '%s' to match criteria", name, a.Namespace)
 	var event *toolchainv1alpha1.SocialEvent
-	err := wait.Poll(a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.SocialEvent{}
 		// retrieve the Space from the host namespace
-		if err := a.Client.Get(context.TODO(),
+		if err := a.Client.Get(a.getContext(),
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -2244,8 +2687,8 @@ func (a *HostAwaitility) WaitForSocialEvent(t *testing.T, name string, criteria
 	return event, err
 }
 
-// UntilSocialEventHasActivationCount returns a `SpaceWaitCriterion` which checks that the
-// Space has the expected value of the state label
+// UntilSocialEventHasActivationCount returns a `SocialEventWaitCriterion` which checks that the
+// SocialEvent has the expected activation count
 func UntilSocialEventHasActivationCount(expected int) SocialEventWaitCriterion {
 	return SocialEventWaitCriterion{
 		Match: func(actual *toolchainv1alpha1.SocialEvent) bool {
@@ -2257,6 +2700,32 @@ func UntilSocialEventHasActivationCount(expected int) SocialEventWaitCriterion {
 	}
 }
 
+// UntilSocialEventHasStartTime returns a `SocialEventWaitCriterion` which checks that the given
+// SocialEvent has the expected start time (with a precision of a second)
+func UntilSocialEventHasStartTime(expected time.Time) SocialEventWaitCriterion {
+	return SocialEventWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.SocialEvent) bool {
+			return actual.Spec.StartTime.Unix() == expected.Unix()
+		},
+		Diff: func(actual *toolchainv1alpha1.SocialEvent) string {
+			return fmt.Sprintf("expected SocialEvent to have start time: %s \nactual: %s", expected.UTC().Format(time.RFC3339), actual.Spec.StartTime.UTC().Format(time.RFC3339))
+		},
+	}
+}
+
+// UntilSocialEventHasEndTime returns a `SocialEventWaitCriterion` which checks that the given
+// SocialEvent has the expected end time (with a precision of a second)
+func UntilSocialEventHasEndTime(expected time.Time) SocialEventWaitCriterion {
+	return SocialEventWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.SocialEvent) bool {
+			return actual.Spec.EndTime.Unix() == expected.Unix()
+		},
+		Diff: func(actual *toolchainv1alpha1.SocialEvent) string {
+			return fmt.Sprintf("expected SocialEvent to have end time: %s \nactual: %s", expected.UTC().Format(time.RFC3339), actual.Spec.EndTime.UTC().Format(time.RFC3339))
+		},
+	}
+}
+
 // UntilSocialEventHasConditions returns a `SocialEventWaitCriterion` which checks that the given
 // SocialEvent has exactly all the given status conditions
 func UntilSocialEventHasConditions(expected ...toolchainv1alpha1.Condition) SocialEventWaitCriterion {
@@ -2270,7 +2739,7 @@ func UntilSocialEventHasConditions(expected ...toolchainv1alpha1.Condition) Soci
 	}
 }
 
-func (a *HostAwaitility) printSocialEventWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.SocialEvent, criteria ...SocialEventWaitCriterion) {
+func (a *HostAwaitility) printSocialEventWaitCriterionDiffs(t T, actual *toolchainv1alpha1.SocialEvent, criteria ...SocialEventWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find SocialEvent\n")
@@ -2291,7 +2760,7 @@ func (a *HostAwaitility) printSocialEventWaitCriterionDiffs(t *testing.T, actual
 	}
 	// also include SocialEvents resources in the host namespace, to help troubleshooting
 	a.listAndPrint(t, "SocialEvents", a.Namespace, &toolchainv1alpha1.SocialEventList{})
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 const (
@@ -2327,11 +2796,11 @@ func EncodeUserIdentifier(subject string) string {
 
 // CreateSpaceAndSpaceBinding creates a space and spacebindig and waits until both are present.
 // We are creating both of them (Space and SpaceBinding) at the same time , with polling logic, so that we mitigate the issue with spacecleanup_controller deleting the Space before we create it's SpaceBinding.
-func (a *HostAwaitility) CreateSpaceAndSpaceBinding(t *testing.T, mur *toolchainv1alpha1.MasterUserRecord, space *toolchainv1alpha1.Space, spaceRole string) (*toolchainv1alpha1.Space, *toolchainv1alpha1.SpaceBinding, error) {
+func (a *HostAwaitility) CreateSpaceAndSpaceBinding(t T, mur *toolchainv1alpha1.MasterUserRecord, space *toolchainv1alpha1.Space, spaceRole string) (*toolchainv1alpha1.Space, *toolchainv1alpha1.SpaceBinding, error) {
 	var spaceBinding *toolchainv1alpha1.SpaceBinding
 	var spaceCreated *toolchainv1alpha1.Space
-	t.Logf("Creating Space %s and SpaceBinding for %s", space.Name, mur.Name)
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	a.logf(t, "Creating Space %s and SpaceBinding for %s", space.Name, mur.Name)
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		// create the space
 		spaceToCreate := space.DeepCopy()
 		if err := a.CreateWithCleanup(t, spaceToCreate); err != nil {
@@ -2348,17 +2817,17 @@ func (a *HostAwaitility) CreateSpaceAndSpaceBinding(t *testing.T, mur *toolchain
 		}
 		// let's see if space was provisioned as expected
 		spaceCreated = &toolchainv1alpha1.Space{}
-		err = a.Client.Get(context.TODO(), client.ObjectKeyFromObject(spaceToCreate), spaceCreated)
+		err = a.Client.Get(a.getContext(), client.ObjectKeyFromObject(spaceToCreate), spaceCreated)
 		if err != nil {
 			if errors.IsNotFound(err) {
-				t.Logf("The created Space %s is not present", spaceCreated.Name)
+				a.logf(t, "The created Space %s is not present", spaceCreated.Name)
 				return false, nil
 			}
 			return false, err
 		}
 		if util.IsBeingDeleted(spaceCreated) {
 			// space is in terminating let's wait until is gone and recreate it ...
-			t.Logf("The created Space %s is being deleted", spaceCreated.Name)
+			a.logf(t, "The created Space %s is being deleted", spaceCreated.Name)
 			return false, a.WaitUntilSpaceAndSpaceBindingsDeleted(t, spaceCreated.Name)
 		}
 		// let's see if SpaceBinding was provisioned as expected
@@ -2367,15 +2836,15 @@ func (a *HostAwaitility) CreateSpaceAndSpaceBinding(t *testing.T, mur *toolchain
 			return false, err
 		}
 		if spaceBinding == nil {
-			t.Logf("The created SpaceBinding %s is not present", spaceCreated.Name)
+			a.logf(t, "The created SpaceBinding %s is not present", spaceCreated.Name)
 			return false, nil
 		}
 		if util.IsBeingDeleted(spaceBinding) {
 			// spacebinding is in terminating let's wait until is gone and recreate it ...
-			t.Logf("The created SpaceBinding %s is being deleted", spaceBinding.Name)
+			a.logf(t, "The created SpaceBinding %s is being deleted", spaceBinding.Name)
 			return false, a.WaitUntilSpaceBindingDeleted(spaceBinding.Name)
 		}
-		t.Logf("Space %s and SpaceBinding %s created", spaceCreated.Name, spaceBinding.Name)
+		a.logf(t, "Space %s and SpaceBinding %s created", spaceCreated.Name, spaceBinding.Name)
 		return true, nil
 	})
 	return spaceCreated, spaceBinding, err
@@ -0,0 +1,77 @@
+package wait
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"sync"
+
+	testutil "github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
+)
+
+// WithHTTPTransport returns an option to send the requests of the HTTP-based waits (eg: WaitForRouteToBeAvailable, WaitForWebSocket or
+// the PromQL queries) with a clone of the given transport, on which the TLS config of each wait is set, eg: a transport with a custom
+// proxy or dialer to run the tests from a disconnected environment. By default, the transports send the requests via the proxy of
+// the `HTTPS_PROXY`/`HTTP_PROXY` and `NO_PROXY` env vars, if any (see util.NewTransport).
+func WithHTTPTransport(transport *http.Transport) RetryOption {
+	return httpTransportOption{transport: transport}
+}
+
+type httpTransportOption struct {
+	transport *http.Transport
+}
+
+var _ RetryOption = httpTransportOption{}
+
+func (o httpTransportOption) apply(a *Awaitility) {
+	a.httpTransport = o.transport
+}
+
+// newTransport returns a new transport with the given TLS config, based on the transport configured with WithHTTPTransport, if any
+func (a *Awaitility) newTransport(tlsConfig *tls.Config) *http.Transport {
+	if a.httpTransport == nil {
+		return testutil.NewTransport(tlsConfig)
+	}
+	transport := a.httpTransport.Clone()
+	transport.TLSClientConfig = tlsConfig
+	return transport
+}
+
+// newInsecureTransport returns a new transport which skips the verification of the server certificates, based on the transport
+// configured with WithHTTPTransport, if any
+func (a *Awaitility) newInsecureTransport() *http.Transport {
+	return a.newTransport(&tls.Config{
+		InsecureSkipVerify: true, // nolint:gosec
+	})
+}
+
+// transportCache the transports of the HTTP-based waits by host and TLS config, so that their connections are reused across the polls
+// and across the waits, instead of opening new connections on every poll (which may exhaust the ephemeral ports during long parallel runs)
+type transportCache struct {
+	mu         sync.Mutex
+	transports map[string]*http.Transport
+}
+
+func newTransportCache() *transportCache {
+	return &transportCache{
+		transports: map[string]*http.Transport{},
+	}
+}
+
+// cachedTransport returns the transport with the given key (eg: the host and the TLS config of a route), which is created with the
+// given func if needed. A new transport is returned on every call if the Awaitility has no transport cache.
+func (a *Awaitility) cachedTransport(key string, newTransport func() *http.Transport) *http.Transport {
+	if a.transports == nil {
+		return newTransport()
+	}
+	// the copies of the Awaitility with another base transport (see WithHTTPTransport) share the same cache
+	key = fmt.Sprintf("%p|%s", a.httpTransport, key)
+	a.transports.mu.Lock()
+	defer a.transports.mu.Unlock()
+	if transport, found := a.transports.transports[key]; found {
+		return transport
+	}
+	transport := newTransport()
+	a.transports.transports[key] = transport
+	return transport
+}
@@ -0,0 +1,136 @@
+package wait_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWithHTTPTransport(t *testing.T) {
+	// given
+	var mu sync.Mutex
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		// the requests sent via a proxy have an absolute URL
+		proxied = append(proxied, r.URL.String())
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+	proxyURL, err := url.Parse(proxy.URL)
+	require.NoError(t, err)
+	s := runtime.NewScheme()
+	require.NoError(t, routev1.AddToScheme(s))
+	route := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "toolchain-host-operator",
+			Name:      "api",
+		},
+		Status: routev1.RouteStatus{
+			Ingress: []routev1.RouteIngress{
+				{
+					// only resolvable by the proxy
+					Host: "api-toolchain-host-operator.apps.e2e.invalid",
+				},
+			},
+		},
+	}
+	a := &wait.Awaitility{
+		Client:        fake.NewClientBuilder().WithScheme(s).WithObjects(route).Build(),
+		Namespace:     "toolchain-host-operator",
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       100 * time.Millisecond,
+	}
+
+	t.Run("via the proxy of the transport", func(t *testing.T) {
+		// when
+		_, err := a.WithRetryOptions(wait.WithHTTPTransport(&http.Transport{Proxy: http.ProxyURL(proxyURL)})).
+			WaitForRouteToBeAvailable(t, route.Namespace, route.Name, "/proxyhealth")
+
+		// then
+		require.NoError(t, err)
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Contains(t, proxied, "http://api-toolchain-host-operator.apps.e2e.invalid/proxyhealth")
+	})
+
+	t.Run("without proxy", func(t *testing.T) {
+		// when
+		_, err := a.WaitForRouteToBeAvailable(t, route.Namespace, route.Name, "/proxyhealth")
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestWaitForRouteToBeAvailableReusesConnections(t *testing.T) {
+	// given
+	var mu sync.Mutex
+	connections := 0
+	requests := 0
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests++
+		if requests < 3 {
+			// not available at the first polls
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			mu.Lock()
+			defer mu.Unlock()
+			connections++
+		}
+	}
+	server.Start()
+	defer server.Close()
+	s := runtime.NewScheme()
+	require.NoError(t, routev1.AddToScheme(s))
+	route := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "toolchain-host-operator",
+			Name:      "api",
+		},
+		Status: routev1.RouteStatus{
+			Ingress: []routev1.RouteIngress{
+				{
+					Host: strings.TrimPrefix(server.URL, "http://"),
+				},
+			},
+		},
+	}
+	hostAwait := wait.NewHostAwaitility(nil, fake.NewClientBuilder().WithScheme(s).WithObjects(route).Build(), "toolchain-host-operator", "toolchain-host-operator")
+	hostAwait.RetryInterval = 10 * time.Millisecond
+	hostAwait.Timeout = time.Second
+
+	// when
+	_, err := hostAwait.WaitForRouteToBeAvailable(t, route.Namespace, route.Name, "/proxyhealth")
+	require.NoError(t, err)
+	_, err = hostAwait.WithRetryOptions(wait.TimeoutOption(2*time.Second)).WaitForRouteToBeAvailable(t, route.Namespace, route.Name, "/proxyhealth")
+	require.NoError(t, err)
+
+	// then
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 4, requests)
+	assert.Equal(t, 1, connections)
+}
@@ -0,0 +1,132 @@
+package wait
+
+import (
+	"fmt"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// InstallMode the way an operator was installed in the cluster
+type InstallMode string
+
+const (
+	// InstallModeOLM the operator was installed by OLM, from a ClusterServiceVersion (CSV) which owns the deployment
+	InstallModeOLM InstallMode = "OLM"
+	// InstallModeDirect the operator was installed by applying its manifests directly, without OLM
+	InstallModeDirect InstallMode = "direct"
+
+	// the labels set by OLM on the deployments created from a CSV
+	olmOwnerLabel     = "olm.owner"
+	olmOwnerKindLabel = "olm.owner.kind"
+)
+
+var csvGVK = schema.GroupVersionKind{Group: "operators.coreos.com", Version: "v1alpha1", Kind: "ClusterServiceVersion"}
+
+// InstallModeOf returns the way the operator of the given deployment was installed, based on the labels set by OLM
+func InstallModeOf(deployment *appsv1.Deployment) InstallMode {
+	if deployment.Labels[olmOwnerKindLabel] == csvGVK.Kind && deployment.Labels[olmOwnerLabel] != "" {
+		return InstallModeOLM
+	}
+	return InstallModeDirect
+}
+
+// GetInstallMode returns the way the operator of the deployment with the given name in the current namespace was installed
+func (a *Awaitility) GetInstallMode(name string) (InstallMode, error) {
+	deployment := &appsv1.Deployment{}
+	if err := a.Client.Get(a.getContext(), test.NamespacedName(a.Namespace, name), deployment); err != nil {
+		return "", err
+	}
+	return InstallModeOf(deployment), nil
+}
+
+// WaitForOperatorImage waits until the operator of the deployment with the given name runs the given image in the given container,
+// regardless of the way it was installed (eg: after an upgrade):
+//   - when installed by OLM, it first waits until the CSV which owns the deployment has the `Succeeded` phase and deploys the image,
+//     since the deployment is only updated once the CSV was replaced
+//   - in both modes, it then waits until the deployment is ready with the image
+func (a *Awaitility) WaitForOperatorImage(t T, name, containerName, image string) (*appsv1.Deployment, error) {
+	recordWaiter(t)
+	deployment := &appsv1.Deployment{}
+	if err := a.Client.Get(a.getContext(), test.NamespacedName(a.Namespace, name), deployment); err != nil {
+		return nil, err
+	}
+	mode := InstallModeOf(deployment)
+	a.logf(t, "waiting until deployment '%s' in namespace '%s' (installed in %s mode) runs image '%s'", name, a.Namespace, mode, image)
+	if mode == InstallModeOLM {
+		if err := a.waitForCSVToDeployImage(t, deployment.Labels[olmOwnerLabel], name, containerName, image); err != nil {
+			return nil, err
+		}
+	}
+	replicas := 1
+	if deployment.Spec.Replicas != nil {
+		replicas = int(*deployment.Spec.Replicas)
+	}
+	return a.TryWaitForDeploymentToGetReady(t, name, replicas, DeploymentHasContainerWithImage(containerName, image))
+}
+
+func (a *Awaitility) waitForCSVToDeployImage(t T, csvName, deploymentName, containerName, image string) error {
+	a.logf(t, "waiting until ClusterServiceVersion '%s' in namespace '%s' succeeded with image '%s'", csvName, a.Namespace, image)
+	var csv *unstructured.Unstructured
+	err := a.poll(t, a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
+		csv = &unstructured.Unstructured{}
+		csv.SetGroupVersionKind(csvGVK)
+		if err := a.Client.Get(a.getContext(), test.NamespacedName(a.Namespace, csvName), csv); err != nil {
+			return false, err
+		}
+		return csvDiff(csv, deploymentName, containerName, image) == "", nil
+	})
+	if err != nil && csv != nil {
+		a.logf(t, "ClusterServiceVersion '%s' did not succeed with image '%s': %s", csvName, image, csvDiff(csv, deploymentName, containerName, image))
+	}
+	return err
+}
+
+// csvDiff returns why the given CSV does not (yet) deploy the given image in the given container of the given deployment
+// with the `Succeeded` phase, or an empty string if it does
+func csvDiff(csv *unstructured.Unstructured, deploymentName, containerName, image string) string {
+	phase, _, _ := unstructured.NestedString(csv.Object, "status", "phase")
+	if phase != "Succeeded" {
+		reason, _, _ := unstructured.NestedString(csv.Object, "status", "reason")
+		message, _, _ := unstructured.NestedString(csv.Object, "status", "message")
+		return fmt.Sprintf("phase is '%s' (reason: '%s', message: '%s')", phase, reason, message)
+	}
+	deployments, _, err := unstructured.NestedSlice(csv.Object, "spec", "install", "spec", "deployments")
+	if err != nil {
+		return err.Error()
+	}
+	for _, d := range deployments {
+		obj, ok := d.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		spec := struct {
+			Name string                `json:"name"`
+			Spec appsv1.DeploymentSpec `json:"spec"`
+		}{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj, &spec); err != nil {
+			return err.Error()
+		}
+		if spec.Name != deploymentName {
+			continue
+		}
+		if !DeploymentHasContainerWithImage(containerName, image)(&appsv1.Deployment{Spec: spec.Spec}) {
+			return fmt.Sprintf("deployment '%s' has no container '%s' with image '%s'", deploymentName, containerName, image)
+		}
+		return ""
+	}
+	return fmt.Sprintf("no deployment '%s' in the install strategy", deploymentName)
+}
+
+// WaitForOperatorImage waits until the host operator runs the given image, regardless of the way it was installed (see Awaitility.WaitForOperatorImage)
+func (a *HostAwaitility) WaitForOperatorImage(t T, image string) (*appsv1.Deployment, error) {
+	return a.Awaitility.WaitForOperatorImage(t, "host-operator-controller-manager", "manager", image)
+}
+
+// WaitForOperatorImage waits until the member operator runs the given image, regardless of the way it was installed (see Awaitility.WaitForOperatorImage)
+func (a *MemberAwaitility) WaitForOperatorImage(t T, image string) (*appsv1.Deployment, error) {
+	return a.Awaitility.WaitForOperatorImage(t, "member-operator-controller-manager", "manager", image)
+}
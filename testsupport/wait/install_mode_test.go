@@ -0,0 +1,125 @@
+package wait_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestInstallMode(t *testing.T) {
+
+	newDeployment := func(labels map[string]string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "toolchain-host-operator",
+				Name:      "host-operator-controller-manager",
+				Labels:    labels,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "manager", Image: "quay.io/codeready-toolchain/host-operator:v1"}},
+					},
+				},
+			},
+		}
+	}
+	olmDeployment := newDeployment(map[string]string{
+		"olm.owner":           "toolchain-host-operator.v2",
+		"olm.owner.kind":      "ClusterServiceVersion",
+		"olm.owner.namespace": "toolchain-host-operator",
+	})
+	newCSV := func(phase, image string) *unstructured.Unstructured {
+		csv := &unstructured.Unstructured{Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"install": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"deployments": []interface{}{
+							map[string]interface{}{
+								"name": "host-operator-controller-manager",
+								"spec": map[string]interface{}{
+									"template": map[string]interface{}{
+										"spec": map[string]interface{}{
+											"containers": []interface{}{
+												map[string]interface{}{"name": "manager", "image": image},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": map[string]interface{}{
+				"phase": phase,
+			},
+		}}
+		csv.SetAPIVersion("operators.coreos.com/v1alpha1")
+		csv.SetKind("ClusterServiceVersion")
+		csv.SetNamespace("toolchain-host-operator")
+		csv.SetName("toolchain-host-operator.v2")
+		return csv
+	}
+	newAwaitility := func(t *testing.T, objs ...runtime.Object) *wait.Awaitility {
+		return &wait.Awaitility{
+			Client:        test.NewFakeClient(t, objs...),
+			Namespace:     "toolchain-host-operator",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+	}
+
+	t.Run("detection", func(t *testing.T) {
+		assert.Equal(t, wait.InstallModeOLM, wait.InstallModeOf(olmDeployment))
+		assert.Equal(t, wait.InstallModeDirect, wait.InstallModeOf(newDeployment(nil)))
+		assert.Equal(t, wait.InstallModeDirect, wait.InstallModeOf(newDeployment(map[string]string{"olm.owner": "some-operatorgroup", "olm.owner.kind": "OperatorGroup"})))
+
+		mode, err := newAwaitility(t, olmDeployment.DeepCopy()).GetInstallMode("host-operator-controller-manager")
+		require.NoError(t, err)
+		assert.Equal(t, wait.InstallModeOLM, mode)
+	})
+
+	t.Run("CSV not succeeded", func(t *testing.T) {
+		// given
+		a := newAwaitility(t, olmDeployment.DeepCopy(), newCSV("Installing", "quay.io/codeready-toolchain/host-operator:v2"))
+
+		// when
+		_, err := a.WaitForOperatorImage(t, "host-operator-controller-manager", "manager", "quay.io/codeready-toolchain/host-operator:v2")
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("CSV succeeded with another image", func(t *testing.T) {
+		// given
+		a := newAwaitility(t, olmDeployment.DeepCopy(), newCSV("Succeeded", "quay.io/codeready-toolchain/host-operator:v1"))
+
+		// when
+		_, err := a.WaitForOperatorImage(t, "host-operator-controller-manager", "manager", "quay.io/codeready-toolchain/host-operator:v2")
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("direct mode without the image", func(t *testing.T) {
+		// given
+		a := newAwaitility(t, newDeployment(nil))
+
+		// when
+		_, err := a.WaitForOperatorImage(t, "host-operator-controller-manager", "manager", "quay.io/codeready-toolchain/host-operator:v2")
+
+		// then
+		require.Error(t, err)
+	})
+}
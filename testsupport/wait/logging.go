@@ -0,0 +1,158 @@
+package wait
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/cluster"
+
+	"github.com/fatih/color"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// LogColorsVar the name of the env var which enables the colors of the labels prefixing the log lines of the Awaitilities (when set to `true`)
+	LogColorsVar = "E2E_LOG_COLORS"
+	// LogFormatVar the name of the env var which sets the format of the log lines of the Awaitilities: when set to `json`, each log line
+	// is a JSON object (see WaitEvent) which can be parsed by the log scrapers, eg: to find out which waits dominate the duration of the tests.
+	LogFormatVar = "E2E_LOG_FORMAT"
+)
+
+// WaitEvent a log line of an Awaitility, when the log lines are formatted as JSON (see LogFormatVar)
+type WaitEvent struct {
+	Time    time.Time `json:"time"`
+	Test    string    `json:"test"`
+	Cluster string    `json:"cluster,omitempty"`
+	// Waiter the waiter called by the test, eg: `(*HostAwaitility).WaitForSpace`
+	Waiter string `json:"waiter,omitempty"`
+	// Message the message logged by the waiter, which describes the awaited resource and criteria (or the failure to match them)
+	Message string `json:"message,omitempty"`
+	// Attempts the number of evaluations of the criteria, set when the wait is over
+	Attempts int `json:"attempts,omitempty"`
+	// Elapsed the duration of the wait, set when the wait is over
+	Elapsed string `json:"elapsed,omitempty"`
+	// Result the outcome of the wait (`met`, `timeout`, `cancelled` or `error`), set when the wait is over
+	Result string `json:"result,omitempty"`
+	// Error the error which ended the wait, if any
+	Error string `json:"error,omitempty"`
+}
+
+func jsonLogs() bool {
+	return CurrentEnvironment().LogFormat == "json"
+}
+
+var logLabelColors = []color.Attribute{color.FgCyan, color.FgMagenta, color.FgYellow, color.FgGreen, color.FgBlue}
+
+// LogLabel returns the label which prefixes the log lines of the Awaitility, so that the interleaved output of the waits on multiple clusters
+// can be followed: the name of the cluster for the member clusters (since there can be more than one), or the type of the cluster otherwise
+func (a *Awaitility) LogLabel() string {
+	if a.Type == cluster.Member && a.ClusterName != "" {
+		return a.ClusterName
+	}
+	return string(a.Type)
+}
+
+func (a *Awaitility) logPrefix() string {
+	label := a.LogLabel()
+	if label == "" {
+		return ""
+	}
+	prefix := "[" + label + "] "
+	if !CurrentEnvironment().LogColors {
+		return prefix
+	}
+	// the same label always gets the same color
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(label))
+	c := color.New(logLabelColors[h.Sum32()%uint32(len(logLabelColors))])
+	c.EnableColor() // the output of the tests is not a terminal
+	return c.Sprint(prefix)
+}
+
+// logf is like `t.Logf`, with the log line prefixed by the label of the Awaitility (see LogLabel), or formatted as JSON (see LogFormatVar)
+func (a *Awaitility) logf(t T, format string, args ...interface{}) {
+	t.Helper()
+	if jsonLogs() {
+		a.logEvent(t, WaitEvent{Message: fmt.Sprintf(format, args...)})
+		return
+	}
+	t.Logf("%s"+format, append([]interface{}{a.logPrefix()}, args...)...)
+}
+
+// log is like `t.Log`, with the log line prefixed by the label of the Awaitility (see LogLabel), or formatted as JSON (see LogFormatVar)
+func (a *Awaitility) log(t T, args ...interface{}) {
+	t.Helper()
+	msg := strings.TrimSuffix(fmt.Sprintln(args...), "\n")
+	if jsonLogs() {
+		a.logEvent(t, WaitEvent{Message: msg})
+		return
+	}
+	t.Log(a.logPrefix() + msg)
+}
+
+// logWaitOutcome records the duration of a wait which is over if the Awaitility has a WaitTelemetry (see WithWaitTelemetry), and logs
+// the number of evaluations of the criteria, the duration and the result of the wait when the log lines are formatted as JSON
+// (see LogFormatVar). Nothing is logged if the given test is nil.
+func (a *Awaitility) logWaitOutcome(t T, attempts int, elapsed time.Duration, err error) {
+	result := waitResult(err)
+	if a.telemetry != nil {
+		waiter := outermostWaiter()
+		if waiter == "" {
+			waiter = "(no waiter)" // eg: a poll of the test itself (see Poll)
+		}
+		a.telemetry.record(WaitDuration{
+			Waiter:   waiter,
+			Cluster:  a.LogLabel(),
+			Attempts: attempts,
+			Elapsed:  elapsed,
+			Result:   result,
+		})
+	}
+	if t == nil || !jsonLogs() {
+		return
+	}
+	t.Helper()
+	event := WaitEvent{
+		Attempts: attempts,
+		Elapsed:  elapsed.String(),
+		Result:   result,
+	}
+	if result == "error" {
+		event.Error = err.Error()
+	}
+	a.logEvent(t, event)
+}
+
+// waitResult returns the outcome of a wait which ended with the given error: `met`, `timeout`, `cancelled` (when the context
+// of the Awaitility is done, see WithContext) or `error`
+func waitResult(err error) string {
+	switch {
+	case err == nil:
+		return "met"
+	case errors.Is(err, wait.ErrWaitTimeout):
+		return "timeout"
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return "cancelled"
+	default:
+		return "error"
+	}
+}
+
+func (a *Awaitility) logEvent(t T, event WaitEvent) {
+	t.Helper()
+	event.Time = time.Now()
+	event.Test = t.Name()
+	event.Cluster = a.LogLabel()
+	event.Waiter = outermostWaiter()
+	line, err := json.Marshal(event)
+	if err != nil {
+		t.Logf("failed to format the log line as JSON: %v (%s)", err, event.Message)
+		return
+	}
+	t.Log(string(line))
+}
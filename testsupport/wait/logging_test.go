@@ -0,0 +1,47 @@
+package wait_test
+
+import (
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/cluster"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogLabel(t *testing.T) {
+
+	t.Run("host", func(t *testing.T) {
+		// given
+		a := wait.NewHostAwaitility(nil, nil, "toolchain-host-operator", "toolchain-host-operator")
+
+		// then
+		assert.Equal(t, "host", a.LogLabel())
+	})
+
+	t.Run("members", func(t *testing.T) {
+		// given
+		member1 := wait.NewMemberAwaitility(nil, nil, "toolchain-member-operator", "member-cluster-1")
+		member2 := wait.NewMemberAwaitility(nil, nil, "toolchain-member-operator", "member-cluster-2")
+
+		// then
+		assert.Equal(t, "member-cluster-1", member1.LogLabel())
+		assert.Equal(t, "member-cluster-2", member2.LogLabel())
+	})
+
+	t.Run("member without cluster name", func(t *testing.T) {
+		// given
+		a := wait.NewMemberAwaitility(nil, nil, "toolchain-member-operator", "")
+
+		// then
+		assert.Equal(t, string(cluster.Member), a.LogLabel())
+	})
+
+	t.Run("no type", func(t *testing.T) {
+		// given
+		a := &wait.Awaitility{}
+
+		// then
+		assert.Empty(t, a.LogLabel())
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/ghodss/yaml"
 	quotav1 "github.com/openshift/api/quota/v1"
 	routev1 "github.com/openshift/api/route/v1"
+	securityv1 "github.com/openshift/api/security/v1"
 	userv1 "github.com/openshift/api/user/v1"
 	"github.com/redhat-cop/operator-utils/pkg/util"
 	"github.com/stretchr/testify/assert"
@@ -32,7 +33,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -62,17 +62,29 @@ func NewMemberAwaitility(cfg *rest.Config, cl client.Client, ns, clusterName str
 			ClusterName:   clusterName,
 			Namespace:     ns,
 			Type:          cluster.Member,
-			RetryInterval: DefaultRetryInterval,
-			Timeout:       DefaultTimeout,
+			RetryInterval: CurrentEnvironment().RetryInterval,
+			Timeout:       CurrentEnvironment().Timeout,
+			baselines:     &metricBaselines{},
+			transports:    newTransportCache(),
 		},
 	}
 }
 
 // WaitForMetricsService verifies that there is a service called `host-operator-metrics-service`
 // in the member namespace.
-func (a *MemberAwaitility) WaitForMetricsService(t *testing.T) {
-	_, err := a.WaitForService(t, "member-operator-metrics-service")
-	require.NoError(t, err, "failed while waiting for 'member-operator-metrics-service' service")
+func (a *MemberAwaitility) WaitForMetricsService(t T, options ...RetryOption) {
+	err := a.TryWaitForMetricsService(t, options...)
+	require.NoError(t, err)
+}
+
+// TryWaitForMetricsService is like WaitForMetricsService, but it returns an error instead of failing the test
+// if the service is not found
+func (a *MemberAwaitility) TryWaitForMetricsService(t T, options ...RetryOption) error {
+	a = a.WithRetryOptions(options...)
+	if _, err := a.WaitForService(t, "member-operator-metrics-service"); err != nil {
+		return fmt.Errorf("failed while waiting for 'member-operator-metrics-service' service: %w", err)
+	}
+	return nil
 }
 
 // metric constants
@@ -80,13 +92,23 @@ const (
 	MemberOperatorVersionMetric = "sandbox_member_operator_version"
 )
 
-// InitMetricsAssertion waits for any pending usersignups and then initialized the metrics assertion helper with baseline values
-func (a *MemberAwaitility) InitMetrics(t *testing.T) {
-	a.WaitForMetricsService(t)
-	// Capture baseline values
-	a.baselineValues = make(map[string]float64)
-	a.baselineValues[MemberOperatorVersionMetric] = a.GetMetricValue(t, MemberOperatorVersionMetric)
-	t.Logf("captured baselines:\n%s", spew.Sdump(a.baselineValues))
+// InitMetrics waits for the metrics service of the member operator (see DiscoverMetricsService) and then captures the baseline values
+// of its metrics (see SetupMetrics for the configuration of the MetricsClient)
+func (a *MemberAwaitility) InitMetrics(t T) {
+	err := a.TryInitMetrics(t)
+	require.NoError(t, err)
+}
+
+// TryInitMetrics is like InitMetrics, but it returns an error instead of failing the test
+// if the metrics can't be initialized
+func (a *MemberAwaitility) TryInitMetrics(t T) error {
+	if a.MetricsClient == nil {
+		return fmt.Errorf("the metrics of the member operator in namespace '%s' are not set up", a.Namespace)
+	}
+	if _, err := a.DiscoverMetricsService(t); err != nil {
+		return fmt.Errorf("failed while discovering the metrics service of the member operator: %w", err)
+	}
+	return a.TryCaptureMetricsBaseline(t)
 }
 
 func (a *MemberAwaitility) WithRetryOptions(options ...RetryOption) *MemberAwaitility {
@@ -95,10 +117,43 @@ func (a *MemberAwaitility) WithRetryOptions(options ...RetryOption) *MemberAwait
 	}
 }
 
+// WithContext returns a new MemberAwaitility whose waits stop as soon as the given context is done
+func (a *MemberAwaitility) WithContext(ctx context.Context) *MemberAwaitility {
+	result := *a
+	result.Awaitility = a.Awaitility.WithContext(ctx)
+	return &result
+}
+
+// PauseReconciliation stops the member operator until the returned func is called (or until the end of the test), so that the test can set up
+// a state which the member operator finds when it starts again (see Awaitility.PauseDeployment)
+func (a *MemberAwaitility) PauseReconciliation(t T) (resume func()) {
+	return a.PauseDeployment(t, "member-operator-controller-manager")
+}
+
 // UserAccountWaitCriterion a struct to compare with a given UserAccount
 type UserAccountWaitCriterion struct {
 	Match func(*toolchainv1alpha1.UserAccount) bool
 	Diff  func(*toolchainv1alpha1.UserAccount) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[UserAccountWaitCriterion] = UserAccountWaitCriterion{}
+
+func (UserAccountWaitCriterion) withRetryOptions(options []RetryOption) UserAccountWaitCriterion {
+	return UserAccountWaitCriterion{
+		Match: func(*toolchainv1alpha1.UserAccount) bool {
+			return true
+		},
+		Diff: func(*toolchainv1alpha1.UserAccount) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c UserAccountWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchUserAccountWaitCriterion(actual *toolchainv1alpha1.UserAccount, criteria ...UserAccountWaitCriterion) bool {
@@ -110,7 +165,7 @@ func matchUserAccountWaitCriterion(actual *toolchainv1alpha1.UserAccount, criter
 	return true
 }
 
-func (a *MemberAwaitility) printUserAccountWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.UserAccount, criteria ...UserAccountWaitCriterion) {
+func (a *MemberAwaitility) printUserAccountWaitCriterionDiffs(t T, actual *toolchainv1alpha1.UserAccount, criteria ...UserAccountWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find UserAccount\n")
@@ -130,7 +185,7 @@ func (a *MemberAwaitility) printUserAccountWaitCriterionDiffs(t *testing.T, actu
 			}
 		}
 	}
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // UntilUserAccountHasLabelWithValue returns a `UserAccountWaitCriterion` which checks that the given
@@ -246,12 +301,89 @@ func UntilUserAccountIsCreatedAfter(timestamp metav1.Time) UserAccountWaitCriter
 	}
 }
 
+// UntilUserAccountHasPropagatedClaims returns a `UserAccountWaitCriterion` which checks that the given
+// UserAccount has the expected propagated claims
+func UntilUserAccountHasPropagatedClaims(expected toolchainv1alpha1.PropagatedClaims) UserAccountWaitCriterion {
+	return UserAccountWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.UserAccount) bool {
+			return reflect.DeepEqual(actual.Spec.PropagatedClaims, expected)
+		},
+		Diff: func(actual *toolchainv1alpha1.UserAccount) string {
+			return fmt.Sprintf("expected propagated claims to match: %s", Diff(expected, actual.Spec.PropagatedClaims))
+		},
+	}
+}
+
+// UntilUserAccountIsDisabled returns a `UserAccountWaitCriterion` which checks that the given
+// UserAccount has the `disabled` flag set to the expected value
+func UntilUserAccountIsDisabled(expected bool) UserAccountWaitCriterion {
+	return UserAccountWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.UserAccount) bool {
+			return actual.Spec.Disabled == expected
+		},
+		Diff: func(actual *toolchainv1alpha1.UserAccount) string {
+			return fmt.Sprintf("expected UserAccount to have `disabled` flag set to '%t'\nbut it was '%t'", expected, actual.Spec.Disabled)
+		},
+	}
+}
+
+// UntilUserAccountHasTierLabel returns a `UserAccountWaitCriterion` which checks that the given
+// UserAccount has the tier label with the given tier name
+func UntilUserAccountHasTierLabel(tierName string) UserAccountWaitCriterion {
+	return UserAccountWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.UserAccount) bool {
+			return actual.Labels[toolchainv1alpha1.TierLabelKey] == tierName
+		},
+		Diff: func(actual *toolchainv1alpha1.UserAccount) string {
+			actualTierName, found := actual.Labels[toolchainv1alpha1.TierLabelKey]
+			if !found {
+				return fmt.Sprintf("expected UserAccount to have label '%s' with value '%s'\nbut it has no such label", toolchainv1alpha1.TierLabelKey, tierName)
+			}
+			return fmt.Sprintf("expected UserAccount to have label '%s' with value '%s'\nbut it was '%s'", toolchainv1alpha1.TierLabelKey, tierName, actualTierName)
+		},
+	}
+}
+
+// UntilUserAccountHasOwnerAnnotations returns a `UserAccountWaitCriterion` which checks that the given
+// UserAccount has the annotations identifying its owner (email, SSO user ID and SSO account ID) set to the same
+// values as in the given UserSignup. The SSO annotations are expected to be absent if they are not set in the UserSignup.
+func UntilUserAccountHasOwnerAnnotations(userSignup *toolchainv1alpha1.UserSignup) UserAccountWaitCriterion {
+	expected := map[string]string{
+		toolchainv1alpha1.UserEmailAnnotationKey: userSignup.Annotations[toolchainv1alpha1.UserSignupUserEmailAnnotationKey],
+	}
+	if userID, found := userSignup.Annotations[toolchainv1alpha1.SSOUserIDAnnotationKey]; found {
+		expected[toolchainv1alpha1.SSOUserIDAnnotationKey] = userID
+	}
+	if accountID, found := userSignup.Annotations[toolchainv1alpha1.SSOAccountIDAnnotationKey]; found {
+		expected[toolchainv1alpha1.SSOAccountIDAnnotationKey] = accountID
+	}
+	ownerAnnotations := func(actual *toolchainv1alpha1.UserAccount) map[string]string {
+		result := map[string]string{}
+		for _, key := range []string{toolchainv1alpha1.UserEmailAnnotationKey, toolchainv1alpha1.SSOUserIDAnnotationKey, toolchainv1alpha1.SSOAccountIDAnnotationKey} {
+			if value, found := actual.Annotations[key]; found {
+				result[key] = value
+			}
+		}
+		return result
+	}
+	return UserAccountWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.UserAccount) bool {
+			return reflect.DeepEqual(ownerAnnotations(actual), expected)
+		},
+		Diff: func(actual *toolchainv1alpha1.UserAccount) string {
+			return fmt.Sprintf("expected owner annotations to match: %s", Diff(expected, ownerAnnotations(actual)))
+		},
+	}
+}
+
 // WaitForUserAccount waits until there is a UserAccount available with the given name, expected spec and the set of status conditions
-func (a *MemberAwaitility) WaitForUserAccount(t *testing.T, name string, criteria ...UserAccountWaitCriterion) (*toolchainv1alpha1.UserAccount, error) {
+func (a *MemberAwaitility) WaitForUserAccount(t T, name string, criteria ...UserAccountWaitCriterion) (*toolchainv1alpha1.UserAccount, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
 	var userAccount *toolchainv1alpha1.UserAccount
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.pollOnEvents(t, &toolchainv1alpha1.UserAccountList{}, name, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.UserAccount{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -271,14 +403,36 @@ func (a *MemberAwaitility) WaitForUserAccount(t *testing.T, name string, criteri
 type SpaceRequestWaitCriterion struct {
 	Match func(request *toolchainv1alpha1.SpaceRequest) bool
 	Diff  func(request *toolchainv1alpha1.SpaceRequest) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[SpaceRequestWaitCriterion] = SpaceRequestWaitCriterion{}
+
+func (SpaceRequestWaitCriterion) withRetryOptions(options []RetryOption) SpaceRequestWaitCriterion {
+	return SpaceRequestWaitCriterion{
+		Match: func(*toolchainv1alpha1.SpaceRequest) bool {
+			return true
+		},
+		Diff: func(*toolchainv1alpha1.SpaceRequest) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c SpaceRequestWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 // WaitForSpaceRequest waits until there is a SpaceRequest available with the given name, namespace, spec and the set of status conditions
-func (a *MemberAwaitility) WaitForSpaceRequest(t *testing.T, namespacedName types.NamespacedName, criteria ...SpaceRequestWaitCriterion) (*toolchainv1alpha1.SpaceRequest, error) {
+func (a *MemberAwaitility) WaitForSpaceRequest(t T, namespacedName types.NamespacedName, criteria ...SpaceRequestWaitCriterion) (*toolchainv1alpha1.SpaceRequest, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
 	var spaceRequest *toolchainv1alpha1.SpaceRequest
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.SpaceRequest{}
-		if err := a.Client.Get(context.TODO(), namespacedName, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), namespacedName, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -397,7 +551,7 @@ func matchSpaceRequestWaitCriterion(actual *toolchainv1alpha1.SpaceRequest, crit
 	return true
 }
 
-func (a *MemberAwaitility) printSpaceRequestWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.SpaceRequest, criteria ...SpaceRequestWaitCriterion) {
+func (a *MemberAwaitility) printSpaceRequestWaitCriterionDiffs(t T, actual *toolchainv1alpha1.SpaceRequest, criteria ...SpaceRequestWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find SpaceRequest\n")
@@ -417,21 +571,43 @@ func (a *MemberAwaitility) printSpaceRequestWaitCriterionDiffs(t *testing.T, act
 			}
 		}
 	}
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // SpaceBindingRequestWaitCriterion a struct to compare with a given SpaceBindingRequest
 type SpaceBindingRequestWaitCriterion struct {
 	Match func(request *toolchainv1alpha1.SpaceBindingRequest) bool
 	Diff  func(request *toolchainv1alpha1.SpaceBindingRequest) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[SpaceBindingRequestWaitCriterion] = SpaceBindingRequestWaitCriterion{}
+
+func (SpaceBindingRequestWaitCriterion) withRetryOptions(options []RetryOption) SpaceBindingRequestWaitCriterion {
+	return SpaceBindingRequestWaitCriterion{
+		Match: func(*toolchainv1alpha1.SpaceBindingRequest) bool {
+			return true
+		},
+		Diff: func(*toolchainv1alpha1.SpaceBindingRequest) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c SpaceBindingRequestWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 // WaitForSpaceBindingRequest waits until there is a SpaceBindingRequest available with the given name, namespace, spec and the set of status conditions
-func (a *MemberAwaitility) WaitForSpaceBindingRequest(t *testing.T, namespacedName types.NamespacedName, criteria ...SpaceBindingRequestWaitCriterion) (*toolchainv1alpha1.SpaceBindingRequest, error) {
+func (a *MemberAwaitility) WaitForSpaceBindingRequest(t T, namespacedName types.NamespacedName, criteria ...SpaceBindingRequestWaitCriterion) (*toolchainv1alpha1.SpaceBindingRequest, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
 	var spaceBindingRequest *toolchainv1alpha1.SpaceBindingRequest
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.SpaceBindingRequest{}
-		if err := a.Client.Get(context.TODO(), namespacedName, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), namespacedName, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -495,7 +671,7 @@ func matchSpaceBindingRequestWaitCriterion(actual *toolchainv1alpha1.SpaceBindin
 	return true
 }
 
-func (a *MemberAwaitility) printSpaceBindingRequestWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.SpaceBindingRequest, criteria ...SpaceBindingRequestWaitCriterion) {
+func (a *MemberAwaitility) printSpaceBindingRequestWaitCriterionDiffs(t T, actual *toolchainv1alpha1.SpaceBindingRequest, criteria ...SpaceBindingRequestWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find SpaceBindingRequest\n")
@@ -515,13 +691,33 @@ func (a *MemberAwaitility) printSpaceBindingRequestWaitCriterionDiffs(t *testing
 			}
 		}
 	}
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // NSTemplateSetWaitCriterion a struct to compare with a given NSTemplateSet
 type NSTemplateSetWaitCriterion struct {
 	Match func(*toolchainv1alpha1.NSTemplateSet) bool
 	Diff  func(*toolchainv1alpha1.NSTemplateSet) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[NSTemplateSetWaitCriterion] = NSTemplateSetWaitCriterion{}
+
+func (NSTemplateSetWaitCriterion) withRetryOptions(options []RetryOption) NSTemplateSetWaitCriterion {
+	return NSTemplateSetWaitCriterion{
+		Match: func(*toolchainv1alpha1.NSTemplateSet) bool {
+			return true
+		},
+		Diff: func(*toolchainv1alpha1.NSTemplateSet) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c NSTemplateSetWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchNSTemplateSetWaitCriterion(actual *toolchainv1alpha1.NSTemplateSet, criteria ...NSTemplateSetWaitCriterion) bool {
@@ -533,7 +729,7 @@ func matchNSTemplateSetWaitCriterion(actual *toolchainv1alpha1.NSTemplateSet, cr
 	return true
 }
 
-func (a *MemberAwaitility) printNSTemplateSetWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.NSTemplateSet, criteria ...NSTemplateSetWaitCriterion) {
+func (a *MemberAwaitility) printNSTemplateSetWaitCriterionDiffs(t T, actual *toolchainv1alpha1.NSTemplateSet, criteria ...NSTemplateSetWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find NSTemplateSet at all\n")
@@ -553,7 +749,7 @@ func (a *MemberAwaitility) printNSTemplateSetWaitCriterionDiffs(t *testing.T, ac
 			}
 		}
 	}
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // UntilNSTemplateSetHasNoOwnerReferences returns a `NSTemplateSetWaitCriterion` which checks that the given
@@ -683,12 +879,14 @@ func UntilNSTemplateSetHasProvisionedNamespaces(expected []toolchainv1alpha1.Spa
 }
 
 // WaitForNSTmplSet wait until the NSTemplateSet with the given name and conditions exists
-func (a *MemberAwaitility) WaitForNSTmplSet(t *testing.T, name string, criteria ...NSTemplateSetWaitCriterion) (*toolchainv1alpha1.NSTemplateSet, error) {
-	t.Logf("waiting for NSTemplateSet '%s' to match criteria", name)
+func (a *MemberAwaitility) WaitForNSTmplSet(t T, name string, criteria ...NSTemplateSetWaitCriterion) (*toolchainv1alpha1.NSTemplateSet, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for NSTemplateSet '%s' to match criteria", name)
 	var nsTmplSet *toolchainv1alpha1.NSTemplateSet
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.pollOnEvents(t, &toolchainv1alpha1.NSTemplateSetList{}, name, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.NSTemplateSet{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: a.Namespace}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: name, Namespace: a.Namespace}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -705,11 +903,12 @@ func (a *MemberAwaitility) WaitForNSTmplSet(t *testing.T, name string, criteria
 }
 
 // WaitUntilNSTemplateSetDeleted waits until the NSTemplateSet with the given name is deleted (ie, is not found)
-func (a *MemberAwaitility) WaitUntilNSTemplateSetDeleted(t *testing.T, name string) error {
-	t.Logf("waiting for until NSTemplateSet '%s' in namespace '%s' is deleted", name, a.Namespace)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *MemberAwaitility) WaitUntilNSTemplateSetDeleted(t T, name string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting for until NSTemplateSet '%s' in namespace '%s' is deleted", name, a.Namespace)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		nsTmplSet := &toolchainv1alpha1.NSTemplateSet{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: a.Namespace}, nsTmplSet); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: name, Namespace: a.Namespace}, nsTmplSet); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -722,11 +921,51 @@ func (a *MemberAwaitility) WaitUntilNSTemplateSetDeleted(t *testing.T, name stri
 type NamespaceWaitCriterion struct {
 	Match func(*corev1.Namespace) bool
 	Diff  func(*corev1.Namespace) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[NamespaceWaitCriterion] = NamespaceWaitCriterion{}
+
+func (NamespaceWaitCriterion) withRetryOptions(options []RetryOption) NamespaceWaitCriterion {
+	return NamespaceWaitCriterion{
+		Match: func(*corev1.Namespace) bool {
+			return true
+		},
+		Diff: func(*corev1.Namespace) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c NamespaceWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 type LabelWaitCriterion struct {
 	Match func(metav1.ObjectMeta) bool
 	Diff  func(metav1.ObjectMeta) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[LabelWaitCriterion] = LabelWaitCriterion{}
+
+func (LabelWaitCriterion) withRetryOptions(options []RetryOption) LabelWaitCriterion {
+	return LabelWaitCriterion{
+		Match: func(metav1.ObjectMeta) bool {
+			return true
+		},
+		Diff: func(metav1.ObjectMeta) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c LabelWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 // UntilNamespaceIsActive returns a `NamespaceWaitCriterion` which checks that the given
@@ -783,7 +1022,9 @@ func matchNamespaceWaitCriteria(actual *corev1.Namespace, criteria ...NamespaceW
 }
 
 // WaitForNamespace waits until a namespace with the given owner (username), type, revision and tier labels exists
-func (a *MemberAwaitility) WaitForNamespace(t *testing.T, owner, tmplRef, tierName string, criteria ...NamespaceWaitCriterion) (*corev1.Namespace, error) {
+func (a *MemberAwaitility) WaitForNamespace(t T, owner, tmplRef, tierName string, criteria ...NamespaceWaitCriterion) (*corev1.Namespace, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
 	_, kind, err := TierAndType(tmplRef)
 	if err != nil {
 		return nil, err
@@ -795,12 +1036,12 @@ func (a *MemberAwaitility) WaitForNamespace(t *testing.T, owner, tmplRef, tierNa
 		toolchainv1alpha1.TypeLabelKey:        kind,
 		toolchainv1alpha1.ProviderLabelKey:    toolchainv1alpha1.ProviderLabelValue,
 	}
-	t.Logf("waiting for namespace with custom criteria and labels %v", labels)
+	a.logf(t, "waiting for namespace with custom criteria and labels %v", labels)
 	var ns *corev1.Namespace
-	err = wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err = a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		nss := &corev1.NamespaceList{}
 		opts := client.MatchingLabels(labels)
-		if err := a.Client.List(context.TODO(), nss, opts); err != nil {
+		if err := a.Client.List(a.getContext(), nss, opts); err != nil {
 			return false, err
 		}
 		if len(nss.Items) != 1 {
@@ -810,30 +1051,32 @@ func (a *MemberAwaitility) WaitForNamespace(t *testing.T, owner, tmplRef, tierNa
 		return matchNamespaceWaitCriteria(ns, criteria...), nil
 	})
 	if err != nil {
-		t.Logf("failed to wait for namespace with labels: %v", labels)
+		a.logf(t, "failed to wait for namespace with labels: %v", labels)
 		opts := client.MatchingLabels(map[string]string{
 			toolchainv1alpha1.ProviderLabelKey: toolchainv1alpha1.ProviderLabelValue,
 		})
 		a.listAndPrint(t, "Namespaces", "", &corev1.NamespaceList{}, opts)
 		if ns == nil {
-			t.Logf("a namespace with the following labels was not found: %v", labels)
+			a.logf(t, "a namespace with the following labels was not found: %v", labels)
 			return nil, err
 		}
 		for _, c := range criteria {
-			t.Logf(c.Diff(ns))
+			a.logf(t, c.Diff(ns))
 		}
 		return nil, err
 	}
-	t.Logf("found namespace %s with custom criteria and labels %v", ns.Name, labels)
+	a.logf(t, "found namespace %s with custom criteria and labels %v", ns.Name, labels)
 	return ns, nil
 }
 
 // WaitForNamespaceWithName waits until a namespace with the given name
-func (a *MemberAwaitility) WaitForNamespaceWithName(t *testing.T, name string, criteria ...LabelWaitCriterion) (*corev1.Namespace, error) {
+func (a *MemberAwaitility) WaitForNamespaceWithName(t T, name string, criteria ...LabelWaitCriterion) (*corev1.Namespace, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
 	ns := &corev1.Namespace{}
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &corev1.Namespace{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -843,7 +1086,7 @@ func (a *MemberAwaitility) WaitForNamespaceWithName(t *testing.T, name string, c
 		return matchLabelWaitCriteria(ns.ObjectMeta, criteria...), nil
 	})
 	if err != nil {
-		t.Log("failed to wait for namespace")
+		a.log(t, "failed to wait for namespace")
 		a.printNamespaceLabelCriterionDiffs(t, ns, criteria...)
 		return nil, err
 	}
@@ -859,7 +1102,7 @@ func matchLabelWaitCriteria(actual metav1.ObjectMeta, criteria ...LabelWaitCrite
 	return true
 }
 
-func (a *MemberAwaitility) printNamespaceLabelCriterionDiffs(t *testing.T, actual *corev1.Namespace, criteria ...LabelWaitCriterion) {
+func (a *MemberAwaitility) printNamespaceLabelCriterionDiffs(t T, actual *corev1.Namespace, criteria ...LabelWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find Namespace\n")
@@ -879,15 +1122,17 @@ func (a *MemberAwaitility) printNamespaceLabelCriterionDiffs(t *testing.T, actua
 			}
 		}
 	}
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // WaitForNamespaceInTerminating waits until a namespace with the given name has a deletion timestamp and in Terminating Phase
-func (a *MemberAwaitility) WaitForNamespaceInTerminating(t *testing.T, nsName string) (*corev1.Namespace, error) {
+func (a *MemberAwaitility) WaitForNamespaceInTerminating(t T, nsName string, options ...RetryOption) (*corev1.Namespace, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(options...)
 	ns := &corev1.Namespace{}
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &corev1.Namespace{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: nsName}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: nsName}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -897,13 +1142,13 @@ func (a *MemberAwaitility) WaitForNamespaceInTerminating(t *testing.T, nsName st
 		return obj.DeletionTimestamp != nil && obj.Status.Phase == corev1.NamespaceTerminating, nil
 	})
 	if err != nil {
-		t.Logf("failed to wait for namespace '%s' to be in 'Terminating' phase", nsName)
+		a.logf(t, "failed to wait for namespace '%s' to be in 'Terminating' phase", nsName)
 		return nil, err
 	}
 	return ns, nil
 }
 
-func (a *MemberAwaitility) printRoleBindingWaitCriterionDiffs(t *testing.T, actual *rbacv1.RoleBinding, criteria ...LabelWaitCriterion) {
+func (a *MemberAwaitility) printRoleBindingWaitCriterionDiffs(t T, actual *rbacv1.RoleBinding, criteria ...LabelWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find RoleBinding\n")
@@ -923,16 +1168,18 @@ func (a *MemberAwaitility) printRoleBindingWaitCriterionDiffs(t *testing.T, actu
 			}
 		}
 	}
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // WaitForRoleBinding waits until a RoleBinding with the given name exists in the given namespace
-func (a *MemberAwaitility) WaitForRoleBinding(t *testing.T, namespace *corev1.Namespace, name string, criteria ...LabelWaitCriterion) (*rbacv1.RoleBinding, error) {
-	t.Logf("waiting for RoleBinding '%s' in namespace '%s'", name, namespace.Name)
+func (a *MemberAwaitility) WaitForRoleBinding(t T, namespace *corev1.Namespace, name string, criteria ...LabelWaitCriterion) (*rbacv1.RoleBinding, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for RoleBinding '%s' in namespace '%s'", name, namespace.Name)
 	roleBinding := &rbacv1.RoleBinding{}
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &rbacv1.RoleBinding{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace.Name, Name: name}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: namespace.Name, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -942,20 +1189,21 @@ func (a *MemberAwaitility) WaitForRoleBinding(t *testing.T, namespace *corev1.Na
 		return matchLabelWaitCriteria(obj.ObjectMeta, criteria...), nil
 	})
 	if err != nil {
-		t.Logf("failed to wait for rolebinding")
+		a.logf(t, "failed to wait for rolebinding")
 		a.printRoleBindingWaitCriterionDiffs(t, roleBinding, criteria...)
 		return nil, err
 	}
-	t.Logf("found rolebinding %s with custom labels", roleBinding.Name)
+	a.logf(t, "found rolebinding %s with custom labels", roleBinding.Name)
 	return roleBinding, err
 }
 
 // WaitUntilRoleBindingDeleted waits until a RoleBinding with the given name does not exist anymore in the given namespace
-func (a *MemberAwaitility) WaitUntilRoleBindingDeleted(t *testing.T, namespace *corev1.Namespace, name string) error {
-	t.Logf("waiting for RoleBinding '%s' in namespace '%s' to be deleted", name, namespace.Name)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *MemberAwaitility) WaitUntilRoleBindingDeleted(t T, namespace *corev1.Namespace, name string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting for RoleBinding '%s' in namespace '%s' to be deleted", name, namespace.Name)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		roleBinding := &rbacv1.RoleBinding{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: a.Namespace}, roleBinding); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: name, Namespace: a.Namespace}, roleBinding); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -965,12 +1213,92 @@ func (a *MemberAwaitility) WaitUntilRoleBindingDeleted(t *testing.T, namespace *
 	})
 }
 
-func (a *MemberAwaitility) WaitForServiceAccount(t *testing.T, namespace string, name string, criteria ...LabelWaitCriterion) (*corev1.ServiceAccount, error) {
-	t.Logf("waiting for ServiceAccount '%s' in namespace '%s'", name, namespace)
+// UserRoleRefs the names of the Roles and ClusterRoles which are expected to be bound to a user
+type UserRoleRefs struct {
+	Username string
+	RoleRefs []string
+}
+
+// ExpectRoleRefs returns the UserRoleRefs for the given user and role names
+func ExpectRoleRefs(username string, roleRefs ...string) UserRoleRefs {
+	return UserRoleRefs{
+		Username: username,
+		RoleRefs: roleRefs,
+	}
+}
+
+// WaitUntilSpaceRoleBindingsPropagated waits until each of the given namespaces contains RoleBindings which bind the expected
+// Roles/ClusterRoles to the expected users, and no RoleBinding for any of the removed users.
+// If the namespaces do not reach the expected state, the missing and unexpected RoleBindings are reported per namespace.
+func (a *MemberAwaitility) WaitUntilSpaceRoleBindingsPropagated(t T, namespaces []string, expected []UserRoleRefs, removedUsers ...string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting for the RoleBindings of %v (and none of %v) in namespaces %v", expected, removedUsers, namespaces)
+	deltas := map[string]string{}
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		deltas = map[string]string{}
+		for _, ns := range namespaces {
+			roleBindings := &rbacv1.RoleBindingList{}
+			if err := a.Client.List(a.getContext(), roleBindings, client.InNamespace(ns)); err != nil {
+				return false, err
+			}
+			if delta := roleBindingsDelta(roleBindings.Items, expected, removedUsers); delta != "" {
+				deltas[ns] = delta
+			}
+		}
+		return len(deltas) == 0, nil
+	})
+	if err != nil {
+		buf := &strings.Builder{}
+		buf.WriteString("failed to find the expected RoleBindings:\n")
+		for _, ns := range namespaces {
+			if delta, found := deltas[ns]; found {
+				buf.WriteString(fmt.Sprintf("namespace '%s':\n%s", ns, delta))
+			}
+		}
+		a.log(t, buf.String())
+	}
+	return err
+}
+
+// roleBindingsDelta returns a description of the expected role bindings which are missing and of the role bindings of removed users
+// which still exist, or an empty string if there is no difference
+func roleBindingsDelta(roleBindings []rbacv1.RoleBinding, expected []UserRoleRefs, removedUsers []string) string {
+	boundRoles := map[string]map[string]string{} // roles bound to each user, with the name of the RoleBinding
+	for _, rb := range roleBindings {
+		for _, s := range rb.Subjects {
+			if s.Kind != rbacv1.UserKind {
+				continue
+			}
+			if boundRoles[s.Name] == nil {
+				boundRoles[s.Name] = map[string]string{}
+			}
+			boundRoles[s.Name][rb.RoleRef.Name] = rb.Name
+		}
+	}
+	buf := &strings.Builder{}
+	for _, e := range expected {
+		for _, roleRef := range e.RoleRefs {
+			if _, found := boundRoles[e.Username][roleRef]; !found {
+				buf.WriteString(fmt.Sprintf("  missing: role '%s' bound to user '%s'\n", roleRef, e.Username))
+			}
+		}
+	}
+	for _, username := range removedUsers {
+		for roleRef, rbName := range boundRoles[username] {
+			buf.WriteString(fmt.Sprintf("  unexpected: RoleBinding '%s' binds role '%s' to removed user '%s'\n", rbName, roleRef, username))
+		}
+	}
+	return buf.String()
+}
+
+func (a *MemberAwaitility) WaitForServiceAccount(t T, namespace string, name string, criteria ...LabelWaitCriterion) (*corev1.ServiceAccount, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for ServiceAccount '%s' in namespace '%s'", name, namespace)
 	serviceAccount := &corev1.ServiceAccount{}
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &corev1.ServiceAccount{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -980,22 +1308,24 @@ func (a *MemberAwaitility) WaitForServiceAccount(t *testing.T, namespace string,
 		return matchLabelWaitCriteria(obj.ObjectMeta, criteria...), nil
 	})
 	if err != nil {
-		t.Logf("failed to wait for ServiceAccount '%s' in namespace '%s'.", name, namespace)
+		a.logf(t, "failed to wait for ServiceAccount '%s' in namespace '%s'.", name, namespace)
 		return nil, err
 	}
 	return serviceAccount, err
 }
 
 // WaitForLimitRange waits until a LimitRange with the given name exists in the given namespace
-func (a *MemberAwaitility) WaitForLimitRange(t *testing.T, namespace *corev1.Namespace, name string) (*corev1.LimitRange, error) {
-	t.Logf("waiting for LimitRange '%s' in namespace '%s'", name, namespace.Name)
+func (a *MemberAwaitility) WaitForLimitRange(t T, namespace *corev1.Namespace, name string, options ...RetryOption) (*corev1.LimitRange, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(options...)
+	a.logf(t, "waiting for LimitRange '%s' in namespace '%s'", name, namespace.Name)
 	lr := &corev1.LimitRange{}
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &corev1.LimitRange{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace.Name, Name: name}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: namespace.Name, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				allLRs := &corev1.LimitRangeList{}
-				if err := a.Client.List(context.TODO(), allLRs, client.MatchingLabels(codereadyToolchainProviderLabel)); err != nil {
+				if err := a.Client.List(a.getContext(), allLRs, client.MatchingLabels(codereadyToolchainProviderLabel)); err != nil {
 					return false, err
 				}
 				return false, nil
@@ -1006,21 +1336,23 @@ func (a *MemberAwaitility) WaitForLimitRange(t *testing.T, namespace *corev1.Nam
 		return true, nil
 	})
 	if err != nil {
-		t.Logf("failed to wait for LimitRange '%s' in namespace '%s'", name, namespace.Name)
+		a.logf(t, "failed to wait for LimitRange '%s' in namespace '%s'", name, namespace.Name)
 	}
 	return lr, err
 }
 
 // WaitForNetworkPolicy waits until a NetworkPolicy with the given name exists in the given namespace
-func (a *MemberAwaitility) WaitForNetworkPolicy(t *testing.T, namespace *corev1.Namespace, name string) (*netv1.NetworkPolicy, error) {
-	t.Logf("waiting for NetworkPolicy '%s' in namespace '%s'", name, namespace.Name)
+func (a *MemberAwaitility) WaitForNetworkPolicy(t T, namespace *corev1.Namespace, name string, options ...RetryOption) (*netv1.NetworkPolicy, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(options...)
+	a.logf(t, "waiting for NetworkPolicy '%s' in namespace '%s'", name, namespace.Name)
 	np := &netv1.NetworkPolicy{}
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &netv1.NetworkPolicy{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace.Name, Name: name}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: namespace.Name, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				allNPs := &netv1.NetworkPolicyList{}
-				if err := a.Client.List(context.TODO(), allNPs, client.MatchingLabels(codereadyToolchainProviderLabel)); err != nil {
+				if err := a.Client.List(a.getContext(), allNPs, client.MatchingLabels(codereadyToolchainProviderLabel)); err != nil {
 					return false, err
 				}
 				return false, nil
@@ -1031,18 +1363,20 @@ func (a *MemberAwaitility) WaitForNetworkPolicy(t *testing.T, namespace *corev1.
 		return true, nil
 	})
 	if err != nil {
-		t.Logf("failed to wait for NetworkPolicy '%s' in namespace '%s'", name, namespace.Name)
+		a.logf(t, "failed to wait for NetworkPolicy '%s' in namespace '%s'", name, namespace.Name)
 	}
 	return np, err
 }
 
 // WaitForRole waits until a Role with the given name exists in the given namespace
-func (a *MemberAwaitility) WaitForRole(t *testing.T, namespace *corev1.Namespace, name string, criteria ...LabelWaitCriterion) (*rbacv1.Role, error) {
-	t.Logf("waiting for Role '%s' in namespace '%s'", name, namespace.Name)
+func (a *MemberAwaitility) WaitForRole(t T, namespace *corev1.Namespace, name string, criteria ...LabelWaitCriterion) (*rbacv1.Role, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for Role '%s' in namespace '%s'", name, namespace.Name)
 	role := &rbacv1.Role{}
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &rbacv1.Role{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace.Name, Name: name}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: namespace.Name, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -1052,18 +1386,19 @@ func (a *MemberAwaitility) WaitForRole(t *testing.T, namespace *corev1.Namespace
 		return matchLabelWaitCriteria(obj.ObjectMeta, criteria...), nil
 	})
 	if err != nil {
-		t.Logf("failed to wait for Role '%s' in namespace '%s'", name, namespace.Name)
+		a.logf(t, "failed to wait for Role '%s' in namespace '%s'", name, namespace.Name)
 		a.printRoleWaitCriterionDiffs(t, role, criteria...)
 	}
 	return role, err
 }
 
 // WaitUntilRoleDeleted waits until a Role with the given name does not exist anymore in the given namespace
-func (a *MemberAwaitility) WaitUntilRoleDeleted(t *testing.T, namespace *corev1.Namespace, name string) error {
-	t.Logf("waiting for Role '%s' in namespace '%s' to be deleted", name, namespace.Name)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *MemberAwaitility) WaitUntilRoleDeleted(t T, namespace *corev1.Namespace, name string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting for Role '%s' in namespace '%s' to be deleted", name, namespace.Name)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		role := &rbacv1.Role{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: a.Namespace}, role); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: name, Namespace: a.Namespace}, role); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -1073,7 +1408,7 @@ func (a *MemberAwaitility) WaitUntilRoleDeleted(t *testing.T, namespace *corev1.
 	})
 }
 
-func (a *MemberAwaitility) printRoleWaitCriterionDiffs(t *testing.T, actual *rbacv1.Role, criteria ...LabelWaitCriterion) {
+func (a *MemberAwaitility) printRoleWaitCriterionDiffs(t T, actual *rbacv1.Role, criteria ...LabelWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find Role\n")
@@ -1093,13 +1428,33 @@ func (a *MemberAwaitility) printRoleWaitCriterionDiffs(t *testing.T, actual *rba
 			}
 		}
 	}
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // ClusterResourceQuotaWaitCriterion a struct to compare with a given ClusterResourceQuota
 type ClusterResourceQuotaWaitCriterion struct {
 	Match func(*quotav1.ClusterResourceQuota) bool
 	Diff  func(*quotav1.ClusterResourceQuota) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[ClusterResourceQuotaWaitCriterion] = ClusterResourceQuotaWaitCriterion{}
+
+func (ClusterResourceQuotaWaitCriterion) withRetryOptions(options []RetryOption) ClusterResourceQuotaWaitCriterion {
+	return ClusterResourceQuotaWaitCriterion{
+		Match: func(*quotav1.ClusterResourceQuota) bool {
+			return true
+		},
+		Diff: func(*quotav1.ClusterResourceQuota) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c ClusterResourceQuotaWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchClusterResourceQuotaWaitCriteria(actual *quotav1.ClusterResourceQuota, criteria ...ClusterResourceQuotaWaitCriterion) bool {
@@ -1111,7 +1466,7 @@ func matchClusterResourceQuotaWaitCriteria(actual *quotav1.ClusterResourceQuota,
 	return true
 }
 
-func (a *MemberAwaitility) printClusterResourceQuotaWaitCriterionDiffs(t *testing.T, actual *quotav1.ClusterResourceQuota, criteria ...ClusterResourceQuotaWaitCriterion) {
+func (a *MemberAwaitility) printClusterResourceQuotaWaitCriterionDiffs(t T, actual *quotav1.ClusterResourceQuota, criteria ...ClusterResourceQuotaWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find ClusterResourceQuota\n")
@@ -1131,20 +1486,22 @@ func (a *MemberAwaitility) printClusterResourceQuotaWaitCriterionDiffs(t *testin
 			}
 		}
 	}
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // WaitForClusterResourceQuota waits until a ClusterResourceQuota with the given name exists
-func (a *MemberAwaitility) WaitForClusterResourceQuota(t *testing.T, name string, criteria ...ClusterResourceQuotaWaitCriterion) (*quotav1.ClusterResourceQuota, error) {
-	t.Logf("waiting for ClusterResourceQuota '%s' to match criteria", name)
+func (a *MemberAwaitility) WaitForClusterResourceQuota(t T, name string, criteria ...ClusterResourceQuotaWaitCriterion) (*quotav1.ClusterResourceQuota, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for ClusterResourceQuota '%s' to match criteria", name)
 	quota := &quotav1.ClusterResourceQuota{}
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &quotav1.ClusterResourceQuota{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				quotaList := &quotav1.ClusterResourceQuotaList{}
 				ls := codereadyToolchainProviderLabel
-				if err := a.Client.List(context.TODO(), quotaList, client.MatchingLabels(ls)); err != nil {
+				if err := a.Client.List(a.getContext(), quotaList, client.MatchingLabels(ls)); err != nil {
 					return false, err
 				}
 				return false, nil
@@ -1165,6 +1522,26 @@ func (a *MemberAwaitility) WaitForClusterResourceQuota(t *testing.T, name string
 type ResourceQuotaWaitCriterion struct {
 	Match func(*corev1.ResourceQuota) bool
 	Diff  func(*corev1.ResourceQuota) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[ResourceQuotaWaitCriterion] = ResourceQuotaWaitCriterion{}
+
+func (ResourceQuotaWaitCriterion) withRetryOptions(options []RetryOption) ResourceQuotaWaitCriterion {
+	return ResourceQuotaWaitCriterion{
+		Match: func(*corev1.ResourceQuota) bool {
+			return true
+		},
+		Diff: func(*corev1.ResourceQuota) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c ResourceQuotaWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchResourceQuotaWaitCriteria(actual *corev1.ResourceQuota, criteria ...ResourceQuotaWaitCriterion) bool {
@@ -1176,7 +1553,7 @@ func matchResourceQuotaWaitCriteria(actual *corev1.ResourceQuota, criteria ...Re
 	return true
 }
 
-func (a *MemberAwaitility) printResourceQuotaWaitCriterionDiffs(t *testing.T, actual *corev1.ResourceQuota, criteria ...ResourceQuotaWaitCriterion) {
+func (a *MemberAwaitility) printResourceQuotaWaitCriterionDiffs(t T, actual *corev1.ResourceQuota, criteria ...ResourceQuotaWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find ResourceQuota\n")
@@ -1196,16 +1573,18 @@ func (a *MemberAwaitility) printResourceQuotaWaitCriterionDiffs(t *testing.T, ac
 			}
 		}
 	}
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // WaitForResourceQuota waits until a ResourceQuota with the given name exists
-func (a *MemberAwaitility) WaitForResourceQuota(t *testing.T, namespace, name string, criteria ...ResourceQuotaWaitCriterion) (*corev1.ResourceQuota, error) {
-	t.Logf("waiting for ResourceQuota '%s' in %s to match criteria", name, namespace)
+func (a *MemberAwaitility) WaitForResourceQuota(t T, namespace, name string, criteria ...ResourceQuotaWaitCriterion) (*corev1.ResourceQuota, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for ResourceQuota '%s' in %s to match criteria", name, namespace)
 	quota := &corev1.ResourceQuota{}
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &corev1.ResourceQuota{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -1225,6 +1604,26 @@ func (a *MemberAwaitility) WaitForResourceQuota(t *testing.T, namespace, name st
 type IdlerWaitCriterion struct {
 	Match func(*toolchainv1alpha1.Idler) bool
 	Diff  func(*toolchainv1alpha1.Idler) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[IdlerWaitCriterion] = IdlerWaitCriterion{}
+
+func (IdlerWaitCriterion) withRetryOptions(options []RetryOption) IdlerWaitCriterion {
+	return IdlerWaitCriterion{
+		Match: func(*toolchainv1alpha1.Idler) bool {
+			return true
+		},
+		Diff: func(*toolchainv1alpha1.Idler) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c IdlerWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchIdlerWaitCriteria(actual *toolchainv1alpha1.Idler, criteria ...IdlerWaitCriterion) bool {
@@ -1237,7 +1636,7 @@ func matchIdlerWaitCriteria(actual *toolchainv1alpha1.Idler, criteria ...IdlerWa
 	return true
 }
 
-func (a *MemberAwaitility) printIdlerWaitCriteriaDiffs(t *testing.T, actual *toolchainv1alpha1.Idler, criteria ...IdlerWaitCriterion) {
+func (a *MemberAwaitility) printIdlerWaitCriteriaDiffs(t T, actual *toolchainv1alpha1.Idler, criteria ...IdlerWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find Idler\n")
@@ -1259,7 +1658,7 @@ func (a *MemberAwaitility) printIdlerWaitCriteriaDiffs(t *testing.T, actual *too
 			}
 		}
 	}
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // IdlerConditions returns a `IdlerWaitCriterion` which checks that the given
@@ -1312,12 +1711,14 @@ func IdlerHasLabel(key, value string) IdlerWaitCriterion {
 }
 
 // WaitForIdler waits until an Idler with the given name exists
-func (a *MemberAwaitility) WaitForIdler(t *testing.T, name string, criteria ...IdlerWaitCriterion) (*toolchainv1alpha1.Idler, error) {
-	t.Logf("waiting for Idler '%s' to match criteria", name)
+func (a *MemberAwaitility) WaitForIdler(t T, name string, criteria ...IdlerWaitCriterion) (*toolchainv1alpha1.Idler, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for Idler '%s' to match criteria", name)
 	idler := &toolchainv1alpha1.Idler{}
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &toolchainv1alpha1.Idler{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -1334,114 +1735,47 @@ func (a *MemberAwaitility) WaitForIdler(t *testing.T, name string, criteria ...I
 }
 
 // UpdateIdlerSpec tries to update the Idler.Spec until success
-func (a *MemberAwaitility) UpdateIdlerSpec(t *testing.T, idler *toolchainv1alpha1.Idler) (*toolchainv1alpha1.Idler, error) {
-	var result *toolchainv1alpha1.Idler
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		obj := &toolchainv1alpha1.Idler{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: idler.Name}, obj); err != nil {
-			return false, err
-		}
+func (a *MemberAwaitility) UpdateIdlerSpec(t T, idler *toolchainv1alpha1.Idler) (*toolchainv1alpha1.Idler, error) {
+	return Update(t, a.Awaitility, types.NamespacedName{Name: idler.Name}, func(obj *toolchainv1alpha1.Idler) {
 		obj.Spec = idler.Spec
-		if err := a.Client.Update(context.TODO(), obj); err != nil {
-			t.Logf("trying to update Idler %s. Error: %s. Will try to update again.", idler.Name, err.Error())
-			return false, nil
-		}
-		result = obj
-		return true, nil
 	})
-	return result, err
 }
 
 // UpdateNamespace tries to update the Spec of the given Namespace
 // If it fails with an error (for example if the object has been modified) then it retrieves the latest version and tries again
 // Returns the updated Namespace
-func (a *MemberAwaitility) UpdateNamespace(t *testing.T, nsName string, modifyNamespace func(ns *corev1.Namespace)) (*corev1.Namespace, error) {
-	var ns *corev1.Namespace
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		freshNs := &corev1.Namespace{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: nsName}, freshNs); err != nil {
-			return true, err
-		}
-		modifyNamespace(freshNs)
-		if err := a.Client.Update(context.TODO(), freshNs); err != nil {
-			t.Logf("error updating Namespace '%s': %s. Will retry again...", nsName, err.Error())
-			return false, nil
-		}
-		ns = freshNs
-		return true, nil
-	})
-	return ns, err
+func (a *MemberAwaitility) UpdateNamespace(t T, nsName string, modifyNamespace func(ns *corev1.Namespace)) (*corev1.Namespace, error) {
+	return Update(t, a.Awaitility, types.NamespacedName{Name: nsName}, modifyNamespace)
 }
 
 // UpdateServiceAccount tries to update the given ServiceAccount
 // If it fails with an error (for example if the object has been modified) then it retrieves the latest version and tries again
 // Returns the updated ServiceAccount
-func (a *MemberAwaitility) UpdateServiceAccount(t *testing.T, namespace, saName string, modifySA func(sa *corev1.ServiceAccount)) (*corev1.ServiceAccount, error) {
-	var sa *corev1.ServiceAccount
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		freshSA := &corev1.ServiceAccount{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: saName}, freshSA); err != nil {
-			return true, err
-		}
-		modifySA(freshSA)
-		if err := a.Client.Update(context.TODO(), freshSA); err != nil {
-			t.Logf("error updating ServiceAccount '%s': %s. Will retry again...", saName, err.Error())
-			return false, nil
-		}
-		sa = freshSA
-		return true, nil
-	})
-	return sa, err
+func (a *MemberAwaitility) UpdateServiceAccount(t T, namespace, saName string, modifySA func(sa *corev1.ServiceAccount)) (*corev1.ServiceAccount, error) {
+	return Update(t, a.Awaitility, types.NamespacedName{Namespace: namespace, Name: saName}, modifySA)
 }
 
 // UpdateSpaceRequest tries to update the Spec of the given SpaceRequest
 // If it fails with an error (for example if the object has been modified) then it retrieves the latest version and tries again
 // Returns the updated SpaceRequest
-func (a *MemberAwaitility) UpdateSpaceRequest(t *testing.T, spaceRequestNamespacedName types.NamespacedName, modifySpaceRequest func(s *toolchainv1alpha1.SpaceRequest)) (*toolchainv1alpha1.SpaceRequest, error) {
-	var sr *toolchainv1alpha1.SpaceRequest
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		freshSpaceRequest := &toolchainv1alpha1.SpaceRequest{}
-		if err := a.Client.Get(context.TODO(), spaceRequestNamespacedName, freshSpaceRequest); err != nil {
-			return true, err
-		}
-		modifySpaceRequest(freshSpaceRequest)
-		if err := a.Client.Update(context.TODO(), freshSpaceRequest); err != nil {
-			t.Logf("error updating SpaceRequest '%s' in namespace '%s': %s. Will retry again...", spaceRequestNamespacedName.Name, spaceRequestNamespacedName.Name, err.Error())
-			return false, nil
-		}
-		sr = freshSpaceRequest
-		return true, nil
-	})
-	return sr, err
+func (a *MemberAwaitility) UpdateSpaceRequest(t T, spaceRequestNamespacedName types.NamespacedName, modifySpaceRequest func(s *toolchainv1alpha1.SpaceRequest)) (*toolchainv1alpha1.SpaceRequest, error) {
+	return Update(t, a.Awaitility, spaceRequestNamespacedName, modifySpaceRequest)
 }
 
 // UpdateSpaceBindingRequest tries to update the Spec of the given SpaceBindingRequest
 // If it fails with an error (for example if the object has been modified) then it retrieves the latest version and tries again
 // Returns the updated SpaceBindingRequest
-func (a *MemberAwaitility) UpdateSpaceBindingRequest(t *testing.T, spaceBindingRequestNamespacedName types.NamespacedName, modifySpaceBindingRequest func(s *toolchainv1alpha1.SpaceBindingRequest)) (*toolchainv1alpha1.SpaceBindingRequest, error) {
-	var sr *toolchainv1alpha1.SpaceBindingRequest
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		freshSpaceBindingRequest := &toolchainv1alpha1.SpaceBindingRequest{}
-		if err := a.Client.Get(context.TODO(), spaceBindingRequestNamespacedName, freshSpaceBindingRequest); err != nil {
-			return true, err
-		}
-		modifySpaceBindingRequest(freshSpaceBindingRequest)
-		if err := a.Client.Update(context.TODO(), freshSpaceBindingRequest); err != nil {
-			t.Logf("error updating SpaceBindingRequest '%s' in namespace '%s': %s. Will retry again...", spaceBindingRequestNamespacedName.Name, spaceBindingRequestNamespacedName.Name, err.Error())
-			return false, err
-		}
-		sr = freshSpaceBindingRequest
-		return true, nil
-	})
-	return sr, err
+func (a *MemberAwaitility) UpdateSpaceBindingRequest(t T, spaceBindingRequestNamespacedName types.NamespacedName, modifySpaceBindingRequest func(s *toolchainv1alpha1.SpaceBindingRequest)) (*toolchainv1alpha1.SpaceBindingRequest, error) {
+	return Update(t, a.Awaitility, spaceBindingRequestNamespacedName, modifySpaceBindingRequest)
 }
 
 // WaitUntilSpaceBindingRequestDeleted waits until a SpaceBindingRequest with the given name does not exist anymore in the given namespace
-func (a *MemberAwaitility) WaitUntilSpaceBindingRequestDeleted(t *testing.T, spaceBindingRequest *toolchainv1alpha1.SpaceBindingRequest) error {
-	t.Logf("waiting for SpaceBindingRequest '%s' in namespace '%s' to be deleted", spaceBindingRequest.GetName(), spaceBindingRequest.GetNamespace())
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *MemberAwaitility) WaitUntilSpaceBindingRequestDeleted(t T, spaceBindingRequest *toolchainv1alpha1.SpaceBindingRequest) error {
+	recordWaiter(t)
+	a.logf(t, "waiting for SpaceBindingRequest '%s' in namespace '%s' to be deleted", spaceBindingRequest.GetName(), spaceBindingRequest.GetNamespace())
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		sbr := &toolchainv1alpha1.SpaceBindingRequest{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: spaceBindingRequest.GetName(), Namespace: spaceBindingRequest.GetNamespace()}, sbr); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: spaceBindingRequest.GetName(), Namespace: spaceBindingRequest.GetNamespace()}, sbr); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -1453,10 +1787,10 @@ func (a *MemberAwaitility) WaitUntilSpaceBindingRequestDeleted(t *testing.T, spa
 
 // Create tries to create the object until success
 // Workaround for https://github.com/kubernetes/kubernetes/issues/67761
-func (a *MemberAwaitility) Create(t *testing.T, obj client.Object) error {
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *MemberAwaitility) Create(t T, obj client.Object) error {
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		if err := a.Client.Create(context.TODO(), obj); err != nil {
-			t.Logf("trying to create %+v. Error: %s. Will try to create again.", obj, err.Error())
+			a.logf(t, "trying to create %+v. Error: %s. Will try to create again.", obj, err.Error())
 			return false, nil
 		}
 		return true, nil
@@ -1467,6 +1801,26 @@ func (a *MemberAwaitility) Create(t *testing.T, obj client.Object) error {
 type PodWaitCriterion struct {
 	Match func(*corev1.Pod) bool
 	Diff  func(*corev1.Pod) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[PodWaitCriterion] = PodWaitCriterion{}
+
+func (PodWaitCriterion) withRetryOptions(options []RetryOption) PodWaitCriterion {
+	return PodWaitCriterion{
+		Match: func(*corev1.Pod) bool {
+			return true
+		},
+		Diff: func(*corev1.Pod) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c PodWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchPodWaitCriterion(actual *corev1.Pod, criteria ...PodWaitCriterion) bool {
@@ -1478,7 +1832,7 @@ func matchPodWaitCriterion(actual *corev1.Pod, criteria ...PodWaitCriterion) boo
 	return true
 }
 
-func (a *MemberAwaitility) printPodWaitCriterionDiffs(t *testing.T, actual *corev1.Pod, ns string, criteria ...PodWaitCriterion) {
+func (a *MemberAwaitility) printPodWaitCriterionDiffs(t T, actual *corev1.Pod, ns string, criteria ...PodWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find Pod\n")
@@ -1492,16 +1846,18 @@ func (a *MemberAwaitility) printPodWaitCriterionDiffs(t *testing.T, actual *core
 			}
 		}
 	}
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // WaitForPod waits until a pod with the given name exists in the given namespace
-func (a *MemberAwaitility) WaitForPod(t *testing.T, namespace, name string, criteria ...PodWaitCriterion) (*corev1.Pod, error) {
-	t.Logf("waiting for Pod '%s' in namespace '%s' with matching criteria", name, namespace)
+func (a *MemberAwaitility) WaitForPod(t T, namespace, name string, criteria ...PodWaitCriterion) (*corev1.Pod, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for Pod '%s' in namespace '%s' with matching criteria", name, namespace)
 	var pod *corev1.Pod
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &corev1.Pod{}
-		if err = a.Client.Get(context.TODO(), types.NamespacedName{
+		if err = a.Client.Get(a.getContext(), types.NamespacedName{
 			Namespace: namespace,
 			Name:      name,
 		}, obj); err != nil {
@@ -1523,12 +1879,14 @@ func (a *MemberAwaitility) WaitForPod(t *testing.T, namespace, name string, crit
 }
 
 // WaitForConfigMap waits until a ConfigMap with the given name exists in the given namespace
-func (a *MemberAwaitility) WaitForConfigMap(t *testing.T, namespace, name string) (*corev1.ConfigMap, error) {
-	t.Logf("waiting for ConfigMap '%s' in namespace '%s'", name, namespace)
+func (a *MemberAwaitility) WaitForConfigMap(t T, namespace, name string, options ...RetryOption) (*corev1.ConfigMap, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(options...)
+	a.logf(t, "waiting for ConfigMap '%s' in namespace '%s'", name, namespace)
 	var cm *corev1.ConfigMap
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &corev1.ConfigMap{}
-		if err = a.Client.Get(context.TODO(), types.NamespacedName{
+		if err = a.Client.Get(a.getContext(), types.NamespacedName{
 			Namespace: namespace,
 			Name:      name,
 		}, obj); err != nil {
@@ -1546,12 +1904,14 @@ func (a *MemberAwaitility) WaitForConfigMap(t *testing.T, namespace, name string
 }
 
 // WaitForSecret waits until a Secret with the given name exists in the operator namespace
-func (a *MemberAwaitility) WaitForSecret(t *testing.T, name string) (*corev1.Secret, error) {
-	t.Logf("waiting for Secret '%s' in namespace '%s'", name, a.Namespace)
+func (a *MemberAwaitility) WaitForSecret(t T, name string, options ...RetryOption) (*corev1.Secret, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(options...)
+	a.logf(t, "waiting for Secret '%s' in namespace '%s'", name, a.Namespace)
 	var cm *corev1.Secret
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &corev1.Secret{}
-		if err = a.Client.Get(context.TODO(), types.NamespacedName{
+		if err = a.Client.Get(a.getContext(), types.NamespacedName{
 			Namespace: a.Namespace,
 			Name:      name,
 		}, obj); err != nil {
@@ -1569,13 +1929,15 @@ func (a *MemberAwaitility) WaitForSecret(t *testing.T, name string) (*corev1.Sec
 }
 
 // WaitForPods waits until "n" number of pods exist in the given namespace
-func (a *MemberAwaitility) WaitForPods(t *testing.T, namespace string, n int, criteria ...PodWaitCriterion) ([]corev1.Pod, error) {
-	t.Logf("waiting for Pods in namespace '%s' with matching criteria", namespace)
+func (a *MemberAwaitility) WaitForPods(t T, namespace string, n int, criteria ...PodWaitCriterion) ([]corev1.Pod, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for Pods in namespace '%s' with matching criteria", namespace)
 	pods := make([]corev1.Pod, 0, n)
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		pds := make([]corev1.Pod, 0, n)
 		foundPods := &corev1.PodList{}
-		if err := a.Client.List(context.TODO(), foundPods, client.InNamespace(namespace)); err != nil {
+		if err := a.Client.List(a.getContext(), foundPods, client.InNamespace(namespace)); err != nil {
 			return false, err
 		}
 	pods:
@@ -1597,11 +1959,12 @@ func (a *MemberAwaitility) WaitForPods(t *testing.T, namespace string, n int, cr
 }
 
 // WaitUntilPodsDeleted waits until the pods are deleted from the given namespace
-func (a *MemberAwaitility) WaitUntilPodsDeleted(t *testing.T, namespace string, criteria ...PodWaitCriterion) error {
-	t.Logf("waiting until Pods with matching criteria in namespace '%s' are deleted", namespace)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *MemberAwaitility) WaitUntilPodsDeleted(t T, namespace string, criteria ...PodWaitCriterion) error {
+	recordWaiter(t)
+	a.logf(t, "waiting until Pods with matching criteria in namespace '%s' are deleted", namespace)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		foundPods := &corev1.PodList{}
-		if err := a.Client.List(context.TODO(), foundPods, &client.ListOptions{Namespace: namespace}); err != nil {
+		if err := a.Client.List(a.getContext(), foundPods, &client.ListOptions{Namespace: namespace}); err != nil {
 			return false, err
 		}
 		if len(foundPods.Items) == 0 {
@@ -1618,11 +1981,12 @@ func (a *MemberAwaitility) WaitUntilPodsDeleted(t *testing.T, namespace string,
 }
 
 // WaitUntilPodDeleted waits until the pod with the given name is deleted from the given namespace
-func (a *MemberAwaitility) WaitUntilPodDeleted(t *testing.T, namespace, name string) error {
-	t.Logf("waiting until Pod '%s' in namespace '%s' is deleted", name, namespace)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *MemberAwaitility) WaitUntilPodDeleted(t T, namespace, name string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting until Pod '%s' in namespace '%s' is deleted", name, namespace)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &corev1.Pod{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -1704,16 +2068,17 @@ func checkPriorityClass(pod *corev1.Pod, name string, priority int) bool {
 }
 
 // WaitUntilNamespaceDeleted waits until the namespace with the given name is deleted (ie, is not found)
-func (a *MemberAwaitility) WaitUntilNamespaceDeleted(t *testing.T, username, typeName string) error {
-	t.Logf("waiting until namespace for user '%s' and type '%s' is deleted", username, typeName)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *MemberAwaitility) WaitUntilNamespaceDeleted(t T, username, typeName string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting until namespace for user '%s' and type '%s' is deleted", username, typeName)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		labels := map[string]string{
 			toolchainv1alpha1.SpaceLabelKey: username,
 			toolchainv1alpha1.TypeLabelKey:  typeName,
 		}
 		opts := client.MatchingLabels(labels)
 		namespaceList := &corev1.NamespaceList{}
-		if err := a.Client.List(context.TODO(), namespaceList, opts); err != nil {
+		if err := a.Client.List(a.getContext(), namespaceList, opts); err != nil {
 			return false, err
 		}
 		if len(namespaceList.Items) < 1 {
@@ -1724,11 +2089,12 @@ func (a *MemberAwaitility) WaitUntilNamespaceDeleted(t *testing.T, username, typ
 }
 
 // WaitUntilSecretsDeleted waits until the secrets with the given labels are deleted (ie, is not found)
-func (a *MemberAwaitility) WaitUntilSecretsDeleted(t *testing.T, namespace string, labels client.MatchingLabels) error {
-	t.Logf("waiting until secrets with lables '%v' in namespace '%s' is deleted", labels, namespace)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *MemberAwaitility) WaitUntilSecretsDeleted(t T, namespace string, labels client.MatchingLabels) error {
+	recordWaiter(t)
+	a.logf(t, "waiting until secrets with lables '%v' in namespace '%s' is deleted", labels, namespace)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		secretList := &corev1.SecretList{}
-		if err := a.Client.List(context.TODO(), secretList, labels); err != nil {
+		if err := a.Client.List(a.getContext(), secretList, labels); err != nil {
 			return false, err
 		}
 		if len(secretList.Items) < 1 {
@@ -1742,6 +2108,26 @@ func (a *MemberAwaitility) WaitUntilSecretsDeleted(t *testing.T, namespace strin
 type UserWaitCriterion struct {
 	Match func(*userv1.User) bool
 	Diff  func(*userv1.User) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[UserWaitCriterion] = UserWaitCriterion{}
+
+func (UserWaitCriterion) withRetryOptions(options []RetryOption) UserWaitCriterion {
+	return UserWaitCriterion{
+		Match: func(*userv1.User) bool {
+			return true
+		},
+		Diff: func(*userv1.User) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c UserWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchUserWaitCriterion(actual *userv1.User, criteria ...UserWaitCriterion) bool {
@@ -1753,7 +2139,7 @@ func matchUserWaitCriterion(actual *userv1.User, criteria ...UserWaitCriterion)
 	return true
 }
 
-func (a *MemberAwaitility) printUserWaitCriterionDiffs(t *testing.T, actual *userv1.User, criteria ...UserWaitCriterion) {
+func (a *MemberAwaitility) printUserWaitCriterionDiffs(t T, actual *userv1.User, criteria ...UserWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find User\n")
@@ -1767,16 +2153,18 @@ func (a *MemberAwaitility) printUserWaitCriterionDiffs(t *testing.T, actual *use
 			}
 		}
 	}
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // WaitForUser waits until there is a User with the given name available
-func (a *MemberAwaitility) WaitForUser(t *testing.T, name string, criteria ...UserWaitCriterion) (*userv1.User, error) {
-	t.Logf("waiting for User '%s'", name)
+func (a *MemberAwaitility) WaitForUser(t T, name string, criteria ...UserWaitCriterion) (*userv1.User, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for User '%s'", name)
 	user := &userv1.User{}
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		user = &userv1.User{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name}, user); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: name}, user); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -1825,6 +2213,26 @@ func UntilUserHasAnnotation(key, value string) UserWaitCriterion {
 type IdentityWaitCriterion struct {
 	Match func(*userv1.Identity) bool
 	Diff  func(*userv1.Identity) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[IdentityWaitCriterion] = IdentityWaitCriterion{}
+
+func (IdentityWaitCriterion) withRetryOptions(options []RetryOption) IdentityWaitCriterion {
+	return IdentityWaitCriterion{
+		Match: func(*userv1.Identity) bool {
+			return true
+		},
+		Diff: func(*userv1.Identity) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c IdentityWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchIdentityWaitCriterion(actual *userv1.Identity, criteria ...IdentityWaitCriterion) bool {
@@ -1837,12 +2245,14 @@ func matchIdentityWaitCriterion(actual *userv1.Identity, criteria ...IdentityWai
 }
 
 // WaitForIdentity waits until there is an Identity with the given name available
-func (a *MemberAwaitility) WaitForIdentity(t *testing.T, name string, criteria ...IdentityWaitCriterion) (*userv1.Identity, error) {
-	t.Logf("waiting for Identity '%s'", name)
+func (a *MemberAwaitility) WaitForIdentity(t T, name string, criteria ...IdentityWaitCriterion) (*userv1.Identity, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for Identity '%s'", name)
 	identity := &userv1.Identity{}
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		identity = &userv1.Identity{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name}, identity); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: name}, identity); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -1862,11 +2272,11 @@ func (a *MemberAwaitility) WaitForIdentity(t *testing.T, name string, criteria .
 	return identity, err
 }
 
-func (a *MemberAwaitility) printIdentities(t *testing.T, expectedName string) {
+func (a *MemberAwaitility) printIdentities(t T, expectedName string) {
 	buf := &strings.Builder{}
 	buf.WriteString(fmt.Sprintf("failed to find Identity '%s'\n", expectedName))
 	buf.WriteString(a.listAndReturnContent("Identity", "", &userv1.IdentityList{}))
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // UntilIdentityHasLabel checks if the Identity has the expected label
@@ -1882,11 +2292,12 @@ func UntilIdentityHasLabel(key, value string) IdentityWaitCriterion {
 }
 
 // WaitUntilUserAccountDeleted waits until the UserAccount with the given name is not found
-func (a *MemberAwaitility) WaitUntilUserAccountDeleted(t *testing.T, name string) error {
-	t.Logf("waiting until UserAccount '%s' in namespace '%s' is deleted", name, a.Namespace)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *MemberAwaitility) WaitUntilUserAccountDeleted(t T, name string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting until UserAccount '%s' in namespace '%s' is deleted", name, a.Namespace)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		ua := &toolchainv1alpha1.UserAccount{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, ua); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, ua); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -1897,11 +2308,12 @@ func (a *MemberAwaitility) WaitUntilUserAccountDeleted(t *testing.T, name string
 }
 
 // WaitUntilUserDeleted waits until the User with the given name is not found
-func (a *MemberAwaitility) WaitUntilUserDeleted(t *testing.T, name string) error {
-	t.Logf("waiting until User is deleted '%s'", name)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *MemberAwaitility) WaitUntilUserDeleted(t T, name string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting until User is deleted '%s'", name)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		user := &userv1.User{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name}, user); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: name}, user); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -1915,11 +2327,12 @@ func (a *MemberAwaitility) WaitUntilUserDeleted(t *testing.T, name string) error
 }
 
 // WaitUntilIdentityDeleted waits until the Identity with the given name is not found
-func (a *MemberAwaitility) WaitUntilIdentityDeleted(t *testing.T, name string) error {
-	t.Logf("waiting until Identity is deleted '%s'", name)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *MemberAwaitility) WaitUntilIdentityDeleted(t T, name string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting until Identity is deleted '%s'", name)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		identity := &userv1.Identity{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name}, identity); err != nil {
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: name}, identity); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -1933,24 +2346,34 @@ func (a *MemberAwaitility) WaitUntilIdentityDeleted(t *testing.T, name string) e
 }
 
 // GetConsoleURL retrieves Web Console Route and returns its URL
-func (a *MemberAwaitility) GetConsoleURL(t *testing.T) string {
+func (a *MemberAwaitility) GetConsoleURL(t T) string {
+	url, err := a.TryGetConsoleURL()
+	require.NoError(t, err)
+	return url
+}
+
+// TryGetConsoleURL is like GetConsoleURL, but it returns an error instead of failing the test
+// if the Web Console Route can't be retrieved
+func (a *MemberAwaitility) TryGetConsoleURL() (string, error) {
 	route := &routev1.Route{}
 	namespacedName := types.NamespacedName{Namespace: "openshift-console", Name: "console"}
-	err := a.Client.Get(context.TODO(), namespacedName, route)
-	require.NoError(t, err)
-	return fmt.Sprintf("https://%s/%s", route.Spec.Host, route.Spec.Path)
+	if err := a.Client.Get(a.getContext(), namespacedName, route); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("https://%s/%s", route.Spec.Host, route.Spec.Path), nil
 }
 
 // WaitUntilClusterResourceQuotasDeleted waits until all ClusterResourceQuotas with the given owner label are deleted (ie, none is found)
-func (a *MemberAwaitility) WaitUntilClusterResourceQuotasDeleted(t *testing.T, username string) error {
-	t.Logf("waiting for deletion of ClusterResourceQuotas for user '%s'", username)
-	return wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+func (a *MemberAwaitility) WaitUntilClusterResourceQuotasDeleted(t T, username string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting for deletion of ClusterResourceQuotas for user '%s'", username)
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		labels := map[string]string{
 			toolchainv1alpha1.SpaceLabelKey: username,
 		}
 		opts := client.MatchingLabels(labels)
 		quotaList := &quotav1.ClusterResourceQuotaList{}
-		if err := a.Client.List(context.TODO(), quotaList, opts); err != nil {
+		if err := a.Client.List(a.getContext(), quotaList, opts); err != nil {
 			return false, err
 		}
 		if len(quotaList.Items) == 0 {
@@ -1964,6 +2387,26 @@ func (a *MemberAwaitility) WaitUntilClusterResourceQuotasDeleted(t *testing.T, u
 type MemberStatusWaitCriterion struct {
 	Match func(*toolchainv1alpha1.MemberStatus) bool
 	Diff  func(*toolchainv1alpha1.MemberStatus) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[MemberStatusWaitCriterion] = MemberStatusWaitCriterion{}
+
+func (MemberStatusWaitCriterion) withRetryOptions(options []RetryOption) MemberStatusWaitCriterion {
+	return MemberStatusWaitCriterion{
+		Match: func(*toolchainv1alpha1.MemberStatus) bool {
+			return true
+		},
+		Diff: func(*toolchainv1alpha1.MemberStatus) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c MemberStatusWaitCriterion) retryOptions() []RetryOption {
+	return c.options
 }
 
 func matchMemberStatusWaitCriterion(actual *toolchainv1alpha1.MemberStatus, criteria ...MemberStatusWaitCriterion) bool {
@@ -1975,7 +2418,7 @@ func matchMemberStatusWaitCriterion(actual *toolchainv1alpha1.MemberStatus, crit
 	return true
 }
 
-func (a *MemberAwaitility) printMemberStatusWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.MemberStatus, criteria ...MemberStatusWaitCriterion) {
+func (a *MemberAwaitility) printMemberStatusWaitCriterionDiffs(t T, actual *toolchainv1alpha1.MemberStatus, criteria ...MemberStatusWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find MemberStatus\n")
@@ -1996,7 +2439,7 @@ func (a *MemberAwaitility) printMemberStatusWaitCriterionDiffs(t *testing.T, act
 			}
 		}
 	}
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
 // UntilMemberStatusHasConditions returns a `MemberStatusWaitCriterion` which checks that the given
@@ -2051,15 +2494,17 @@ func UntilMemberStatusHasConsoleURLSet(expectedURL string, expectedCondition too
 }
 
 // WaitForMemberStatus waits until the MemberStatus is available with the provided criteria, if any
-func (a *MemberAwaitility) WaitForMemberStatus(t *testing.T, criteria ...MemberStatusWaitCriterion) error {
+func (a *MemberAwaitility) WaitForMemberStatus(t T, criteria ...MemberStatusWaitCriterion) error {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
 	name := "toolchain-member-status"
-	t.Logf("waiting for MemberStatus '%s' to match criteria", name)
+	a.logf(t, "waiting for MemberStatus '%s' to match criteria", name)
 	// there should only be one member status with the name toolchain-member-status
 	var memberStatus *toolchainv1alpha1.MemberStatus
-	err := wait.Poll(a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
 		// retrieve the memberstatus from the member namespace
 		obj := &toolchainv1alpha1.MemberStatus{}
-		err = a.Client.Get(context.TODO(),
+		err = a.Client.Get(a.getContext(),
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -2081,38 +2526,69 @@ func (a *MemberAwaitility) WaitForMemberStatus(t *testing.T, criteria ...MemberS
 }
 
 // GetMemberOperatorConfig returns MemberOperatorConfig instance, nil if not found
-func (a *MemberAwaitility) GetMemberOperatorConfig(t *testing.T) *toolchainv1alpha1.MemberOperatorConfig {
+func (a *MemberAwaitility) GetMemberOperatorConfig(t T) *toolchainv1alpha1.MemberOperatorConfig {
+	config, err := a.TryGetMemberOperatorConfig()
+	require.NoError(t, err)
+	return config
+}
+
+// TryGetMemberOperatorConfig is like GetMemberOperatorConfig, but it returns an error instead of failing the test
+// if the MemberOperatorConfig can't be retrieved
+func (a *MemberAwaitility) TryGetMemberOperatorConfig() (*toolchainv1alpha1.MemberOperatorConfig, error) {
 	config := &toolchainv1alpha1.MemberOperatorConfig{}
-	if err := a.Client.Get(context.TODO(), test.NamespacedName(a.Namespace, "config"), config); err != nil {
+	if err := a.Client.Get(a.getContext(), test.NamespacedName(a.Namespace, "config"), config); err != nil {
 		if errors.IsNotFound(err) {
-			return nil
+			return nil, nil
 		}
-		require.NoError(t, err)
+		return nil, err
+	}
+	return config, nil
+}
+
+// MemberOperatorConfigWaitCriterion a struct to check that an MemberOperatorConfig has the expected criteria
+type MemberOperatorConfigWaitCriterion struct {
+	Match func(*HostAwaitility, *MemberAwaitility, *toolchainv1alpha1.MemberOperatorConfig) bool
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[MemberOperatorConfigWaitCriterion] = MemberOperatorConfigWaitCriterion{}
+
+func (MemberOperatorConfigWaitCriterion) withRetryOptions(options []RetryOption) MemberOperatorConfigWaitCriterion {
+	return MemberOperatorConfigWaitCriterion{
+		Match: func(*HostAwaitility, *MemberAwaitility, *toolchainv1alpha1.MemberOperatorConfig) bool {
+			return true
+		},
+		options: options,
 	}
-	return config
 }
 
-// MemberOperatorConfigWaitCriterion a function to check that an MemberOperatorConfig has the expected criteria
-type MemberOperatorConfigWaitCriterion func(*HostAwaitility, *MemberAwaitility, *toolchainv1alpha1.MemberOperatorConfig) bool
+func (c MemberOperatorConfigWaitCriterion) retryOptions() []RetryOption {
+	return c.options
+}
 
 // UntilMemberConfigMatches returns a `MemberOperatorConfigWaitCriterion` which checks that the given
 // MemberOperatorConfig matches the provided one
 func UntilMemberConfigMatches(expectedMemberOperatorConfigSpec toolchainv1alpha1.MemberOperatorConfigSpec) MemberOperatorConfigWaitCriterion {
-	return func(h *HostAwaitility, a *MemberAwaitility, memberConfig *toolchainv1alpha1.MemberOperatorConfig) bool {
-		return reflect.DeepEqual(expectedMemberOperatorConfigSpec, memberConfig.Spec)
+	return MemberOperatorConfigWaitCriterion{
+		Match: func(h *HostAwaitility, a *MemberAwaitility, memberConfig *toolchainv1alpha1.MemberOperatorConfig) bool {
+			return reflect.DeepEqual(expectedMemberOperatorConfigSpec, memberConfig.Spec)
+		},
 	}
 }
 
 // WaitForMemberOperatorConfig waits until the MemberOperatorConfig is available with the provided criteria, if any
-func (a *MemberAwaitility) WaitForMemberOperatorConfig(t *testing.T, hostAwait *HostAwaitility, criteria ...MemberOperatorConfigWaitCriterion) (*toolchainv1alpha1.MemberOperatorConfig, error) {
+func (a *MemberAwaitility) WaitForMemberOperatorConfig(t T, hostAwait *HostAwaitility, criteria ...MemberOperatorConfigWaitCriterion) (*toolchainv1alpha1.MemberOperatorConfig, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
 	// there should only be one MemberOperatorConfig with the name config
 	name := "config"
-	t.Logf("waiting for MemberOperatorConfig '%s'", name)
+	a.logf(t, "waiting for MemberOperatorConfig '%s'", name)
 	memberOperatorConfig := &toolchainv1alpha1.MemberOperatorConfig{}
-	err := wait.Poll(a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, 2*a.Timeout, func() (done bool, err error) {
 		memberOperatorConfig = &toolchainv1alpha1.MemberOperatorConfig{}
 		// retrieve the MemberOperatorConfig from the member namespace
-		err = a.Client.Get(context.TODO(),
+		err = a.Client.Get(a.getContext(),
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -2124,8 +2600,8 @@ func (a *MemberAwaitility) WaitForMemberOperatorConfig(t *testing.T, hostAwait *
 			}
 			return false, err
 		}
-		for _, match := range criteria {
-			if !match(hostAwait, a, memberOperatorConfig) {
+		for _, c := range criteria {
+			if !c.Match(hostAwait, a, memberOperatorConfig) {
 				return false, nil
 			}
 		}
@@ -2137,7 +2613,7 @@ func (a *MemberAwaitility) WaitForMemberOperatorConfig(t *testing.T, hostAwait *
 // GetMemberOperatorPod returns the pod running the member operator controllers
 func (a *MemberAwaitility) GetMemberOperatorPod() (corev1.Pod, error) {
 	pods := corev1.PodList{}
-	if err := a.Client.List(context.TODO(), &pods, client.InNamespace(a.Namespace), client.MatchingLabels{"control-plane": "controller-manager"}); err != nil {
+	if err := a.Client.List(a.getContext(), &pods, client.InNamespace(a.Namespace), client.MatchingLabels{"control-plane": "controller-manager"}); err != nil {
 		return corev1.Pod{}, err
 	}
 	if len(pods.Items) != 1 {
@@ -2146,29 +2622,30 @@ func (a *MemberAwaitility) GetMemberOperatorPod() (corev1.Pod, error) {
 	return pods.Items[0], nil
 }
 
-func (a *MemberAwaitility) WaitForMemberWebhooks(t *testing.T, image string) {
+func (a *MemberAwaitility) WaitForMemberWebhooks(t *testing.T, image string, options ...RetryOption) {
+	a = a.WithRetryOptions(options...)
 	a.waitForUsersPodPriorityClass(t)
 	a.waitForService(t)
 	a.waitForWebhookDeployment(t, image)
+	a.verifyWebhookSecurityContextConstraints(t)
 	ca := a.verifySecret(t)
 	a.verifyMutatingWebhookConfig(t, ca)
 	a.verifyValidatingWebhookConfig(t, ca)
 }
 
-func (a *MemberAwaitility) waitForUsersPodPriorityClass(t *testing.T) {
-	t.Logf("checking PrioritiyClass resource '%s'", "sandbox-users-pods")
-	actualPrioClass := &schedulingv1.PriorityClass{}
-	a.waitForResource(t, "", "sandbox-users-pods", actualPrioClass)
-
-	assert.Equal(t, codereadyToolchainProviderLabel, actualPrioClass.Labels)
-	assert.Equal(t, int32(-3), actualPrioClass.Value)
-	assert.False(t, actualPrioClass.GlobalDefault)
-	assert.Equal(t, "Priority class for pods in users' namespaces", actualPrioClass.Description)
+func (a *MemberAwaitility) waitForUsersPodPriorityClass(t T) {
+	a.logf(t, "checking PrioritiyClass resource '%s'", "sandbox-users-pods")
+	_, err := a.WaitForPriorityClass(t, "sandbox-users-pods",
+		UntilPriorityClassHasLabels(codereadyToolchainProviderLabel),
+		UntilPriorityClassHasValue(-3),
+		UntilPriorityClassIsGlobalDefault(false),
+		UntilPriorityClassHasDescription("Priority class for pods in users' namespaces"))
+	require.NoError(t, err)
 }
 
-func (a *MemberAwaitility) waitForResource(t *testing.T, namespace, name string, object client.Object) {
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		if err := a.Client.Get(context.TODO(), test.NamespacedName(namespace, name), object); err != nil {
+func (a *MemberAwaitility) waitForResource(t T, namespace, name string, object client.Object) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		if err := a.Client.Get(a.getContext(), test.NamespacedName(namespace, name), object); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -2179,8 +2656,273 @@ func (a *MemberAwaitility) waitForResource(t *testing.T, namespace, name string,
 	require.NoError(t, err)
 }
 
-func (a *MemberAwaitility) waitForService(t *testing.T) {
-	t.Logf("waiting for Service '%s' in namespace '%s'", "member-operator-webhook", a.Namespace)
+// PriorityClassWaitCriterion a struct to compare with a given PriorityClass
+type PriorityClassWaitCriterion struct {
+	Match func(*schedulingv1.PriorityClass) bool
+	Diff  func(*schedulingv1.PriorityClass) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[PriorityClassWaitCriterion] = PriorityClassWaitCriterion{}
+
+func (PriorityClassWaitCriterion) withRetryOptions(options []RetryOption) PriorityClassWaitCriterion {
+	return PriorityClassWaitCriterion{
+		Match: func(*schedulingv1.PriorityClass) bool {
+			return true
+		},
+		Diff: func(*schedulingv1.PriorityClass) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c PriorityClassWaitCriterion) retryOptions() []RetryOption {
+	return c.options
+}
+
+func matchPriorityClassWaitCriteria(actual *schedulingv1.PriorityClass, criteria ...PriorityClassWaitCriterion) bool {
+	for _, c := range criteria {
+		// if at least one criteria does not match, keep waiting
+		if !c.Match(actual) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *MemberAwaitility) printPriorityClassWaitCriteriaDiffs(t T, actual *schedulingv1.PriorityClass, criteria ...PriorityClassWaitCriterion) {
+	buf := &strings.Builder{}
+	if actual == nil {
+		buf.WriteString("failed to find PriorityClass\n")
+		buf.WriteString(a.listAndReturnContent("PriorityClass", "", &schedulingv1.PriorityClassList{}))
+	} else {
+		buf.WriteString("failed to find PriorityClass with matching criteria:\n")
+		buf.WriteString("----\n")
+		buf.WriteString("actual:\n")
+		y, _ := StringifyObject(actual)
+		buf.Write(y)
+		buf.WriteString("\n----\n")
+		buf.WriteString("diffs:\n")
+		for _, c := range criteria {
+			if !c.Match(actual) {
+				buf.WriteString(c.Diff(actual))
+				buf.WriteString("\n")
+			}
+		}
+	}
+	a.log(t, buf.String())
+}
+
+// UntilPriorityClassHasValue checks if the PriorityClass has the given value
+func UntilPriorityClassHasValue(expected int32) PriorityClassWaitCriterion {
+	return PriorityClassWaitCriterion{
+		Match: func(actual *schedulingv1.PriorityClass) bool {
+			return actual.Value == expected
+		},
+		Diff: func(actual *schedulingv1.PriorityClass) string {
+			return fmt.Sprintf("expected PriorityClass value to be '%d' but it was '%d'", expected, actual.Value)
+		},
+	}
+}
+
+// UntilPriorityClassIsGlobalDefault checks if the PriorityClass is (or is not) the global default
+func UntilPriorityClassIsGlobalDefault(expected bool) PriorityClassWaitCriterion {
+	return PriorityClassWaitCriterion{
+		Match: func(actual *schedulingv1.PriorityClass) bool {
+			return actual.GlobalDefault == expected
+		},
+		Diff: func(actual *schedulingv1.PriorityClass) string {
+			return fmt.Sprintf("expected PriorityClass globalDefault to be '%t' but it was '%t'", expected, actual.GlobalDefault)
+		},
+	}
+}
+
+// UntilPriorityClassHasDescription checks if the PriorityClass has the given description
+func UntilPriorityClassHasDescription(expected string) PriorityClassWaitCriterion {
+	return PriorityClassWaitCriterion{
+		Match: func(actual *schedulingv1.PriorityClass) bool {
+			return actual.Description == expected
+		},
+		Diff: func(actual *schedulingv1.PriorityClass) string {
+			return fmt.Sprintf("expected PriorityClass description to be '%s' but it was '%s'", expected, actual.Description)
+		},
+	}
+}
+
+// UntilPriorityClassHasLabels checks if the PriorityClass has exactly the given labels
+func UntilPriorityClassHasLabels(expected map[string]string) PriorityClassWaitCriterion {
+	return PriorityClassWaitCriterion{
+		Match: func(actual *schedulingv1.PriorityClass) bool {
+			return reflect.DeepEqual(expected, actual.Labels)
+		},
+		Diff: func(actual *schedulingv1.PriorityClass) string {
+			return fmt.Sprintf("expected PriorityClass labels to match: %s", Diff(expected, actual.Labels))
+		},
+	}
+}
+
+// WaitForPriorityClass waits until the cluster-scoped PriorityClass with the given name exists and matches the given criteria
+func (a *MemberAwaitility) WaitForPriorityClass(t T, name string, criteria ...PriorityClassWaitCriterion) (*schedulingv1.PriorityClass, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for PriorityClass '%s' to match criteria", name)
+	var priorityClass *schedulingv1.PriorityClass
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		obj := &schedulingv1.PriorityClass{}
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: name}, obj); err != nil {
+			if errors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		priorityClass = obj
+		return matchPriorityClassWaitCriteria(obj, criteria...), nil
+	})
+	// no match found, print the diffs
+	if err != nil {
+		a.printPriorityClassWaitCriteriaDiffs(t, priorityClass, criteria...)
+	}
+	return priorityClass, err
+}
+
+// SecurityContextConstraintsWaitCriterion a struct to compare with a given SecurityContextConstraints
+type SecurityContextConstraintsWaitCriterion struct {
+	Match func(*securityv1.SecurityContextConstraints) bool
+	Diff  func(*securityv1.SecurityContextConstraints) string
+	// options the RetryOptions of the wait (see WaitWith)
+	options []RetryOption
+}
+
+var _ retryOptionsCarrier[SecurityContextConstraintsWaitCriterion] = SecurityContextConstraintsWaitCriterion{}
+
+func (SecurityContextConstraintsWaitCriterion) withRetryOptions(options []RetryOption) SecurityContextConstraintsWaitCriterion {
+	return SecurityContextConstraintsWaitCriterion{
+		Match: func(*securityv1.SecurityContextConstraints) bool {
+			return true
+		},
+		Diff: func(*securityv1.SecurityContextConstraints) string {
+			return ""
+		},
+		options: options,
+	}
+}
+
+func (c SecurityContextConstraintsWaitCriterion) retryOptions() []RetryOption {
+	return c.options
+}
+
+func matchSecurityContextConstraintsWaitCriteria(actual *securityv1.SecurityContextConstraints, criteria ...SecurityContextConstraintsWaitCriterion) bool {
+	for _, c := range criteria {
+		// if at least one criteria does not match, keep waiting
+		if !c.Match(actual) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *MemberAwaitility) printSecurityContextConstraintsWaitCriteriaDiffs(t T, actual *securityv1.SecurityContextConstraints, criteria ...SecurityContextConstraintsWaitCriterion) {
+	buf := &strings.Builder{}
+	if actual == nil {
+		buf.WriteString("failed to find SecurityContextConstraints\n")
+		buf.WriteString(a.listAndReturnContent("SecurityContextConstraints", "", &securityv1.SecurityContextConstraintsList{}))
+	} else {
+		buf.WriteString("failed to find SecurityContextConstraints with matching criteria:\n")
+		buf.WriteString("----\n")
+		buf.WriteString("actual:\n")
+		y, _ := StringifyObject(actual)
+		buf.Write(y)
+		buf.WriteString("\n----\n")
+		buf.WriteString("diffs:\n")
+		for _, c := range criteria {
+			if !c.Match(actual) {
+				buf.WriteString(c.Diff(actual))
+				buf.WriteString("\n")
+			}
+		}
+	}
+	a.log(t, buf.String())
+}
+
+// UntilSecurityContextConstraintsHasUsers checks if the SecurityContextConstraints has exactly the given users (in any order)
+func UntilSecurityContextConstraintsHasUsers(expected ...string) SecurityContextConstraintsWaitCriterion {
+	return SecurityContextConstraintsWaitCriterion{
+		Match: func(actual *securityv1.SecurityContextConstraints) bool {
+			return reflect.DeepEqual(sortedCopy(expected), sortedCopy(actual.Users))
+		},
+		Diff: func(actual *securityv1.SecurityContextConstraints) string {
+			return fmt.Sprintf("expected SecurityContextConstraints users to match: %s", Diff(sortedCopy(expected), sortedCopy(actual.Users)))
+		},
+	}
+}
+
+// UntilSecurityContextConstraintsHasGroups checks if the SecurityContextConstraints has exactly the given groups (in any order)
+func UntilSecurityContextConstraintsHasGroups(expected ...string) SecurityContextConstraintsWaitCriterion {
+	return SecurityContextConstraintsWaitCriterion{
+		Match: func(actual *securityv1.SecurityContextConstraints) bool {
+			return reflect.DeepEqual(sortedCopy(expected), sortedCopy(actual.Groups))
+		},
+		Diff: func(actual *securityv1.SecurityContextConstraints) string {
+			return fmt.Sprintf("expected SecurityContextConstraints groups to match: %s", Diff(sortedCopy(expected), sortedCopy(actual.Groups)))
+		},
+	}
+}
+
+// UntilSecurityContextConstraintsHasPriority checks if the SecurityContextConstraints has the given priority
+func UntilSecurityContextConstraintsHasPriority(expected int32) SecurityContextConstraintsWaitCriterion {
+	return SecurityContextConstraintsWaitCriterion{
+		Match: func(actual *securityv1.SecurityContextConstraints) bool {
+			return actual.Priority != nil && *actual.Priority == expected
+		},
+		Diff: func(actual *securityv1.SecurityContextConstraints) string {
+			if actual.Priority == nil {
+				return fmt.Sprintf("expected SecurityContextConstraints priority to be '%d' but it was not set", expected)
+			}
+			return fmt.Sprintf("expected SecurityContextConstraints priority to be '%d' but it was '%d'", expected, *actual.Priority)
+		},
+	}
+}
+
+// UntilSecurityContextConstraintsAllowsPrivilegedContainers checks if the SecurityContextConstraints allows (or does not allow) the privileged containers
+func UntilSecurityContextConstraintsAllowsPrivilegedContainers(expected bool) SecurityContextConstraintsWaitCriterion {
+	return SecurityContextConstraintsWaitCriterion{
+		Match: func(actual *securityv1.SecurityContextConstraints) bool {
+			return actual.AllowPrivilegedContainer == expected
+		},
+		Diff: func(actual *securityv1.SecurityContextConstraints) string {
+			return fmt.Sprintf("expected SecurityContextConstraints allowPrivilegedContainer to be '%t' but it was '%t'", expected, actual.AllowPrivilegedContainer)
+		},
+	}
+}
+
+// WaitForSecurityContextConstraints waits until the cluster-scoped SecurityContextConstraints with the given name exists and matches the given criteria
+func (a *MemberAwaitility) WaitForSecurityContextConstraints(t T, name string, criteria ...SecurityContextConstraintsWaitCriterion) (*securityv1.SecurityContextConstraints, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for SecurityContextConstraints '%s' to match criteria", name)
+	var scc *securityv1.SecurityContextConstraints
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		obj := &securityv1.SecurityContextConstraints{}
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: name}, obj); err != nil {
+			if errors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		scc = obj
+		return matchSecurityContextConstraintsWaitCriteria(obj, criteria...), nil
+	})
+	// no match found, print the diffs
+	if err != nil {
+		a.printSecurityContextConstraintsWaitCriteriaDiffs(t, scc, criteria...)
+	}
+	return scc, err
+}
+
+func (a *MemberAwaitility) waitForService(t T) {
+	a.logf(t, "waiting for Service '%s' in namespace '%s'", "member-operator-webhook", a.Namespace)
 	actualService := &corev1.Service{}
 	a.waitForResource(t, a.Namespace, "member-operator-webhook", actualService)
 	assert.Equal(t, map[string]string{
@@ -2195,10 +2937,12 @@ func (a *MemberAwaitility) waitForService(t *testing.T) {
 	assert.Equal(t, appMemberOperatorWebhookLabel, actualService.Spec.Selector)
 }
 
-func (a *MemberAwaitility) waitForWebhookDeployment(t *testing.T, image string) {
-	t.Logf("checking Deployment '%s' in namespace '%s'", "member-operator-webhook", a.Namespace)
+func (a *MemberAwaitility) waitForWebhookDeployment(t T, image string) {
+	a.logf(t, "checking Deployment '%s' in namespace '%s'", "member-operator-webhook", a.Namespace)
 	actualDeployment := a.WaitForDeploymentToGetReady(t, "member-operator-webhook", 1,
-		DeploymentHasContainerWithImage("mutator", image))
+		DeploymentHasContainerWithImage("mutator", image),
+		DeploymentHasReplicas(1),
+		DeploymentHasMountedSecret("mutator", "webhook-certs"))
 
 	assert.Equal(t, bothWebhookLabels, actualDeployment.Labels)
 	assert.Equal(t, int32(1), *actualDeployment.Spec.Replicas)
@@ -2223,14 +2967,31 @@ func (a *MemberAwaitility) waitForWebhookDeployment(t *testing.T, image string)
 	assert.Equal(t, "webhook-certs", container.VolumeMounts[0].Name)
 	assert.Equal(t, "/etc/webhook/certs", container.VolumeMounts[0].MountPath)
 	assert.True(t, container.VolumeMounts[0].ReadOnly)
+}
 
-	a.WaitForDeploymentToGetReady(t, "member-operator-webhook", 1)
+// verifyWebhookSecurityContextConstraints verifies that the pods of the webhook were admitted with SecurityContextConstraints
+// (as reported by OpenShift in their `openshift.io/scc` annotation) which do not allow privileged containers
+func (a *MemberAwaitility) verifyWebhookSecurityContextConstraints(t T) {
+	a.logf(t, "checking the SecurityContextConstraints of the pods of Deployment '%s' in namespace '%s'", "member-operator-webhook", a.Namespace)
+	pods := &corev1.PodList{}
+	err := a.Client.List(a.getContext(), pods, client.InNamespace(a.Namespace), client.MatchingLabels(appMemberOperatorWebhookLabel))
+	require.NoError(t, err)
+	require.NotEmpty(t, pods.Items, "no pod found for Deployment '%s' in namespace '%s'", "member-operator-webhook", a.Namespace)
+	for _, pod := range pods.Items {
+		name := pod.Annotations[sccAnnotation]
+		require.NotEmpty(t, name, "pod '%s' has no '%s' annotation", pod.Name, sccAnnotation)
+		_, err := a.WaitForSecurityContextConstraints(t, name, UntilSecurityContextConstraintsAllowsPrivilegedContainers(false))
+		require.NoError(t, err)
+	}
 }
 
-func (a *MemberAwaitility) verifySecret(t *testing.T) []byte {
-	t.Logf("checking Secret '%s' in namespace '%s'", "webhook-certs", a.Namespace)
+// sccAnnotation the annotation set by OpenShift on the pods, with the name of the SecurityContextConstraints which admitted them
+const sccAnnotation = "openshift.io/scc"
+
+func (a *MemberAwaitility) verifySecret(t T) []byte {
+	a.logf(t, "checking Secret '%s' in namespace '%s'", WebhookCertsSecretName, a.Namespace)
 	secret := &corev1.Secret{}
-	a.waitForResource(t, a.Namespace, "webhook-certs", secret)
+	a.waitForResource(t, a.Namespace, WebhookCertsSecretName, secret)
 	assert.NotEmpty(t, secret.Data["server-key.pem"])
 	assert.NotEmpty(t, secret.Data["server-cert.pem"])
 	ca := secret.Data["ca-cert.pem"]
@@ -2239,9 +3000,9 @@ func (a *MemberAwaitility) verifySecret(t *testing.T) []byte {
 }
 
 func (a *MemberAwaitility) verifyMutatingWebhookConfig(t *testing.T, ca []byte) {
-	t.Logf("checking MutatingWebhookConfiguration")
+	a.logf(t, "checking MutatingWebhookConfiguration")
 	actualMutWbhConf := &admv1.MutatingWebhookConfiguration{}
-	a.waitForResource(t, "", "member-operator-webhook", actualMutWbhConf)
+	a.waitForResource(t, "", MutatingWebhookConfigurationName, actualMutWbhConf)
 	assert.Equal(t, bothWebhookLabels, actualMutWbhConf.Labels)
 	require.Len(t, actualMutWbhConf.Webhooks, 2)
 
@@ -2312,10 +3073,10 @@ func (a *MemberAwaitility) verifyMutatingWebhookConfig(t *testing.T, ca []byte)
 	}
 }
 
-func (a *MemberAwaitility) verifyValidatingWebhookConfig(t *testing.T, ca []byte) {
-	t.Logf("checking ValidatingWebhookConfiguration '%s'", "member-operator-validating-webhook")
+func (a *MemberAwaitility) verifyValidatingWebhookConfig(t T, ca []byte) {
+	a.logf(t, "checking ValidatingWebhookConfiguration '%s'", ValidatingWebhookConfigurationName)
 	actualValWbhConf := &admv1.ValidatingWebhookConfiguration{}
-	a.waitForResource(t, "", "member-operator-validating-webhook", actualValWbhConf)
+	a.waitForResource(t, "", ValidatingWebhookConfigurationName, actualValWbhConf)
 	assert.Equal(t, bothWebhookLabels, actualValWbhConf.Labels)
 	// require.Len(t, actualValWbhConf.Webhooks, 2)
 
@@ -2387,24 +3148,25 @@ func (a *MemberAwaitility) verifyValidatingWebhookConfig(t *testing.T, ca []byte
 
 }
 
-func (a *MemberAwaitility) WaitForAutoscalingBufferApp(t *testing.T) {
+func (a *MemberAwaitility) WaitForAutoscalingBufferApp(t T, options ...RetryOption) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(options...)
 	a.verifyAutoscalingBufferPriorityClass(t)
 	a.verifyAutoscalingBufferDeployment(t)
 }
 
-func (a *MemberAwaitility) verifyAutoscalingBufferPriorityClass(t *testing.T) {
-	t.Logf("checking PrioritiyClass '%s'", "member-operator-autoscaling-buffer")
-	actualPrioClass := &schedulingv1.PriorityClass{}
-	a.waitForResource(t, "", "member-operator-autoscaling-buffer", actualPrioClass)
-
-	assert.Equal(t, codereadyToolchainProviderLabel, actualPrioClass.Labels)
-	assert.Equal(t, int32(-5), actualPrioClass.Value)
-	assert.False(t, actualPrioClass.GlobalDefault)
-	assert.Equal(t, "This priority class is to be used by the autoscaling buffer pod only", actualPrioClass.Description)
+func (a *MemberAwaitility) verifyAutoscalingBufferPriorityClass(t T) {
+	a.logf(t, "checking PrioritiyClass '%s'", "member-operator-autoscaling-buffer")
+	_, err := a.WaitForPriorityClass(t, "member-operator-autoscaling-buffer",
+		UntilPriorityClassHasLabels(codereadyToolchainProviderLabel),
+		UntilPriorityClassHasValue(-5),
+		UntilPriorityClassIsGlobalDefault(false),
+		UntilPriorityClassHasDescription("This priority class is to be used by the autoscaling buffer pod only"))
+	require.NoError(t, err)
 }
 
-func (a *MemberAwaitility) verifyAutoscalingBufferDeployment(t *testing.T) {
-	t.Logf("checking Deployment '%s' in namespace '%s'", "autoscaling-buffer", a.Namespace)
+func (a *MemberAwaitility) verifyAutoscalingBufferDeployment(t T) {
+	a.logf(t, "checking Deployment '%s' in namespace '%s'", "autoscaling-buffer", a.Namespace)
 	actualDeployment := &appsv1.Deployment{}
 	a.waitForResource(t, a.Namespace, "autoscaling-buffer", actualDeployment)
 
@@ -2436,26 +3198,30 @@ func (a *MemberAwaitility) verifyAutoscalingBufferDeployment(t *testing.T) {
 }
 
 // WaitForExpectedNumberOfResources waits until the number of resources matches the expected count
-func (a *MemberAwaitility) WaitForExpectedNumberOfResources(t *testing.T, namespace, kind string, expected int, list func() (int, error)) error {
-	if actual, err := a.waitForExpectedNumberOfResources(expected, list); err != nil {
-		t.Logf("expected number of resources of kind '%s' in namespace '%s' to be %d but it was %d", kind, namespace, expected, actual)
+func (a *MemberAwaitility) WaitForExpectedNumberOfResources(t T, namespace, kind string, expected int, list func() (int, error), options ...RetryOption) error {
+	recordWaiter(t)
+	a = a.WithRetryOptions(options...)
+	if actual, err := a.waitForExpectedNumberOfResources(t, expected, list); err != nil {
+		a.logf(t, "expected number of resources of kind '%s' in namespace '%s' to be %d but it was %d", kind, namespace, expected, actual)
 		return err
 	}
 	return nil
 }
 
 // WaitForExpectedNumberOfClusterResources waits until the number of resources matches the expected count
-func (a *MemberAwaitility) WaitForExpectedNumberOfClusterResources(t *testing.T, kind string, expected int, list func() (int, error)) error {
-	if actual, err := a.waitForExpectedNumberOfResources(expected, list); err != nil {
-		t.Logf("expected number of resources of kind '%s' to be %d but it was %d", kind, expected, actual)
+func (a *MemberAwaitility) WaitForExpectedNumberOfClusterResources(t T, kind string, expected int, list func() (int, error), options ...RetryOption) error {
+	recordWaiter(t)
+	a = a.WithRetryOptions(options...)
+	if actual, err := a.waitForExpectedNumberOfResources(t, expected, list); err != nil {
+		a.logf(t, "expected number of resources of kind '%s' to be %d but it was %d", kind, expected, actual)
 		return err
 	}
 	return nil
 }
 
-func (a *MemberAwaitility) waitForExpectedNumberOfResources(expected int, list func() (int, error)) (int, error) {
+func (a *MemberAwaitility) waitForExpectedNumberOfResources(t T, expected int, list func() (int, error)) (int, error) {
 	var actual int
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		a, err := list()
 		if err != nil {
 			return false, err
@@ -2466,52 +3232,22 @@ func (a *MemberAwaitility) waitForExpectedNumberOfResources(expected int, list f
 	return actual, err
 }
 
-func (a *MemberAwaitility) UpdatePod(t *testing.T, namespace, podName string, modifyPod func(pod *corev1.Pod)) (*corev1.Pod, error) {
-	var m *corev1.Pod
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		freshPod := &corev1.Pod{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: podName}, freshPod); err != nil {
-			return true, err
-		}
-
-		modifyPod(freshPod)
-		if err := a.Client.Update(context.TODO(), freshPod); err != nil {
-			t.Logf("error updating Pod '%s' Will retry again...", podName)
-			return false, nil // nolint:nilerr
-		}
-		m = freshPod
-		return true, nil
-	})
-	return m, err
+func (a *MemberAwaitility) UpdatePod(t T, namespace, podName string, modifyPod func(pod *corev1.Pod)) (*corev1.Pod, error) {
+	return Update(t, a.Awaitility, types.NamespacedName{Namespace: namespace, Name: podName}, modifyPod)
 }
 
-func (a *MemberAwaitility) UpdateConfigMap(t *testing.T, namespace, cmName string, modifyCM func(*corev1.ConfigMap)) (*corev1.ConfigMap, error) {
-	var cm *corev1.ConfigMap
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
-		obj := &corev1.ConfigMap{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{
-			Namespace: namespace,
-			Name:      cmName},
-			obj); err != nil {
-			return true, err
-		}
-		modifyCM(obj)
-		if err := a.Client.Update(context.TODO(), obj); err != nil {
-			t.Logf("error updating ConfigMap '%s' Will retry again...", cmName)
-			return false, nil // nolint:nilerr
-		}
-		cm = obj
-		return true, nil
-	})
-	return cm, err
+func (a *MemberAwaitility) UpdateConfigMap(t T, namespace, cmName string, modifyCM func(*corev1.ConfigMap)) (*corev1.ConfigMap, error) {
+	return Update(t, a.Awaitility, types.NamespacedName{Namespace: namespace, Name: cmName}, modifyCM)
 }
 
-func (a *MemberAwaitility) WaitForEnvironment(t *testing.T, namespace, name string, criteria ...LabelWaitCriterion) (*appstudiov1.Environment, error) {
-	t.Logf("waiting for Environment resource '%s' to exist in namespace '%s'", name, namespace)
+func (a *MemberAwaitility) WaitForEnvironment(t T, namespace, name string, criteria ...LabelWaitCriterion) (*appstudiov1.Environment, error) {
+	recordWaiter(t)
+	a = a.WithRetryOptions(retryOptionsOf(criteria)...)
+	a.logf(t, "waiting for Environment resource '%s' to exist in namespace '%s'", name, namespace)
 	var env *appstudiov1.Environment
-	err := wait.Poll(a.RetryInterval, a.Timeout, func() (done bool, err error) {
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
 		obj := &appstudiov1.Environment{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{
 			Namespace: namespace,
 			Name:      name},
 			obj); errors.IsNotFound(err) {
@@ -2523,15 +3259,15 @@ func (a *MemberAwaitility) WaitForEnvironment(t *testing.T, namespace, name stri
 		return matchLabelWaitCriteria(obj.ObjectMeta, criteria...), nil
 	})
 	if err != nil {
-		t.Logf("failed to wait for Environment")
+		a.logf(t, "failed to wait for Environment")
 		a.printEnvironmentWaitCriterionDiffs(t, env, criteria...)
 		return nil, err
 	}
-	t.Logf("found Environment %s with custom labels", env.Name)
+	a.logf(t, "found Environment %s with custom labels", env.Name)
 	return env, err
 }
 
-func (a *MemberAwaitility) printEnvironmentWaitCriterionDiffs(t *testing.T, actual *appstudiov1.Environment, criteria ...LabelWaitCriterion) {
+func (a *MemberAwaitility) printEnvironmentWaitCriterionDiffs(t T, actual *appstudiov1.Environment, criteria ...LabelWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find Environment\n")
@@ -2551,10 +3287,10 @@ func (a *MemberAwaitility) printEnvironmentWaitCriterionDiffs(t *testing.T, actu
 			}
 		}
 	}
-	t.Log(buf.String())
+	a.log(t, buf.String())
 }
 
-func (a *MemberAwaitility) GetContainerEnv(t *testing.T, name string) string {
+func (a *MemberAwaitility) GetContainerEnv(t T, name string) string {
 	deployment := a.WaitForDeploymentToGetReady(t, "member-operator-controller-manager", 1)
 	var value string
 containers:
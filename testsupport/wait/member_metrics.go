@@ -0,0 +1,119 @@
+package wait
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WebhookMetricsPortName the name of the container port on which the member operator webhook exposes its metrics
+const WebhookMetricsPortName = "metrics"
+
+// DiscoverMetricsService waits until there is a service with an `https` port which selects the pods of the member operator (ie: the pods
+// of the `member-operator-controller-manager` deployment) and returns it, so that the tests don't depend on the name of the service.
+// If several services match, then the one with `metrics` in its name is preferred.
+func (a *MemberAwaitility) DiscoverMetricsService(t T) (corev1.Service, error) {
+	recordWaiter(t)
+	a.logf(t, "discovering the metrics service of the member operator in namespace '%s'", a.Namespace)
+	var service corev1.Service
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		deployment := &appsv1.Deployment{}
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: "member-operator-controller-manager"}, deployment); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		services := &corev1.ServiceList{}
+		if err := a.Client.List(a.getContext(), services, client.InNamespace(a.Namespace)); err != nil {
+			return false, err
+		}
+		var found bool
+		service, found = metricsServiceOf(services.Items, deployment.Spec.Template.Labels)
+		return found, nil
+	})
+	return service, err
+}
+
+// metricsServiceOf returns the service with an `https` port which selects the pods with the given labels, preferably the one with
+// `metrics` in its name
+func metricsServiceOf(services []corev1.Service, podLabels map[string]string) (corev1.Service, bool) {
+	candidates := []corev1.Service{}
+	for _, s := range services {
+		if len(s.Spec.Selector) == 0 || !labels.SelectorFromSet(s.Spec.Selector).Matches(labels.Set(podLabels)) {
+			continue
+		}
+		for _, p := range s.Spec.Ports {
+			if p.Name == "https" {
+				candidates = append(candidates, s)
+				break
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return corev1.Service{}, false
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		mi, mj := strings.Contains(candidates[i].Name, "metrics"), strings.Contains(candidates[j].Name, "metrics")
+		if mi != mj {
+			return mi
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+	return candidates[0], true
+}
+
+// SetupMetrics discovers the metrics service of the member operator (see DiscoverMetricsService), sets up a route for it if possible
+// (the metrics are scraped via a port-forward otherwise, see NewMetricsClient) and configures the MetricsClient of the MemberAwaitility,
+// which obtains its bearer token with the given function
+func (a *MemberAwaitility) SetupMetrics(t T, tokenFunc metrics.TokenFunc) {
+	service, err := a.DiscoverMetricsService(t)
+	require.NoError(t, err, "failed while discovering the metrics service of the member operator")
+	if _, err := a.SetupRouteForService(t, service.Name, "/metrics"); err != nil {
+		a.logf(t, "unable to set up the route to the '%s' service, falling back to a port-forward: %s", service.Name, err.Error())
+	}
+	a.MetricsClient = a.NewMetricsClient(service.Name, tokenFunc)
+}
+
+// WebhookMetrics returns a copy of the MemberAwaitility whose metric waits (eg: WaitForMetricDelta) apply to the metrics of the member
+// operator webhook, which are scraped via a port-forward to the container port named `metrics` of a running webhook pod (see
+// GetWebhookMetricsPod), with the bearer token obtained with the given function. The copy has its own baseline, which must be captured
+// with CaptureMetricsBaseline.
+func (a *MemberAwaitility) WebhookMetrics(tokenFunc metrics.TokenFunc) *MemberAwaitility {
+	result := a.copy()
+	result.baselines = &metricBaselines{}
+	result.MetricsClient = metrics.NewClient(a.portForwardEndpoint(a.GetWebhookMetricsPod), tokenFunc)
+	return &MemberAwaitility{Awaitility: result}
+}
+
+// GetWebhookMetricsPod returns a running pod of the member operator webhook, along with its container port named `metrics`
+func (a *MemberAwaitility) GetWebhookMetricsPod() (corev1.Pod, int32, error) {
+	pods := &corev1.PodList{}
+	if err := a.Client.List(a.getContext(), pods, client.InNamespace(a.Namespace), client.MatchingLabels(appMemberOperatorWebhookLabel)); err != nil {
+		return corev1.Pod{}, 0, err
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+			continue
+		}
+		for _, c := range pod.Spec.Containers {
+			for _, p := range c.Ports {
+				if p.Name == WebhookMetricsPortName {
+					return pod, p.ContainerPort, nil
+				}
+			}
+		}
+		return corev1.Pod{}, 0, fmt.Errorf("pod '%s' has no container port named '%s'", pod.Name, WebhookMetricsPortName)
+	}
+	return corev1.Pod{}, 0, fmt.Errorf("no running webhook pod in namespace '%s'", a.Namespace)
+}
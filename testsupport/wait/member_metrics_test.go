@@ -0,0 +1,146 @@
+package wait_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDiscoverMetricsService(t *testing.T) {
+
+	newMemberAwaitility := func(t *testing.T, objs ...runtime.Object) *wait.MemberAwaitility {
+		a := wait.NewMemberAwaitility(nil, test.NewFakeClient(t, objs...), "toolchain-member-operator", "member-cluster")
+		return a.WithRetryOptions(wait.TimeoutOption(100*time.Millisecond), wait.RetryInterval(10*time.Millisecond))
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "toolchain-member-operator", Name: "member-operator-controller-manager"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"control-plane": "controller-manager", "app": "member-operator"},
+				},
+			},
+		},
+	}
+	newService := func(name string, selector map[string]string, portName string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "toolchain-member-operator", Name: name},
+			Spec: corev1.ServiceSpec{
+				Selector: selector,
+				Ports:    []corev1.ServicePort{{Name: portName, Port: 8443}},
+			},
+		}
+	}
+
+	t.Run("found", func(t *testing.T) {
+		// given
+		a := newMemberAwaitility(t, deployment,
+			newService("member-operator-webhook", map[string]string{"app": "member-operator-webhook"}, "https"),
+			newService("member-operator-http", map[string]string{"control-plane": "controller-manager"}, "http"),
+			newService("member-operator-api", map[string]string{"control-plane": "controller-manager"}, "https"),
+			newService("custom-metrics", map[string]string{"control-plane": "controller-manager"}, "https"))
+
+		// when
+		service, err := a.DiscoverMetricsService(t)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "custom-metrics", service.Name)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// given
+		a := newMemberAwaitility(t, deployment,
+			newService("member-operator-webhook", map[string]string{"app": "member-operator-webhook"}, "https"))
+
+		// when
+		_, err := a.DiscoverMetricsService(t)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+	})
+}
+
+func TestGetWebhookMetricsPod(t *testing.T) {
+
+	newPod := func(name string, phase corev1.PodPhase, portName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "toolchain-member-operator",
+				Name:      name,
+				Labels:    map[string]string{"app": "member-operator-webhook"},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{Name: "mutator", Ports: []corev1.ContainerPort{{Name: portName, ContainerPort: 8080}}},
+				},
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+
+	t.Run("found", func(t *testing.T) {
+		// given
+		a := wait.NewMemberAwaitility(nil, test.NewFakeClient(t, newPod("pending", corev1.PodPending, wait.WebhookMetricsPortName),
+			newPod("running", corev1.PodRunning, wait.WebhookMetricsPortName)), "toolchain-member-operator", "member-cluster")
+
+		// when
+		pod, port, err := a.GetWebhookMetricsPod()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "running", pod.Name)
+		assert.Equal(t, int32(8080), port)
+	})
+
+	t.Run("no metrics port", func(t *testing.T) {
+		// given
+		a := wait.NewMemberAwaitility(nil, test.NewFakeClient(t, newPod("running", corev1.PodRunning, "webhook")), "toolchain-member-operator", "member-cluster")
+
+		// when
+		_, _, err := a.GetWebhookMetricsPod()
+
+		// then
+		require.EqualError(t, err, "pod 'running' has no container port named 'metrics'")
+	})
+
+	t.Run("no running pod", func(t *testing.T) {
+		// given
+		a := wait.NewMemberAwaitility(nil, test.NewFakeClient(t), "toolchain-member-operator", "member-cluster")
+
+		// when
+		_, _, err := a.GetWebhookMetricsPod()
+
+		// then
+		require.EqualError(t, err, "no running webhook pod in namespace 'toolchain-member-operator'")
+	})
+}
+
+func TestWebhookMetrics(t *testing.T) {
+	// given
+	a := wait.NewMemberAwaitility(nil, test.NewFakeClient(t), "toolchain-member-operator", "member-cluster")
+	memberMetrics := metrics.NewClient(metrics.StaticEndpoint("member-operator-metrics"), metrics.StaticToken("token"))
+	a.MetricsClient = memberMetrics
+
+	// when
+	webhookAwait := a.WebhookMetrics(metrics.StaticToken("token"))
+
+	// then
+	require.NotNil(t, webhookAwait.MetricsClient)
+	assert.NotSame(t, memberMetrics, webhookAwait.MetricsClient)
+	assert.Same(t, memberMetrics, a.MetricsClient)
+	// the webhook metrics are scraped from a webhook pod
+	_, err := webhookAwait.MetricsClient.Snapshot()
+	require.EqualError(t, err, "unable to discover the metrics endpoint: no running webhook pod in namespace 'toolchain-member-operator'")
+}
@@ -0,0 +1,144 @@
+package wait_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	securityv1 "github.com/openshift/api/security/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWaitForPriorityClass(t *testing.T) {
+	// given
+	s := runtime.NewScheme()
+	require.NoError(t, schedulingv1.AddToScheme(s))
+	priorityClass := &schedulingv1.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "sandbox-users-pods",
+			Labels: map[string]string{
+				"toolchain.dev.openshift.com/provider": "codeready-toolchain",
+			},
+		},
+		Value:         -3,
+		GlobalDefault: false,
+		Description:   "Priority class for pods in users' namespaces",
+	}
+	memberAwait := wait.NewMemberAwaitility(nil, fake.NewClientBuilder().WithScheme(s).WithObjects(priorityClass).Build(), "toolchain-member-operator", "member-1").
+		WithRetryOptions(wait.RetryInterval(10*time.Millisecond), wait.TimeoutOption(100*time.Millisecond))
+
+	t.Run("matching", func(t *testing.T) {
+		// when
+		actual, err := memberAwait.WaitForPriorityClass(t, "sandbox-users-pods",
+			wait.UntilPriorityClassHasLabels(map[string]string{"toolchain.dev.openshift.com/provider": "codeready-toolchain"}),
+			wait.UntilPriorityClassHasValue(-3),
+			wait.UntilPriorityClassIsGlobalDefault(false),
+			wait.UntilPriorityClassHasDescription("Priority class for pods in users' namespaces"))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int32(-3), actual.Value)
+	})
+
+	t.Run("other value", func(t *testing.T) {
+		// when
+		_, err := memberAwait.WaitForPriorityClass(t, "sandbox-users-pods", wait.UntilPriorityClassHasValue(-5))
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+	})
+
+	t.Run("global default", func(t *testing.T) {
+		// when
+		_, err := memberAwait.WaitForPriorityClass(t, "sandbox-users-pods", wait.UntilPriorityClassIsGlobalDefault(true))
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// when
+		actual, err := memberAwait.WaitForPriorityClass(t, "unknown")
+
+		// then
+		require.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestWaitForSecurityContextConstraints(t *testing.T) {
+	// given
+	s := runtime.NewScheme()
+	require.NoError(t, securityv1.Install(s))
+	priority := int32(10)
+	scc := &securityv1.SecurityContextConstraints{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "sandbox-scc",
+		},
+		Priority:                 &priority,
+		AllowPrivilegedContainer: false,
+		Users:                    []string{"system:serviceaccount:toolchain-member-operator:member-operator-webhook-sa", "system:admin"},
+		Groups:                   []string{"system:cluster-admins"},
+	}
+	memberAwait := wait.NewMemberAwaitility(nil, fake.NewClientBuilder().WithScheme(s).WithObjects(scc).Build(), "toolchain-member-operator", "member-1").
+		WithRetryOptions(wait.RetryInterval(10*time.Millisecond), wait.TimeoutOption(100*time.Millisecond))
+
+	t.Run("matching", func(t *testing.T) {
+		// when
+		actual, err := memberAwait.WaitForSecurityContextConstraints(t, "sandbox-scc",
+			// the users and groups are compared regardless of their order
+			wait.UntilSecurityContextConstraintsHasUsers("system:admin", "system:serviceaccount:toolchain-member-operator:member-operator-webhook-sa"),
+			wait.UntilSecurityContextConstraintsHasGroups("system:cluster-admins"),
+			wait.UntilSecurityContextConstraintsHasPriority(10),
+			wait.UntilSecurityContextConstraintsAllowsPrivilegedContainers(false))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "sandbox-scc", actual.Name)
+	})
+
+	t.Run("missing user", func(t *testing.T) {
+		// when
+		_, err := memberAwait.WaitForSecurityContextConstraints(t, "sandbox-scc", wait.UntilSecurityContextConstraintsHasUsers("system:admin"))
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+	})
+
+	t.Run("extra group", func(t *testing.T) {
+		// when
+		_, err := memberAwait.WaitForSecurityContextConstraints(t, "sandbox-scc",
+			wait.UntilSecurityContextConstraintsHasGroups("system:cluster-admins", "system:authenticated"))
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+	})
+
+	t.Run("privileged containers", func(t *testing.T) {
+		// when
+		_, err := memberAwait.WaitForSecurityContextConstraints(t, "sandbox-scc", wait.UntilSecurityContextConstraintsAllowsPrivilegedContainers(true))
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// when
+		actual, err := memberAwait.WaitForSecurityContextConstraints(t, "unknown")
+
+		// then
+		require.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
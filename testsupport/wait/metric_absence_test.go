@@ -0,0 +1,59 @@
+package wait_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitUntilMetricDisappears(t *testing.T) {
+	// given
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "# TYPE sandbox_spaces_current gauge\nsandbox_spaces_current{cluster_name=\"member-1\"} 0\n")
+	}))
+	defer ts.Close()
+	a := &wait.Awaitility{
+		Client:        test.NewFakeClient(t),
+		Namespace:     "toolchain-host-operator",
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       100 * time.Millisecond,
+		MetricsClient: metrics.NewClient(metrics.StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), metrics.StaticToken("token")),
+	}
+
+	t.Run("family not exposed", func(t *testing.T) {
+		require.NoError(t, a.WaitUntilMetricDisappears(t, wait.UserSignupsMetric))
+	})
+
+	t.Run("labelset not exposed", func(t *testing.T) {
+		require.NoError(t, a.WaitUntilMetricDisappears(t, wait.SpacesMetric, "cluster_name", "member-2"))
+	})
+
+	t.Run("labelset still exposed with value 0", func(t *testing.T) {
+		// when
+		err := a.WaitUntilMetricDisappears(t, wait.SpacesMetric, "cluster_name", "member-1")
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+		assert.Contains(t, err.Error(), `waited for metric 'sandbox_spaces_current{[cluster_name member-1]}' to disappear. Remaining series: [{cluster_name="member-1"}=0]`)
+	})
+
+	t.Run("family still exposed", func(t *testing.T) {
+		// when
+		err := a.WaitUntilMetricDisappears(t, wait.SpacesMetric)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `waited for metric 'sandbox_spaces_current{[]}' to disappear. Remaining series: [{cluster_name="member-1"}=0]`)
+	})
+}
@@ -0,0 +1,70 @@
+package wait_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricAggregation(t *testing.T) {
+	// given
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "# TYPE sandbox_users_per_activations_and_domain gauge\n")
+		fmt.Fprint(w, "sandbox_users_per_activations_and_domain{activations=\"1\",domain=\"external\"} 5\n")
+		fmt.Fprint(w, "sandbox_users_per_activations_and_domain{activations=\"2\",domain=\"external\"} 2\n")
+		fmt.Fprint(w, "sandbox_users_per_activations_and_domain{activations=\"1\",domain=\"internal\"} 3\n")
+	}))
+	defer ts.Close()
+	a := &wait.Awaitility{
+		Client:        test.NewFakeClient(t),
+		Namespace:     "toolchain-host-operator",
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       100 * time.Millisecond,
+		MetricsClient: metrics.NewClient(metrics.StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), metrics.StaticToken("token")),
+	}
+
+	t.Run("sum across labels", func(t *testing.T) {
+		// when
+		sums := a.SumMetricAcrossLabels(t, wait.UsersPerActivationsAndDomainMetric, "domain")
+
+		// then
+		assert.Equal(t, []metrics.Series{
+			{Labels: map[string]string{"domain": "external"}, Value: 7},
+			{Labels: map[string]string{"domain": "internal"}, Value: 3},
+		}, sums)
+	})
+
+	t.Run("wait until sum has value", func(t *testing.T) {
+		t.Run("all series", func(t *testing.T) {
+			require.NoError(t, a.WaitUntilMetricSumHasValue(t, wait.UsersPerActivationsAndDomainMetric, 10))
+		})
+
+		t.Run("series with labels", func(t *testing.T) {
+			require.NoError(t, a.WaitUntilMetricSumHasValue(t, wait.UsersPerActivationsAndDomainMetric, 7, "domain", "external"))
+		})
+
+		t.Run("no series with labels", func(t *testing.T) {
+			require.NoError(t, a.WaitUntilMetricSumHasValue(t, wait.UsersPerActivationsAndDomainMetric, 0, "domain", "unknown"))
+		})
+
+		t.Run("not reached", func(t *testing.T) {
+			// when
+			err := a.WaitUntilMetricSumHasValue(t, wait.UsersPerActivationsAndDomainMetric, 8, "domain", "external")
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "waited for the sum of metric 'sandbox_users_per_activations_and_domain{[domain external]}' to reach '8'. "+
+				`Current sum: 7 of [{activations="1",domain="external"}=5, {activations="2",domain="external"}=2]`)
+		})
+	})
+}
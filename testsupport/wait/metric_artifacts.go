@@ -0,0 +1,103 @@
+package wait
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// MetricArtifactsVar the name of the env var which enables the export of the metrics scraped from the operators during each test
+// (when set to `true`), see Awaitility.WithMetricArtifacts
+const MetricArtifactsVar = "E2E_METRIC_ARTIFACTS"
+
+// MetricArtifactsEnabled returns `true` if the export of the metrics scraped from the operators during each test is enabled
+func MetricArtifactsEnabled() bool {
+	return CurrentEnvironment().MetricArtifacts
+}
+
+// metricArtifacts the dir in which the metrics scraped during a test are exported
+type metricArtifacts struct {
+	dir  string
+	test string
+}
+
+// WithMetricArtifacts returns a new Awaitility which exports all the metrics exposed by the operator as OpenMetrics files in the given dir
+// (or in the temp dir if empty, eg: when the `ARTIFACT_DIR` is not set) at the beginning of the test, when a metric wait fails and at the
+// end of the test, so that the failed metric assertions can be analyzed post-mortem. The name of each file contains the name of the test,
+// the cluster, the point of the test (`start`, `failure` or `teardown`) and a timestamp.
+// Returns the Awaitility as is if it has no metrics client.
+func (a *Awaitility) WithMetricArtifacts(t T, dir string) *Awaitility {
+	if a.MetricsClient == nil {
+		return a
+	}
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	result := a.copy()
+	result.metricArtifacts = &metricArtifacts{
+		dir:  dir,
+		test: t.Name(),
+	}
+	result.exportMetrics(t, "start")
+	t.Cleanup(func() {
+		result.exportMetrics(t, "teardown")
+	})
+	return result
+}
+
+// WithMetricArtifacts returns a new HostAwaitility which exports the metrics of the host operator during the test
+// (see Awaitility.WithMetricArtifacts)
+func (a *HostAwaitility) WithMetricArtifacts(t T, dir string) *HostAwaitility {
+	result := *a
+	result.Awaitility = a.Awaitility.WithMetricArtifacts(t, dir)
+	return &result
+}
+
+// WithMetricArtifacts returns a new MemberAwaitility which exports the metrics of the member operator during the test
+// (see Awaitility.WithMetricArtifacts)
+func (a *MemberAwaitility) WithMetricArtifacts(t T, dir string) *MemberAwaitility {
+	result := *a
+	result.Awaitility = a.Awaitility.WithMetricArtifacts(t, dir)
+	return &result
+}
+
+// WithMetricArtifacts returns new Awaitilities which export the metrics of the host and all the member operators during the test
+// (see Awaitility.WithMetricArtifacts)
+func (a Awaitilities) WithMetricArtifacts(t T, dir string) Awaitilities {
+	members := make([]*MemberAwaitility, len(a.memberAwaitilities))
+	for i, m := range a.memberAwaitilities {
+		members[i] = m.WithMetricArtifacts(t, dir)
+	}
+	return NewAwaitilities(a.hostAwaitility.WithMetricArtifacts(t, dir), members...)
+}
+
+// exportMetrics writes all the metrics currently exposed by the operator in a file of the dir of the metric artifacts, if they are
+// enabled (see WithMetricArtifacts)
+func (a *Awaitility) exportMetrics(t T, point string) {
+	if a.metricArtifacts == nil {
+		return
+	}
+	snapshot, err := a.MetricsClient.Snapshot()
+	if err != nil {
+		a.logf(t, "unable to export the metrics (%s): %s", point, err.Error())
+		return
+	}
+	payload := &bytes.Buffer{}
+	if err := snapshot.WriteOpenMetrics(payload); err != nil {
+		a.logf(t, "unable to export the metrics (%s): %s", point, err.Error())
+		return
+	}
+	name := fmt.Sprintf("metrics-%s-%s-%s-%s.txt",
+		strings.NewReplacer("/", "_", " ", "_").Replace(a.metricArtifacts.test),
+		a.LogLabel(),
+		point,
+		a.getClock().Now().UTC().Format("20060102T150405.000"))
+	path := filepath.Join(a.metricArtifacts.dir, name)
+	if err := os.WriteFile(path, payload.Bytes(), 0600); err != nil {
+		a.logf(t, "unable to export the metrics (%s): %s", point, err.Error())
+		return
+	}
+	a.logf(t, "the metrics (%s) were exported in %s", point, path)
+}
@@ -0,0 +1,97 @@
+package wait_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/cluster"
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithMetricArtifacts(t *testing.T) {
+	// given
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "# TYPE sandbox_spaces_current gauge\nsandbox_spaces_current{cluster_name=\"member-1\"} 11\n")
+	}))
+	defer ts.Close()
+	newAwaitility := func() *wait.Awaitility {
+		return &wait.Awaitility{
+			Client:        test.NewFakeClient(t),
+			Type:          cluster.Host,
+			Namespace:     "toolchain-host-operator",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+			MetricsClient: metrics.NewClient(metrics.StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), metrics.StaticToken("token")),
+		}
+	}
+	exported := func(t *testing.T, dir string) []string {
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		names := []string{}
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		return names
+	}
+
+	t.Run("start and teardown", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+
+		// when
+		t.Run("scenario", func(t *testing.T) {
+			a := newAwaitility().WithMetricArtifacts(t, dir)
+			require.NoError(t, a.TryWaitUntilMetricHasValue(t, wait.SpacesMetric, 11, "cluster_name", "member-1"))
+		})
+
+		// then
+		names := exported(t, dir)
+		require.Len(t, names, 2)
+		assert.Regexp(t, `^metrics-TestWithMetricArtifacts_start_and_teardown_scenario-host-start-\d{8}T\d{6}\.\d{3}\.txt$`, names[0])
+		assert.Regexp(t, `^metrics-TestWithMetricArtifacts_start_and_teardown_scenario-host-teardown-\d{8}T\d{6}\.\d{3}\.txt$`, names[1])
+		payload, err := os.ReadFile(filepath.Join(dir, names[0]))
+		require.NoError(t, err)
+		assert.Equal(t, "# TYPE sandbox_spaces_current gauge\nsandbox_spaces_current{cluster_name=\"member-1\"} 11.0\n# EOF\n", string(payload))
+	})
+
+	t.Run("failed metric wait", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+
+		// when
+		t.Run("scenario", func(t *testing.T) {
+			a := newAwaitility().WithMetricArtifacts(t, dir)
+			require.Error(t, a.TryWaitUntilMetricHasValue(t, wait.SpacesMetric, 10, "cluster_name", "member-1"))
+		})
+
+		// then
+		names := exported(t, dir)
+		require.Len(t, names, 3)
+		assert.Contains(t, names[0], "-host-failure-")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+
+		// when
+		t.Run("scenario", func(t *testing.T) {
+			a := newAwaitility()
+			require.Error(t, a.TryWaitUntilMetricHasValue(t, wait.SpacesMetric, 10, "cluster_name", "member-1"))
+		})
+
+		// then
+		assert.Empty(t, exported(t, dir))
+	})
+}
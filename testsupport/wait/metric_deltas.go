@@ -0,0 +1,116 @@
+package wait
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+)
+
+// MetricDeltasVar the name of the env var which enables the report of the metrics which changed during each test (when set to `true`)
+const MetricDeltasVar = "E2E_METRIC_DELTAS"
+
+// MetricDeltasEnabled returns `true` if the report of the metrics which changed during each test is enabled
+func MetricDeltasEnabled() bool {
+	return CurrentEnvironment().MetricDeltas
+}
+
+// runtimeMetricPrefixes the prefixes of the metric families of the Go runtime and of the process, which change all the time
+// and are thus excluded from the report of the metric deltas (unless they are explicitly given)
+var runtimeMetricPrefixes = []string{"go_", "process_", "promhttp_"}
+
+// ReportMetricDeltas captures the values of the metrics exposed by the operator now and at the end of the test, and logs the
+// series of the given families (or of all the families but the ones of the Go runtime and of the process, when none is given)
+// whose value changed in between (see WriteMetricDeltas), so that the drift of the metrics and the leaked counters are visible
+// for every test. Does nothing if the Awaitility has no metrics client.
+func (a *Awaitility) ReportMetricDeltas(t T, families ...string) {
+	if a.MetricsClient == nil {
+		return
+	}
+	before, err := a.MetricsClient.Snapshot()
+	if err != nil {
+		a.logf(t, "unable to capture the metrics at the beginning of the test: %s", err.Error())
+		return
+	}
+	t.Cleanup(func() {
+		after, err := a.MetricsClient.Snapshot()
+		if err != nil {
+			a.logf(t, "unable to capture the metrics at the end of the test: %s", err.Error())
+			return
+		}
+		selected := families
+		if len(selected) == 0 {
+			selected = withoutRuntimeMetrics(append(before.Families(), after.Families()...))
+		}
+		var deltas []metrics.SeriesDelta
+		if len(selected) > 0 {
+			deltas = before.Diff(after, selected...)
+		}
+		if len(deltas) == 0 {
+			a.logf(t, "no metric changed during the test")
+			return
+		}
+		report := &strings.Builder{}
+		if err := WriteMetricDeltas(report, deltas); err != nil {
+			a.logf(t, "unable to generate the report of the metric deltas: %s", err.Error())
+			return
+		}
+		a.logf(t, "metrics which changed during the test:\n%s", report.String())
+	})
+}
+
+// ReportMetricDeltas reports the metrics which changed during the test, for the host and all the member operators
+// (see Awaitility.ReportMetricDeltas)
+func (a Awaitilities) ReportMetricDeltas(t T, families ...string) {
+	a.hostAwaitility.ReportMetricDeltas(t, families...)
+	for _, m := range a.memberAwaitilities {
+		m.ReportMetricDeltas(t, families...)
+	}
+}
+
+// WriteMetricDeltas writes the given deltas as a table, with a row per series: its family, its labels, its value before and after,
+// and the difference
+func WriteMetricDeltas(out io.Writer, deltas []metrics.SeriesDelta) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "FAMILY\tLABELS\tBEFORE\tAFTER\tDELTA")
+	for _, d := range deltas {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", d.Family, d.LabelsString(), formatMetricValue(d.Before), formatMetricValue(d.After),
+			formatMetricDelta(d.Delta()))
+	}
+	return w.Flush()
+}
+
+func formatMetricValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatMetricDelta(v float64) string {
+	if v > 0 {
+		return "+" + formatMetricValue(v)
+	}
+	return formatMetricValue(v)
+}
+
+func withoutRuntimeMetrics(families []string) []string {
+	var result []string
+	seen := map[string]bool{}
+families:
+	for _, f := range families {
+		if seen[f] {
+			continue
+		}
+		seen[f] = true
+		for _, prefix := range runtimeMetricPrefixes {
+			if strings.HasPrefix(f, prefix) {
+				continue families
+			}
+		}
+		result = append(result, f)
+	}
+	sort.Strings(result)
+	return result
+}
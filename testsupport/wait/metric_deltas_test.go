@@ -0,0 +1,103 @@
+package wait_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteMetricDeltas(t *testing.T) {
+	// given
+	deltas := []metrics.SeriesDelta{
+		{Family: "sandbox_spaces_current", Labels: map[string]string{"cluster_name": "member-1"}, Before: 3, After: 5},
+		{Family: "sandbox_user_signups_total", Labels: map[string]string{}, Before: 10, After: 9},
+	}
+	out := &strings.Builder{}
+
+	// when
+	err := wait.WriteMetricDeltas(out, deltas)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, ""+
+		"FAMILY                      LABELS                     BEFORE  AFTER  DELTA\n"+
+		"sandbox_spaces_current      {cluster_name=\"member-1\"}  3       5      +2\n"+
+		"sandbox_user_signups_total  {}                         10      9      -1\n", out.String())
+}
+
+func TestReportMetricDeltas(t *testing.T) {
+	// given
+	var spaces, gcs atomic.Int64
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "# TYPE sandbox_spaces_current gauge\nsandbox_spaces_current{cluster_name=\"member-1\"} %d\n", spaces.Load())
+		fmt.Fprint(w, "# TYPE sandbox_user_signups_total counter\nsandbox_user_signups_total 10\n")
+		fmt.Fprintf(w, "# TYPE go_gc_cycles_total counter\ngo_gc_cycles_total %d\n", gcs.Add(1))
+	}))
+	defer ts.Close()
+	a := &wait.Awaitility{
+		Client:        test.NewFakeClient(t),
+		Namespace:     "toolchain-host-operator",
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       time.Second,
+		MetricsClient: metrics.NewClient(metrics.StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), metrics.StaticToken("token")),
+	}
+
+	t.Run("metrics changed", func(t *testing.T) {
+		// given
+		spaces.Store(3)
+		out := &strings.Builder{}
+
+		// when
+		wait.RunStandalone("TestSpaces", out, func(st wait.T) {
+			a.ReportMetricDeltas(st)
+			spaces.Store(4)
+		})
+
+		// then
+		assert.Contains(t, out.String(), "metrics which changed during the test:")
+		assert.Contains(t, out.String(), `sandbox_spaces_current  {cluster_name="member-1"}  3       4      +1`)
+		assert.NotContains(t, out.String(), "sandbox_user_signups_total")
+		assert.NotContains(t, out.String(), "go_gc_cycles_total")
+	})
+
+	t.Run("given families", func(t *testing.T) {
+		// given
+		spaces.Store(3)
+		out := &strings.Builder{}
+
+		// when
+		wait.RunStandalone("TestGC", out, func(st wait.T) {
+			a.ReportMetricDeltas(st, "go_gc_cycles_total", "sandbox_user_signups_total")
+			spaces.Store(4)
+		})
+
+		// then
+		assert.Contains(t, out.String(), "go_gc_cycles_total")
+		assert.NotContains(t, out.String(), "sandbox_spaces_current")
+	})
+
+	t.Run("no metric changed", func(t *testing.T) {
+		// given
+		spaces.Store(3)
+		out := &strings.Builder{}
+
+		// when
+		wait.RunStandalone("TestNothing", out, func(st wait.T) {
+			a.ReportMetricDeltas(st)
+		})
+
+		// then
+		assert.Contains(t, out.String(), "no metric changed during the test")
+	})
+}
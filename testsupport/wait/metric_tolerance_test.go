@@ -0,0 +1,93 @@
+package wait_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricTolerance(t *testing.T) {
+	// given
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "# TYPE sandbox_spaces_current gauge\nsandbox_spaces_current{cluster_name=\"member-1\"} 11\n")
+	}))
+	defer ts.Close()
+	a := &wait.Awaitility{
+		Client:        test.NewFakeClient(t),
+		Namespace:     "toolchain-host-operator",
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       100 * time.Millisecond,
+		MetricsClient: metrics.NewClient(metrics.StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), metrics.StaticToken("token")),
+	}
+
+	t.Run("exact value", func(t *testing.T) {
+		t.Run("without tolerance", func(t *testing.T) {
+			err := a.TryWaitUntilMetricHasValue(t, wait.SpacesMetric, 10, "cluster_name", "member-1")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "waited for metric 'sandbox_spaces_current{[cluster_name member-1]}' to reach '10'. Current value: 11")
+		})
+
+		t.Run("within tolerance", func(t *testing.T) {
+			err := a.WithRetryOptions(wait.WithTolerance(1)).TryWaitUntilMetricHasValue(t, wait.SpacesMetric, 10, "cluster_name", "member-1")
+			require.NoError(t, err)
+		})
+
+		t.Run("beyond tolerance", func(t *testing.T) {
+			err := a.WithRetryOptions(wait.WithTolerance(0.5)).TryWaitUntilMetricHasValue(t, wait.SpacesMetric, 10, "cluster_name", "member-1")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "to reach '10' (±0.5). Current value: 11")
+		})
+	})
+
+	t.Run("value or less", func(t *testing.T) {
+		require.Error(t, a.WaitUntilMetricHasValueOrLess(t, wait.SpacesMetric, 10, "cluster_name", "member-1"))
+		require.NoError(t, a.WithRetryOptions(wait.WithTolerance(1)).WaitUntilMetricHasValueOrLess(t, wait.SpacesMetric, 10, "cluster_name", "member-1"))
+	})
+
+	t.Run("value or more", func(t *testing.T) {
+		require.Error(t, a.WaitUntilMetricHasValueOrMore(t, wait.SpacesMetric, 12, "cluster_name", "member-1"))
+		require.NoError(t, a.WithRetryOptions(wait.WithTolerance(1)).WaitUntilMetricHasValueOrMore(t, wait.SpacesMetric, 12, "cluster_name", "member-1"))
+	})
+}
+
+func TestWaitForMetricInRange(t *testing.T) {
+	// given
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "# TYPE sandbox_spaces_current gauge\nsandbox_spaces_current{cluster_name=\"member-1\"} 11\n")
+	}))
+	defer ts.Close()
+	a := &wait.Awaitility{
+		Client:        test.NewFakeClient(t),
+		Namespace:     "toolchain-host-operator",
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       100 * time.Millisecond,
+		MetricsClient: metrics.NewClient(metrics.StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), metrics.StaticToken("token")),
+	}
+
+	t.Run("in range", func(t *testing.T) {
+		require.NoError(t, a.WaitForMetricInRange(t, wait.SpacesMetric, 10, 12, "cluster_name", "member-1"))
+		require.NoError(t, a.WaitForMetricInRange(t, wait.SpacesMetric, 11, 11, "cluster_name", "member-1"))
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		err := a.WaitForMetricInRange(t, wait.SpacesMetric, 0, 10, "cluster_name", "member-1")
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+		assert.Contains(t, err.Error(), "waited for metric 'sandbox_spaces_current{[cluster_name member-1]}' to be in [0, 10]. Current value: 11")
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		err := a.WaitForMetricInRange(t, wait.SpacesMetric, 12, 10, "cluster_name", "member-1")
+		require.EqualError(t, err, "invalid range of values for metric 'sandbox_spaces_current{[cluster_name member-1]}': [12, 10]")
+	})
+}
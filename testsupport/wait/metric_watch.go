@@ -0,0 +1,114 @@
+package wait
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultMetricWatchInterval the default interval between two samples of a watched metric (see WatchMetric)
+const DefaultMetricWatchInterval = time.Second
+
+// MetricPoint a sample of a watched metric, or the error which occurred while scraping it
+type MetricPoint struct {
+	// Elapsed the time elapsed between the start of the watch and the scrape
+	Elapsed time.Duration
+	Value   float64
+	Err     error
+}
+
+func (p MetricPoint) String() string {
+	if p.Err != nil {
+		return fmt.Sprintf("+%s: error (%s)", p.Elapsed.Round(time.Millisecond), p.Err.Error())
+	}
+	return fmt.Sprintf("+%s: %v", p.Elapsed.Round(time.Millisecond), p.Value)
+}
+
+// MetricWatch samples a metric in the background (see WatchMetric)
+type MetricWatch struct {
+	stop chan struct{}
+	done chan struct{}
+	once sync.Once
+
+	mu     sync.Mutex
+	points []MetricPoint
+}
+
+// WatchMetric samples the metric with the given family and label key-value pairs in a background goroutine every
+// DefaultMetricWatchInterval (or every retry interval of the Awaitility if shorter), until the watch is stopped or the test ends.
+// Only the samples whose value (or scrape error) differs from the previous one are kept, and the resulting time series is logged
+// when the watch stops, eg: to see how a metric evolved when a wait for its value failed.
+//
+//	hostAwait.WatchMetric(t, wait.SpacesMetric, "cluster_name", memberAwait.ClusterName)
+func (a *Awaitility) WatchMetric(t T, family string, labels ...string) *MetricWatch {
+	requireLabelPairs(t, labels)
+	interval := DefaultMetricWatchInterval
+	if a.RetryInterval > 0 && a.RetryInterval < interval {
+		interval = a.RetryInterval
+	}
+	w := &MetricWatch{
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	clk := a.getClock()
+	start := clk.Now()
+	go func() {
+		defer close(w.done)
+		for {
+			elapsed := clk.Since(start)
+			value, err := a.MetricsClient.GetMetricValue(family, labels)
+			w.record(MetricPoint{Elapsed: elapsed, Value: value, Err: err})
+			timer := clk.NewTimer(interval)
+			select {
+			case <-w.stop:
+				timer.Stop()
+				return
+			case <-timer.C():
+			}
+		}
+	}()
+	t.Cleanup(func() {
+		w.Stop()
+		a.logf(t, "metric '%s{%v}' over time: %s", family, labels, w.String())
+	})
+	return w
+}
+
+// record appends the given point, unless it has the same value (or error) as the last one
+func (w *MetricWatch) record(p MetricPoint) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if n := len(w.points); n > 0 {
+		last := w.points[n-1]
+		if (last.Err == nil) == (p.Err == nil) && last.Value == p.Value && (p.Err == nil || last.Err.Error() == p.Err.Error()) {
+			return
+		}
+	}
+	w.points = append(w.points, p)
+}
+
+// Stop stops sampling the metric and waits until the background goroutine is done. It can be called more than once.
+func (w *MetricWatch) Stop() {
+	w.once.Do(func() {
+		close(w.stop)
+	})
+	<-w.done
+}
+
+// Points returns the samples of the metric recorded so far, ie: the first one and each change of value (or of scrape error)
+func (w *MetricWatch) Points() []MetricPoint {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]MetricPoint{}, w.points...)
+}
+
+// String returns the samples of the metric recorded so far, eg: `[+0s: 1, +2.5s: 2]`
+func (w *MetricWatch) String() string {
+	points := w.Points()
+	values := make([]string, 0, len(points))
+	for _, p := range points {
+		values = append(values, p.String())
+	}
+	return "[" + strings.Join(values, ", ") + "]"
+}
@@ -0,0 +1,90 @@
+package wait_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchMetric(t *testing.T) {
+	// given
+	var spaces atomic.Int64
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "# TYPE sandbox_spaces_current gauge\nsandbox_spaces_current{cluster_name=\"member-1\"} %d\n", spaces.Load())
+	}))
+	defer ts.Close()
+	a := &wait.Awaitility{
+		Client:        test.NewFakeClient(t),
+		Namespace:     "toolchain-host-operator",
+		RetryInterval: 5 * time.Millisecond,
+		Timeout:       time.Second,
+		MetricsClient: metrics.NewClient(metrics.StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), metrics.StaticToken("token"), metrics.WithCacheTTL(0)),
+	}
+
+	t.Run("changes of value", func(t *testing.T) {
+		// given
+		spaces.Store(1)
+		w := a.WatchMetric(t, wait.SpacesMetric, "cluster_name", "member-1")
+		require.NoError(t, a.TryWaitUntilMetricHasValue(t, wait.SpacesMetric, 1, "cluster_name", "member-1"))
+
+		// when
+		spaces.Store(2)
+		require.NoError(t, a.TryWaitUntilMetricHasValue(t, wait.SpacesMetric, 2, "cluster_name", "member-1"))
+		require.Eventually(t, func() bool {
+			return len(w.Points()) == 2
+		}, time.Second, 5*time.Millisecond)
+		w.Stop()
+
+		// then
+		points := w.Points()
+		require.Len(t, points, 2)
+		assert.Equal(t, float64(1), points[0].Value)
+		assert.Equal(t, float64(2), points[1].Value)
+		assert.Greater(t, points[1].Elapsed, points[0].Elapsed)
+		w.Stop() // no-op when already stopped
+	})
+
+	t.Run("scrape errors", func(t *testing.T) {
+		// when
+		w := a.WatchMetric(t, wait.SpacesMetric, "cluster_name", "member-2")
+		require.Eventually(t, func() bool {
+			return len(w.Points()) > 0
+		}, time.Second, 5*time.Millisecond)
+		w.Stop()
+
+		// then
+		points := w.Points()
+		require.Len(t, points, 1)
+		require.Error(t, points[0].Err)
+		assert.Contains(t, w.String(), "error (metric 'sandbox_spaces_current{[cluster_name member-2]}' not found)")
+	})
+
+	t.Run("logged at the end of the test", func(t *testing.T) {
+		// given
+		spaces.Store(3)
+		out := &strings.Builder{}
+
+		// when
+		ok := wait.RunStandalone(t.Name(), out, func(st wait.T) {
+			w := a.WatchMetric(st, wait.SpacesMetric, "cluster_name", "member-1")
+			require.Eventually(t, func() bool {
+				return len(w.Points()) > 0
+			}, time.Second, 5*time.Millisecond)
+		})
+
+		// then
+		assert.True(t, ok)
+		assert.Contains(t, out.String(), "metric 'sandbox_spaces_current{[cluster_name member-1]}' over time: [+0s: 3]")
+	})
+}
@@ -0,0 +1,171 @@
+package wait
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// NarrativeVar the name of the env var which enables the narrative of the major steps of each test (when set to `true`)
+const NarrativeVar = "E2E_NARRATIVE"
+
+// NarrativeEnabled returns `true` if the narrative of the major steps of each test is enabled
+func NarrativeEnabled() bool {
+	return CurrentEnvironment().Narrative
+}
+
+// NarrativeStep a major step of a test, eg: `When the user 'johnsmith' signs up`
+type NarrativeStep struct {
+	// Keyword the Gherkin keyword of the step: `Given`, `When`, `Then` or `Finally`
+	Keyword string
+	Text    string
+}
+
+// Narrative records the major steps of a test (eg: the signup is created, approved, the space is ready, and the resources are cleaned up)
+// and renders them as a human-readable, Gherkin-style log, so that the QE and the release managers can review what the e2e tests
+// actually exercised without reading their code nor their verbose output (see Awaitility.WithNarrative).
+// All the methods can be called on a nil Narrative, in which case the steps are simply not recorded.
+type Narrative struct {
+	lock     sync.Mutex
+	scenario string
+	steps    []NarrativeStep
+}
+
+// NewNarrative returns a new Narrative of the given test, which is written at the end of the test as a text file in the given dir
+// if not empty (eg: the `ARTIFACT_DIR`), or logged otherwise
+func NewNarrative(t T, dir string) *Narrative {
+	n := &Narrative{
+		scenario: t.Name(),
+	}
+	t.Cleanup(func() {
+		if f, ok := t.(interface{ Failed() bool }); ok && f.Failed() {
+			n.record("Finally", "the test failed")
+		}
+		if dir == "" {
+			t.Log(n.String())
+			return
+		}
+		path, err := n.writeFile(dir)
+		if err != nil {
+			t.Logf("unable to write the narrative of the test: %v", err)
+			return
+		}
+		t.Logf("the narrative of the test was written in %s", path)
+	})
+	return n
+}
+
+// Given records a step which sets up the test
+func (n *Narrative) Given(format string, args ...interface{}) {
+	n.record("Given", format, args...)
+}
+
+// When records a step which is exercised by the test
+func (n *Narrative) When(format string, args ...interface{}) {
+	n.record("When", format, args...)
+}
+
+// Then records an outcome which was verified by the test
+func (n *Narrative) Then(format string, args ...interface{}) {
+	n.record("Then", format, args...)
+}
+
+// Finally records a step which happens at the end of the test (eg: the cleanup of a resource). These steps are rendered after
+// all the other ones, regardless of when they were recorded.
+func (n *Narrative) Finally(format string, args ...interface{}) {
+	n.record("Finally", format, args...)
+}
+
+func (n *Narrative) record(keyword, format string, args ...interface{}) {
+	if n == nil {
+		return
+	}
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.steps = append(n.steps, NarrativeStep{
+		Keyword: keyword,
+		Text:    fmt.Sprintf(format, args...),
+	})
+}
+
+// Steps returns the steps recorded so far, in the order in which they are rendered
+func (n *Narrative) Steps() []NarrativeStep {
+	if n == nil {
+		return nil
+	}
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	steps := make([]NarrativeStep, 0, len(n.steps))
+	var finally []NarrativeStep
+	for _, s := range n.steps {
+		if s.Keyword == "Finally" {
+			finally = append(finally, s)
+			continue
+		}
+		steps = append(steps, s)
+	}
+	return append(steps, finally...)
+}
+
+// String renders the narrative as a Gherkin-style scenario, in which the consecutive steps with the same keyword are joined with `And`
+func (n *Narrative) String() string {
+	if n == nil {
+		return ""
+	}
+	narrative := &strings.Builder{}
+	narrative.WriteString(fmt.Sprintf("Scenario: %s\n", n.scenario))
+	previous := ""
+	for _, s := range n.Steps() {
+		keyword := s.Keyword
+		if keyword == previous {
+			keyword = "And"
+		}
+		previous = s.Keyword
+		narrative.WriteString(fmt.Sprintf("  %s %s\n", keyword, s.Text))
+	}
+	return narrative.String()
+}
+
+// writeFile writes the narrative in a file named after its test in the given dir, and returns its path
+func (n *Narrative) writeFile(dir string) (string, error) {
+	path := filepath.Join(dir, fmt.Sprintf("narrative-%s.txt", strings.NewReplacer("/", "_", " ", "_").Replace(n.scenario)))
+	return path, os.WriteFile(path, []byte(n.String()), 0600)
+}
+
+// Narrative returns the Narrative in which the major steps of the test are recorded, or nil if the narrative is not enabled
+// (in which case the steps are not recorded)
+func (a *Awaitility) Narrative() *Narrative {
+	return a.narrative
+}
+
+// WithNarrative returns a new Awaitility which records the major steps of the test in the given Narrative
+func (a *Awaitility) WithNarrative(narrative *Narrative) *Awaitility {
+	result := a.copy()
+	result.narrative = narrative
+	return result
+}
+
+// WithNarrative returns a new HostAwaitility which records the major steps of the test in the given Narrative
+func (a *HostAwaitility) WithNarrative(narrative *Narrative) *HostAwaitility {
+	result := *a
+	result.Awaitility = a.Awaitility.WithNarrative(narrative)
+	return &result
+}
+
+// WithNarrative returns a new MemberAwaitility which records the major steps of the test in the given Narrative
+func (a *MemberAwaitility) WithNarrative(narrative *Narrative) *MemberAwaitility {
+	result := *a
+	result.Awaitility = a.Awaitility.WithNarrative(narrative)
+	return &result
+}
+
+// WithNarrative returns new Awaitilities which all record the major steps of the test in the given Narrative
+func (a Awaitilities) WithNarrative(narrative *Narrative) Awaitilities {
+	members := make([]*MemberAwaitility, len(a.memberAwaitilities))
+	for i, m := range a.memberAwaitilities {
+		members[i] = m.WithNarrative(narrative)
+	}
+	return NewAwaitilities(a.hostAwaitility.WithNarrative(narrative), members...)
+}
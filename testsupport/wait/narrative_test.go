@@ -0,0 +1,83 @@
+package wait_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNarrative(t *testing.T) {
+	// given
+	a := &wait.Awaitility{
+		Client:    test.NewFakeClient(t),
+		Namespace: "toolchain-host-operator",
+	}
+
+	t.Run("written in the dir", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		out := &bytes.Buffer{}
+
+		// when
+		passed := wait.RunStandalone("TestSomething/with a subtest", out, func(st wait.T) {
+			a := a.WithNarrative(wait.NewNarrative(st, dir))
+			a.Narrative().Given("the 'base' tier")
+			a.Narrative().When("the user '%s' signs up", "johnsmith")
+			a.Narrative().Finally("the UserSignup '%s' and its resources are deleted", "johnsmith")
+			a.Narrative().When("the UserSignup '%s' is approved by an admin", "johnsmith")
+			a.Narrative().Then("the Space '%s' is ready", "johnsmith")
+			a.Narrative().Then("the MasterUserRecord '%s' is provisioned", "johnsmith")
+		})
+
+		// then
+		require.True(t, passed)
+		path := filepath.Join(dir, "narrative-TestSomething_with_a_subtest.txt")
+		assert.Contains(t, out.String(), "the narrative of the test was written in "+path)
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, `Scenario: TestSomething/with a subtest
+  Given the 'base' tier
+  When the user 'johnsmith' signs up
+  And the UserSignup 'johnsmith' is approved by an admin
+  Then the Space 'johnsmith' is ready
+  And the MasterUserRecord 'johnsmith' is provisioned
+  Finally the UserSignup 'johnsmith' and its resources are deleted
+`, string(content))
+	})
+
+	t.Run("logged with the failure", func(t *testing.T) {
+		// given
+		out := &bytes.Buffer{}
+
+		// when
+		passed := wait.RunStandalone("TestSomething", out, func(st wait.T) {
+			a := a.WithNarrative(wait.NewNarrative(st, ""))
+			a.Narrative().When("the user '%s' signs up", "johnsmith")
+			st.Errorf("the space is not ready")
+		})
+
+		// then
+		require.False(t, passed)
+		assert.Contains(t, out.String(), `Scenario: TestSomething
+  When the user 'johnsmith' signs up
+  Finally the test failed
+`)
+	})
+
+	t.Run("not recorded when disabled", func(t *testing.T) {
+		// when
+		a.Narrative().When("the user '%s' signs up", "johnsmith")
+
+		// then
+		assert.Nil(t, a.Narrative())
+		assert.Empty(t, a.Narrative().Steps())
+		assert.Empty(t, a.Narrative().String())
+	})
+}
@@ -0,0 +1,49 @@
+package wait
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NeverAppears checks that the object of type `T` with the given key does not exist, and is not created during the given window, eg:
+//
+//	err := wait.NeverAppears[*toolchainv1alpha1.Notification](t, hostAwait.Awaitility, types.NamespacedName{Namespace: ns, Name: name}, 5*time.Second)
+//	require.NoError(t, err)
+//
+// Unlike a sleep followed by a single check, the object is looked up at the retry interval of the Awaitility during the whole window,
+// so that the check fails as soon as the object appears. The window is not overridden by Within, but it is shortened if the test
+// would be over before its end.
+// Returns an error with the state of the object if it appeared, or the error of the wait if it did not last the whole window
+// (ie, if it was shortened to not outlive the test, or if the context of the Awaitility is done).
+func NeverAppears[T client.Object](t testingT, a *Awaitility, key types.NamespacedName, during time.Duration) error {
+	recordWaiter(t)
+	kind := objectKind[T]()
+	a.logf(t, "checking that %s '%s' does not appear within %s", kind, key.String(), during)
+	err := a.pollWithin(t, a.RetryInterval, during, func() (done bool, err error) {
+		obj := newObject[T]()
+		if err := a.Client.Get(a.getContext(), key, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		y, _ := StringifyObject(obj)
+		return false, fmt.Errorf("%s '%s' should not be present, but it is:\n%s", kind, key.String(), y)
+	})
+	if a.windowElapsed(err) {
+		// the object did not appear during the whole window
+		return nil
+	}
+	return err
+}
+
+// windowElapsed returns `true` if the given error is returned by a wait which timed out after its whole window, as opposed to a wait
+// which gave up before, because the deadline of the test is imminent or because the context of the Awaitility is done
+func (a *Awaitility) windowElapsed(err error) bool {
+	return IsTimeout(err) && !errors.Is(err, ErrTestDeadlineImminent) && (a.ctx == nil || a.ctx.Err() == nil)
+}
@@ -0,0 +1,121 @@
+package wait_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestNeverAppears(t *testing.T) {
+	// given
+	key := types.NamespacedName{Namespace: "toolchain-host-operator", Name: "johnsmith-provisioned"}
+	newConfigMap := func() *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: key.Namespace,
+				Name:      key.Name,
+			},
+		}
+	}
+	newAwaitility := func(cl *test.FakeClient) *wait.Awaitility {
+		return &wait.Awaitility{
+			Client:        cl,
+			Namespace:     key.Namespace,
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       time.Minute,
+		}
+	}
+
+	t.Run("never appears", func(t *testing.T) {
+		// given
+		a := newAwaitility(test.NewFakeClient(t))
+		start := time.Now()
+
+		// when
+		err := wait.NeverAppears[*corev1.ConfigMap](t, a, key, 100*time.Millisecond)
+
+		// then
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("already present", func(t *testing.T) {
+		// given
+		a := newAwaitility(test.NewFakeClient(t, newConfigMap()))
+
+		// when
+		err := wait.NeverAppears[*corev1.ConfigMap](t, a, key, time.Minute)
+
+		// then
+		require.Error(t, err)
+		assert.False(t, wait.IsTimeout(err))
+		assert.Contains(t, err.Error(), "ConfigMap 'toolchain-host-operator/johnsmith-provisioned' should not be present, but it is")
+	})
+
+	t.Run("appears during the window", func(t *testing.T) {
+		// given
+		cl := test.NewFakeClient(t)
+		a := newAwaitility(cl)
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			_ = cl.Create(context.TODO(), newConfigMap())
+		}()
+		start := time.Now()
+
+		// when
+		err := wait.NeverAppears[*corev1.ConfigMap](t, a, key, time.Minute)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "should not be present, but it is")
+		assert.Less(t, time.Since(start), time.Minute)
+	})
+
+	t.Run("context done before the end of the window", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		a := newAwaitility(test.NewFakeClient(t)).WithContext(ctx)
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			cancel()
+		}()
+		start := time.Now()
+
+		// when
+		err := wait.NeverAppears[*corev1.ConfigMap](t, a, key, time.Minute)
+
+		// then
+		require.Error(t, err)
+		assert.Less(t, time.Since(start), time.Minute)
+	})
+
+	t.Run("test deadline imminent before the end of the window", func(t *testing.T) {
+		// given
+		a := newAwaitility(test.NewFakeClient(t))
+
+		// when
+		err := wait.NeverAppears[*corev1.ConfigMap](withDeadline{T: t, deadline: time.Now().Add(wait.TestDeadlineMargin + 100*time.Millisecond)}, a, key, time.Minute)
+
+		// then
+		require.ErrorIs(t, err, wait.ErrTestDeadlineImminent)
+	})
+}
+
+// withDeadline a test whose deadline is overridden
+type withDeadline struct {
+	*testing.T
+	deadline time.Time
+}
+
+func (t withDeadline) Deadline() (time.Time, bool) {
+	return t.deadline, true
+}
@@ -0,0 +1,198 @@
+package wait
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Criterion a struct to compare with a given object of type `T`. The Diff func is optional.
+type Criterion[T client.Object] struct {
+	Match func(T) bool
+	Diff  func(T) string
+}
+
+// diff returns the diff of the criterion with the given index for the given object, or a generic message if the criterion has no Diff func
+func (c Criterion[T]) diff(actual T, index int) string {
+	if c.Diff == nil {
+		return fmt.Sprintf("criterion #%d did not match", index+1)
+	}
+	return c.Diff(actual)
+}
+
+// WaitForObject waits until the object of type `T` with the given key exists and matches all the given criteria,
+// using the retry interval and timeout of the given Awaitility. If the wait times out, the returned ErrTimeout contains
+// the last observed state of the object along with the diffs (see LastState). This can be used for the objects which don't have
+// a dedicated `WaitForXxx` function, eg:
+//
+//	cm, err := wait.WaitForObject(t, memberAwait.Awaitility, types.NamespacedName{Namespace: ns, Name: "my-config"},
+//		wait.UntilObjectLabeled[*corev1.ConfigMap]("app", "my-app"))
+func WaitForObject[T client.Object](t testingT, a *Awaitility, key types.NamespacedName, criteria ...Criterion[T]) (T, error) {
+	recordWaiter(t)
+	kind := objectKind[T]()
+	a.logf(t, "waiting for %s '%s' to match criteria", kind, key.String())
+	var result T
+	found := false
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		obj := newObject[T]()
+		if err := a.Client.Get(a.getContext(), key, obj); err != nil {
+			if errors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		result = obj
+		found = true
+		return matchCriteria(obj, criteria...), nil
+	})
+	// no match found, print the diffs
+	if err != nil {
+		buf := &strings.Builder{}
+		if !found {
+			buf.WriteString(fmt.Sprintf("failed to find %s '%s'\n", kind, key.String()))
+		} else {
+			buf.WriteString(fmt.Sprintf("failed to find %s with matching criteria:\n", kind))
+			buf.WriteString("----\n")
+			buf.WriteString("actual:\n")
+			y, _ := StringifyObject(result)
+			buf.Write(y)
+			buf.WriteString("\n----\n")
+			buf.WriteString("diffs:\n")
+			for i, c := range criteria {
+				if !c.Match(result) {
+					buf.WriteString(c.diff(result, i))
+					buf.WriteString("\n")
+				}
+			}
+		}
+		a.log(t, buf.String())
+		err = withLastState(err, buf.String())
+	}
+	return result, err
+}
+
+func matchCriteria[T client.Object](actual T, criteria ...Criterion[T]) bool {
+	for _, c := range criteria {
+		// if at least one criteria does not match, keep waiting
+		if !c.Match(actual) {
+			return false
+		}
+	}
+	return true
+}
+
+// newObject returns a new, empty object of type `T` (which must be a pointer to a struct, eg: `*corev1.ConfigMap`)
+func newObject[T client.Object]() T {
+	var zero T
+	return reflect.New(reflect.TypeOf(zero).Elem()).Interface().(T)
+}
+
+func objectKind[T client.Object]() string {
+	var zero T
+	return reflect.TypeOf(zero).Elem().Name()
+}
+
+// UntilObjectLabeled returns a `Criterion` which checks that the object has the given label with the given value
+func UntilObjectLabeled[T client.Object](key, value string) Criterion[T] {
+	return Criterion[T]{
+		Match: func(actual T) bool {
+			v, found := actual.GetLabels()[key]
+			return found && v == value
+		},
+		Diff: func(actual T) string {
+			return fmt.Sprintf("expected label '%s' to be '%s'. Actual labels: %v", key, value, actual.GetLabels())
+		},
+	}
+}
+
+// UntilObjectHasAnnotation returns a `Criterion` which checks that the object has the given annotation with the given value
+func UntilObjectHasAnnotation[T client.Object](key, value string) Criterion[T] {
+	return Criterion[T]{
+		Match: func(actual T) bool {
+			v, found := actual.GetAnnotations()[key]
+			return found && v == value
+		},
+		Diff: func(actual T) string {
+			return fmt.Sprintf("expected annotation '%s' to be '%s'. Actual annotations: %v", key, value, actual.GetAnnotations())
+		},
+	}
+}
+
+// UntilObjectMatches returns a `Criterion` which checks that the object matches the given predicate, described
+// by the given message in the diff
+func UntilObjectMatches[T client.Object](description string, predicate func(T) bool) Criterion[T] {
+	return Criterion[T]{
+		Match: predicate,
+		Diff: func(actual T) string {
+			return fmt.Sprintf("expected %s '%s' to match: %s", objectKind[T](), actual.GetName(), description)
+		},
+	}
+}
+
+// UntilHasConditions returns a `Criterion` which checks that the toolchain CR of type `T` (eg: `*toolchainv1alpha1.Space`) has
+// all the given conditions in its `Status.Conditions`, along with any other condition, eg:
+//
+//	wait.UntilHasConditions[*toolchainv1alpha1.MemberStatus](wait.Ready())
+//
+// The reason and the message of an expected condition match the actual ones which contain them, and an empty reason or message
+// matches any actual one.
+func UntilHasConditions[T client.Object](expected ...toolchainv1alpha1.Condition) Criterion[T] {
+	return Criterion[T]{
+		Match: func(actual T) bool {
+			conditions, found := statusConditions(actual)
+			if !found {
+				return false
+			}
+			for _, e := range expected {
+				if !containsCondition(conditions, e) {
+					return false
+				}
+			}
+			return true
+		},
+		Diff: func(actual T) string {
+			conditions, found := statusConditions(actual)
+			if !found {
+				return fmt.Sprintf("expected %s '%s' to have status conditions, but it has none", objectKind[T](), actual.GetName())
+			}
+			return fmt.Sprintf("expected conditions to contain:\n%s", Diff(expected, conditions))
+		},
+	}
+}
+
+// containsCondition returns `true` if the given conditions contain one with the type and the status of the expected condition,
+// and whose reason and message contain the expected ones
+func containsCondition(conditions []toolchainv1alpha1.Condition, expected toolchainv1alpha1.Condition) bool {
+	for _, c := range conditions {
+		if c.Type == expected.Type && c.Status == expected.Status &&
+			strings.Contains(c.Reason, expected.Reason) && strings.Contains(c.Message, expected.Message) {
+			return true
+		}
+	}
+	return false
+}
+
+var conditionsType = reflect.TypeOf([]toolchainv1alpha1.Condition{})
+
+// statusConditions returns the `Status.Conditions` of the given object, or `false` if the object has no such field
+func statusConditions(obj client.Object) ([]toolchainv1alpha1.Condition, bool) {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, false
+	}
+	status := v.Elem().FieldByName("Status")
+	if !status.IsValid() || status.Kind() != reflect.Struct {
+		return nil, false
+	}
+	conditions := status.FieldByName("Conditions")
+	if !conditions.IsValid() || conditions.Type() != conditionsType {
+		return nil, false
+	}
+	return conditions.Interface().([]toolchainv1alpha1.Condition), true
+}
@@ -0,0 +1,167 @@
+package wait_test
+
+import (
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestWaitForObject(t *testing.T) {
+	// given
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "config",
+			Labels: map[string]string{
+				"app": "e2e",
+			},
+		},
+		Data: map[string]string{
+			"key": "value",
+		},
+	}
+	a := &wait.Awaitility{
+		Client:        test.NewFakeClient(t, cm),
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       100 * time.Millisecond,
+	}
+
+	t.Run("match", func(t *testing.T) {
+		// when
+		actual, err := wait.WaitForObject(t, a, types.NamespacedName{Namespace: "test", Name: "config"},
+			wait.UntilObjectLabeled[*corev1.ConfigMap]("app", "e2e"),
+			wait.UntilObjectMatches("data has key", func(actual *corev1.ConfigMap) bool {
+				return actual.Data["key"] == "value"
+			}))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "config", actual.Name)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		// when
+		_, err := wait.WaitForObject(t, a, types.NamespacedName{Namespace: "test", Name: "config"},
+			wait.UntilObjectHasAnnotation[*corev1.ConfigMap]("owner", "someone"))
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+		assert.Contains(t, wait.LastState(err), "expected annotation 'owner' to be 'someone'. Actual annotations: map[]")
+	})
+
+	t.Run("no match with a criterion without diff", func(t *testing.T) {
+		// when
+		_, err := wait.WaitForObject(t, a, types.NamespacedName{Namespace: "test", Name: "config"},
+			wait.UntilObjectLabeled[*corev1.ConfigMap]("app", "e2e"),
+			wait.Criterion[*corev1.ConfigMap]{
+				Match: func(actual *corev1.ConfigMap) bool {
+					return actual.Data["key"] == "other"
+				},
+			})
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+		assert.Contains(t, wait.LastState(err), "criterion #2 did not match")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// when
+		actual, err := wait.WaitForObject[*corev1.ConfigMap](t, a, types.NamespacedName{Namespace: "test", Name: "unknown"})
+
+		// then
+		require.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestUntilHasConditions(t *testing.T) {
+	// given
+	space := &toolchainv1alpha1.Space{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "space",
+		},
+		Status: toolchainv1alpha1.SpaceStatus{
+			Conditions: []toolchainv1alpha1.Condition{
+				{
+					Type:    toolchainv1alpha1.ConditionReady,
+					Status:  corev1.ConditionTrue,
+					Reason:  "Provisioned",
+					Message: "the space is provisioned on member-cluster",
+				},
+				{
+					Type:   "Other",
+					Status: corev1.ConditionFalse,
+				},
+			},
+		},
+	}
+
+	t.Run("match", func(t *testing.T) {
+		for name, expected := range map[string][]toolchainv1alpha1.Condition{
+			"ready":                 {wait.Ready()},
+			"provisioned":           {wait.Provisioned()},
+			"message substring":     {{Type: toolchainv1alpha1.ConditionReady, Status: corev1.ConditionTrue, Message: "member-cluster"}},
+			"multiple":              {wait.Provisioned(), {Type: "Other", Status: corev1.ConditionFalse}},
+			"no expected condition": {},
+		} {
+			t.Run(name, func(t *testing.T) {
+				assert.True(t, wait.UntilHasConditions[*toolchainv1alpha1.Space](expected...).Match(space))
+			})
+		}
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		for name, expected := range map[string]toolchainv1alpha1.Condition{
+			"status":  {Type: toolchainv1alpha1.ConditionReady, Status: corev1.ConditionFalse},
+			"reason":  {Type: toolchainv1alpha1.ConditionReady, Status: corev1.ConditionTrue, Reason: "Terminating"},
+			"message": {Type: toolchainv1alpha1.ConditionReady, Status: corev1.ConditionTrue, Message: "member2-cluster"},
+			"type":    {Type: "Unknown", Status: corev1.ConditionTrue},
+		} {
+			t.Run(name, func(t *testing.T) {
+				criterion := wait.UntilHasConditions[*toolchainv1alpha1.Space](expected)
+				assert.False(t, criterion.Match(space))
+				assert.Contains(t, criterion.Diff(space), "expected conditions to contain:")
+			})
+		}
+	})
+
+	t.Run("no status conditions", func(t *testing.T) {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "config",
+			},
+		}
+		criterion := wait.UntilHasConditions[*corev1.ConfigMap](wait.Ready())
+		assert.False(t, criterion.Match(cm))
+		assert.Equal(t, "expected ConfigMap 'config' to have status conditions, but it has none", criterion.Diff(cm))
+	})
+
+	t.Run("wait for object", func(t *testing.T) {
+		// given
+		a := &wait.Awaitility{
+			Client:        test.NewFakeClient(t, space),
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+
+		// when
+		actual, err := wait.WaitForObject(t, a, types.NamespacedName{Namespace: "test", Name: "space"},
+			wait.UntilHasConditions[*toolchainv1alpha1.Space](wait.Provisioned()))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "space", actual.Name)
+	})
+}
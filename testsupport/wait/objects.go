@@ -0,0 +1,63 @@
+package wait
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WaitForObjectsMatching waits until exactly `count` objects of type `T` among those returned by the given selector (eg: `client.MatchingLabels`,
+// `client.MatchingFields`, or `&client.ListOptions{...}` to combine them with a namespace) match all the given criteria, eg:
+//
+//	pods, err := wait.WaitForObjectsMatching(t, memberAwait.Awaitility, &corev1.PodList{}, client.MatchingLabels{"app": "member-operator-webhook"}, 1,
+//		wait.UntilObjectMatches("is running", func(p *corev1.Pod) bool { return p.Status.Phase == corev1.PodRunning }))
+//
+// The given list (eg: `&corev1.PodList{}`) must hold objects of type `T`. The selected objects which don't match the criteria are ignored
+// (eg: the pods of a previous rollout which are still terminating). Returns the matching objects.
+// Note that the field selectors are only supported by the API server for some fields of some kinds of objects (eg: `metadata.name` or
+// `status.phase` for the pods).
+func WaitForObjectsMatching[T client.Object](t testingT, a *Awaitility, list client.ObjectList, selector client.ListOption, count int, criteria ...Criterion[T]) ([]T, error) {
+	recordWaiter(t)
+	kind := objectKind[T]()
+	a.logf(t, "waiting for %d %s(s) selected with %v to match criteria", count, kind, selector)
+	var selected, matching []T
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		if err := a.Client.List(a.getContext(), list, selector); err != nil {
+			return false, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return false, err
+		}
+		selected = make([]T, 0, len(items))
+		matching = make([]T, 0, len(items))
+		for _, item := range items {
+			obj, ok := item.(T)
+			if !ok {
+				return false, fmt.Errorf("unexpected item of type %T in %T (expected %s)", item, list, kind)
+			}
+			selected = append(selected, obj)
+			if matchCriteria(obj, criteria...) {
+				matching = append(matching, obj)
+			}
+		}
+		return len(matching) == count, nil
+	})
+	if err != nil {
+		buf := &strings.Builder{}
+		buf.WriteString(fmt.Sprintf("expected %d %s(s) selected with %v to match criteria, but found %d out of %d selected object(s)\n", count, kind, selector, len(matching), len(selected)))
+		for _, obj := range selected {
+			for i, c := range criteria {
+				if !c.Match(obj) {
+					buf.WriteString(c.diff(obj, i))
+					buf.WriteString("\n")
+				}
+			}
+		}
+		a.log(t, buf.String())
+		err = withLastState(err, buf.String())
+	}
+	return matching, err
+}
@@ -0,0 +1,87 @@
+package wait_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestWaitForObjectsMatching(t *testing.T) {
+	// given
+	newNamespace := func(name, space string, phase corev1.NamespacePhase) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					"toolchain.dev.openshift.com/space": space,
+				},
+			},
+			Status: corev1.NamespaceStatus{
+				Phase: phase,
+			},
+		}
+	}
+	a := &wait.Awaitility{
+		Client: test.NewFakeClient(t,
+			newNamespace("johnsmith-dev", "johnsmith", corev1.NamespaceActive),
+			newNamespace("johnsmith-stage", "johnsmith", corev1.NamespaceActive),
+			newNamespace("johnsmith-old", "johnsmith", corev1.NamespaceTerminating),
+			newNamespace("jane-dev", "jane", corev1.NamespaceActive)),
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       100 * time.Millisecond,
+	}
+	selector := client.MatchingLabels{"toolchain.dev.openshift.com/space": "johnsmith"}
+	active := wait.UntilObjectMatches("is active", func(ns *corev1.Namespace) bool {
+		return ns.Status.Phase == corev1.NamespaceActive
+	})
+
+	t.Run("match", func(t *testing.T) {
+		// when
+		actual, err := wait.WaitForObjectsMatching(t, a, &corev1.NamespaceList{}, selector, 2, active)
+
+		// then
+		require.NoError(t, err)
+		names := make([]string, 0, len(actual))
+		for _, ns := range actual {
+			names = append(names, ns.Name)
+		}
+		assert.ElementsMatch(t, []string{"johnsmith-dev", "johnsmith-stage"}, names)
+	})
+
+	t.Run("match without criteria", func(t *testing.T) {
+		// when
+		actual, err := wait.WaitForObjectsMatching[*corev1.Namespace](t, a, &corev1.NamespaceList{}, selector, 3)
+
+		// then
+		require.NoError(t, err)
+		assert.Len(t, actual, 3)
+	})
+
+	t.Run("too many objects match", func(t *testing.T) {
+		// when
+		_, err := wait.WaitForObjectsMatching(t, a, &corev1.NamespaceList{}, selector, 1, active)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+		assert.Contains(t, wait.LastState(err), "expected 1 Namespace(s) selected with map[toolchain.dev.openshift.com/space:johnsmith] to match criteria, but found 2 out of 3 selected object(s)")
+		assert.Contains(t, wait.LastState(err), "expected Namespace 'johnsmith-old' to match: is active")
+	})
+
+	t.Run("no object selected", func(t *testing.T) {
+		// when
+		_, err := wait.WaitForObjectsMatching(t, a, &corev1.NamespaceList{}, client.MatchingLabels{"toolchain.dev.openshift.com/space": "unknown"}, 1, active)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, wait.LastState(err), "but found 0 out of 0 selected object(s)")
+	})
+}
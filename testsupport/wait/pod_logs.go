@@ -0,0 +1,211 @@
+package wait
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// logTailLines the number of lines of the logs of each pod which are logged when a log wait fails
+const logTailLines = 20
+
+// operatorPodLabels the labels of the pods of the operators
+var operatorPodLabels = client.MatchingLabels{"control-plane": "controller-manager"}
+
+// WithClientset returns an option to retrieve the logs of the pods with the given clientset, instead of a clientset created from
+// the RestConfig of the Awaitility on each call
+func WithClientset(clientset kubernetes.Interface) RetryOption {
+	return clientsetOption{clientset: clientset}
+}
+
+type clientsetOption struct {
+	clientset kubernetes.Interface
+}
+
+var _ RetryOption = clientsetOption{}
+
+func (o clientsetOption) apply(a *Awaitility) {
+	a.clientset = o.clientset
+}
+
+func (a *Awaitility) kubernetesClientset() (kubernetes.Interface, error) {
+	if a.clientset != nil {
+		return a.clientset, nil
+	}
+	return kubernetes.NewForConfig(a.RestConfig)
+}
+
+// GetPodLogs returns the logs of the given container (or of the default container of each pod if empty) of the pods matching
+// the given labels in the current namespace, indexed by pod name. Only the logs of the last `since` are returned (all the logs if 0), eg:
+//
+//	logs := hostAwait.GetPodLogs(t, client.MatchingLabels{"control-plane": "controller-manager"}, "manager", time.Minute)
+func (a *Awaitility) GetPodLogs(t T, selector client.MatchingLabels, container string, since time.Duration) map[string]string {
+	logs, err := a.podLogs(selector, &corev1.PodLogOptions{
+		Container:    container,
+		SinceSeconds: sinceSeconds(since),
+	})
+	require.NoError(t, err)
+	return logs
+}
+
+// WaitForLogLine waits until one of the pods matching the given labels in the current namespace logs a line which matches the given
+// regexp in its default container (eg: a warning or an error logged by the operator while reconciling a resource).
+// The lines logged before the call are also considered. Returns the first matching line, or an error along with the last lines
+// logged by each pod if none matched.
+func (a *Awaitility) WaitForLogLine(t T, selector client.MatchingLabels, re *regexp.Regexp) (string, error) {
+	recordWaiter(t)
+	a.logf(t, "waiting until a pod with labels %v in namespace '%s' logs a line matching '%s'", map[string]string(selector), a.Namespace, re.String())
+	var line string
+	var logs map[string]string
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		if logs, err = a.podLogs(selector, &corev1.PodLogOptions{}); err != nil {
+			return false, err
+		}
+		line = findLogLine(logs, re)
+		return line != "", nil
+	})
+	if err != nil {
+		a.logf(t, "no line matching '%s' was logged by the pods with labels %v:\n%s", re.String(), map[string]string(selector), tailLogs(logs, logTailLines))
+		return "", err
+	}
+	return line, nil
+}
+
+// WaitForNoLogLine checks that none of the pods matching the given labels in the current namespace logs a line which matches the given
+// regexp in its default container during the given window (the lines logged before the call are also considered). As with NeverAppears,
+// the window is not overridden by Within.
+// Returns an error with the matching line if it was logged, or if the wait did not last the whole window (see NeverAppears).
+func (a *Awaitility) WaitForNoLogLine(t T, selector client.MatchingLabels, re *regexp.Regexp, during time.Duration) error {
+	recordWaiter(t)
+	a.logf(t, "checking that no pod with labels %v in namespace '%s' logs a line matching '%s' within %s", map[string]string(selector), a.Namespace, re.String(), during)
+	err := a.pollWithin(t, a.RetryInterval, during, func() (done bool, err error) {
+		logs, err := a.podLogs(selector, &corev1.PodLogOptions{})
+		if err != nil {
+			return false, err
+		}
+		if line := findLogLine(logs, re); line != "" {
+			return false, fmt.Errorf("a pod with labels %v in namespace '%s' logged a line matching '%s': %s", map[string]string(selector), a.Namespace, re.String(), line)
+		}
+		return false, nil
+	})
+	if a.windowElapsed(err) {
+		// no matching line was logged during the whole window
+		return nil
+	}
+	return err
+}
+
+// AttachOperatorLogsOnFailure writes the logs of the operator pods in the current namespace since the beginning of the test into files
+// of the given dir (or of the temp dir if empty, eg: when the `ARTIFACT_DIR` is not set) if the test failed, so that the failure can be
+// analyzed along with what the operator was doing at the same time. The name of each file contains the name of the test, the cluster
+// and the name of the pod.
+func (a *Awaitility) AttachOperatorLogsOnFailure(t T, dir string) {
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	start := metav1.NewTime(a.getClock().Now())
+	t.Cleanup(func() {
+		if f, ok := t.(interface{ Failed() bool }); !ok || !f.Failed() {
+			return
+		}
+		logs, err := a.podLogs(operatorPodLabels, &corev1.PodLogOptions{SinceTime: &start})
+		if err != nil {
+			a.logf(t, "unable to attach the logs of the operator: %s", err.Error())
+			return
+		}
+		for pod, l := range logs {
+			name := fmt.Sprintf("logs-%s-%s-%s.txt", strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()), a.LogLabel(), pod)
+			path := filepath.Join(dir, name)
+			if err := os.WriteFile(path, []byte(l), 0600); err != nil {
+				a.logf(t, "unable to attach the logs of the operator: %s", err.Error())
+				continue
+			}
+			a.logf(t, "the logs of the operator pod '%s' were written in %s", pod, path)
+		}
+	})
+}
+
+// AttachOperatorLogsOnFailure writes the logs of the host and all the member operators into files of the given dir if the test failed
+// (see Awaitility.AttachOperatorLogsOnFailure)
+func (a Awaitilities) AttachOperatorLogsOnFailure(t T, dir string) {
+	a.hostAwaitility.AttachOperatorLogsOnFailure(t, dir)
+	for _, m := range a.memberAwaitilities {
+		m.AttachOperatorLogsOnFailure(t, dir)
+	}
+}
+
+// podLogs returns the logs of the pods matching the given labels in the current namespace, with the given options, indexed by pod name
+func (a *Awaitility) podLogs(selector client.MatchingLabels, options *corev1.PodLogOptions) (map[string]string, error) {
+	pods := &corev1.PodList{}
+	if err := a.Client.List(a.getContext(), pods, client.InNamespace(a.Namespace), selector); err != nil {
+		return nil, err
+	}
+	if len(pods.Items) == 0 {
+		return nil, fmt.Errorf("no pod found with labels %v in namespace '%s'", map[string]string(selector), a.Namespace)
+	}
+	clientset, err := a.kubernetesClientset()
+	if err != nil {
+		return nil, err
+	}
+	logs := make(map[string]string, len(pods.Items))
+	for _, pod := range pods.Items {
+		body, err := clientset.CoreV1().Pods(a.Namespace).GetLogs(pod.Name, options).DoRaw(a.getContext())
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the logs of pod '%s': %w", pod.Name, err)
+		}
+		logs[pod.Name] = string(body)
+	}
+	return logs, nil
+}
+
+func sinceSeconds(since time.Duration) *int64 {
+	if since <= 0 {
+		return nil
+	}
+	seconds := int64((since + time.Second - 1) / time.Second)
+	return &seconds
+}
+
+// findLogLine returns the first line of the given logs which matches the given regexp (the pods are searched by name), or an empty string
+func findLogLine(logs map[string]string, re *regexp.Regexp) string {
+	for _, pod := range sortedKeys(logs) {
+		for _, line := range strings.Split(logs[pod], "\n") {
+			if re.MatchString(line) {
+				return line
+			}
+		}
+	}
+	return ""
+}
+
+// tailLogs returns the last lines of the given logs of each pod
+func tailLogs(logs map[string]string, n int) string {
+	buf := &strings.Builder{}
+	for _, pod := range sortedKeys(logs) {
+		lines := strings.Split(strings.TrimRight(logs[pod], "\n"), "\n")
+		if len(lines) > n {
+			lines = lines[len(lines)-n:]
+		}
+		fmt.Fprintf(buf, "--- pod '%s' ---\n%s\n", pod, strings.Join(lines, "\n"))
+	}
+	return buf.String()
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
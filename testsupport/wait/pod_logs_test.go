@@ -0,0 +1,137 @@
+package wait_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/cluster"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// the logs of all the pods are `fake logs` with the fake clientset
+func TestPodLogs(t *testing.T) {
+	selector := client.MatchingLabels{"control-plane": "controller-manager"}
+	newAwaitility := func(t *testing.T) *wait.Awaitility {
+		pod := newWorkloadPod("host-operator-1", "host-operator", true)
+		pod.Labels["control-plane"] = "controller-manager"
+		a := newWorkloadsAwaitility(t, pod).WithRetryOptions(wait.WithClientset(k8sfake.NewSimpleClientset(pod)))
+		a.Type = cluster.Host
+		return a
+	}
+
+	t.Run("get logs", func(t *testing.T) {
+		// when
+		logs := newAwaitility(t).GetPodLogs(t, selector, "manager", 0)
+
+		// then
+		assert.Equal(t, map[string]string{"host-operator-1": "fake logs"}, logs)
+	})
+
+	t.Run("wait for log line", func(t *testing.T) {
+		t.Run("logged", func(t *testing.T) {
+			// when
+			line, err := newAwaitility(t).WaitForLogLine(t, selector, regexp.MustCompile("^fake"))
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, "fake logs", line)
+		})
+
+		t.Run("not logged", func(t *testing.T) {
+			// given
+			a := newAwaitility(t)
+			out := &strings.Builder{}
+			var err error
+
+			// when
+			wait.RunStandalone(t.Name(), out, func(st wait.T) {
+				_, err = a.WaitForLogLine(st, selector, regexp.MustCompile("ERROR"))
+			})
+
+			// then
+			require.Error(t, err)
+			assert.True(t, wait.IsTimeout(err))
+			assert.Contains(t, out.String(), "--- pod 'host-operator-1' ---\nfake logs")
+		})
+
+		t.Run("no pod", func(t *testing.T) {
+			// when
+			_, err := newAwaitility(t).WaitForLogLine(t, client.MatchingLabels{"app": "unknown"}, regexp.MustCompile("ERROR"))
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "no pod found with labels map[app:unknown] in namespace 'toolchain-host-operator'")
+		})
+	})
+
+	t.Run("wait for no log line", func(t *testing.T) {
+		t.Run("not logged", func(t *testing.T) {
+			// when
+			err := newAwaitility(t).WaitForNoLogLine(t, selector, regexp.MustCompile("ERROR"), 50*time.Millisecond)
+
+			// then
+			require.NoError(t, err)
+		})
+
+		t.Run("logged", func(t *testing.T) {
+			// when
+			err := newAwaitility(t).WaitForNoLogLine(t, selector, regexp.MustCompile("logs$"), 50*time.Millisecond)
+
+			// then
+			require.EqualError(t, err, "a pod with labels map[control-plane:controller-manager] in namespace 'toolchain-host-operator' logged a line matching 'logs$': fake logs")
+		})
+
+		t.Run("context done before the end of the window", func(t *testing.T) {
+			// given
+			ctx, cancel := context.WithCancel(context.Background())
+			a := newAwaitility(t).WithContext(ctx)
+			time.AfterFunc(30*time.Millisecond, cancel)
+
+			// when
+			err := a.WaitForNoLogLine(t, selector, regexp.MustCompile("ERROR"), time.Minute)
+
+			// then
+			require.Error(t, err) // the logs were not checked during the whole window
+		})
+	})
+
+	t.Run("attach operator logs on failure", func(t *testing.T) {
+		for name, failed := range map[string]bool{
+			"failed": true,
+			"passed": false,
+		} {
+			t.Run(name, func(t *testing.T) {
+				// given
+				a := newAwaitility(t)
+				dir := t.TempDir()
+
+				// when
+				wait.RunStandalone("TestSignup/approved", &strings.Builder{}, func(st wait.T) {
+					a.AttachOperatorLogsOnFailure(st, dir)
+					if failed {
+						st.Errorf("the signup was not approved")
+					}
+				})
+
+				// then
+				content, err := os.ReadFile(filepath.Join(dir, "logs-TestSignup_approved-host-host-operator-1.txt"))
+				if failed {
+					require.NoError(t, err)
+					assert.Equal(t, "fake logs", string(content))
+				} else {
+					assert.True(t, os.IsNotExist(err))
+				}
+			})
+		}
+	})
+}
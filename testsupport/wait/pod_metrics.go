@@ -0,0 +1,80 @@
+package wait
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PodMetricsAPIService the name of the APIService of the `metrics.k8s.io` API, which is served by the metrics-server
+const PodMetricsAPIService = "v1beta1.metrics.k8s.io"
+
+// APIServiceGVK the GroupVersionKind of the APIServices of the aggregation layer (whose API types are not vendored)
+var APIServiceGVK = schema.GroupVersionKind{Group: "apiregistration.k8s.io", Version: "v1", Kind: "APIService"}
+
+// ErrPodMetricsUnavailable the error returned when the memory usage of a pod can't be retrieved, neither from the `metrics.k8s.io` API
+// nor from Prometheus
+var ErrPodMetricsUnavailable = errors.New("pod metrics unavailable")
+
+// CheckPodMetricsAvailability verifies that the `metrics.k8s.io` API is served on the cluster of the Awaitility, ie: that its APIService
+// exists and is available. Otherwise, the returned error explains why it isn't, so that the callers don't wait for the pod metrics
+// until their timeout.
+func (a *Awaitility) CheckPodMetricsAvailability() error {
+	apiService := &unstructured.Unstructured{}
+	apiService.SetGroupVersionKind(APIServiceGVK)
+	if err := a.Client.Get(a.getContext(), types.NamespacedName{Name: PodMetricsAPIService}, apiService); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("APIService '%s' not found on the '%s' cluster: the metrics-server is not deployed", PodMetricsAPIService, a.LogLabel())
+		}
+		return err
+	}
+	// the conditions may be `null` when the APIService has no status yet
+	value, _, _ := unstructured.NestedFieldNoCopy(apiService.Object, "status", "conditions")
+	conditions, _ := value.([]interface{})
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != "Available" {
+			continue
+		}
+		if condition["status"] == "True" {
+			return nil
+		}
+		return fmt.Errorf("APIService '%s' is not available on the '%s' cluster: %v: %v", PodMetricsAPIService, a.LogLabel(), condition["reason"], condition["message"])
+	}
+	return fmt.Errorf("APIService '%s' has no 'Available' condition on the '%s' cluster", PodMetricsAPIService, a.LogLabel())
+}
+
+// getMemoryUsageFromPrometheus retrieves the memory usage (in KB) of the `manager` container of the given pod from the
+// `container_memory_working_set_bytes` metric of the Prometheus instance of the OpenShift monitoring stack (see GetPrometheusURL),
+// which is what the metrics-server reports too
+func (a *Awaitility) getMemoryUsageFromPrometheus(podname, ns string) (int64, error) {
+	prometheusURL, err := a.GetPrometheusURL()
+	if err != nil {
+		return -1, fmt.Errorf("unable to find the Prometheus instance of the OpenShift monitoring stack: %w", err)
+	}
+	prometheus := metrics.NewPrometheusClient(prometheusURL, a.bearerToken, metrics.WithPrometheusTransport(a.newInsecureTransport()))
+	query := fmt.Sprintf(`container_memory_working_set_bytes{namespace=%q,pod=%q,container="manager"}`, ns, podname)
+	var usage int64
+	err = a.poll(nil, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		samples, err := prometheus.Query(query, time.Now())
+		if err != nil {
+			return false, err
+		}
+		if len(samples) == 0 {
+			// keep waiting until the metric of the pod is scraped
+			return false, nil
+		}
+		// rounded up, like the memory usage from the `metrics.k8s.io` API
+		usage = int64(math.Ceil(samples[0].Value / 1000))
+		return true, nil
+	})
+	return usage, err
+}
@@ -0,0 +1,103 @@
+package wait_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/cluster"
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCheckPodMetricsAvailability(t *testing.T) {
+
+	newAwaitility := func(t *testing.T, objs ...runtime.Object) *wait.Awaitility {
+		return &wait.Awaitility{
+			Client:        test.NewFakeClient(t, objs...),
+			ClusterName:   "member-cluster",
+			Type:          cluster.Member,
+			Namespace:     "toolchain-member-operator",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       time.Second,
+		}
+	}
+	newAPIService := func(conditions ...interface{}) *unstructured.Unstructured {
+		apiService := &unstructured.Unstructured{Object: map[string]interface{}{
+			"status": map[string]interface{}{
+				"conditions": conditions,
+			},
+		}}
+		apiService.SetGroupVersionKind(wait.APIServiceGVK)
+		apiService.SetName(wait.PodMetricsAPIService)
+		return apiService
+	}
+
+	t.Run("available", func(t *testing.T) {
+		// given
+		a := newAwaitility(t, newAPIService(map[string]interface{}{
+			"type":   "Available",
+			"status": "True",
+			"reason": "Passed",
+		}))
+
+		// when
+		err := a.CheckPodMetricsAvailability()
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("not available", func(t *testing.T) {
+		// given
+		a := newAwaitility(t, newAPIService(map[string]interface{}{
+			"type":    "Available",
+			"status":  "False",
+			"reason":  "MissingEndpoints",
+			"message": "endpoints for service/metrics-server in \"kube-system\" have no addresses",
+		}))
+
+		// when
+		err := a.CheckPodMetricsAvailability()
+
+		// then
+		require.EqualError(t, err, `APIService 'v1beta1.metrics.k8s.io' is not available on the 'member-cluster' cluster: MissingEndpoints: endpoints for service/metrics-server in "kube-system" have no addresses`)
+	})
+
+	t.Run("no condition", func(t *testing.T) {
+		// given
+		a := newAwaitility(t, newAPIService())
+
+		// when
+		err := a.CheckPodMetricsAvailability()
+
+		// then
+		require.EqualError(t, err, "APIService 'v1beta1.metrics.k8s.io' has no 'Available' condition on the 'member-cluster' cluster")
+	})
+
+	t.Run("metrics-server not deployed", func(t *testing.T) {
+		// given
+		a := newAwaitility(t)
+
+		// when
+		err := a.CheckPodMetricsAvailability()
+
+		// then
+		require.EqualError(t, err, "APIService 'v1beta1.metrics.k8s.io' not found on the 'member-cluster' cluster: the metrics-server is not deployed")
+
+		t.Run("memory usage unavailable without waiting", func(t *testing.T) {
+			// when
+			start := time.Now()
+			_, err := a.GetMemoryUsage("member-operator-controller-manager-abcde", "toolchain-member-operator")
+
+			// then
+			require.ErrorIs(t, err, wait.ErrPodMetricsUnavailable)
+			assert.Contains(t, err.Error(), "the metrics-server is not deployed, and the fallback to Prometheus failed: unable to find the Prometheus instance of the OpenShift monitoring stack")
+			assert.Less(t, time.Since(start), a.Timeout)
+		})
+	})
+}
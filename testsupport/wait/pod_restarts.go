@@ -0,0 +1,116 @@
+package wait
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// UntilPodHasRestartedTimes checks if the containers of the Pod have restarted at least the given number of times in total
+// (eg: to verify that a crash-looping pod is detected and handled)
+func UntilPodHasRestartedTimes(n int) PodWaitCriterion {
+	return PodWaitCriterion{
+		Match: func(actual *corev1.Pod) bool {
+			return podRestartCount(actual) >= n
+		},
+		Diff: func(actual *corev1.Pod) string {
+			return fmt.Sprintf("expected Pod to have restarted at least %d time(s)\nbut it restarted %d time(s): %s", n, podRestartCount(actual), describeRestarts(actual, nil))
+		},
+	}
+}
+
+// WaitForNoPodRestarts checks that the containers of the pods matching the given labels in the current namespace do not restart
+// during the given window, eg: to verify that the host operator did not crash while processing a burst of UserSignups:
+//
+//	err := hostAwait.WaitForNoPodRestarts(t, client.MatchingLabels{"control-plane": "controller-manager"}, 2*time.Minute)
+//	require.NoError(t, err)
+//
+// The restarts which occurred before the call are ignored, but a pod which is created during the window (eg: after a crash of its node)
+// must not have restarted at all. As with NeverAppears, the window is not overridden by Within.
+// Returns an error with the restarted containers and the reasons of their last termination, if no pod matches the given labels,
+// or if the wait did not last the whole window (see NeverAppears).
+func (a *Awaitility) WaitForNoPodRestarts(t T, selector client.MatchingLabels, during time.Duration) error {
+	recordWaiter(t)
+	a.logf(t, "checking that the pods with labels %v in namespace '%s' do not restart within %s", map[string]string(selector), a.Namespace, during)
+	pods := &corev1.PodList{}
+	if err := a.Client.List(a.getContext(), pods, client.InNamespace(a.Namespace), selector); err != nil {
+		return err
+	}
+	if len(pods.Items) == 0 {
+		return fmt.Errorf("no pod found with labels %v in namespace '%s'", map[string]string(selector), a.Namespace)
+	}
+	initial := map[types.UID]map[string]int32{}
+	for i := range pods.Items {
+		initial[pods.Items[i].UID] = containerRestartCounts(&pods.Items[i])
+	}
+	err := a.pollWithin(t, a.RetryInterval, during, func() (done bool, err error) {
+		pods := &corev1.PodList{}
+		if err := a.Client.List(a.getContext(), pods, client.InNamespace(a.Namespace), selector); err != nil {
+			return false, err
+		}
+		restarted := []string{}
+		for i := range pods.Items {
+			if msg := describeRestarts(&pods.Items[i], initial[pods.Items[i].UID]); msg != "" {
+				restarted = append(restarted, fmt.Sprintf("pod '%s': %s", pods.Items[i].Name, msg))
+			}
+		}
+		if len(restarted) > 0 {
+			return false, fmt.Errorf("pods with labels %v in namespace '%s' restarted:\n%s", map[string]string(selector), a.Namespace, strings.Join(restarted, "\n"))
+		}
+		return false, nil
+	})
+	if a.windowElapsed(err) {
+		// no pod restarted during the whole window
+		return nil
+	}
+	return err
+}
+
+func podRestartCount(pod *corev1.Pod) int {
+	count := 0
+	for _, restarts := range containerRestartCounts(pod) {
+		count += int(restarts)
+	}
+	return count
+}
+
+// containerRestartCounts returns the restart counts of the (init) containers of the given pod, indexed by container name
+func containerRestartCounts(pod *corev1.Pod) map[string]int32 {
+	counts := map[string]int32{}
+	for _, status := range containerStatuses(pod) {
+		counts[status.Name] = status.RestartCount
+	}
+	return counts
+}
+
+func containerStatuses(pod *corev1.Pod) []corev1.ContainerStatus {
+	statuses := make([]corev1.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	return append(statuses, pod.Status.ContainerStatuses...)
+}
+
+// describeRestarts returns the containers of the given pod which restarted more times than in the given counts (all the containers
+// which restarted if the counts are nil), with the reason of their last termination, or an empty string if none restarted
+func describeRestarts(pod *corev1.Pod, previous map[string]int32) string {
+	restarts := []string{}
+	for _, status := range containerStatuses(pod) {
+		if status.RestartCount <= previous[status.Name] {
+			continue
+		}
+		msg := fmt.Sprintf("container '%s' restarted %d time(s)", status.Name, status.RestartCount-previous[status.Name])
+		if terminated := status.LastTerminationState.Terminated; terminated != nil {
+			msg += fmt.Sprintf(" (last termination: reason='%s', exit code=%d)", terminated.Reason, terminated.ExitCode)
+		}
+		if status.State.Waiting != nil && status.State.Waiting.Reason != "" {
+			msg += fmt.Sprintf(" and is now waiting: %s", status.State.Waiting.Reason)
+		}
+		restarts = append(restarts, msg)
+	}
+	sort.Strings(restarts)
+	return strings.Join(restarts, ", ")
+}
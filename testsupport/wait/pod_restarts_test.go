@@ -0,0 +1,121 @@
+package wait_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestWaitForNoPodRestarts(t *testing.T) {
+	selector := client.MatchingLabels{"app": "host-operator"}
+	newPod := func(name string, restarts int32) *corev1.Pod {
+		pod := newWorkloadPod(name, "host-operator", true)
+		pod.UID = types.UID(name)
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{{Name: "manager", RestartCount: restarts}}
+		return pod
+	}
+
+	t.Run("no restart", func(t *testing.T) {
+		// given
+		a := newWorkloadsAwaitility(t, newPod("host-operator-1", 3))
+
+		// when
+		err := a.WaitForNoPodRestarts(t, selector, 100*time.Millisecond)
+
+		// then
+		require.NoError(t, err) // the restarts which occurred before are ignored
+	})
+
+	t.Run("restart during the window", func(t *testing.T) {
+		// given
+		a := newWorkloadsAwaitility(t, newPod("host-operator-1", 3))
+		time.AfterFunc(30*time.Millisecond, func() {
+			pod := &corev1.Pod{}
+			if !assert.NoError(t, a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: "host-operator-1"}, pod)) {
+				return
+			}
+			pod.Status.ContainerStatuses[0].RestartCount = 4
+			pod.Status.ContainerStatuses[0].LastTerminationState.Terminated = &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}
+			pod.Status.ContainerStatuses[0].State.Waiting = &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}
+			assert.NoError(t, a.Client.Update(context.TODO(), pod))
+		})
+
+		// when
+		err := a.WaitForNoPodRestarts(t, selector, time.Second)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "pod 'host-operator-1': container 'manager' restarted 1 time(s) (last termination: reason='Error', exit code=1) and is now waiting: CrashLoopBackOff")
+	})
+
+	t.Run("new pod restarted", func(t *testing.T) {
+		// given
+		a := newWorkloadsAwaitility(t, newPod("host-operator-1", 0))
+		time.AfterFunc(30*time.Millisecond, func() {
+			assert.NoError(t, a.Client.Create(context.TODO(), newPod("host-operator-2", 1)))
+		})
+
+		// when
+		err := a.WaitForNoPodRestarts(t, selector, time.Second)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "pod 'host-operator-2': container 'manager' restarted 1 time(s)")
+	})
+
+	t.Run("context done before the end of the window", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		a := newWorkloadsAwaitility(t, newPod("host-operator-1", 0)).WithContext(ctx)
+		time.AfterFunc(30*time.Millisecond, cancel)
+
+		// when
+		err := a.WaitForNoPodRestarts(t, selector, time.Minute)
+
+		// then
+		require.Error(t, err) // the pods were not checked during the whole window
+	})
+
+	t.Run("no pod", func(t *testing.T) {
+		// given
+		a := newWorkloadsAwaitility(t)
+
+		// when
+		err := a.WaitForNoPodRestarts(t, selector, 100*time.Millisecond)
+
+		// then
+		require.EqualError(t, err, "no pod found with labels map[app:host-operator] in namespace 'toolchain-host-operator'")
+	})
+}
+
+func TestUntilPodHasRestartedTimes(t *testing.T) {
+	// given
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{{Name: "init", RestartCount: 1}},
+			ContainerStatuses:     []corev1.ContainerStatus{{Name: "manager", RestartCount: 2}},
+		},
+	}
+
+	t.Run("match", func(t *testing.T) {
+		assert.True(t, wait.UntilPodHasRestartedTimes(3).Match(pod))
+		assert.True(t, wait.UntilPodHasRestartedTimes(2).Match(pod))
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		// when
+		criterion := wait.UntilPodHasRestartedTimes(4)
+
+		// then
+		assert.False(t, criterion.Match(pod))
+		assert.Equal(t, "expected Pod to have restarted at least 4 time(s)\nbut it restarted 3 time(s): container 'init' restarted 1 time(s), container 'manager' restarted 2 time(s)", criterion.Diff(pod))
+	})
+}
@@ -0,0 +1,140 @@
+package wait
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// portForwardReadyTimeout the max duration to wait for a port-forward to be ready
+const portForwardReadyTimeout = 30 * time.Second
+
+// GetServicePod returns a running pod of the given service, along with the container port targeted by the service port with the given name
+// (or by the single port of the service if it has only one)
+func (a *Awaitility) GetServicePod(serviceName, portName string) (corev1.Pod, int32, error) {
+	service := &corev1.Service{}
+	if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: serviceName}, service); err != nil {
+		return corev1.Pod{}, 0, err
+	}
+	var servicePort *corev1.ServicePort
+	for i, p := range service.Spec.Ports {
+		if p.Name == portName || len(service.Spec.Ports) == 1 {
+			servicePort = &service.Spec.Ports[i]
+			break
+		}
+	}
+	if servicePort == nil {
+		return corev1.Pod{}, 0, fmt.Errorf("service '%s' has no port named '%s'", serviceName, portName)
+	}
+	pods := &corev1.PodList{}
+	if err := a.Client.List(a.getContext(), pods, client.InNamespace(a.Namespace), client.MatchingLabels(service.Spec.Selector)); err != nil {
+		return corev1.Pod{}, 0, err
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+			continue
+		}
+		if servicePort.TargetPort.StrVal == "" {
+			if servicePort.TargetPort.IntVal == 0 {
+				// the target port defaults to the port of the service
+				return pod, servicePort.Port, nil
+			}
+			return pod, servicePort.TargetPort.IntVal, nil
+		}
+		for _, c := range pod.Spec.Containers {
+			for _, p := range c.Ports {
+				if p.Name == servicePort.TargetPort.StrVal {
+					return pod, p.ContainerPort, nil
+				}
+			}
+		}
+		return corev1.Pod{}, 0, fmt.Errorf("pod '%s' has no container port named '%s'", pod.Name, servicePort.TargetPort.StrVal)
+	}
+	return corev1.Pod{}, 0, fmt.Errorf("no running pod for service '%s' in namespace '%s'", serviceName, a.Namespace)
+}
+
+// PortForwardEndpoint returns an endpoint function which forwards a random local port to the `https` port of a running pod of the given
+// service (see GetServicePod) through the Kube API, and returns the `localhost:<port>` address. Each call stops the previous forwarding
+// and starts a new one, since the metrics client only discovers the endpoint again after a failed request (eg: the pod was deleted).
+// The last forwarding keeps running until the end of the test process.
+func (a *Awaitility) PortForwardEndpoint(serviceName string) metrics.EndpointFunc {
+	return a.portForwardEndpoint(func() (corev1.Pod, int32, error) {
+		return a.GetServicePod(serviceName, "https")
+	})
+}
+
+// portForwardEndpoint returns an endpoint function which forwards a random local port to the pod and port returned by the given function
+// (see PortForwardEndpoint)
+func (a *Awaitility) portForwardEndpoint(podFunc func() (corev1.Pod, int32, error)) metrics.EndpointFunc {
+	var (
+		mu   sync.Mutex
+		stop chan struct{}
+	)
+	return func() (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if stop != nil {
+			close(stop)
+			stop = nil
+		}
+		pod, port, err := podFunc()
+		if err != nil {
+			return "", err
+		}
+		s, localPort, err := a.forwardPort(pod, port)
+		if err != nil {
+			return "", fmt.Errorf("unable to forward a local port to port %d of pod '%s': %w", port, pod.Name, err)
+		}
+		stop = s
+		return fmt.Sprintf("localhost:%d", localPort), nil
+	}
+}
+
+// forwardPort forwards a random local port to the given port of the pod, until the returned channel is closed
+func (a *Awaitility) forwardPort(pod corev1.Pod, port int32) (chan struct{}, uint16, error) {
+	transport, upgrader, err := spdy.RoundTripperFor(a.RestConfig)
+	if err != nil {
+		return nil, 0, err
+	}
+	clientset, err := kubernetes.NewForConfig(a.RestConfig)
+	if err != nil {
+		return nil, 0, err
+	}
+	req := clientset.CoreV1().RESTClient().Post().Resource("pods").Namespace(pod.Namespace).Name(pod.Name).SubResource("portforward")
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL())
+	stop := make(chan struct{})
+	ready := make(chan struct{})
+	forwarder, err := portforward.NewOnAddresses(dialer, []string{"localhost"}, []string{fmt.Sprintf("0:%d", port)}, stop, ready, io.Discard, io.Discard)
+	if err != nil {
+		return nil, 0, err
+	}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- forwarder.ForwardPorts()
+	}()
+	select {
+	case <-ready:
+	case err := <-errs:
+		return nil, 0, err
+	case <-time.After(portForwardReadyTimeout):
+		close(stop)
+		return nil, 0, fmt.Errorf("port-forward not ready after %s", portForwardReadyTimeout)
+	}
+	ports, err := forwarder.GetPorts()
+	if err != nil {
+		close(stop)
+		return nil, 0, err
+	}
+	return stop, ports[0].Local, nil
+}
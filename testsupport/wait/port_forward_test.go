@@ -0,0 +1,113 @@
+package wait_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestGetServicePod(t *testing.T) {
+
+	newAwaitility := func(t *testing.T, objs ...runtime.Object) *wait.Awaitility {
+		return &wait.Awaitility{
+			Client:        test.NewFakeClient(t, objs...),
+			Namespace:     "toolchain-host-operator",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       time.Second,
+		}
+	}
+	newService := func(targetPort intstr.IntOrString) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "toolchain-host-operator", Name: "host-operator-metrics-service"},
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{"control-plane": "controller-manager"},
+				Ports: []corev1.ServicePort{
+					{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080)},
+					{Name: "https", Port: 8443, TargetPort: targetPort},
+				},
+			},
+		}
+	}
+	newPod := func(name string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "toolchain-host-operator",
+				Name:      name,
+				Labels:    map[string]string{"control-plane": "controller-manager"},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{Name: "manager", Ports: []corev1.ContainerPort{{Name: "metrics", ContainerPort: 8444}}},
+				},
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+
+	t.Run("numeric target port", func(t *testing.T) {
+		// given
+		a := newAwaitility(t, newService(intstr.FromInt(8443)), newPod("pending", corev1.PodPending), newPod("running", corev1.PodRunning))
+
+		// when
+		pod, port, err := a.GetServicePod("host-operator-metrics-service", "https")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "running", pod.Name)
+		assert.Equal(t, int32(8443), port)
+	})
+
+	t.Run("named target port", func(t *testing.T) {
+		// given
+		a := newAwaitility(t, newService(intstr.FromString("metrics")), newPod("running", corev1.PodRunning))
+
+		// when
+		_, port, err := a.GetServicePod("host-operator-metrics-service", "https")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int32(8444), port)
+	})
+
+	t.Run("unknown target port", func(t *testing.T) {
+		// given
+		a := newAwaitility(t, newService(intstr.FromString("unknown")), newPod("running", corev1.PodRunning))
+
+		// when
+		_, _, err := a.GetServicePod("host-operator-metrics-service", "https")
+
+		// then
+		require.EqualError(t, err, "pod 'running' has no container port named 'unknown'")
+	})
+
+	t.Run("unknown service port", func(t *testing.T) {
+		// given
+		a := newAwaitility(t, newService(intstr.FromInt(8443)), newPod("running", corev1.PodRunning))
+
+		// when
+		_, _, err := a.GetServicePod("host-operator-metrics-service", "grpc")
+
+		// then
+		require.EqualError(t, err, "service 'host-operator-metrics-service' has no port named 'grpc'")
+	})
+
+	t.Run("no running pod", func(t *testing.T) {
+		// given
+		a := newAwaitility(t, newService(intstr.FromInt(8443)), newPod("pending", corev1.PodPending))
+
+		// when
+		_, _, err := a.GetServicePod("host-operator-metrics-service", "https")
+
+		// then
+		require.EqualError(t, err, "no running pod for service 'host-operator-metrics-service' in namespace 'toolchain-host-operator'")
+	})
+}
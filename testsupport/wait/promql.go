@@ -0,0 +1,117 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// ThanosQuerierRouteName the name of the route to the Thanos querier of the OpenShift monitoring stack, which queries the
+	// Prometheus instances of the cluster (and of the user workloads, when their monitoring is enabled)
+	ThanosQuerierRouteName = "thanos-querier"
+	// DefaultPromQLStep the default resolution of the range queries (see AssertPromQLStayedAtMost), which matches the default
+	// scrape interval of the ServiceMonitors
+	DefaultPromQLStep = 30 * time.Second
+)
+
+// GetPromQLURL returns the URL of the API which runs the PromQL queries in the cluster: the Thanos querier of the OpenShift monitoring
+// stack, or its Prometheus instance if there is no Thanos querier (see GetPrometheusURL)
+func (a *Awaitility) GetPromQLURL() (string, error) {
+	route := &routev1.Route{}
+	if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: OpenShiftMonitoringNamespace, Name: ThanosQuerierRouteName}, route); err == nil {
+		return "https://" + route.Spec.Host, nil
+	}
+	return a.GetPrometheusURL()
+}
+
+// NewPrometheusClient returns a client which runs the PromQL queries in the cluster (see GetPromQLURL), with the bearer token of the
+// Awaitility (see TokenProvider)
+func (a *Awaitility) NewPrometheusClient() (*metrics.PrometheusClient, error) {
+	url, err := a.GetPromQLURL()
+	if err != nil {
+		return nil, fmt.Errorf("unable to find the Thanos querier or the Prometheus instance of the OpenShift monitoring stack: %w", err)
+	}
+	return metrics.NewPrometheusClient(url, a.bearerToken, metrics.WithPrometheusTransport(a.newInsecureTransport())), nil
+}
+
+// bearerToken returns the bearer token of the HTTP-based waits of the Awaitility, if any (see TokenProvider)
+func (a *Awaitility) bearerToken() (string, error) {
+	return a.TokenProvider().Token(context.TODO())
+}
+
+// QueryPrometheus runs the given PromQL query in the cluster (see NewPrometheusClient) and returns the samples of the result.
+// Fails if the query can't be run.
+func (a *Awaitility) QueryPrometheus(t T, query string) []metrics.Sample {
+	prometheus, err := a.NewPrometheusClient()
+	require.NoError(t, err)
+	samples, err := prometheus.Query(query, a.getClock().Now())
+	require.NoError(t, err, "unable to run the PromQL query '%s'", query)
+	return samples
+}
+
+// WaitUntilPromQLHasValue waits until the given PromQL query has a single sample with the expected value (within the tolerance, see
+// WithTolerance), eg: until the ServiceMonitor of the operator is scraped after a metric was updated
+//
+//	hostAwait.WaitUntilPromQLHasValue(t, `sandbox_master_user_records{domain="external"}`, 1)
+func (a *Awaitility) WaitUntilPromQLHasValue(t T, query string, expectedValue float64) error {
+	recordWaiter(t)
+	a.logf(t, "waiting for PromQL query '%s' to return '%v'%s", query, expectedValue, a.toleranceString())
+	prometheus, err := a.NewPrometheusClient()
+	if err != nil {
+		return err
+	}
+	var samples []metrics.Sample
+	err = a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		samples, err = prometheus.Query(query, a.getClock().Now())
+		if err != nil {
+			return false, err
+		}
+		return len(samples) == 1 && math.Abs(samples[0].Value-expectedValue) <= a.tolerance, nil
+	})
+	if err != nil {
+		return fmt.Errorf("waited for PromQL query '%s' to return '%v'%s. Last result: %s: %w", query, expectedValue, a.toleranceString(), samplesString(samples), err)
+	}
+	return nil
+}
+
+// AssertPromQLStayedAtMost asserts that the values of all the series of the given PromQL query stayed at most at the given value
+// since the given time (at the resolution of DefaultPromQLStep), eg: that the rate of the reconcile errors stayed at 0 during the test
+//
+//	start := time.Now()
+//	...
+//	hostAwait.AssertPromQLStayedAtMost(t, `sum(rate(controller_runtime_reconcile_errors_total{namespace="toolchain-host-operator"}[1m]))`, 0, start)
+func (a *Awaitility) AssertPromQLStayedAtMost(t T, query string, maxValue float64, since time.Time) {
+	prometheus, err := a.NewPrometheusClient()
+	require.NoError(t, err)
+	now := a.getClock().Now()
+	series, err := prometheus.QueryRange(query, since, now, DefaultPromQLStep)
+	require.NoError(t, err, "unable to run the PromQL range query '%s'", query)
+	var above []string
+	for _, s := range series {
+		for _, p := range s.Points {
+			if p.Value > maxValue+a.tolerance {
+				above = append(above, fmt.Sprintf("%s=%v at %s", s.LabelsString(), p.Value, p.Time.UTC().Format(time.RFC3339)))
+			}
+		}
+	}
+	a.logf(t, "PromQL query '%s' returned %d series between %s and %s", query, len(series), since.UTC().Format(time.RFC3339), now.UTC().Format(time.RFC3339))
+	require.Empty(t, above, "PromQL query '%s' was above %v%s since %s: %s", query, maxValue, a.toleranceString(), since.UTC().Format(time.RFC3339), strings.Join(above, ", "))
+}
+
+// samplesString returns the given samples as `{labels}=value` pairs, to be logged
+func samplesString(samples []metrics.Sample) string {
+	values := make([]string, 0, len(samples))
+	for _, s := range samples {
+		values = append(values, fmt.Sprintf("%s=%v", s.LabelsString(), s.Value))
+	}
+	return "[" + strings.Join(values, ", ") + "]"
+}
@@ -0,0 +1,132 @@
+package wait_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPromQL(t *testing.T) {
+	// given
+	var errorRate atomic.Value
+	errorRate.Store("0")
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/query":
+			fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"%s"]}]}}`, errorRate.Load())
+		case "/api/v1/query_range":
+			fmt.Fprintf(w, `{"status":"success","data":{"resultType":"matrix","result":[{"metric":{"controller":"usersignup"},"values":[[1700000000,"0"],[1700000030,"%s"]]}]}}`, errorRate.Load())
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	route := func(name string) *routev1.Route {
+		return &routev1.Route{
+			ObjectMeta: metav1.ObjectMeta{Namespace: wait.OpenShiftMonitoringNamespace, Name: name},
+			Spec:       routev1.RouteSpec{Host: strings.TrimPrefix(ts.URL, "https://")},
+		}
+	}
+	s := runtime.NewScheme()
+	require.NoError(t, routev1.AddToScheme(s))
+	newAwaitility := func(t *testing.T, objs ...runtime.Object) *wait.Awaitility {
+		return &wait.Awaitility{
+			Client:        fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build(),
+			Namespace:     "toolchain-host-operator",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+	}
+	query := `sum(rate(controller_runtime_reconcile_errors_total[1m]))`
+
+	t.Run("query URL", func(t *testing.T) {
+		t.Run("thanos querier", func(t *testing.T) {
+			url, err := newAwaitility(t, route(wait.ThanosQuerierRouteName), route(wait.PrometheusRouteName)).GetPromQLURL()
+			require.NoError(t, err)
+			assert.Equal(t, ts.URL, url)
+		})
+
+		t.Run("prometheus", func(t *testing.T) {
+			url, err := newAwaitility(t, route(wait.PrometheusRouteName)).GetPromQLURL()
+			require.NoError(t, err)
+			assert.Equal(t, ts.URL, url)
+		})
+
+		t.Run("no monitoring stack", func(t *testing.T) {
+			_, err := newAwaitility(t).NewPrometheusClient()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "unable to find the Thanos querier or the Prometheus instance of the OpenShift monitoring stack")
+		})
+	})
+
+	t.Run("query", func(t *testing.T) {
+		// given
+		errorRate.Store("0")
+		a := newAwaitility(t, route(wait.ThanosQuerierRouteName))
+
+		// when
+		samples := a.QueryPrometheus(t, query)
+
+		// then
+		require.Len(t, samples, 1)
+		assert.Equal(t, float64(0), samples[0].Value)
+	})
+
+	t.Run("wait until value", func(t *testing.T) {
+		// given
+		errorRate.Store("0.5")
+		a := newAwaitility(t, route(wait.ThanosQuerierRouteName))
+
+		t.Run("reached", func(t *testing.T) {
+			require.NoError(t, a.WaitUntilPromQLHasValue(t, query, 0.5))
+		})
+
+		t.Run("not reached", func(t *testing.T) {
+			err := a.WaitUntilPromQLHasValue(t, query, 0)
+			require.Error(t, err)
+			assert.True(t, wait.IsTimeout(err))
+			assert.Contains(t, err.Error(), "waited for PromQL query '"+query+"' to return '0'. Last result: [{}=0.5]")
+		})
+	})
+
+	t.Run("stayed at most", func(t *testing.T) {
+		// given
+		a := newAwaitility(t, route(wait.ThanosQuerierRouteName))
+
+		t.Run("success", func(t *testing.T) {
+			// given
+			errorRate.Store("0")
+
+			// then
+			a.AssertPromQLStayedAtMost(t, query, 0, time.Now().Add(-time.Minute))
+		})
+
+		t.Run("failure", func(t *testing.T) {
+			// given
+			errorRate.Store("0.2")
+			out := &strings.Builder{}
+
+			// when
+			ok := wait.RunStandalone(t.Name(), out, func(st wait.T) {
+				a.AssertPromQLStayedAtMost(st, query, 0, time.Now().Add(-time.Minute))
+			})
+
+			// then
+			assert.False(t, ok)
+			assert.Contains(t, out.String(), `{controller="usersignup"}=0.2 at 2023-11-14T22:13:50Z`)
+		})
+	})
+}
@@ -0,0 +1,186 @@
+package wait
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ProxyRequestsMetric the histogram of the duration of the requests handled by the proxy, by status code and route,
+	// whose number of observations is the number of requests
+	ProxyRequestsMetric = "sandbox_proxy_api_http_request_time"
+	// ProxyRouteRejected the route of the requests rejected by the proxy
+	ProxyRouteRejected = "Rejected"
+
+	proxyMetricsService = "proxy-metrics-service"
+)
+
+// ProxyRequestKey the labels of the requests handled by the proxy
+type ProxyRequestKey struct {
+	StatusCode string
+	RouteTo    string
+}
+
+func (k ProxyRequestKey) String() string {
+	return fmt.Sprintf("status_code=%s,route_to=%s", k.StatusCode, k.RouteTo)
+}
+
+// ProxyRequests the expected number of requests handled by the proxy with the given status code and route.
+// An empty status code or route matches all the status codes or routes.
+type ProxyRequests struct {
+	StatusCode string
+	RouteTo    string
+	Count      int
+}
+
+func (r ProxyRequests) matches(key ProxyRequestKey) bool {
+	return (r.StatusCode == "" || r.StatusCode == key.StatusCode) && (r.RouteTo == "" || r.RouteTo == key.RouteTo)
+}
+
+func (r ProxyRequests) String() string {
+	status, route := r.StatusCode, r.RouteTo
+	if status == "" {
+		status = "*"
+	}
+	if route == "" {
+		route = "*"
+	}
+	return fmt.Sprintf("status_code=%s,route_to=%s", status, route)
+}
+
+// ProxyAuthFailures returns the expected number of requests rejected by the proxy because of a missing or invalid token
+func ProxyAuthFailures(count int) ProxyRequests {
+	return ProxyRequests{
+		StatusCode: strconv.Itoa(http.StatusUnauthorized),
+		RouteTo:    ProxyRouteRejected,
+		Count:      count,
+	}
+}
+
+// ProxyRequestDeltas the number of requests counted by the proxy since a ProxyRequestCounter was started, by status code and route
+type ProxyRequestDeltas map[ProxyRequestKey]int
+
+// Diff returns how the deltas differ from the given expected requests, or an empty string if they match: the number of requests
+// matching each expectation must be exactly the expected one (more requests reveal a double-counting, fewer requests reveal a missing
+// instrumentation), and there must be no other requests
+func (d ProxyRequestDeltas) Diff(expected ...ProxyRequests) string {
+	var diffs []string
+	matched := map[ProxyRequestKey]bool{}
+	for _, e := range expected {
+		actual := 0
+		for key, delta := range d {
+			if e.matches(key) {
+				actual += delta
+				matched[key] = true
+			}
+		}
+		switch {
+		case actual > e.Count:
+			diffs = append(diffs, fmt.Sprintf("expected %d request(s) with %s, but %d were counted (double-counted?)", e.Count, e, actual))
+		case actual < e.Count:
+			diffs = append(diffs, fmt.Sprintf("expected %d request(s) with %s, but %d were counted (missing instrumentation?)", e.Count, e, actual))
+		}
+	}
+	var unexpected []string
+	for key, delta := range d {
+		if !matched[key] && delta != 0 {
+			unexpected = append(unexpected, fmt.Sprintf("%d request(s) with %s", delta, key))
+		}
+	}
+	sort.Strings(unexpected)
+	if len(unexpected) > 0 {
+		diffs = append(diffs, "unexpected requests: "+strings.Join(unexpected, ", "))
+	}
+	return strings.Join(diffs, "\n")
+}
+
+// ProxyRequestCounter counts the requests handled by the proxy since it was started (see HostAwaitility.CountProxyRequests),
+// so that a test can correlate its own calls to the proxy with the metrics of the proxy.
+// The counts are only meaningful as long as no other test calls the proxy at the same time, and the pods of the proxy are not restarted.
+type ProxyRequestCounter struct {
+	baseline map[ProxyRequestKey]float64
+}
+
+// CountProxyRequests starts counting the requests handled by the proxy, before the test calls the proxy
+func (a *HostAwaitility) CountProxyRequests(t T) *ProxyRequestCounter {
+	baseline, err := a.proxyRequests()
+	require.NoError(t, err)
+	return &ProxyRequestCounter{
+		baseline: baseline,
+	}
+}
+
+// WaitForProxyRequests waits until the requests counted by the proxy since the given counter was started match exactly the expected ones
+// (see ProxyRequestDeltas.Diff)
+func (a *HostAwaitility) WaitForProxyRequests(t T, counter *ProxyRequestCounter, expected ...ProxyRequests) error {
+	recordWaiter(t)
+	a.logf(t, "waiting for the proxy to count the requests: %v", expected)
+	var deltas ProxyRequestDeltas
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		current, err := a.proxyRequests()
+		if err != nil {
+			return false, err
+		}
+		deltas = ProxyRequestDeltas{}
+		for key, value := range current {
+			if delta := int(value - counter.baseline[key]); delta != 0 {
+				deltas[key] = delta
+			}
+		}
+		return deltas.Diff(expected...) == "", nil
+	})
+	if err != nil && deltas != nil {
+		a.logf(t, "requests counted by the proxy did not match the expected ones:\n%s", deltas.Diff(expected...))
+	}
+	return err
+}
+
+// proxyRequests returns the number of requests handled by the proxy, by status code and route, summed over all the pods of the proxy
+// (each pod only counts the requests it handled). The metrics are scraped via the API server, which proxies the requests to the pods.
+func (a *HostAwaitility) proxyRequests() (map[ProxyRequestKey]float64, error) {
+	service := &corev1.Service{}
+	if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: proxyMetricsService}, service); err != nil {
+		return nil, err
+	}
+	if len(service.Spec.Ports) == 0 {
+		return nil, fmt.Errorf("service '%s' has no port", proxyMetricsService)
+	}
+	port := service.Spec.Ports[0].TargetPort.String()
+	pods := &corev1.PodList{}
+	if err := a.Client.List(a.getContext(), pods, client.InNamespace(a.Namespace), client.MatchingLabels(service.Spec.Selector)); err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(a.RestConfig)
+	if err != nil {
+		return nil, err
+	}
+	requests := map[ProxyRequestKey]float64{}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		body, err := clientset.CoreV1().Pods(a.Namespace).ProxyGet("http", pod.Name, port, "/metrics", nil).DoRaw(a.getContext())
+		if err != nil {
+			return nil, fmt.Errorf("unable to scrape the metrics of pod '%s': %w", pod.Name, err)
+		}
+		series, err := metrics.ParseSeries(body, ProxyRequestsMetric)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range series {
+			requests[ProxyRequestKey{StatusCode: s.Labels["status_code"], RouteTo: s.Labels["route_to"]}] += s.Value
+		}
+	}
+	return requests, nil
+}
@@ -0,0 +1,47 @@
+package wait_test
+
+import (
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProxyRequestDeltas(t *testing.T) {
+
+	apiServer := wait.ProxyRequestKey{StatusCode: "202", RouteTo: "api_server"}
+	rejected := wait.ProxyRequestKey{StatusCode: "401", RouteTo: wait.ProxyRouteRejected}
+
+	t.Run("match", func(t *testing.T) {
+		deltas := wait.ProxyRequestDeltas{apiServer: 2, rejected: 1}
+
+		assert.Empty(t, deltas.Diff(wait.ProxyRequests{StatusCode: "202", RouteTo: "api_server", Count: 2}, wait.ProxyAuthFailures(1)))
+		assert.Empty(t, deltas.Diff(wait.ProxyRequests{Count: 3}))
+	})
+
+	t.Run("no request", func(t *testing.T) {
+		assert.Empty(t, wait.ProxyRequestDeltas{}.Diff())
+	})
+
+	t.Run("double-counted", func(t *testing.T) {
+		deltas := wait.ProxyRequestDeltas{rejected: 2}
+
+		assert.Equal(t, "expected 1 request(s) with status_code=401,route_to=Rejected, but 2 were counted (double-counted?)",
+			deltas.Diff(wait.ProxyAuthFailures(1)))
+	})
+
+	t.Run("missing instrumentation", func(t *testing.T) {
+		deltas := wait.ProxyRequestDeltas{}
+
+		assert.Equal(t, "expected 1 request(s) with status_code=*,route_to=*, but 0 were counted (missing instrumentation?)",
+			deltas.Diff(wait.ProxyRequests{Count: 1}))
+	})
+
+	t.Run("unexpected requests", func(t *testing.T) {
+		deltas := wait.ProxyRequestDeltas{apiServer: 1, rejected: 1}
+
+		assert.Equal(t, "unexpected requests: 1 request(s) with status_code=202,route_to=api_server",
+			deltas.Diff(wait.ProxyAuthFailures(1)))
+	})
+}
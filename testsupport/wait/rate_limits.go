@@ -0,0 +1,66 @@
+package wait
+
+import (
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ClientQPSVar the name of the env var which overrides the max number of queries per second of the clients to the API servers
+	ClientQPSVar = "E2E_CLIENT_QPS"
+	// ClientBurstVar the name of the env var which overrides the max burst of queries of the clients to the API servers
+	ClientBurstVar = "E2E_CLIENT_BURST"
+)
+
+// ClientRateLimits the settings of the client-side rate limiter of the clients to the API servers. The defaults of the `rest.Config`
+// (5 queries per second, with bursts of 10) cause the requests to be throttled when many tests run in parallel, in which case the
+// throttling warnings dominate the logs; whereas the tests running on a shared CI cluster may need to throttle down.
+type ClientRateLimits struct {
+	// QPS the max number of queries per second (the default of the `rest.Config` when 0)
+	QPS float32
+	// Burst the max burst of queries (the default of the `rest.Config` when 0)
+	Burst int
+}
+
+// ApplyTo sets the rate limits on the given config (only the non-zero ones), which must be done before the clients are created
+// from the config. Returns the given config.
+func (l ClientRateLimits) ApplyTo(config *rest.Config) *rest.Config {
+	if l.QPS > 0 {
+		config.QPS = l.QPS
+	}
+	if l.Burst > 0 {
+		config.Burst = l.Burst
+	}
+	return config
+}
+
+// WithClientRateLimits returns an option to send the requests of the Awaitility to the API server with the given rate limits
+// (see ClientRateLimits), eg: to raise them for a test which creates many resources in parallel. The client of the returned
+// Awaitility is created from a copy of its config (and thus uses the identity of the config), with the same scheme and REST mapper.
+// The option has no effect on an Awaitility without a config (eg: with a fake client).
+func WithClientRateLimits(qps float32, burst int) RetryOption {
+	return ClientRateLimits{
+		QPS:   qps,
+		Burst: burst,
+	}
+}
+
+var _ RetryOption = ClientRateLimits{}
+
+func (l ClientRateLimits) apply(a *Awaitility) {
+	if a.RestConfig == nil {
+		return
+	}
+	config := l.ApplyTo(rest.CopyConfig(a.RestConfig))
+	cl, err := client.New(config, client.Options{
+		Scheme: a.Client.Scheme(),
+		Mapper: a.Client.RESTMapper(),
+	})
+	if err != nil {
+		// can't happen in practice with the config of an initialized Awaitility: in that case, the Awaitility keeps its current
+		// client and rate limits
+		return
+	}
+	a.Client = cl
+	a.RestConfig = config
+}
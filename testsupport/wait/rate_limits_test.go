@@ -0,0 +1,81 @@
+package wait_test
+
+import (
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/rest"
+)
+
+func TestClientRateLimitsApplyTo(t *testing.T) {
+	t.Run("all limits", func(t *testing.T) {
+		// given
+		config := &rest.Config{QPS: 5, Burst: 10}
+
+		// when
+		result := wait.ClientRateLimits{QPS: 50, Burst: 100}.ApplyTo(config)
+
+		// then
+		assert.Same(t, config, result)
+		assert.Equal(t, float32(50), config.QPS)
+		assert.Equal(t, 100, config.Burst)
+	})
+
+	t.Run("only the non-zero limits", func(t *testing.T) {
+		// given
+		config := &rest.Config{QPS: 5, Burst: 10}
+
+		// when
+		wait.ClientRateLimits{Burst: 20}.ApplyTo(config)
+
+		// then
+		assert.Equal(t, float32(5), config.QPS)
+		assert.Equal(t, 20, config.Burst)
+	})
+}
+
+func TestWithClientRateLimits(t *testing.T) {
+	t.Run("new client", func(t *testing.T) {
+		// given
+		config := &rest.Config{Host: "https://api.cluster.example.com:6443", QPS: 5, Burst: 10}
+		cl := test.NewFakeClient(t)
+		a := &wait.Awaitility{
+			Client:     cl,
+			RestConfig: config,
+		}
+
+		// when
+		result := a.WithRetryOptions(wait.WithClientRateLimits(50, 100))
+
+		// then
+		assert.NotSame(t, config, result.RestConfig)
+		assert.Equal(t, float32(50), result.RestConfig.QPS)
+		assert.Equal(t, 100, result.RestConfig.Burst)
+		assert.Equal(t, config.Host, result.RestConfig.Host)
+		assert.NotSame(t, cl, result.Client)
+		assert.Same(t, cl.Scheme(), result.Client.Scheme())
+		// the original Awaitility is unchanged
+		assert.Same(t, config, a.RestConfig)
+		assert.Equal(t, float32(5), config.QPS)
+		assert.Equal(t, 10, config.Burst)
+		assert.Same(t, cl, a.Client)
+	})
+
+	t.Run("no config", func(t *testing.T) {
+		// given
+		cl := test.NewFakeClient(t)
+		a := &wait.Awaitility{
+			Client: cl,
+		}
+
+		// when
+		result := a.WithRetryOptions(wait.WithClientRateLimits(50, 100))
+
+		// then
+		assert.Same(t, cl, result.Client)
+		assert.Nil(t, result.RestConfig)
+	})
+}
@@ -0,0 +1,62 @@
+package wait
+
+import (
+	"fmt"
+	"reflect"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WaitForReconciled waits until the `status.observedGeneration` of the given object caught up with its `metadata.generation`,
+// ie: until its controller has seen the latest spec, so that the test does not assert on a status which reflects a previous spec, eg:
+//
+//	deployment, err := a.ScaleDeployment(t, name, 0)
+//	require.NoError(t, err)
+//	_, err = wait.WaitForReconciled(t, a, deployment)
+//	require.NoError(t, err)
+//
+// Returns an error without waiting if the objects of type `T` do not expose a `status.observedGeneration` (note that the toolchain
+// CRs of the vendored API, such as the ToolchainConfig or the NSTemplateSet, do not expose it yet).
+func WaitForReconciled[T client.Object](t testingT, a *Awaitility, obj T) (T, error) {
+	if _, found := observedGeneration(obj); !found {
+		return obj, fmt.Errorf("%s '%s' has no 'status.observedGeneration' field", objectKind[T](), obj.GetName())
+	}
+	return WaitForObject(t, a, client.ObjectKeyFromObject(obj), UntilReconciled[T]())
+}
+
+// UntilReconciled returns a `Criterion` which checks that the `status.observedGeneration` of the object is equal to (or greater than)
+// its `metadata.generation`
+func UntilReconciled[T client.Object]() Criterion[T] {
+	return Criterion[T]{
+		Match: func(actual T) bool {
+			observed, found := observedGeneration(actual)
+			return found && observed >= actual.GetGeneration()
+		},
+		Diff: func(actual T) string {
+			observed, found := observedGeneration(actual)
+			if !found {
+				return fmt.Sprintf("expected %s '%s' to have a 'status.observedGeneration' field, but it has none", objectKind[T](), actual.GetName())
+			}
+			return fmt.Sprintf("expected %s '%s' to be reconciled: generation: %d, observed generation: %d", objectKind[T](), actual.GetName(), actual.GetGeneration(), observed)
+		},
+	}
+}
+
+var observedGenerationType = reflect.TypeOf(int64(0))
+
+// observedGeneration returns the `Status.ObservedGeneration` of the given object, or `false` if the object has no such field
+func observedGeneration(obj client.Object) (int64, bool) {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return 0, false
+	}
+	status := v.Elem().FieldByName("Status")
+	if !status.IsValid() || status.Kind() != reflect.Struct {
+		return 0, false
+	}
+	observed := status.FieldByName("ObservedGeneration")
+	if !observed.IsValid() || observed.Type() != observedGenerationType {
+		return 0, false
+	}
+	return observed.Int(), true
+}
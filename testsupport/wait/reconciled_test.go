@@ -0,0 +1,111 @@
+package wait_test
+
+import (
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWaitForReconciled(t *testing.T) {
+	// given
+	newDeployment := func(generation, observedGeneration int64) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  "toolchain-host-operator",
+				Name:       "host-operator-controller-manager",
+				Generation: generation,
+			},
+			Status: appsv1.DeploymentStatus{
+				ObservedGeneration: observedGeneration,
+			},
+		}
+	}
+	newAwaitility := func(t *testing.T, deployment *appsv1.Deployment) *wait.Awaitility {
+		return &wait.Awaitility{
+			Client:        test.NewFakeClient(t, deployment),
+			Namespace:     "toolchain-host-operator",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+	}
+
+	t.Run("reconciled", func(t *testing.T) {
+		// given
+		deployment := newDeployment(2, 2)
+		a := newAwaitility(t, deployment)
+
+		// when
+		result, err := wait.WaitForReconciled(t, a, deployment)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), result.Status.ObservedGeneration)
+	})
+
+	t.Run("not reconciled yet", func(t *testing.T) {
+		// given
+		deployment := newDeployment(2, 1)
+		a := newAwaitility(t, deployment)
+
+		// when
+		_, err := wait.WaitForReconciled(t, a, deployment)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+		assert.Contains(t, wait.LastState(err), "expected Deployment 'host-operator-controller-manager' to be reconciled: generation: 2, observed generation: 1")
+	})
+
+	t.Run("no observed generation", func(t *testing.T) {
+		// given
+		config := &toolchainv1alpha1.ToolchainConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "toolchain-host-operator",
+				Name:      "config",
+			},
+		}
+		a := &wait.Awaitility{
+			Client:        test.NewFakeClient(t, config),
+			Namespace:     "toolchain-host-operator",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       time.Minute,
+		}
+
+		// when
+		_, err := wait.WaitForReconciled(t, a, config)
+
+		// then
+		require.EqualError(t, err, "ToolchainConfig 'config' has no 'status.observedGeneration' field")
+		assert.False(t, wait.IsTimeout(err))
+	})
+}
+
+func TestUntilReconciled(t *testing.T) {
+	criterion := wait.UntilReconciled[*appsv1.Deployment]()
+
+	for name, tc := range map[string]struct {
+		generation         int64
+		observedGeneration int64
+		match              bool
+	}{
+		"same generation":  {generation: 3, observedGeneration: 3, match: true},
+		"newer generation": {generation: 3, observedGeneration: 2, match: false},
+		"never observed":   {generation: 1, observedGeneration: 0, match: false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "deployment", Generation: tc.generation},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: tc.observedGeneration},
+			}
+			assert.Equal(t, tc.match, criterion.Match(deployment))
+		})
+	}
+}
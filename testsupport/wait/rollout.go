@@ -0,0 +1,134 @@
+package wait
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+
+	"github.com/redhat-cop/operator-utils/pkg/util"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/kubectl/pkg/util/podutils"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DeploymentRevisionAnnotation the annotation set by the deployment controller on the Deployments and on their ReplicaSets
+const DeploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+
+// GetDeploymentRevision returns the current revision of the deployment with the given name, to be passed to WaitForDeploymentRollout
+// before changing something which should cause the deployment to roll out its pods again (eg: the config of an operator)
+func (a *Awaitility) GetDeploymentRevision(t T, name string) string {
+	deployment := &appsv1.Deployment{}
+	require.NoError(t, a.Client.Get(a.getContext(), test.NamespacedName(a.Namespace, name), deployment))
+	return deployment.Annotations[DeploymentRevisionAnnotation]
+}
+
+// WaitForDeploymentRollout waits until the deployment with the given name has rolled out a revision which is newer than the given one,
+// ie: until all its replicas belong to the ReplicaSet of the new revision and are ready, and until the pods of the previous revisions
+// are gone. Unlike WaitForDeploymentToGetReady, this does not pass when the pods of the previous revision are still ready.
+func (a *Awaitility) WaitForDeploymentRollout(t T, name, previousRevision string) *appsv1.Deployment {
+	deployment, err := a.TryWaitForDeploymentRollout(t, name, previousRevision)
+	require.NoError(t, err)
+	return deployment
+}
+
+// TryWaitForDeploymentRollout is like WaitForDeploymentRollout, but it returns an error instead of failing the test
+// if the deployment does not roll out a new revision
+func (a *Awaitility) TryWaitForDeploymentRollout(t T, name, previousRevision string) (*appsv1.Deployment, error) {
+	recordWaiter(t)
+	a.logf(t, "waiting until deployment '%s' in namespace '%s' has rolled out a revision newer than '%s'", name, a.Namespace, previousRevision)
+	previous := 0 // the deployment may not have been rolled out yet
+	if previousRevision != "" {
+		var err error
+		if previous, err = strconv.Atoi(previousRevision); err != nil {
+			return nil, fmt.Errorf("invalid revision of deployment '%s': '%s'", name, previousRevision)
+		}
+	}
+	deployment := &appsv1.Deployment{}
+	var reason string
+	err := a.poll(t, a.RetryInterval, 6*a.Timeout, func() (done bool, err error) {
+		deployment = &appsv1.Deployment{}
+		if err := a.Client.Get(a.getContext(), test.NamespacedName(a.Namespace, name), deployment); err != nil {
+			if apierrors.IsNotFound(err) {
+				reason = "deployment not found"
+				return false, nil
+			}
+			return false, err
+		}
+		reason, err = a.deploymentRolloutPending(deployment, previous)
+		return reason == "", err
+	})
+	if err != nil {
+		a.logf(t, "rollout of deployment '%s' is not complete: %s", name, reason)
+	}
+	return deployment, err
+}
+
+// deploymentRolloutPending returns the reason why the rollout of a revision newer than the given one is not complete,
+// or an empty string if it is complete
+func (a *Awaitility) deploymentRolloutPending(deployment *appsv1.Deployment, previousRevision int) (string, error) {
+	revision, err := strconv.Atoi(deployment.Annotations[DeploymentRevisionAnnotation])
+	if err != nil || revision <= previousRevision {
+		return fmt.Sprintf("revision is still '%s'", deployment.Annotations[DeploymentRevisionAnnotation]), nil
+	}
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	s := deployment.Status
+	if s.ObservedGeneration < deployment.Generation ||
+		s.UpdatedReplicas != replicas || s.Replicas != replicas || s.AvailableReplicas != replicas {
+		return fmt.Sprintf("%d updated, %d total and %d available replica(s) out of %d", s.UpdatedReplicas, s.Replicas, s.AvailableReplicas, replicas), nil
+	}
+
+	// look up the ReplicaSet of the current revision, and make sure that the ones of the previous revisions are scaled down
+	replicaSets := &appsv1.ReplicaSetList{}
+	if err := a.Client.List(a.getContext(), replicaSets, client.InNamespace(a.Namespace), client.MatchingLabels(deployment.Spec.Selector.MatchLabels)); err != nil {
+		return "", err
+	}
+	var podTemplateHash string
+	for _, rs := range replicaSets.Items {
+		if !isOwnedByDeployment(rs, deployment.Name) {
+			continue
+		}
+		if rs.Annotations[DeploymentRevisionAnnotation] == strconv.Itoa(revision) {
+			podTemplateHash = rs.Labels[appsv1.DefaultDeploymentUniqueLabelKey]
+		} else if rs.Status.Replicas > 0 {
+			return fmt.Sprintf("ReplicaSet '%s' of revision '%s' still has %d replica(s)", rs.Name, rs.Annotations[DeploymentRevisionAnnotation], rs.Status.Replicas), nil
+		}
+	}
+	if podTemplateHash == "" {
+		return fmt.Sprintf("no ReplicaSet found for revision '%d'", revision), nil
+	}
+
+	// all the pods must belong to the ReplicaSet of the current revision, including the terminating ones
+	pods := &corev1.PodList{}
+	if err := a.Client.List(a.getContext(), pods, client.InNamespace(a.Namespace), client.MatchingLabels(deployment.Spec.Selector.MatchLabels)); err != nil {
+		return "", err
+	}
+	ready := 0
+	for _, pod := range pods.Items { // nolint
+		if pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey] != podTemplateHash {
+			return fmt.Sprintf("pod '%s' of a previous revision still exists", pod.Name), nil
+		}
+		if !util.IsBeingDeleted(&pod) && podutils.IsPodReady(&pod) {
+			ready++
+		}
+	}
+	if ready != int(replicas) || len(pods.Items) != int(replicas) {
+		return fmt.Sprintf("%d ready pod(s) out of %d for revision '%d' (%d pod(s) in total)", ready, replicas, revision, len(pods.Items)), nil
+	}
+	return "", nil
+}
+
+func isOwnedByDeployment(rs appsv1.ReplicaSet, name string) bool {
+	for _, ref := range rs.OwnerReferences {
+		if ref.Kind == "Deployment" && ref.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package wait_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8swait "k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestWaitForDeploymentRollout(t *testing.T) {
+	newDeployment := func(revision string, updated, total int32) *appsv1.Deployment {
+		replicas := int32(2)
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "toolchain-host-operator",
+				Name:        "registration-service",
+				Annotations: map[string]string{wait.DeploymentRevisionAnnotation: revision},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "registration-service"}},
+			},
+			Status: appsv1.DeploymentStatus{
+				UpdatedReplicas:   updated,
+				Replicas:          total,
+				AvailableReplicas: updated,
+			},
+		}
+	}
+	newReplicaSet := func(revision, hash string, replicas int32) *appsv1.ReplicaSet {
+		return &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "toolchain-host-operator",
+				Name:            "registration-service-" + hash,
+				Labels:          map[string]string{"app": "registration-service", appsv1.DefaultDeploymentUniqueLabelKey: hash},
+				Annotations:     map[string]string{wait.DeploymentRevisionAnnotation: revision},
+				OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "registration-service"}},
+			},
+			Status: appsv1.ReplicaSetStatus{Replicas: replicas},
+		}
+	}
+	newPod := func(name, hash string, ready bool) *corev1.Pod {
+		pod := newWorkloadPod(name, "registration-service", ready)
+		pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey] = hash
+		return pod
+	}
+
+	t.Run("rolled out", func(t *testing.T) {
+		// given
+		a := newWorkloadsAwaitility(t, newDeployment("2", 2, 2),
+			newReplicaSet("1", "old", 0), newReplicaSet("2", "new", 2),
+			newPod("registration-service-new-1", "new", true), newPod("registration-service-new-2", "new", true))
+		previousRevision := "1"
+
+		// when
+		deployment, err := a.TryWaitForDeploymentRollout(t, "registration-service", previousRevision)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "2", deployment.Annotations[wait.DeploymentRevisionAnnotation])
+	})
+
+	t.Run("current revision", func(t *testing.T) {
+		// given
+		a := newWorkloadsAwaitility(t, newDeployment("2", 2, 2))
+
+		// when
+		revision := a.GetDeploymentRevision(t, "registration-service")
+
+		// then
+		assert.Equal(t, "2", revision)
+	})
+
+	t.Run("not rolled out", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			objs   []runtime.Object
+			reason string
+		}{
+			"same revision": {
+				objs: []runtime.Object{newDeployment("1", 2, 2), newReplicaSet("1", "old", 2),
+					newPod("registration-service-old-1", "old", true), newPod("registration-service-old-2", "old", true)},
+				reason: "revision is still '1'",
+			},
+			"rollout in progress": {
+				objs: []runtime.Object{newDeployment("2", 1, 3), newReplicaSet("1", "old", 2), newReplicaSet("2", "new", 1),
+					newPod("registration-service-old-1", "old", true), newPod("registration-service-old-2", "old", true),
+					newPod("registration-service-new-1", "new", true)},
+				reason: "1 updated, 3 total and 1 available replica(s) out of 2",
+			},
+			"previous replicaset not scaled down": {
+				objs: []runtime.Object{newDeployment("2", 2, 2), newReplicaSet("1", "old", 1), newReplicaSet("2", "new", 2),
+					newPod("registration-service-new-1", "new", true), newPod("registration-service-new-2", "new", true)},
+				reason: "ReplicaSet 'registration-service-old' of revision '1' still has 1 replica(s)",
+			},
+			"previous pod still exists": {
+				objs: []runtime.Object{newDeployment("2", 2, 2), newReplicaSet("1", "old", 0), newReplicaSet("2", "new", 2),
+					newPod("registration-service-old-1", "old", false),
+					newPod("registration-service-new-1", "new", true), newPod("registration-service-new-2", "new", true)},
+				reason: "pod 'registration-service-old-1' of a previous revision still exists",
+			},
+			"new pod not ready": {
+				objs: []runtime.Object{newDeployment("2", 2, 2), newReplicaSet("1", "old", 0), newReplicaSet("2", "new", 2),
+					newPod("registration-service-new-1", "new", true), newPod("registration-service-new-2", "new", false)},
+				reason: "1 ready pod(s) out of 2 for revision '2' (2 pod(s) in total)",
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// given
+				a := newWorkloadsAwaitility(t, tc.objs...)
+				out := &strings.Builder{}
+				var err error
+
+				// when
+				wait.RunStandalone(t.Name(), out, func(st wait.T) {
+					_, err = a.TryWaitForDeploymentRollout(st, "registration-service", "1")
+				})
+
+				// then
+				require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+				assert.Contains(t, out.String(), tc.reason)
+			})
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package wait
+
+import (
+	"reflect"
+
+	routev1 "github.com/openshift/api/route/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// RouteOption an option to configure the route created (or updated) by SetupRouteForService. By default, the route has a passthrough
+// TLS termination, targets the `https` port of the service, has the host generated by the router and has no annotations.
+type RouteOption func(*routeConfig)
+
+type routeConfig struct {
+	termination routev1.TLSTerminationType
+	targetPort  intstr.IntOrString
+	host        string
+	annotations map[string]string
+}
+
+func newRouteConfig(opts ...RouteOption) *routeConfig {
+	c := &routeConfig{
+		termination: routev1.TLSTerminationPassthrough,
+		targetPort:  intstr.FromString("https"),
+		annotations: map[string]string{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithRouteTermination an option to configure the TLS termination of the route, eg: `edge` (in which case the route targets the
+// port of the service with plain HTTP, see WithRouteTargetPort) or `reencrypt` (in which case the router verifies the serving certificate
+// of the service with the service CA)
+func WithRouteTermination(termination routev1.TLSTerminationType) RouteOption {
+	return func(c *routeConfig) {
+		c.termination = termination
+	}
+}
+
+// WithRouteTargetPort an option to configure the port of the service targeted by the route, by its name (eg: `http`) or its number
+func WithRouteTargetPort(port intstr.IntOrString) RouteOption {
+	return func(c *routeConfig) {
+		c.targetPort = port
+	}
+}
+
+// WithRouteHost an option to configure the host of the route, instead of the one generated by the router
+func WithRouteHost(host string) RouteOption {
+	return func(c *routeConfig) {
+		c.host = host
+	}
+}
+
+// WithRouteAnnotations an option to set the given annotations on the route (eg: `haproxy.router.openshift.io/timeout`)
+func WithRouteAnnotations(annotations map[string]string) RouteOption {
+	return func(c *routeConfig) {
+		for k, v := range annotations {
+			c.annotations[k] = v
+		}
+	}
+}
+
+// applyToRoute configures the given route, and returns `true` if it was modified
+func (c *routeConfig) applyToRoute(route *routev1.Route) bool {
+	original := route.DeepCopy()
+	route.Spec.Port = &routev1.RoutePort{
+		TargetPort: c.targetPort,
+	}
+	if route.Spec.TLS == nil {
+		route.Spec.TLS = &routev1.TLSConfig{}
+	}
+	route.Spec.TLS.Termination = c.termination
+	if c.host != "" {
+		route.Spec.Host = c.host
+	}
+	if len(c.annotations) > 0 && route.Annotations == nil {
+		route.Annotations = map[string]string{}
+	}
+	for k, v := range c.annotations {
+		route.Annotations[k] = v
+	}
+	return !reflect.DeepEqual(original.Spec, route.Spec) || !reflect.DeepEqual(original.Annotations, route.Annotations)
+}
+
+// ingressBackendPort returns the port of the service targeted by the ingress which replaces the route
+func (c *routeConfig) ingressBackendPort() networkingv1.ServiceBackendPort {
+	if c.targetPort.Type == intstr.Int {
+		return networkingv1.ServiceBackendPort{Number: c.targetPort.IntVal}
+	}
+	return networkingv1.ServiceBackendPort{Name: c.targetPort.StrVal}
+}
+
+// ingressAnnotations returns the annotations of the ingress which replaces the route
+func (c *routeConfig) ingressAnnotations() map[string]string {
+	annotations := map[string]string{}
+	if c.termination != routev1.TLSTerminationEdge {
+		// the service only serves HTTPS (supported by the NGINX ingress controller)
+		annotations["nginx.ingress.kubernetes.io/backend-protocol"] = "HTTPS"
+	}
+	for k, v := range c.annotations {
+		annotations[k] = v
+	}
+	return annotations
+}
@@ -0,0 +1,136 @@
+package wait_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSetupRouteForServiceWithOptions(t *testing.T) {
+	// given
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "toolchain-host-operator",
+			Name:      "registration-service",
+		},
+	}
+	key := types.NamespacedName{Namespace: "toolchain-host-operator", Name: "registration-service"}
+	opts := []wait.RouteOption{
+		wait.WithRouteTermination(routev1.TLSTerminationEdge),
+		wait.WithRouteTargetPort(intstr.FromInt(8080)),
+		wait.WithRouteHost("registration-service.apps.example.com"),
+		wait.WithRouteAnnotations(map[string]string{"haproxy.router.openshift.io/timeout": "5m"}),
+	}
+	newAwaitility := func(s *runtime.Scheme, objs ...runtime.Object) *wait.Awaitility {
+		return &wait.Awaitility{
+			Client:        fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build(),
+			Namespace:     "toolchain-host-operator",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+	}
+	s := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, routev1.AddToScheme(s))
+
+	t.Run("route created", func(t *testing.T) {
+		// given
+		a := newAwaitility(s, service)
+
+		// when
+		_, err := a.SetupRouteForService(t, "registration-service", "/", opts...)
+
+		// then
+		// the route is not admitted by a router
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+		route := &routev1.Route{}
+		require.NoError(t, a.Client.Get(context.TODO(), key, route))
+		assert.Equal(t, routev1.TLSTerminationEdge, route.Spec.TLS.Termination)
+		assert.Equal(t, intstr.FromInt(8080), route.Spec.Port.TargetPort)
+		assert.Equal(t, "registration-service.apps.example.com", route.Spec.Host)
+		assert.Equal(t, "registration-service", route.Spec.To.Name)
+		assert.Equal(t, map[string]string{"haproxy.router.openshift.io/timeout": "5m"}, route.Annotations)
+	})
+
+	t.Run("route created with the default options", func(t *testing.T) {
+		// given
+		a := newAwaitility(s, service)
+
+		// when
+		_, err := a.SetupRouteForService(t, "registration-service", "/")
+
+		// then
+		require.Error(t, err)
+		route := &routev1.Route{}
+		require.NoError(t, a.Client.Get(context.TODO(), key, route))
+		assert.Equal(t, routev1.TLSTerminationPassthrough, route.Spec.TLS.Termination)
+		assert.Equal(t, intstr.FromString("https"), route.Spec.Port.TargetPort)
+		assert.Empty(t, route.Spec.Host)
+		assert.Empty(t, route.Annotations)
+	})
+
+	t.Run("existing route updated", func(t *testing.T) {
+		// given
+		existing := &routev1.Route{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "toolchain-host-operator",
+				Name:        "registration-service",
+				Annotations: map[string]string{"openshift.io/host.generated": "true"},
+			},
+			Spec: routev1.RouteSpec{
+				Host: "registration-service-toolchain-host-operator.apps.example.com",
+				Port: &routev1.RoutePort{TargetPort: intstr.FromString("https")},
+				TLS:  &routev1.TLSConfig{Termination: routev1.TLSTerminationPassthrough},
+				To:   routev1.RouteTargetReference{Kind: "Service", Name: "registration-service"},
+			},
+		}
+		a := newAwaitility(s, service, existing)
+
+		// when
+		_, err := a.SetupRouteForService(t, "registration-service", "/", wait.WithRouteTermination(routev1.TLSTerminationReencrypt))
+
+		// then
+		require.Error(t, err)
+		route := &routev1.Route{}
+		require.NoError(t, a.Client.Get(context.TODO(), key, route))
+		assert.Equal(t, routev1.TLSTerminationReencrypt, route.Spec.TLS.Termination)
+		assert.Equal(t, intstr.FromString("https"), route.Spec.Port.TargetPort)
+		assert.Equal(t, "registration-service-toolchain-host-operator.apps.example.com", route.Spec.Host)
+		assert.Equal(t, map[string]string{"openshift.io/host.generated": "true"}, route.Annotations)
+	})
+
+	t.Run("ingress created without the Route API", func(t *testing.T) {
+		// given
+		s := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(s))
+		require.NoError(t, networkingv1.AddToScheme(s))
+		a := newAwaitility(s, service)
+
+		// when
+		_, err := a.SetupRouteForService(t, "registration-service", "/", opts...)
+
+		// then
+		require.Error(t, err)
+		ingress := &networkingv1.Ingress{}
+		require.NoError(t, a.Client.Get(context.TODO(), key, ingress))
+		require.Len(t, ingress.Spec.Rules, 1)
+		assert.Equal(t, "registration-service.apps.example.com", ingress.Spec.Rules[0].Host)
+		assert.Equal(t, networkingv1.ServiceBackendPort{Number: 8080}, ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Port)
+		// no `backend-protocol: HTTPS` annotation with the edge termination
+		assert.Equal(t, map[string]string{"haproxy.router.openshift.io/timeout": "5m"}, ingress.Annotations)
+	})
+}
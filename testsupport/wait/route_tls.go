@@ -0,0 +1,115 @@
+package wait
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"os"
+
+	testutil "github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
+
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// RouteCABundleVar the name of the env var with the path of a PEM bundle of additional CAs which are trusted when verifying the
+	// certificates served on the routes (eg: the CA of a custom ingress certificate), see WaitForRouteToBeAvailable
+	RouteCABundleVar = "E2E_ROUTE_CA_BUNDLE"
+	// IngressCANamespace the namespace of the ConfigMap with the CA of the default ingress certificate of an OpenShift cluster
+	IngressCANamespace = "openshift-config-managed"
+	// IngressCAConfigMapName the name of the ConfigMap with the CA of the default ingress certificate of an OpenShift cluster
+	IngressCAConfigMapName = "default-ingress-cert"
+	// ServiceCAConfigMapName the name of the ConfigMap with the CA of the serving certificates of the services, which is injected
+	// in all the namespaces of an OpenShift cluster
+	ServiceCAConfigMapName = "openshift-service-ca.crt"
+)
+
+// InsecureRoutes returns an option to skip the verification of the certificates served on the routes by the route waits (eg:
+// WaitForRouteToBeAvailable), instead of verifying them against the CAs of the cluster (see RouteTransport)
+func InsecureRoutes() RetryOption {
+	return insecureRoutes{}
+}
+
+type insecureRoutes struct{}
+
+var _ RetryOption = insecureRoutes{}
+
+func (o insecureRoutes) apply(a *Awaitility) {
+	a.insecureRoutes = true
+}
+
+// RouteTransport returns the transport used to call the endpoints of the given route, which verifies the certificate served on
+// the route against the CAs of the system, the CA of the default ingress certificate of the cluster, the service CA of the namespace
+// of the route and the CA bundle given with RouteCABundleVar, if any. The certificate served on a passthrough route, or on a reencrypt
+// route with a custom certificate (eg: the route created by ExposeConsolePlugin), is verified against the name of the service behind it
+// (eg: `host-operator-metrics-service.toolchain-host-operator.svc`), since it is the serving certificate of the service.
+// The certificates are not verified at all with InsecureRoutes.
+// The transports are cached by host and CAs, so that their connections are reused across the polls and the waits.
+func (a *Awaitility) RouteTransport(route routev1.Route) (*http.Transport, error) {
+	host := routeEndpoint(route).Host
+	if a.insecureRoutes || route.Spec.TLS == nil {
+		return a.cachedTransport("insecure|"+host, a.newInsecureTransport), nil
+	}
+	var bundles [][]byte
+	for _, key := range []struct {
+		namespacedName types.NamespacedName
+		dataKey        string
+	}{
+		{types.NamespacedName{Namespace: IngressCANamespace, Name: IngressCAConfigMapName}, "ca-bundle.crt"},
+		{types.NamespacedName{Namespace: route.Namespace, Name: ServiceCAConfigMapName}, "service-ca.crt"},
+	} {
+		cm := &corev1.ConfigMap{}
+		if err := a.Client.Get(a.getContext(), key.namespacedName, cm); err != nil {
+			// the CA is not available, eg: on a non-OpenShift cluster or when the user can't read it
+			if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) || runtime.IsNotRegisteredError(err) {
+				continue
+			}
+			return nil, err
+		}
+		if ca := cm.Data[key.dataKey]; ca != "" {
+			bundles = append(bundles, []byte(ca))
+		}
+	}
+	if path := CurrentEnvironment().RouteCABundle; path != "" {
+		ca, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the CA bundle of the routes: %w", err)
+		}
+		bundles = append(bundles, ca)
+	}
+	roots, err := testutil.CertPoolWith(bundles...)
+	if err != nil {
+		return nil, err
+	}
+	dnsName := ""
+	if servesServiceCertificate(route) {
+		dnsName = fmt.Sprintf("%s.%s.svc", route.Spec.To.Name, route.Namespace)
+	}
+	// the CAs may be rotated, in which case a new transport is needed
+	digest := sha256.New()
+	for _, bundle := range bundles {
+		digest.Write(bundle)
+	}
+	key := fmt.Sprintf("%s|%s|%x", host, dnsName, digest.Sum(nil))
+	return a.cachedTransport(key, func() *http.Transport {
+		return a.newTransport(testutil.VerifyingTLSConfig(roots, dnsName))
+	}), nil
+}
+
+// servesServiceCertificate returns `true` if the certificate served on the given route is the serving certificate of the service behind it:
+// the route passes the TLS connections through to the service, or it re-encrypts them with a custom certificate which, in the tests,
+// is the serving certificate of the service (it is not issued for the host of the route)
+func servesServiceCertificate(route routev1.Route) bool {
+	switch route.Spec.TLS.Termination {
+	case routev1.TLSTerminationPassthrough:
+		return true
+	case routev1.TLSTerminationReencrypt:
+		return route.Spec.TLS.Certificate != ""
+	default:
+		return false
+	}
+}
@@ -0,0 +1,151 @@
+package wait_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRouteTransport(t *testing.T) {
+	// given
+	caPEM, ca := newTestCA(t)
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	ts.TLS = &tls.Config{
+		Certificates: []tls.Certificate{newTestServingCert(t, ca, "member-operator-console-plugin.toolchain-member-operator.svc")},
+		MinVersion:   tls.VersionTLS12,
+	}
+	ts.StartTLS()
+	defer ts.Close()
+	serviceCA := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "toolchain-member-operator", Name: wait.ServiceCAConfigMapName},
+		Data:       map[string]string{"service-ca.crt": string(caPEM)},
+	}
+	a := &wait.Awaitility{
+		Client:        test.NewFakeClient(t, serviceCA),
+		Namespace:     "toolchain-member-operator",
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       time.Second,
+	}
+	newRoute := func(tlsConfig *routev1.TLSConfig) routev1.Route {
+		return routev1.Route{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "toolchain-member-operator", Name: "consolepluginroute"},
+			Spec: routev1.RouteSpec{
+				To:  routev1.RouteTargetReference{Kind: "Service", Name: "member-operator-console-plugin"},
+				TLS: tlsConfig,
+			},
+			Status: routev1.RouteStatus{
+				Ingress: []routev1.RouteIngress{{Host: strings.TrimPrefix(ts.URL, "https://")}},
+			},
+		}
+	}
+
+	t.Run("reencrypt route with the serving certificate of the service", func(t *testing.T) {
+		// given
+		route := newRoute(&routev1.TLSConfig{Termination: routev1.TLSTerminationReencrypt, Certificate: "tls.crt", Key: "tls.key"})
+
+		// when
+		transport, err := a.RouteTransport(route)
+
+		// then
+		require.NoError(t, err)
+		resp, err := (&http.Client{Transport: transport}).Get(ts.URL)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("passthrough route", func(t *testing.T) {
+		// given
+		route := newRoute(&routev1.TLSConfig{Termination: routev1.TLSTerminationPassthrough})
+
+		// when
+		transport, err := a.RouteTransport(route)
+
+		// then
+		require.NoError(t, err)
+		resp, err := (&http.Client{Transport: transport}).Get(ts.URL)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("reencrypt route with the default certificate", func(t *testing.T) {
+		// given
+		route := newRoute(&routev1.TLSConfig{Termination: routev1.TLSTerminationReencrypt})
+
+		// when
+		transport, err := a.RouteTransport(route)
+
+		// then
+		require.NoError(t, err)
+		_, err = (&http.Client{Transport: transport}).Get(ts.URL) // nolint:bodyclose
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "x509: cannot validate certificate for 127.0.0.1")
+	})
+}
+
+// newTestCA returns the PEM and the certificate (with its key) of a new self-signed CA
+func newTestCA(t *testing.T) ([]byte, tls.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "e2e-test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+		Leaf:        cert,
+	}
+}
+
+// newTestServingCert returns a new serving certificate for the given DNS name, issued by the given CA
+func newTestServingCert(t *testing.T, ca tls.Certificate, dnsName string) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.Leaf, &key.PublicKey, ca.PrivateKey)
+	require.NoError(t, err)
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}
+}
@@ -0,0 +1,163 @@
+package wait
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/httpclient"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// routeURL returns the URL of the given endpoint of the route, on the host of its (first) Ingress
+func routeURL(route routev1.Route, endpoint string) string {
+	return routeEndpoint(route).URL(endpoint)
+}
+
+// RouteRequestOption an option to configure the request sent by WaitForRouteToBeAvailable to verify that a route is available,
+// and the response which is expected
+type RouteRequestOption func(*routeRequest)
+
+var _ retryOptionsCarrier[RouteRequestOption] = RouteRequestOption(nil)
+
+func (RouteRequestOption) withRetryOptions(options []RetryOption) RouteRequestOption {
+	return func(r *routeRequest) {
+		r.retryOptions = append(r.retryOptions, options...)
+	}
+}
+
+type routeRequest struct {
+	method  string
+	header  http.Header
+	body    string
+	expect  func(status int, body []byte) bool
+	summary string
+	// retryOptions the RetryOptions of the wait (see WaitWith)
+	retryOptions []RetryOption
+}
+
+func newRouteRequest(opts ...RouteRequestOption) *routeRequest {
+	r := &routeRequest{
+		method:  http.MethodGet,
+		header:  http.Header{},
+		summary: "status 200",
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.expect == nil {
+		r.expect = func(status int, _ []byte) bool {
+			return status == http.StatusOK
+		}
+	}
+	return r
+}
+
+// newHTTPRequest returns a new HTTP request to the given URL
+func (r *routeRequest) newHTTPRequest(url string) (*http.Request, error) {
+	var body io.Reader
+	if r.body != "" {
+		body = strings.NewReader(r.body)
+	}
+	request, err := http.NewRequest(r.method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range r.header {
+		request.Header[key] = values
+	}
+	return request, nil
+}
+
+// WithRequestMethod an option to send a request with the given method (eg: `POST`) instead of a `GET` request
+func WithRequestMethod(method string) RouteRequestOption {
+	return func(r *routeRequest) {
+		r.method = method
+	}
+}
+
+// WithRequestHeader an option to send a request with the given header. An `Authorization` header replaces the bearer token which is
+// sent by default on the TLS routes
+func WithRequestHeader(key, value string) RouteRequestOption {
+	return func(r *routeRequest) {
+		r.header.Add(key, value)
+	}
+}
+
+// WithRequestBody an option to send a request with the given body (eg: a JSON payload, along with a `Content-Type` header)
+func WithRequestBody(body string) RouteRequestOption {
+	return func(r *routeRequest) {
+		r.body = body
+	}
+}
+
+// ExpectStatus an option to consider the route as available when the response has one of the given statuses, instead of `200 OK`
+// (eg: `401 Unauthorized` for an endpoint which requires another token than the one of the test client)
+func ExpectStatus(statuses ...int) RouteRequestOption {
+	return func(r *routeRequest) {
+		r.summary = fmt.Sprintf("status in %v", statuses)
+		r.expect = func(status int, _ []byte) bool {
+			for _, s := range statuses {
+				if status == s {
+					return true
+				}
+			}
+			return false
+		}
+	}
+}
+
+// ExpectResponse an option to consider the route as available when the given predicate matches the status and the body of the response,
+// instead of when the response has the `200 OK` status
+func ExpectResponse(description string, predicate func(status int, body []byte) bool) RouteRequestOption {
+	return func(r *routeRequest) {
+		r.summary = description
+		r.expect = predicate
+	}
+}
+
+// WaitForRecreatedRoute waits until the route with the given name was recreated by its operator, ie: until it exists with another UID
+// than the given one (eg: after the route was deleted, or after a change of the configuration which requires the route to be replaced),
+// and until it is available with its new spec (see WaitForRouteToBeAvailable)
+func (a *Awaitility) WaitForRecreatedRoute(t T, ns, name string, deletedUID types.UID, endpoint string, opts ...RouteRequestOption) (routev1.Route, error) {
+	recordWaiter(t)
+	if _, err := WaitForRecreatedObject[*routev1.Route](t, a, types.NamespacedName{Namespace: ns, Name: name}, deletedUID); err != nil {
+		return routev1.Route{}, err
+	}
+	return a.WaitForRouteToBeAvailable(t, ns, name, endpoint, opts...)
+}
+
+// WaitUntilRouteStopsServing waits until the given endpoint is no longer served on the host of the given route (eg: the host of a route which was
+// deleted or recreated with another host), ie: until the request fails or until its response has another status than `200 OK`
+// (the router responds with `503 Service Unavailable` for the unknown hosts)
+func (a *Awaitility) WaitUntilRouteStopsServing(t T, route routev1.Route, endpoint string) error {
+	recordWaiter(t)
+	u := routeURL(route, endpoint)
+	a.logf(t, "waiting until '%s' is no longer served by route '%s' in namespace '%s'", u, route.Name, route.Namespace)
+	client := httpclient.New(httpclient.WithTimeout(5*time.Second), httpclient.WithTransport(a.newInsecureTransport()))
+	return a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		request, err := http.NewRequestWithContext(a.getContext(), "GET", u, nil)
+		if err != nil {
+			return false, err
+		}
+		resp, err := client.Do(request)
+		urlError := &url.Error{}
+		if errors.As(err, &urlError) && urlError.Timeout() {
+			// keep waiting: the route may still be served, but slowly
+			return false, nil
+		} else if err != nil {
+			// the host can't be resolved or the connection is refused
+			return true, nil // nolint:nilerr
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		return resp.StatusCode != http.StatusOK, nil
+	})
+}
@@ -0,0 +1,324 @@
+package wait_test
+
+import (
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWaitForRecreatedRoute(t *testing.T) {
+	// given
+	s := runtime.NewScheme()
+	require.NoError(t, routev1.AddToScheme(s))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	newRoute := func(uid types.UID) *routev1.Route {
+		return &routev1.Route{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "toolchain-host-operator",
+				Name:      "api",
+				UID:       uid,
+			},
+			Status: routev1.RouteStatus{
+				Ingress: []routev1.RouteIngress{
+					{
+						Host: strings.TrimPrefix(server.URL, "http://"),
+					},
+				},
+			},
+		}
+	}
+	newAwaitility := func(route *routev1.Route) *wait.Awaitility {
+		return &wait.Awaitility{
+			Client:        fake.NewClientBuilder().WithScheme(s).WithObjects(route).Build(),
+			Namespace:     "toolchain-host-operator",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+	}
+
+	t.Run("recreated", func(t *testing.T) {
+		// given
+		a := newAwaitility(newRoute("new-uid"))
+
+		// when
+		route, err := a.WaitForRecreatedRoute(t, "toolchain-host-operator", "api", "old-uid", "/proxyhealth")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, types.UID("new-uid"), route.UID)
+	})
+
+	t.Run("not recreated", func(t *testing.T) {
+		// given
+		a := newAwaitility(newRoute("old-uid"))
+
+		// when
+		_, err := a.WaitForRecreatedRoute(t, "toolchain-host-operator", "api", "old-uid", "/proxyhealth")
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+		assert.Contains(t, wait.LastState(err), "expected Route 'api' to be recreated, but it still has the UID of the deleted object: old-uid")
+	})
+}
+
+func TestWaitUntilRouteStopsServing(t *testing.T) {
+	// given
+	a := &wait.Awaitility{
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       100 * time.Millisecond,
+	}
+	routeTo := func(server *httptest.Server) routev1.Route {
+		return routev1.Route{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "toolchain-host-operator",
+				Name:      "api",
+			},
+			Status: routev1.RouteStatus{
+				Ingress: []routev1.RouteIngress{
+					{
+						Host: strings.TrimPrefix(server.URL, "http://"),
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("not served anymore", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable) // what the router responds for an unknown host
+		}))
+		defer server.Close()
+
+		// when
+		err := a.WaitUntilRouteStopsServing(t, routeTo(server), "/proxyhealth")
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("host not reachable", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		route := routeTo(server)
+		server.Close()
+
+		// when
+		err := a.WaitUntilRouteStopsServing(t, route, "/proxyhealth")
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("still served", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		// when
+		err := a.WaitUntilRouteStopsServing(t, routeTo(server), "/proxyhealth")
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+	})
+}
+
+func TestWaitForRouteToBeAvailableVerifiesCertificate(t *testing.T) {
+	// given
+	s := runtime.NewScheme()
+	require.NoError(t, routev1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	route := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "toolchain-host-operator",
+			Name:      "registration-service",
+		},
+		Spec: routev1.RouteSpec{
+			TLS: &routev1.TLSConfig{
+				Termination: routev1.TLSTerminationEdge,
+			},
+		},
+		Status: routev1.RouteStatus{
+			Ingress: []routev1.RouteIngress{
+				{
+					Host: strings.TrimPrefix(server.URL, "https://"),
+				},
+			},
+		},
+	}
+	ingressCA := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: wait.IngressCANamespace,
+			Name:      wait.IngressCAConfigMapName,
+		},
+		Data: map[string]string{
+			"ca-bundle.crt": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})),
+		},
+	}
+	newAwaitility := func(objs ...runtime.Object) *wait.Awaitility {
+		return &wait.Awaitility{
+			Client:        fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build(),
+			RestConfig:    &rest.Config{BearerToken: "token"},
+			Namespace:     "toolchain-host-operator",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+	}
+
+	t.Run("trusted by the ingress CA", func(t *testing.T) {
+		// when
+		_, err := newAwaitility(route, ingressCA).WaitForRouteToBeAvailable(t, route.Namespace, route.Name, "/")
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown authority", func(t *testing.T) {
+		// when
+		_, err := newAwaitility(route).WaitForRouteToBeAvailable(t, route.Namespace, route.Name, "/")
+
+		// then
+		require.Error(t, err)
+		assert.False(t, wait.IsTimeout(err))
+		assert.Contains(t, err.Error(), "certificate signed by unknown authority")
+	})
+
+	t.Run("trusted by the CA bundle of the env var", func(t *testing.T) {
+		// given
+		bundle := filepath.Join(t.TempDir(), "ca.crt")
+		require.NoError(t, os.WriteFile(bundle, []byte(ingressCA.Data["ca-bundle.crt"]), 0o600))
+		wait.OverrideEnvironment(t, func(env *wait.Environment) {
+			env.RouteCABundle = bundle
+		})
+
+		// when
+		_, err := newAwaitility(route).WaitForRouteToBeAvailable(t, route.Namespace, route.Name, "/")
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("insecure", func(t *testing.T) {
+		// when
+		_, err := newAwaitility(route).WithRetryOptions(wait.InsecureRoutes()).WaitForRouteToBeAvailable(t, route.Namespace, route.Name, "/")
+
+		// then
+		require.NoError(t, err)
+	})
+}
+
+func TestWaitForRouteToBeAvailableWithRequest(t *testing.T) {
+	// given
+	s := runtime.NewScheme()
+	require.NoError(t, routev1.AddToScheme(s))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method != http.MethodPost:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		case r.Header.Get("Authorization") != "Bearer user-token":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			body, _ := io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write(body)
+		}
+	}))
+	defer server.Close()
+	route := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "toolchain-host-operator",
+			Name:      "registration-service",
+		},
+		Status: routev1.RouteStatus{
+			Ingress: []routev1.RouteIngress{
+				{
+					Host: strings.TrimPrefix(server.URL, "http://"),
+				},
+			},
+		},
+	}
+	a := &wait.Awaitility{
+		Client:        fake.NewClientBuilder().WithScheme(s).WithObjects(route).Build(),
+		Namespace:     "toolchain-host-operator",
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       100 * time.Millisecond,
+	}
+
+	t.Run("default GET request", func(t *testing.T) {
+		// when
+		_, err := a.WaitForRouteToBeAvailable(t, route.Namespace, route.Name, "/api/v1/signup")
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+	})
+
+	t.Run("expected status", func(t *testing.T) {
+		// when
+		_, err := a.WaitForRouteToBeAvailable(t, route.Namespace, route.Name, "/api/v1/signup",
+			wait.ExpectStatus(http.StatusUnauthorized, http.StatusMethodNotAllowed))
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("configured request", func(t *testing.T) {
+		// when
+		_, err := a.WaitForRouteToBeAvailable(t, route.Namespace, route.Name, "/api/v1/signup",
+			wait.WithRequestMethod(http.MethodPost),
+			wait.WithRequestHeader("Authorization", "Bearer user-token"),
+			wait.WithRequestBody(`{"phone":"+1234"}`),
+			wait.ExpectResponse("202 with the payload", func(status int, body []byte) bool {
+				return status == http.StatusAccepted && string(body) == `{"phone":"+1234"}`
+			}))
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("unexpected response", func(t *testing.T) {
+		// given
+		out := &strings.Builder{}
+
+		// when
+		ok := wait.RunStandalone(t.Name(), out, func(st wait.T) {
+			_, err := a.WaitForRouteToBeAvailable(st, route.Namespace, route.Name, "/api/v1/signup",
+				wait.WithRequestMethod(http.MethodPost),
+				wait.ExpectStatus(http.StatusAccepted))
+			require.Error(st, err)
+		})
+
+		// then
+		assert.True(t, ok)
+		assert.Contains(t, out.String(), "last response from route 'registration-service' (expected status in [202]): 401 Unauthorized")
+	})
+}
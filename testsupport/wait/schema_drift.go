@@ -0,0 +1,153 @@
+package wait
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SchemaDrift a field which is declared in the vendored API types but not in the schema of the CRD installed in the cluster,
+// or the other way around
+type SchemaDrift struct {
+	Kind string
+	// Path the JSON path of the field, eg: `.spec.tierName`
+	Path string
+	// MissingInCluster is true when the field is declared in the vendored types but not in the CRD installed in the cluster,
+	// and false when the field is declared in the CRD but not in the vendored types
+	MissingInCluster bool
+}
+
+func (d SchemaDrift) String() string {
+	if d.MissingInCluster {
+		return fmt.Sprintf("%s%s: declared in the vendored types but missing in the CRD of the cluster", d.Kind, d.Path)
+	}
+	return fmt.Sprintf("%s%s: declared in the CRD of the cluster but missing in the vendored types", d.Kind, d.Path)
+}
+
+// SchemaDrifts compares the JSON fields of the types of the given group/version registered in the scheme of the client
+// with the `openAPIV3Schema` of the corresponding CRDs installed in the cluster, and returns the fields which are declared
+// on one side only. Types which have no CRD in the cluster (eg: the member types on the host cluster) are ignored.
+func (a *Awaitility) SchemaDrifts(gv schema.GroupVersion) ([]SchemaDrift, error) {
+	crds := &unstructured.UnstructuredList{}
+	crds.SetGroupVersionKind(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinitionList"})
+	if err := a.Client.List(a.getContext(), crds); err != nil {
+		return nil, err
+	}
+	schemas := map[string]map[string]interface{}{}
+	for _, crd := range crds.Items {
+		if group, _, _ := unstructured.NestedString(crd.Object, "spec", "group"); group != gv.Group {
+			continue
+		}
+		kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+		versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+		for _, v := range versions {
+			version, ok := v.(map[string]interface{})
+			if !ok || version["name"] != gv.Version {
+				continue
+			}
+			if s, found, _ := unstructured.NestedMap(version, "schema", "openAPIV3Schema"); found {
+				schemas[kind] = s
+			}
+		}
+	}
+
+	var drifts []SchemaDrift
+	for kind, goType := range a.Client.Scheme().KnownTypes(gv) {
+		s, found := schemas[kind]
+		if !found {
+			continue
+		}
+		drifts = append(drifts, compareSchema(kind, "", goType, s)...)
+	}
+	sort.Slice(drifts, func(i, j int) bool {
+		if drifts[i].Kind != drifts[j].Kind {
+			return drifts[i].Kind < drifts[j].Kind
+		}
+		return drifts[i].Path < drifts[j].Path
+	})
+	return drifts, nil
+}
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// compareSchema compares the JSON fields of the given Go type with the properties of the given OpenAPI schema, recursively.
+// Types with a custom JSON representation (eg: `metav1.Time`, `resource.Quantity`) and schemas which don't declare their
+// properties (eg: `metadata`, or fields with `x-kubernetes-preserve-unknown-fields`) are not compared any further.
+func compareSchema(kind, path string, goType reflect.Type, s map[string]interface{}) []SchemaDrift {
+	for goType.Kind() == reflect.Pointer {
+		goType = goType.Elem()
+	}
+	if goType.Implements(jsonMarshalerType) || reflect.PointerTo(goType).Implements(jsonMarshalerType) {
+		return nil
+	}
+	switch goType.Kind() {
+	case reflect.Struct:
+		properties, ok := s["properties"].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		var drifts []SchemaDrift
+		fields := jsonFields(goType)
+		for name, field := range fields {
+			property, found := properties[name]
+			if !found {
+				drifts = append(drifts, SchemaDrift{Kind: kind, Path: path + "." + name, MissingInCluster: true})
+				continue
+			}
+			if propertySchema, ok := property.(map[string]interface{}); ok {
+				drifts = append(drifts, compareSchema(kind, path+"."+name, field, propertySchema)...)
+			}
+		}
+		for name := range properties {
+			if _, found := fields[name]; !found {
+				drifts = append(drifts, SchemaDrift{Kind: kind, Path: path + "." + name})
+			}
+		}
+		return drifts
+	case reflect.Slice, reflect.Array:
+		if items, ok := s["items"].(map[string]interface{}); ok && goType.Elem().Kind() != reflect.Uint8 {
+			return compareSchema(kind, path+"[]", goType.Elem(), items)
+		}
+	case reflect.Map:
+		if values, ok := s["additionalProperties"].(map[string]interface{}); ok {
+			return compareSchema(kind, path+"[*]", goType.Elem(), values)
+		}
+	}
+	return nil
+}
+
+// jsonFields returns the types of the fields of the given struct, indexed by their JSON name. The fields of the
+// embedded structs without a JSON name (eg: `metav1.TypeMeta` with its `json:",inline"` tag) are promoted.
+func jsonFields(structType reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" || (!field.IsExported() && !field.Anonymous) {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if name == "" && field.Anonymous {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for n, f := range jsonFields(embedded) {
+					fields[n] = f
+				}
+				continue
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
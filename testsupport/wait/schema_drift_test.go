@@ -0,0 +1,87 @@
+package wait_test
+
+import (
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSchemaDrifts(t *testing.T) {
+
+	newAwaitility := func(specProperties map[string]interface{}) *wait.Awaitility {
+		crd := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"name": "bannedusers.toolchain.dev.openshift.com",
+			},
+			"spec": map[string]interface{}{
+				"group": toolchainv1alpha1.GroupVersion.Group,
+				"names": map[string]interface{}{"kind": "BannedUser"},
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": toolchainv1alpha1.GroupVersion.Version,
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{"type": "string"},
+									"kind":       map[string]interface{}{"type": "string"},
+									"metadata":   map[string]interface{}{"type": "object"},
+									"spec": map[string]interface{}{
+										"type":       "object",
+										"properties": specProperties,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}}
+		return &wait.Awaitility{
+			Client:        test.NewFakeClient(t, crd),
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+	}
+
+	t.Run("no drift", func(t *testing.T) {
+		// given
+		a := newAwaitility(map[string]interface{}{
+			"email": map[string]interface{}{"type": "string"},
+		})
+
+		// when
+		drifts, err := a.SchemaDrifts(toolchainv1alpha1.GroupVersion)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, drifts)
+	})
+
+	t.Run("drift in both directions", func(t *testing.T) {
+		// given
+		a := newAwaitility(map[string]interface{}{
+			"phoneNumber": map[string]interface{}{"type": "string"},
+		})
+
+		// when
+		drifts, err := a.SchemaDrifts(toolchainv1alpha1.GroupVersion)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []wait.SchemaDrift{
+			{Kind: "BannedUser", Path: ".spec.email", MissingInCluster: true},
+			{Kind: "BannedUser", Path: ".spec.phoneNumber"},
+		}, drifts)
+		assert.Equal(t, "BannedUser.spec.email: declared in the vendored types but missing in the CRD of the cluster", drifts[0].String())
+	})
+}
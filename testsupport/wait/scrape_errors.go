@@ -0,0 +1,51 @@
+package wait
+
+import (
+	"fmt"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+)
+
+// FailAfterScrapeErrors returns an option to fail the metric waits (eg: TryWaitUntilMetricHasValue) as soon as the metrics endpoint
+// could not be scraped the given number of consecutive times (eg: because of an invalid token or a broken route), with the error of
+// the last scrape, instead of waiting until the timeout as if the metric did not have the expected value.
+// It is disabled by default (and with a number of 0 or less), ie: the metric waits keep retrying until their timeout regardless of
+// the scrape errors, since the endpoint is expected to be unavailable for a while in some tests, eg: after a restart of the operator.
+func FailAfterScrapeErrors(n int) RetryOption {
+	if n < 0 {
+		n = 0
+	}
+	return maxScrapeErrors(n)
+}
+
+type maxScrapeErrors int
+
+var _ RetryOption = maxScrapeErrors(0)
+
+func (o maxScrapeErrors) apply(a *Awaitility) {
+	a.maxScrapeErrors = int(o)
+}
+
+// scrapeErrors counts the consecutive scrape errors of a metric wait (see FailAfterScrapeErrors)
+type scrapeErrors struct {
+	max   int
+	count int
+}
+
+func (a *Awaitility) newScrapeErrors() *scrapeErrors {
+	return &scrapeErrors{max: a.maxScrapeErrors}
+}
+
+// check records the error of a call to the metrics client, and returns an error when it is the scrape error which reached
+// the maximum number of consecutive scrape errors, so that the condition of the wait fails
+func (s *scrapeErrors) check(err error) error {
+	if !metrics.IsScrapeError(err) {
+		s.count = 0
+		return nil
+	}
+	s.count++
+	if s.max > 0 && s.count >= s.max {
+		return fmt.Errorf("unable to scrape the metrics endpoint %d consecutive times: %w", s.count, err)
+	}
+	return nil
+}
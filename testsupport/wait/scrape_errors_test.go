@@ -0,0 +1,88 @@
+package wait_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailAfterScrapeErrors(t *testing.T) {
+	// given
+	unauthorized := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer unauthorized.Close()
+	empty := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer empty.Close()
+	newAwaitility := func(ts *httptest.Server) *wait.Awaitility {
+		return &wait.Awaitility{
+			Client:        test.NewFakeClient(t),
+			Namespace:     "toolchain-host-operator",
+			RetryInterval: 5 * time.Millisecond,
+			Timeout:       10 * time.Second,
+			MetricsClient: metrics.NewClient(metrics.StaticEndpoint(strings.TrimPrefix(ts.URL, "https://")), metrics.StaticToken("token"), metrics.WithCacheTTL(0)),
+		}
+	}
+
+	t.Run("fail fast on scrape errors", func(t *testing.T) {
+		// given
+		a := newAwaitility(unauthorized).WithRetryOptions(wait.FailAfterScrapeErrors(3))
+		start := time.Now()
+
+		// when
+		err := a.TryWaitUntilMetricHasValue(t, wait.SpacesMetric, 1, "cluster_name", "member-1")
+
+		// then
+		require.Error(t, err)
+		assert.False(t, wait.IsTimeout(err))
+		assert.True(t, metrics.IsScrapeError(err))
+		assert.Contains(t, err.Error(), "unable to scrape the metrics endpoint 3 consecutive times: unexpected response status from the metrics endpoint")
+		assert.Contains(t, err.Error(), "401 Unauthorized")
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		// given
+		a := newAwaitility(unauthorized).WithRetryOptions(wait.Within(200 * time.Millisecond))
+
+		// when
+		err := a.WaitUntilMetricHasValueOrMore(t, wait.SpacesMetric, 1, "cluster_name", "member-1")
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		// given
+		a := newAwaitility(unauthorized).WithRetryOptions(wait.FailAfterScrapeErrors(0), wait.Within(200*time.Millisecond))
+
+		// when
+		err := a.TryWaitUntilMetricHasValue(t, wait.SpacesMetric, 1, "cluster_name", "member-1")
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+	})
+
+	t.Run("missing metric is not a scrape error", func(t *testing.T) {
+		// given
+		a := newAwaitility(empty).WithRetryOptions(wait.FailAfterScrapeErrors(3), wait.Within(200*time.Millisecond))
+
+		// when
+		err := a.TryWaitUntilMetricHasValue(t, wait.SpacesMetric, 1, "cluster_name", "member-1")
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+	})
+}
@@ -0,0 +1,141 @@
+package wait
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/httpclient"
+
+	routev1 "github.com/openshift/api/route/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// OpenShiftMonitoringNamespace the namespace of the OpenShift monitoring stack
+	OpenShiftMonitoringNamespace = "openshift-monitoring"
+	// PrometheusRouteName the name of the route to the Prometheus instance of the OpenShift monitoring stack
+	PrometheusRouteName = "prometheus-k8s"
+)
+
+// ServiceMonitorGVK the GroupVersionKind of the ServiceMonitors of the Prometheus operator (whose API types are not vendored)
+var ServiceMonitorGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "ServiceMonitor"}
+
+// WaitForServiceMonitor waits until there's a ServiceMonitor with the given name in the current namespace
+func (a *Awaitility) WaitForServiceMonitor(t T, name string) (*unstructured.Unstructured, error) {
+	recordWaiter(t)
+	a.logf(t, "waiting for ServiceMonitor '%s' in namespace '%s'", name, a.Namespace)
+	var serviceMonitor *unstructured.Unstructured
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		serviceMonitor = &unstructured.Unstructured{}
+		serviceMonitor.SetGroupVersionKind(ServiceMonitorGVK)
+		if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: a.Namespace, Name: name}, serviceMonitor); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	})
+	return serviceMonitor, err
+}
+
+// GetPrometheusURL returns the URL of the Prometheus instance of the OpenShift monitoring stack
+func (a *Awaitility) GetPrometheusURL() (string, error) {
+	route := &routev1.Route{}
+	if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: OpenShiftMonitoringNamespace, Name: PrometheusRouteName}, route); err != nil {
+		return "", err
+	}
+	return "https://" + route.Spec.Host, nil
+}
+
+// WaitUntilServiceMonitorIsScraped waits until the targets of the ServiceMonitor with the given name in the current namespace are scraped
+// successfully by the Prometheus instance at the given URL (see GetPrometheusURL), ie: until the targets API of Prometheus reports
+// at least one active target for the ServiceMonitor, and all of them are up. This verifies that the metrics are actually flowing,
+// not only that the ServiceMonitor exists.
+// If the targets are not all up before the timeout, then the returned error contains their last scrape errors.
+func (a *Awaitility) WaitUntilServiceMonitorIsScraped(t T, prometheusURL, name string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting until the targets of the ServiceMonitor '%s' in namespace '%s' are scraped by Prometheus", name, a.Namespace)
+	client := httpclient.New(httpclient.WithTimeout(10*time.Second), httpclient.WithTransport(a.newInsecureTransport()),
+		httpclient.WithTokenProvider(a.TokenProvider()))
+	var health []string
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		req, err := http.NewRequest("GET", strings.TrimSuffix(prometheusURL, "/")+"/api/v1/targets?state=active", nil)
+		if err != nil {
+			return false, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			// Prometheus may not be reachable yet
+			health = []string{err.Error()}
+			return false, nil
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return false, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			health = []string{fmt.Sprintf("unexpected status of the targets API: %d (%s)", resp.StatusCode, string(body))}
+			return false, nil
+		}
+		var up bool
+		up, health, err = serviceMonitorTargetsHealth(body, a.Namespace, name)
+		return up, err
+	})
+	if err != nil {
+		return fmt.Errorf("targets of the ServiceMonitor '%s' in namespace '%s' not scraped successfully: %s: %w", name, a.Namespace, strings.Join(health, "; "), err)
+	}
+	return nil
+}
+
+// prometheusTargets the response of the targets API of Prometheus
+type prometheusTargets struct {
+	Status string `json:"status"`
+	Data   struct {
+		ActiveTargets []struct {
+			ScrapePool string `json:"scrapePool"`
+			ScrapeURL  string `json:"scrapeUrl"`
+			Health     string `json:"health"`
+			LastError  string `json:"lastError"`
+		} `json:"activeTargets"`
+	} `json:"data"`
+}
+
+// serviceMonitorTargetsHealth returns true if the given response of the targets API contains at least one target of the ServiceMonitor
+// with the given namespace and name, and if all these targets are up. Also returns the health of each target (or the reason why
+// there is none), to report it in case of timeout.
+func serviceMonitorTargetsHealth(body []byte, namespace, name string) (bool, []string, error) {
+	targets := prometheusTargets{}
+	if err := json.Unmarshal(body, &targets); err != nil {
+		return false, nil, fmt.Errorf("unable to parse the response of the targets API: %w", err)
+	}
+	// the scrape pools of the ServiceMonitors are named `serviceMonitor/<namespace>/<name>/<endpoint index>` by the Prometheus operator
+	scrapePool := fmt.Sprintf("serviceMonitor/%s/%s/", namespace, name)
+	var health []string
+	up := true
+	for _, target := range targets.Data.ActiveTargets {
+		if !strings.HasPrefix(target.ScrapePool, scrapePool) {
+			continue
+		}
+		if target.Health != "up" {
+			up = false
+			health = append(health, fmt.Sprintf("%s is %s: %s", target.ScrapeURL, target.Health, target.LastError))
+			continue
+		}
+		health = append(health, fmt.Sprintf("%s is up", target.ScrapeURL))
+	}
+	if len(health) == 0 {
+		return false, []string{"no active target"}, nil
+	}
+	return up, health, nil
+}
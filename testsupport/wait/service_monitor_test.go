@@ -0,0 +1,113 @@
+package wait_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestWaitForServiceMonitor(t *testing.T) {
+
+	newAwaitility := func(t *testing.T, objs ...runtime.Object) *wait.Awaitility {
+		return &wait.Awaitility{
+			Client:        test.NewFakeClient(t, objs...),
+			Namespace:     "toolchain-host-operator",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+	}
+	serviceMonitor := &unstructured.Unstructured{}
+	serviceMonitor.SetGroupVersionKind(wait.ServiceMonitorGVK)
+	serviceMonitor.SetNamespace("toolchain-host-operator")
+	serviceMonitor.SetName("host-operator-metrics")
+
+	t.Run("found", func(t *testing.T) {
+		// given
+		a := newAwaitility(t, serviceMonitor.DeepCopy())
+
+		// when
+		actual, err := a.WaitForServiceMonitor(t, "host-operator-metrics")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "host-operator-metrics", actual.GetName())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// given
+		a := newAwaitility(t)
+
+		// when
+		_, err := a.WaitForServiceMonitor(t, "host-operator-metrics")
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestWaitUntilServiceMonitorIsScraped(t *testing.T) {
+
+	newPrometheus := func(targets string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v1/targets" || r.URL.Query().Get("state") != "active" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(`{"status":"success","data":{"activeTargets":[` + targets + `]}}`))
+		}))
+	}
+	a := &wait.Awaitility{
+		Namespace:     "toolchain-host-operator",
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       100 * time.Millisecond,
+	}
+	otherTarget := `{"scrapePool":"serviceMonitor/toolchain-member-operator/member-operator-metrics/0","scrapeUrl":"https://10.0.0.2:8443/metrics","health":"down","lastError":"connection refused"}`
+
+	t.Run("all targets up", func(t *testing.T) {
+		// given
+		prometheus := newPrometheus(otherTarget + `,{"scrapePool":"serviceMonitor/toolchain-host-operator/host-operator-metrics/0","scrapeUrl":"https://10.0.0.1:8443/metrics","health":"up","lastError":""}`)
+		defer prometheus.Close()
+
+		// when
+		err := a.WaitUntilServiceMonitorIsScraped(t, prometheus.URL, "host-operator-metrics")
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("target down", func(t *testing.T) {
+		// given
+		prometheus := newPrometheus(`{"scrapePool":"serviceMonitor/toolchain-host-operator/host-operator-metrics/0","scrapeUrl":"https://10.0.0.1:8443/metrics","health":"up","lastError":""},` +
+			`{"scrapePool":"serviceMonitor/toolchain-host-operator/host-operator-metrics/0","scrapeUrl":"https://10.0.0.3:8443/metrics","health":"down","lastError":"server returned HTTP status 403 Forbidden"}`)
+		defer prometheus.Close()
+
+		// when
+		err := a.WaitUntilServiceMonitorIsScraped(t, prometheus.URL, "host-operator-metrics")
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "https://10.0.0.3:8443/metrics is down: server returned HTTP status 403 Forbidden")
+	})
+
+	t.Run("no target", func(t *testing.T) {
+		// given
+		prometheus := newPrometheus(otherTarget)
+		defer prometheus.Close()
+
+		// when
+		err := a.WaitUntilServiceMonitorIsScraped(t, prometheus.URL, "host-operator-metrics")
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no active target")
+	})
+}
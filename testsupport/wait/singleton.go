@@ -0,0 +1,61 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DeleteSingleton deletes the singleton object of type `T` with the given key (eg: the ToolchainConfig, the ToolchainStatus or the MemberStatus)
+// along with its dependents, and waits until the object is gone, ie: until its finalizers (if any) were processed, or until it was already
+// recreated by its operator. Returns the UID of the deleted object, so that the recreated object cannot be mistaken for the deleted one
+// (see WaitForRecreatedObject).
+func DeleteSingleton[T client.Object](t testingT, a *Awaitility, key types.NamespacedName) (types.UID, error) {
+	recordWaiter(t)
+	kind := objectKind[T]()
+	obj := newObject[T]()
+	if err := a.Client.Get(a.getContext(), key, obj); err != nil {
+		return "", err
+	}
+	uid := obj.GetUID()
+	a.logf(t, "deleting %s '%s' (uid=%s)", kind, key.String(), uid)
+	if err := a.Client.Delete(context.TODO(), obj, client.Preconditions{UID: &uid}, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+		return "", err
+	}
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		if err := a.Client.Get(a.getContext(), key, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		return obj.GetUID() != uid, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("%s '%s' (uid=%s) still exists: %w", kind, key.String(), uid, err)
+	}
+	return uid, nil
+}
+
+// WaitForRecreatedObject waits until the object of type `T` with the given key was recreated, ie: until it exists with another UID than
+// the given one (see DeleteSingleton), and until it matches all the given criteria (eg: the expected default values).
+func WaitForRecreatedObject[T client.Object](t testingT, a *Awaitility, key types.NamespacedName, deletedUID types.UID, criteria ...Criterion[T]) (T, error) {
+	return WaitForObject(t, a, key, append([]Criterion[T]{UntilObjectHasNotUID[T](deletedUID)}, criteria...)...)
+}
+
+// UntilObjectHasNotUID returns a `Criterion` which checks that the object does not have the given UID, ie: that it is not
+// the object with this UID which was deleted
+func UntilObjectHasNotUID[T client.Object](uid types.UID) Criterion[T] {
+	return Criterion[T]{
+		Match: func(actual T) bool {
+			return actual.GetUID() != uid
+		},
+		Diff: func(actual T) string {
+			return fmt.Sprintf("expected %s '%s' to be recreated, but it still has the UID of the deleted object: %s", objectKind[T](), actual.GetName(), uid)
+		},
+	}
+}
@@ -0,0 +1,95 @@
+package wait_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestDeleteSingletonAndWaitForRecreatedObject(t *testing.T) {
+
+	key := types.NamespacedName{Namespace: "test", Name: "config"}
+	newConfigMap := func(uid types.UID) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: key.Namespace,
+				Name:      key.Name,
+				UID:       uid,
+			},
+			Data: map[string]string{"mode": "default"},
+		}
+	}
+
+	t.Run("recreated with the expected defaults", func(t *testing.T) {
+		// given
+		cl := test.NewFakeClient(t, newConfigMap("uid-1"))
+		a := &wait.Awaitility{
+			Client:        cl,
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       time.Second,
+		}
+
+		// when
+		uid, err := wait.DeleteSingleton[*corev1.ConfigMap](t, a, key)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, types.UID("uid-1"), uid)
+		err = cl.Get(context.TODO(), key, &corev1.ConfigMap{})
+		require.True(t, apierrors.IsNotFound(err))
+
+		// when the "operator" recreates the object
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			_ = cl.Create(context.TODO(), newConfigMap("uid-2"))
+		}()
+		recreated, err := wait.WaitForRecreatedObject(t, a, key, uid,
+			wait.UntilObjectMatches("has the default mode", func(cm *corev1.ConfigMap) bool {
+				return cm.Data["mode"] == "default"
+			}))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, types.UID("uid-2"), recreated.UID)
+	})
+
+	t.Run("not recreated", func(t *testing.T) {
+		// given
+		a := &wait.Awaitility{
+			Client:        test.NewFakeClient(t, newConfigMap("uid-1")),
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+
+		// when
+		_, err := wait.WaitForRecreatedObject[*corev1.ConfigMap](t, a, key, "uid-1")
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("singleton not found", func(t *testing.T) {
+		// given
+		a := &wait.Awaitility{
+			Client:        test.NewFakeClient(t),
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+
+		// when
+		_, err := wait.DeleteSingleton[*corev1.ConfigMap](t, a, key)
+
+		// then
+		require.True(t, apierrors.IsNotFound(err))
+	})
+}
@@ -0,0 +1,128 @@
+package wait
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SoftAwaitility an Awaitility whose checks don't stop the test when they fail, so that all the failed checks are reported together
+// (see Awaitility.Soft)
+type SoftAwaitility struct {
+	*Awaitility
+	t        T
+	checks   int
+	failures []string
+}
+
+// Soft runs the given func with a SoftAwaitility, whose checks are all run even if some of them fail (see SoftAwaitility.Check).
+// Then, if some checks failed, their failures are reported together and the given test fails. This is useful for the verification
+// helpers which check many resources, and which would otherwise hide the full picture by stopping at the first failure, eg:
+//
+//	memberAwait.Soft(t, func(sa *wait.SoftAwaitility) {
+//		sa.Check("User", func(t wait.T) {
+//			_, err := memberAwait.WaitForUser(t, name)
+//			require.NoError(t, err)
+//		})
+//		sa.Check("Identity", func(t wait.T) {
+//			_, err := memberAwait.WaitForIdentity(t, identityName)
+//			require.NoError(t, err)
+//		})
+//	})
+func (a *Awaitility) Soft(t T, f func(sa *SoftAwaitility)) {
+	t.Helper()
+	sa := &SoftAwaitility{
+		Awaitility: a,
+		t:          t,
+	}
+	f(sa)
+	if len(sa.failures) > 0 {
+		t.Errorf("%d check(s) failed out of %d:\n%s", len(sa.failures), sa.checks, strings.Join(sa.failures, "\n"))
+		t.FailNow()
+	}
+}
+
+// Check runs the given check with a T which records its failures instead of failing the test: when the check fails
+// (eg: with `require.NoError(t, err)`), it stops, and the failure is reported at the end of Soft along with the failures
+// of the other checks. The checks must be run sequentially, from the goroutine running the func given to Soft, and the T given
+// to the check must not be used by other goroutines, since its `FailNow` can only stop the goroutine running the check.
+// The funcs registered with `t.Cleanup()` are called at the end of the test, and their failures are reported to the test itself.
+// Returns `true` if the check succeeded, so that the checks which depend on it can be skipped.
+func (sa *SoftAwaitility) Check(name string, check func(t T)) bool {
+	sa.t.Helper()
+	sa.checks++
+	st := &softT{
+		T: sa.t,
+	}
+	func() {
+		defer func() {
+			st.done = true
+			if r := recover(); r != nil {
+				if _, ok := r.(failNow); !ok {
+					panic(r)
+				}
+			}
+		}()
+		check(st)
+	}()
+	if len(st.errors) == 0 {
+		return true
+	}
+	failure := fmt.Sprintf("%s: %s", name, strings.Join(st.errors, "\n"))
+	sa.t.Logf("check failed: %s", failure)
+	sa.failures = append(sa.failures, failure)
+	return false
+}
+
+// Failures returns the failures of the checks run so far
+func (sa *SoftAwaitility) Failures() []string {
+	return sa.failures
+}
+
+// softT a T which records the errors instead of failing the test, and which stops the check on `FailNow` (see SoftAwaitility.Check).
+// Once the check is done (eg: in the funcs registered with `Cleanup`), the errors are reported to the underlying T.
+type softT struct {
+	T
+	errors []string
+	done   bool
+}
+
+var _ T = &softT{}
+
+// Errorf records the given formatted message as an error of the check
+func (t *softT) Errorf(format string, args ...interface{}) {
+	if t.done {
+		t.T.Helper()
+		t.T.Errorf(format, args...)
+		return
+	}
+	t.errors = append(t.errors, strings.TrimSpace(fmt.Sprintf(format, args...)))
+}
+
+// Fatal records the given args as an error of the check, and stops it
+func (t *softT) Fatal(args ...interface{}) {
+	if t.done {
+		t.T.Helper()
+		t.T.Fatal(args...)
+		return
+	}
+	t.errors = append(t.errors, strings.TrimSpace(fmt.Sprint(args...)))
+	t.FailNow()
+}
+
+// FailNow stops the check, or the test once the check is done
+func (t *softT) FailNow() {
+	if t.done {
+		t.T.FailNow()
+		return
+	}
+	if len(t.errors) == 0 {
+		t.errors = append(t.errors, "check failed")
+	}
+	panic(failNow{})
+}
+
+// Cleanup registers the given func on the underlying T, so that it is called at the end of the test. Since the check is done by then,
+// the failures of the func are reported to the underlying T, and `FailNow` stops the func as it would in any other cleanup.
+func (t *softT) Cleanup(f func()) {
+	t.T.Cleanup(f)
+}
@@ -0,0 +1,133 @@
+package wait_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSoft(t *testing.T) {
+	// given
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "toolchain-host-operator",
+			Name:      "host-operator-metrics-service",
+		},
+	}
+	a := &wait.Awaitility{
+		Client:        test.NewFakeClient(t, service),
+		Namespace:     "toolchain-host-operator",
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       100 * time.Millisecond,
+	}
+
+	t.Run("all checks passed", func(t *testing.T) {
+		// given
+		out := &bytes.Buffer{}
+		var results []bool
+
+		// when
+		passed := wait.RunStandalone("TestSomething", out, func(st wait.T) {
+			a.Soft(st, func(sa *wait.SoftAwaitility) {
+				results = append(results, sa.Check("service", func(t wait.T) {
+					_, err := sa.WaitForService(t, "host-operator-metrics-service")
+					require.NoError(t, err)
+				}))
+			})
+		})
+
+		// then
+		assert.True(t, passed)
+		assert.Equal(t, []bool{true}, results)
+	})
+
+	t.Run("all checks run despite failures", func(t *testing.T) {
+		// given
+		out := &bytes.Buffer{}
+		var results []bool
+		var failures []string
+		after := false
+
+		// when
+		passed := wait.RunStandalone("TestSomething", out, func(st wait.T) {
+			a.Soft(st, func(sa *wait.SoftAwaitility) {
+				results = append(results, sa.Check("unknown service", func(t wait.T) {
+					_, err := sa.WaitForService(t, "unknown")
+					require.NoError(t, err, "no service 'unknown'")
+					t.Log("unreachable")
+				}))
+				results = append(results, sa.Check("service", func(t wait.T) {
+					_, err := sa.WaitForService(t, "host-operator-metrics-service")
+					require.NoError(t, err)
+				}))
+				results = append(results, sa.Check("assertions", func(t wait.T) {
+					assert.Equal(t, "expected", "actual")
+					assert.True(t, false, "not true")
+				}))
+				failures = sa.Failures()
+			})
+			after = true
+		})
+
+		// then
+		assert.False(t, passed)
+		assert.False(t, after) // the test stopped at the end of Soft
+		assert.Equal(t, []bool{false, true, false}, results)
+		require.Len(t, failures, 2)
+		assert.Contains(t, failures[0], "unknown service: ")
+		assert.Contains(t, failures[0], "no service 'unknown'")
+		assert.Contains(t, failures[1], "assertions: ")
+		assert.Contains(t, failures[1], "not true")
+		assert.NotContains(t, out.String(), "unreachable")
+		assert.Contains(t, out.String(), "2 check(s) failed out of 3:\nunknown service: ")
+	})
+
+	t.Run("failing cleanup is reported to the test", func(t *testing.T) {
+		// given
+		out := &bytes.Buffer{}
+		var results []bool
+		var cleanups []string
+
+		// when
+		passed := wait.RunStandalone("TestSomething", out, func(st wait.T) {
+			st.Cleanup(func() {
+				cleanups = append(cleanups, "test")
+			})
+			a.Soft(st, func(sa *wait.SoftAwaitility) {
+				results = append(results, sa.Check("service", func(t wait.T) {
+					t.Cleanup(func() {
+						require.Fail(t, "cleanup failed")
+						cleanups = append(cleanups, "unreachable")
+					})
+					_, err := sa.WaitForService(t, "host-operator-metrics-service")
+					require.NoError(t, err)
+				}))
+			})
+		})
+
+		// then
+		assert.False(t, passed)
+		assert.Equal(t, []bool{true}, results)
+		assert.Equal(t, []string{"test"}, cleanups) // the other cleanups are still called
+		assert.Contains(t, out.String(), "cleanup failed")
+		assert.NotContains(t, out.String(), "check(s) failed")
+	})
+
+	t.Run("panics are not recovered", func(t *testing.T) {
+		assert.PanicsWithValue(t, "boom", func() {
+			a.Soft(t, func(sa *wait.SoftAwaitility) {
+				sa.Check("panic", func(t wait.T) {
+					panic("boom")
+				})
+			})
+		})
+	})
+}
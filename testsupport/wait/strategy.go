@@ -0,0 +1,113 @@
+package wait
+
+import (
+	"math"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Strategy decides when the criteria of the waits of an Awaitility are evaluated, by computing the delay before each evaluation.
+// A Strategy is a RetryOption, eg:
+//
+//	hostAwait.WithRetryOptions(wait.ImmediateFirst(wait.Backoff(time.Second, 2, 10*time.Second, 0.1))).WaitForSpace(t, name)
+//
+// The strategies are FixedInterval (the default), Backoff, ImmediateFirst and UseWatch.
+type Strategy interface {
+	RetryOption
+	// delays returns the func which computes the delay before each evaluation of the criteria of a wait, given the retry interval
+	// of the waiter. It is called once per wait, so that the strategies can keep their state (eg: the current step of a backoff).
+	delays(interval time.Duration) func() time.Duration
+}
+
+// getStrategy returns the Strategy configured on the Awaitility, or FixedInterval if none was configured
+func (a *Awaitility) getStrategy() Strategy {
+	if a.strategy == nil {
+		return FixedInterval()
+	}
+	return a.strategy
+}
+
+// FixedInterval returns the default Strategy, which waits for the retry interval of the waiter (usually the RetryInterval
+// of the Awaitility) before each evaluation of the criteria
+func FixedInterval() Strategy {
+	return fixedInterval{}
+}
+
+type fixedInterval struct{}
+
+var _ Strategy = fixedInterval{}
+
+func (s fixedInterval) apply(a *Awaitility) {
+	a.strategy = s
+}
+
+func (s fixedInterval) delays(interval time.Duration) func() time.Duration {
+	return func() time.Duration {
+		return interval
+	}
+}
+
+// Backoff returns a Strategy to wait for an exponentially increasing delay between two evaluations of the criteria, instead of
+// the fixed RetryInterval: the first delay is the given initial one, and each subsequent delay is multiplied by the given factor
+// until it reaches the given max. Each delay is increased by a random duration of up to `jitter` times the delay,
+// so that the waits of the tests running in parallel don't hit the API server all at once.
+// This allows long waits to start fast and then back off, instead of polling the API server every RetryInterval until the timeout.
+func Backoff(initial time.Duration, factor float64, max time.Duration, jitter float64) Strategy {
+	return backoffOption{
+		Duration: initial,
+		Factor:   factor,
+		Cap:      max,
+		Jitter:   jitter,
+		Steps:    math.MaxInt32,
+	}
+}
+
+type backoffOption wait.Backoff
+
+var _ Strategy = backoffOption{}
+
+func (o backoffOption) apply(a *Awaitility) {
+	a.strategy = o
+}
+
+func (o backoffOption) delays(_ time.Duration) func() time.Duration {
+	backoff := wait.Backoff(o) // a copy for each wait, since each step updates the backoff
+	return backoff.Step
+}
+
+// ImmediateFirst returns a Strategy which evaluates the criteria immediately, and then waits for the delays of the given Strategy
+// (eg: for the waits whose criteria are usually already met, and which would otherwise always take at least one retry interval).
+// If the given Strategy is nil, then the Strategy configured before on the Awaitility is used (FixedInterval by default).
+func ImmediateFirst(next Strategy) Strategy {
+	return immediateFirst{next: next}
+}
+
+type immediateFirst struct {
+	next Strategy
+}
+
+var _ Strategy = immediateFirst{}
+
+func (s immediateFirst) apply(a *Awaitility) {
+	if s.next != nil {
+		s.next.apply(a) // eg: to also wait on the watch events with UseWatch
+	}
+	a.strategy = immediateFirst{next: a.strategy}
+}
+
+func (s immediateFirst) delays(interval time.Duration) func() time.Duration {
+	next := FixedInterval()
+	if s.next != nil {
+		next = s.next
+	}
+	delays := next.delays(interval)
+	first := true
+	return func() time.Duration {
+		if first {
+			first = false
+			return 0
+		}
+		return delays()
+	}
+}
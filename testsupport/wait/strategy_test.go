@@ -0,0 +1,107 @@
+package wait_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrategies(t *testing.T) {
+
+	newAwaitility := func(options ...wait.RetryOption) *wait.Awaitility {
+		a := &wait.Awaitility{
+			RetryInterval: 50 * time.Millisecond,
+			Timeout:       time.Second,
+		}
+		return a.WithRetryOptions(options...)
+	}
+
+	// pollUntil polls with the given awaitility until the condition was evaluated the given number of times,
+	// and returns the delays between the evaluations
+	pollUntil := func(a *wait.Awaitility, calls int) []time.Duration {
+		var delays []time.Duration
+		last := time.Now()
+		err := a.Poll(t, func() (bool, error) {
+			delays = append(delays, time.Since(last))
+			last = time.Now()
+			return len(delays) == calls, nil
+		})
+		require.NoError(t, err)
+		require.Len(t, delays, calls)
+		return delays
+	}
+
+	t.Run("fixed interval", func(t *testing.T) {
+		// when
+		delays := pollUntil(newAwaitility(wait.FixedInterval()), 2)
+
+		// then
+		for i, delay := range delays {
+			assert.GreaterOrEqual(t, delay, 50*time.Millisecond, fmt.Sprintf("delay #%d", i))
+		}
+	})
+
+	t.Run("fixed interval replaces the backoff", func(t *testing.T) {
+		// when
+		delays := pollUntil(newAwaitility(wait.Backoff(10*time.Millisecond, 1, 10*time.Millisecond, 0), wait.FixedInterval()), 2)
+
+		// then
+		for i, delay := range delays {
+			assert.GreaterOrEqual(t, delay, 50*time.Millisecond, fmt.Sprintf("delay #%d", i))
+		}
+	})
+
+	t.Run("immediate first", func(t *testing.T) {
+		// when
+		delays := pollUntil(newAwaitility(wait.ImmediateFirst(nil)), 3)
+
+		// then
+		assert.Less(t, delays[0], 50*time.Millisecond)
+		assert.GreaterOrEqual(t, delays[1], 50*time.Millisecond)
+		assert.GreaterOrEqual(t, delays[2], 50*time.Millisecond)
+	})
+
+	t.Run("immediate first with backoff", func(t *testing.T) {
+		// when
+		delays := pollUntil(newAwaitility(wait.ImmediateFirst(wait.Backoff(20*time.Millisecond, 2, time.Second, 0))), 3)
+
+		// then
+		assert.Less(t, delays[0], 20*time.Millisecond)
+		assert.GreaterOrEqual(t, delays[1], 20*time.Millisecond)
+		assert.GreaterOrEqual(t, delays[2], 40*time.Millisecond)
+	})
+
+	t.Run("immediate first with the configured strategy", func(t *testing.T) {
+		// when
+		delays := pollUntil(newAwaitility(wait.Backoff(20*time.Millisecond, 2, time.Second, 0), wait.ImmediateFirst(nil)), 3)
+
+		// then
+		assert.Less(t, delays[0], 20*time.Millisecond)
+		assert.GreaterOrEqual(t, delays[1], 20*time.Millisecond)
+		assert.GreaterOrEqual(t, delays[2], 40*time.Millisecond)
+	})
+
+	t.Run("immediate first with watch", func(t *testing.T) {
+		// when the wait does not support the watches
+		delays := pollUntil(newAwaitility(wait.ImmediateFirst(wait.UseWatch())), 2)
+
+		// then it polls immediately, and then at the fixed interval
+		assert.Less(t, delays[0], 50*time.Millisecond)
+		assert.GreaterOrEqual(t, delays[1], 50*time.Millisecond)
+	})
+
+	t.Run("retry interval replaces the strategy", func(t *testing.T) {
+		// when
+		delays := pollUntil(newAwaitility(wait.ImmediateFirst(nil), wait.RetryInterval(20*time.Millisecond)), 2)
+
+		// then
+		for i, delay := range delays {
+			assert.GreaterOrEqual(t, delay, 20*time.Millisecond, fmt.Sprintf("delay #%d", i))
+		}
+	})
+}
@@ -0,0 +1,159 @@
+package wait
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WaitTelemetryVar the name of the env var which enables the recording of the duration of the waits of each test (when set to `true`)
+const WaitTelemetryVar = "E2E_WAIT_TELEMETRY"
+
+// WaitTelemetryEnabled returns `true` if the recording of the duration of the waits of each test is enabled
+func WaitTelemetryEnabled() bool {
+	return CurrentEnvironment().WaitTelemetry
+}
+
+// WaitDuration the duration and the outcome of a wait
+type WaitDuration struct {
+	// Waiter the waiter called by the test, eg: `(*HostAwaitility).WaitForSpace`
+	Waiter  string `json:"waiter"`
+	Cluster string `json:"cluster,omitempty"`
+	// Attempts the number of evaluations of the criteria
+	Attempts int `json:"attempts"`
+	// Elapsed the duration of the wait (in nanoseconds, once marshalled)
+	Elapsed time.Duration `json:"elapsed"`
+	// Result the outcome of the wait (`met`, `timeout`, `cancelled` or `error`)
+	Result string `json:"result"`
+}
+
+// WaitTelemetry records the duration of the waits of a test (see Awaitility.WithWaitTelemetry), in order to find out which waits
+// dominate the duration of the test suite
+type WaitTelemetry struct {
+	lock      sync.Mutex
+	durations []WaitDuration
+}
+
+// NewWaitTelemetry returns a new WaitTelemetry which logs the summary of the durations of the waits at the end of the given test
+// (see Summary), and which also writes the recorded durations as JSON in the given dir, if not empty (eg: the `ARTIFACT_DIR`)
+func NewWaitTelemetry(t T, dir string) *WaitTelemetry {
+	w := &WaitTelemetry{}
+	t.Cleanup(func() {
+		t.Log(w.Summary())
+		if dir == "" {
+			return
+		}
+		path, err := w.writeFile(dir, t.Name())
+		if err != nil {
+			t.Logf("unable to write the durations of the waits: %v", err)
+			return
+		}
+		t.Logf("the durations of the waits were written in %s", path)
+	})
+	return w
+}
+
+func (w *WaitTelemetry) record(d WaitDuration) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.durations = append(w.durations, d)
+}
+
+// Durations returns the durations of the waits recorded so far, in the order in which the waits ended
+func (w *WaitTelemetry) Durations() []WaitDuration {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return append([]WaitDuration{}, w.durations...)
+}
+
+// Summary returns the number of waits, their total and max durations and the number of timeouts of each waiter,
+// starting with the waiter which took the longest in total
+func (w *WaitTelemetry) Summary() string {
+	type stats struct {
+		waiter   string
+		count    int
+		total    time.Duration
+		max      time.Duration
+		timeouts int
+	}
+	var total time.Duration
+	byWaiter := map[string]*stats{}
+	durations := w.Durations()
+	for _, d := range durations {
+		s, found := byWaiter[d.Waiter]
+		if !found {
+			s = &stats{waiter: d.Waiter}
+			byWaiter[d.Waiter] = s
+		}
+		s.count++
+		s.total += d.Elapsed
+		if d.Elapsed > s.max {
+			s.max = d.Elapsed
+		}
+		if d.Result == "timeout" {
+			s.timeouts++
+		}
+		total += d.Elapsed
+	}
+	all := make([]*stats, 0, len(byWaiter))
+	for _, s := range byWaiter {
+		all = append(all, s)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].total != all[j].total {
+			return all[i].total > all[j].total
+		}
+		return all[i].waiter < all[j].waiter
+	})
+	summary := &strings.Builder{}
+	summary.WriteString(fmt.Sprintf("durations of the waits (%d wait(s), %s in total):\n", len(durations), total))
+	for _, s := range all {
+		summary.WriteString(fmt.Sprintf("  %s: %d wait(s), %s in total, %s max, %d timeout(s)\n", s.waiter, s.count, s.total, s.max, s.timeouts))
+	}
+	return summary.String()
+}
+
+// writeFile writes the recorded durations as JSON in a file named after the given test in the given dir, and returns its path
+func (w *WaitTelemetry) writeFile(dir, testName string) (string, error) {
+	content, err := json.MarshalIndent(w.Durations(), "", "  ")
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("wait-durations-%s.json", strings.NewReplacer("/", "_", " ", "_").Replace(testName)))
+	return path, os.WriteFile(path, content, 0600)
+}
+
+// WithWaitTelemetry returns a new Awaitility which records the duration of its waits in the given WaitTelemetry
+func (a *Awaitility) WithWaitTelemetry(telemetry *WaitTelemetry) *Awaitility {
+	result := a.copy()
+	result.telemetry = telemetry
+	return result
+}
+
+// WithWaitTelemetry returns a new HostAwaitility which records the duration of its waits in the given WaitTelemetry
+func (a *HostAwaitility) WithWaitTelemetry(telemetry *WaitTelemetry) *HostAwaitility {
+	result := *a
+	result.Awaitility = a.Awaitility.WithWaitTelemetry(telemetry)
+	return &result
+}
+
+// WithWaitTelemetry returns a new MemberAwaitility which records the duration of its waits in the given WaitTelemetry
+func (a *MemberAwaitility) WithWaitTelemetry(telemetry *WaitTelemetry) *MemberAwaitility {
+	result := *a
+	result.Awaitility = a.Awaitility.WithWaitTelemetry(telemetry)
+	return &result
+}
+
+// WithWaitTelemetry returns new Awaitilities which all record the duration of their waits in the given WaitTelemetry
+func (a Awaitilities) WithWaitTelemetry(telemetry *WaitTelemetry) Awaitilities {
+	members := make([]*MemberAwaitility, len(a.memberAwaitilities))
+	for i, m := range a.memberAwaitilities {
+		members[i] = m.WithWaitTelemetry(telemetry)
+	}
+	return NewAwaitilities(a.hostAwaitility.WithWaitTelemetry(telemetry), members...)
+}
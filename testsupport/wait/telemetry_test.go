@@ -0,0 +1,67 @@
+package wait_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWaitTelemetry(t *testing.T) {
+	// given
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "toolchain-host-operator",
+			Name:      "host-operator-metrics-service",
+		},
+	}
+	a := &wait.Awaitility{
+		Client:        test.NewFakeClient(t, service),
+		Namespace:     "toolchain-host-operator",
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       100 * time.Millisecond,
+	}
+	dir := t.TempDir()
+	out := &bytes.Buffer{}
+	var telemetry *wait.WaitTelemetry
+
+	// when
+	wait.RunStandalone("TestSomething/with a subtest", out, func(st wait.T) {
+		telemetry = wait.NewWaitTelemetry(st, dir)
+		a := a.WithWaitTelemetry(telemetry)
+		_, err := a.WaitForService(st, "host-operator-metrics-service")
+		require.NoError(st, err)
+		_, err = a.WaitForService(st, "unknown")
+		require.Error(st, err)
+		_, err = a.WaitForService(st, "host-operator-metrics-service")
+		require.NoError(st, err)
+	})
+
+	// then
+	durations := telemetry.Durations()
+	require.Len(t, durations, 3)
+	assert.Equal(t, "(*Awaitility).WaitForService", durations[0].Waiter)
+	assert.Equal(t, "met", durations[0].Result)
+	assert.Equal(t, 1, durations[0].Attempts)
+	assert.Equal(t, "timeout", durations[1].Result)
+	assert.GreaterOrEqual(t, durations[1].Elapsed, 100*time.Millisecond)
+	assert.Contains(t, out.String(), "durations of the waits (3 wait(s), ")
+	assert.Contains(t, out.String(), "  (*Awaitility).WaitForService: 3 wait(s), ")
+	assert.Contains(t, out.String(), ", 1 timeout(s)\n")
+
+	content, err := os.ReadFile(filepath.Join(dir, "wait-durations-TestSomething_with_a_subtest.json"))
+	require.NoError(t, err)
+	var written []wait.WaitDuration
+	require.NoError(t, json.Unmarshal(content, &written))
+	assert.Equal(t, durations, written)
+}
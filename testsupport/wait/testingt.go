@@ -0,0 +1,150 @@
+package wait
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// T the methods of `*testing.T` used by the Awaitilities to log, to fail and to clean up. This allows the Awaitilities to be used
+// outside of the Go tests, eg: from the `setup` CLI or from a debugging binary, with a StandaloneT (see RunStandalone)
+type T interface {
+	Helper()
+	Name() string
+	Log(args ...interface{})
+	Logf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+	Fatal(args ...interface{})
+	FailNow()
+	Cleanup(f func())
+	Deadline() (time.Time, bool)
+}
+
+var _ T = &testing.T{}
+
+// testingT an alias of T, for the generic funcs whose type parameter (eg: the type of the awaited object) shadows T
+type testingT = T
+
+// StandaloneT a T which writes the log lines to a writer, for the Awaitilities used outside of the Go tests
+type StandaloneT struct {
+	name     string
+	out      io.Writer
+	lock     sync.Mutex
+	failed   bool
+	cleanups []func()
+}
+
+var _ T = &StandaloneT{}
+
+// failNow the value of the panic which stops the func run by RunStandalone, like `runtime.Goexit` stops a Go test
+type failNow struct{}
+
+// RunStandalone runs the given func with a StandaloneT with the given name, which writes the log lines to the given writer (eg: `os.Stderr`).
+// As with a Go test, the func stops when `t.FailNow()` or `t.Fatal()` is called (including by the `require` assertions), and the funcs
+// registered with `t.Cleanup()` are called in the reverse order at the end.
+// Returns `true` if the func did not fail.
+func RunStandalone(name string, out io.Writer, f func(t T)) bool {
+	t := &StandaloneT{
+		name: name,
+		out:  out,
+	}
+	defer t.cleanup()
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(failNow); !ok {
+				panic(r)
+			}
+		}
+	}()
+	f(t)
+	return !t.Failed()
+}
+
+func (t *StandaloneT) cleanup() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.runCleanup(t.cleanups[i])
+	}
+}
+
+// runCleanup calls the given cleanup func, which is stopped by `t.FailNow()` without stopping the other cleanup funcs
+func (t *StandaloneT) runCleanup(f func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(failNow); !ok {
+				panic(r)
+			}
+		}
+	}()
+	f()
+}
+
+// Helper does nothing, since the log lines are not prefixed with the location of the calls
+func (t *StandaloneT) Helper() {}
+
+// Name returns the name given to RunStandalone
+func (t *StandaloneT) Name() string {
+	return t.name
+}
+
+// Log writes the given args to the output, like `fmt.Sprintln`
+func (t *StandaloneT) Log(args ...interface{}) {
+	t.write(fmt.Sprintln(args...))
+}
+
+// Logf writes the given formatted message to the output
+func (t *StandaloneT) Logf(format string, args ...interface{}) {
+	t.write(fmt.Sprintf(format, args...))
+}
+
+func (t *StandaloneT) write(msg string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	_, _ = fmt.Fprintf(t.out, "%s: %s\n", t.name, strings.TrimSuffix(msg, "\n"))
+}
+
+// Errorf writes the given formatted message to the output, and marks the run as failed
+func (t *StandaloneT) Errorf(format string, args ...interface{}) {
+	t.Logf(format, args...)
+	t.Fail()
+}
+
+// Fatal writes the given args to the output, marks the run as failed and stops it
+func (t *StandaloneT) Fatal(args ...interface{}) {
+	t.Log(args...)
+	t.FailNow()
+}
+
+// Fail marks the run as failed
+func (t *StandaloneT) Fail() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.failed = true
+}
+
+// Failed returns `true` if the run failed
+func (t *StandaloneT) Failed() bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.failed
+}
+
+// FailNow marks the run as failed and stops it. It must be called from the goroutine running the func given to RunStandalone.
+func (t *StandaloneT) FailNow() {
+	t.Fail()
+	panic(failNow{})
+}
+
+// Cleanup registers a func to be called at the end of the run
+func (t *StandaloneT) Cleanup(f func()) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.cleanups = append(t.cleanups, f)
+}
+
+// Deadline returns `false`, since there is no deadline outside of the Go tests
+func (t *StandaloneT) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
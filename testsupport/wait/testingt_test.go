@@ -0,0 +1,97 @@
+package wait_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRunStandalone(t *testing.T) {
+
+	t.Run("passed", func(t *testing.T) {
+		// given
+		out := &bytes.Buffer{}
+		var cleanups []string
+
+		// when
+		passed := wait.RunStandalone("debug", out, func(st wait.T) {
+			st.Cleanup(func() {
+				cleanups = append(cleanups, "first")
+			})
+			st.Cleanup(func() {
+				cleanups = append(cleanups, "second")
+			})
+			st.Logf("hello %s", "world")
+		})
+
+		// then
+		assert.True(t, passed)
+		assert.Equal(t, "debug: hello world\n", out.String())
+		assert.Equal(t, []string{"second", "first"}, cleanups)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		// given
+		out := &bytes.Buffer{}
+		cleanedUp := false
+		stopped := true
+
+		// when
+		passed := wait.RunStandalone("debug", out, func(st wait.T) {
+			st.Cleanup(func() {
+				cleanedUp = true
+			})
+			require.Equal(st, 1, 2)
+			stopped = false
+		})
+
+		// then
+		assert.False(t, passed)
+		assert.True(t, stopped)
+		assert.True(t, cleanedUp)
+		assert.Contains(t, out.String(), "Not equal")
+	})
+
+	t.Run("other panics are not recovered", func(t *testing.T) {
+		assert.PanicsWithValue(t, "boom", func() {
+			wait.RunStandalone("debug", &bytes.Buffer{}, func(st wait.T) {
+				panic("boom")
+			})
+		})
+	})
+
+	t.Run("with an awaitility", func(t *testing.T) {
+		// given
+		service := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "my-service",
+			},
+		}
+		a := &wait.Awaitility{
+			Client:        test.NewFakeClient(t, service),
+			Namespace:     "test",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}
+		out := &bytes.Buffer{}
+
+		// when
+		passed := wait.RunStandalone("debug", out, func(st wait.T) {
+			_, err := a.WaitForService(st, "my-service")
+			require.NoError(st, err)
+		})
+
+		// then
+		assert.True(t, passed)
+		assert.Contains(t, out.String(), "debug: waiting for Service 'my-service' in namespace 'test'")
+	})
+}
@@ -0,0 +1,41 @@
+package wait
+
+import (
+	"sync"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/httpclient"
+)
+
+// WithTokenProvider returns an option to use the given provider for the bearer token of the requests sent by the HTTP-based waits
+// (eg: WaitForRouteToBeAvailable or the PromQL queries), instead of the bearer token of the cluster config of the Awaitility, eg:
+// a httpclient.ServiceAccountTokenProvider when the kubeconfig authenticates with a client certificate
+func WithTokenProvider(tokens httpclient.TokenProvider) RetryOption {
+	return tokenProviderOption{tokens: tokens}
+}
+
+type tokenProviderOption struct {
+	tokens httpclient.TokenProvider
+}
+
+var _ RetryOption = tokenProviderOption{}
+
+func (o tokenProviderOption) apply(a *Awaitility) {
+	a.tokenProvider = o.tokens
+}
+
+// restConfigTokenProviders the providers of the tokens of the cluster configs, so that the tokens obtained by the exec plugins are
+// shared by all the Awaitilities of a cluster
+var restConfigTokenProviders sync.Map
+
+// TokenProvider returns the provider of the bearer token of the requests sent by the HTTP-based waits: the one configured with
+// WithTokenProvider, or the one of the cluster config of the Awaitility otherwise (see httpclient.RestConfigTokenProvider)
+func (a *Awaitility) TokenProvider() httpclient.TokenProvider {
+	if a.tokenProvider != nil {
+		return a.tokenProvider
+	}
+	if a.RestConfig == nil {
+		return httpclient.StaticToken("")
+	}
+	tokens, _ := restConfigTokenProviders.LoadOrStore(a.RestConfig, httpclient.RestConfigTokenProvider(a.RestConfig))
+	return tokens.(httpclient.TokenProvider)
+}
@@ -0,0 +1,95 @@
+package wait_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/httpclient"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWaitForRouteToBeAvailableWithTokenProvider(t *testing.T) {
+	// given
+	s := runtime.NewScheme()
+	require.NoError(t, routev1.AddToScheme(s))
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer sa-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	route := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "toolchain-host-operator",
+			Name:      "api",
+		},
+		Spec: routev1.RouteSpec{
+			TLS: &routev1.TLSConfig{
+				Termination: routev1.TLSTerminationEdge,
+			},
+		},
+		Status: routev1.RouteStatus{
+			Ingress: []routev1.RouteIngress{
+				{
+					Host: strings.TrimPrefix(server.URL, "https://"),
+				},
+			},
+		},
+	}
+	newAwaitility := func(config *rest.Config) *wait.Awaitility {
+		return (&wait.Awaitility{
+			Client:        fake.NewClientBuilder().WithScheme(s).WithObjects(route).Build(),
+			RestConfig:    config,
+			Namespace:     "toolchain-host-operator",
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       100 * time.Millisecond,
+		}).WithRetryOptions(wait.InsecureRoutes())
+	}
+
+	t.Run("token of the cluster config", func(t *testing.T) {
+		// given
+		a := newAwaitility(&rest.Config{Host: "https://api.example.com:6443", BearerToken: "sa-token"})
+
+		// when
+		_, err := a.WaitForRouteToBeAvailable(t, route.Namespace, route.Name, "/proxyhealth")
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("cluster config without token", func(t *testing.T) {
+		// given
+		a := newAwaitility(&rest.Config{Host: "https://api.example.com:6443", TLSClientConfig: rest.TLSClientConfig{CertData: []byte("cert"), KeyData: []byte("key")}})
+
+		t.Run("no token", func(t *testing.T) {
+			// when
+			_, err := a.WaitForRouteToBeAvailable(t, route.Namespace, route.Name, "/proxyhealth")
+
+			// then
+			require.Error(t, err)
+			assert.True(t, wait.IsTimeout(err))
+		})
+
+		t.Run("token provider", func(t *testing.T) {
+			// when
+			_, err := a.WithRetryOptions(wait.WithTokenProvider(httpclient.StaticToken("sa-token"))).
+				WaitForRouteToBeAvailable(t, route.Namespace, route.Name, "/proxyhealth")
+
+			// then
+			require.NoError(t, err)
+		})
+	})
+}
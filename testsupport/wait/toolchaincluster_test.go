@@ -0,0 +1,65 @@
+package wait_test
+
+import (
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestToolchainClusterWaitCriteria(t *testing.T) {
+	// given
+	tc := &toolchainv1alpha1.ToolchainCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "member-1",
+			Labels: map[string]string{"type": "member"},
+		},
+		Status: toolchainv1alpha1.ToolchainClusterStatus{
+			Conditions: []toolchainv1alpha1.ToolchainClusterCondition{
+				{Type: toolchainv1alpha1.ToolchainClusterReady, Status: corev1.ConditionFalse},
+			},
+		},
+	}
+
+	t.Run("name", func(t *testing.T) {
+		// when
+		c := wait.UntilToolchainClusterHasName("member-2")
+
+		// then
+		assert.False(t, c.Match(tc))
+		assert.Contains(t, c.Diff(tc), "expected name to match")
+		assert.Contains(t, c.Diff(tc), `"member-2"`)
+		assert.Contains(t, c.Diff(tc), `"member-1"`)
+	})
+
+	t.Run("condition", func(t *testing.T) {
+		// when
+		c := wait.UntilToolchainClusterHasCondition(*wait.ReadyToolchainCluster)
+
+		// then
+		assert.False(t, c.Match(tc))
+		assert.Contains(t, c.Diff(tc), "expected conditions to contain condition of type 'Ready' with status 'True'")
+		assert.Contains(t, c.Diff(tc), `Status: (v1.ConditionStatus) (len=5) "False"`)
+	})
+
+	t.Run("labels", func(t *testing.T) {
+		// when
+		c := wait.UntilToolchainClusterHasLabels(client.MatchingLabels{"type": "member", "ownerClusterName": "host"})
+
+		// then
+		assert.False(t, c.Match(tc))
+		assert.Contains(t, c.Diff(tc), "expected labels to contain")
+		assert.Contains(t, c.Diff(tc), `"ownerClusterName"`)
+	})
+
+	t.Run("matching", func(t *testing.T) {
+		assert.True(t, wait.UntilToolchainClusterHasName("member-1").Match(tc))
+		assert.True(t, wait.UntilToolchainClusterHasLabels(client.MatchingLabels{"type": "member"}).Match(tc))
+		assert.True(t, wait.UntilToolchainClusterHasNoTenantLabel().Match(tc))
+	})
+}
@@ -0,0 +1,108 @@
+package wait
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// UpdateOption an option to configure how Update sends the modified object to the API server
+type UpdateOption func(*updateConfig)
+
+type updateConfig struct {
+	status       bool
+	fieldManager string
+}
+
+// UpdateStatus an option to update the status subresource of the object, instead of the object itself
+func UpdateStatus() UpdateOption {
+	return func(c *updateConfig) {
+		c.status = true
+	}
+}
+
+// ServerSideApply an option to send the modified object with a server-side apply by the given field manager (eg: `e2e-tests`),
+// instead of an update. In that case, the func which modifies the object receives an empty object with only its name and namespace
+// (and its kind), and must set all the fields owned by the field manager, and only them: the fields previously owned by the field
+// manager which are not set anymore are removed, and the fields owned by other managers (eg: an operator) are left unchanged,
+// unless they are set too, in which case the field manager takes their ownership.
+// Since there is no precondition on the resource version of the object, the apply does not conflict with the concurrent updates
+// (eg: of the status of the object by its operator).
+func ServerSideApply(fieldManager string) UpdateOption {
+	return func(c *updateConfig) {
+		c.fieldManager = fieldManager
+	}
+}
+
+// Update modifies the object of type `T` with the given key with the given func, and sends it to the API server. By default, the
+// latest version of the object is retrieved before it is modified and updated, and if the update fails because of a conflict
+// (ie: the object was modified in the meantime, eg: by its operator) or because of a transient error of the API server, the latest
+// version is retrieved and modified again, until the timeout of the Awaitility, eg:
+//
+//	userSignup, err := wait.Update(t, hostAwait.Awaitility, types.NamespacedName{Namespace: hostAwait.Namespace, Name: name},
+//		func(us *toolchainv1alpha1.UserSignup) {
+//			states.SetDeactivated(us, true)
+//		})
+//
+// Any other error is returned without retrying (eg: when the update is rejected by a webhook). See UpdateStatus and ServerSideApply.
+// Returns the updated object.
+func Update[T client.Object](t testingT, a *Awaitility, key types.NamespacedName, modify func(T), opts ...UpdateOption) (T, error) {
+	config := &updateConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+	kind := objectKind[T]()
+	var result T
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		obj := newObject[T]()
+		if config.fieldManager != "" {
+			gvk, err := apiutil.GVKForObject(obj, a.Client.Scheme())
+			if err != nil {
+				return false, err
+			}
+			obj.GetObjectKind().SetGroupVersionKind(gvk)
+			obj.SetNamespace(key.Namespace)
+			obj.SetName(key.Name)
+		} else if err := a.Client.Get(a.getContext(), key, obj); err != nil {
+			return false, err
+		}
+		modify(obj)
+		if err := a.send(obj, config); err != nil {
+			if isRetriableUpdateError(err) {
+				a.logf(t, "error updating %s '%s': %s. Will retry again...", kind, key.String(), err.Error())
+				return false, nil
+			}
+			return false, err
+		}
+		result = obj
+		return true, nil
+	})
+	return result, err
+}
+
+func (a *Awaitility) send(obj client.Object, config *updateConfig) error {
+	switch {
+	case config.fieldManager != "" && config.status:
+		return a.Client.Status().Patch(context.TODO(), obj, client.Apply, client.FieldOwner(config.fieldManager), client.ForceOwnership)
+	case config.fieldManager != "":
+		return a.Client.Patch(context.TODO(), obj, client.Apply, client.FieldOwner(config.fieldManager), client.ForceOwnership)
+	case config.status:
+		return a.Client.Status().Update(context.TODO(), obj)
+	default:
+		return a.Client.Update(context.TODO(), obj)
+	}
+}
+
+// isRetriableUpdateError returns `true` if the update failed because the object was modified in the meantime, or because of
+// a transient error of the API server
+func isRetriableUpdateError(err error) bool {
+	return apierrors.IsConflict(err) ||
+		apierrors.IsServerTimeout(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsInternalError(err)
+}
@@ -0,0 +1,112 @@
+package wait_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestUpdate(t *testing.T) {
+	// given
+	key := types.NamespacedName{Namespace: "toolchain-member-operator", Name: "config"}
+	newAwaitility := func(t *testing.T) (*wait.Awaitility, *test.FakeClient) {
+		cl := test.NewFakeClient(t, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: key.Namespace,
+				Name:      key.Name,
+			},
+			Data: map[string]string{
+				"owner": "operator",
+			},
+		})
+		return &wait.Awaitility{
+			Client:        cl,
+			Namespace:     key.Namespace,
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       time.Second,
+		}, cl
+	}
+
+	t.Run("update", func(t *testing.T) {
+		// given
+		a, cl := newAwaitility(t)
+
+		// when
+		cm, err := wait.Update(t, a, key, func(cm *corev1.ConfigMap) {
+			cm.Data["e2e"] = "true"
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "true", cm.Data["e2e"])
+		actual := &corev1.ConfigMap{}
+		require.NoError(t, cl.Get(context.TODO(), key, actual))
+		assert.Equal(t, map[string]string{"owner": "operator", "e2e": "true"}, actual.Data)
+	})
+
+	t.Run("retry on conflict", func(t *testing.T) {
+		// given
+		a, cl := newAwaitility(t)
+		attempts := 0
+
+		// when
+		cm, err := wait.Update(t, a, key, func(cm *corev1.ConfigMap) {
+			attempts++
+			if attempts == 1 {
+				// the object is modified by someone else in the meantime
+				concurrent := cm.DeepCopy()
+				concurrent.Data["owner"] = "someone-else"
+				require.NoError(t, cl.Update(context.TODO(), concurrent))
+			}
+			cm.Data["e2e"] = "true"
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 2, attempts)
+		assert.Equal(t, map[string]string{"owner": "someone-else", "e2e": "true"}, cm.Data)
+	})
+
+	t.Run("no retry on other errors", func(t *testing.T) {
+		// given
+		a, cl := newAwaitility(t)
+		cl.MockUpdate = func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+			return apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, key.Name, nil)
+		}
+		attempts := 0
+
+		// when
+		_, err := wait.Update(t, a, key, func(cm *corev1.ConfigMap) {
+			attempts++
+		})
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apierrors.IsForbidden(err))
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("object not found", func(t *testing.T) {
+		// given
+		a, _ := newAwaitility(t)
+
+		// when
+		_, err := wait.Update(t, a, types.NamespacedName{Namespace: key.Namespace, Name: "unknown"}, func(cm *corev1.ConfigMap) {})
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+}
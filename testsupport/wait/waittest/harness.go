@@ -0,0 +1,81 @@
+// Package waittest provides a harness to unit-test the waiters of the `wait` package against a fake client and a fake clock,
+// so that changes to the waiters can be validated without a cluster, and without actually waiting for their retry intervals and timeouts.
+package waittest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/clock"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+// Harness an Awaitility whose client is a fake client, and whose polls are timed by a fake clock.
+// The Awaitility of the harness can only wait within Run, which steps the fake clock.
+type Harness struct {
+	Client     *test.FakeClient
+	Clock      *testingclock.FakeClock
+	Awaitility *wait.Awaitility
+	timers     chan time.Duration
+}
+
+// NewHarness returns a new Harness with a fake client initialized with the given objects, and an Awaitility in the `test` namespace
+// with the default retry interval and timeout
+func NewHarness(t *testing.T, initObjs ...runtime.Object) *Harness {
+	h := &Harness{
+		Client: test.NewFakeClient(t, initObjs...),
+		Clock:  testingclock.NewFakeClock(time.Now()),
+		timers: make(chan time.Duration),
+	}
+	a := &wait.Awaitility{
+		Client:        h.Client,
+		Namespace:     "test",
+		RetryInterval: wait.DefaultRetryInterval,
+		Timeout:       wait.DefaultTimeout,
+	}
+	h.Awaitility = a.WithRetryOptions(wait.UseClock(&signalingClock{Clock: h.Clock, timers: h.timers}))
+	return h
+}
+
+// Run runs the given waiter, and steps the fake clock to the end of each delay which the waiter is waiting for (eg: the retry interval),
+// until the waiter returns. Before each step, the given funcs are called with the fake time which will have elapsed since the start of the run
+// at the end of the step (ie: when the criteria are evaluated again, or when the wait times out), so that they can update the objects
+// of the fake client while the waiter is polling.
+// Returns the fake time elapsed until the waiter returned, along with the error returned by the waiter.
+// The waiter must use the Awaitility of the harness (or an Awaitility derived from it).
+func (h *Harness) Run(waiter func() error, beforeStep ...func(elapsed time.Duration)) (time.Duration, error) {
+	start := h.Clock.Now()
+	result := make(chan error, 1)
+	go func() {
+		result <- waiter()
+	}()
+	for {
+		select {
+		case err := <-result:
+			return h.Clock.Since(start), err
+		case delay := <-h.timers:
+			elapsed := h.Clock.Since(start) + delay
+			for _, f := range beforeStep {
+				f(elapsed)
+			}
+			h.Clock.Step(delay)
+		}
+	}
+}
+
+// signalingClock a clock which sends the duration of each new timer to the harness once the timer is created,
+// so that the harness steps the fake clock only when the waiter is waiting for it
+type signalingClock struct {
+	clock.Clock
+	timers chan<- time.Duration
+}
+
+func (c *signalingClock) NewTimer(d time.Duration) clock.Timer {
+	timer := c.Clock.NewTimer(d)
+	c.timers <- d
+	return timer
+}
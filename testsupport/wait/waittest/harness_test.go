@@ -0,0 +1,101 @@
+package waittest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait/waittest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8swait "k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestHarness(t *testing.T) {
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "metrics",
+		},
+	}
+
+	t.Run("found immediately", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t, svc.DeepCopy())
+
+		// when
+		elapsed, err := h.Run(func() error {
+			_, err := h.Awaitility.WaitForService(t, "metrics")
+			return err
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, wait.DefaultRetryInterval, elapsed)
+	})
+
+	t.Run("found after the service is created", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t)
+		var evaluations int
+
+		// when
+		elapsed, err := h.Run(func() error {
+			_, err := h.Awaitility.WaitForService(t, "metrics")
+			return err
+		}, func(elapsed time.Duration) {
+			evaluations++
+			if elapsed == time.Second {
+				require.NoError(t, h.Client.Create(context.TODO(), svc.DeepCopy()))
+			}
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, time.Second, elapsed)
+		assert.Equal(t, 10, evaluations)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t)
+		start := time.Now()
+
+		// when
+		elapsed, err := h.Run(func() error {
+			_, err := h.Awaitility.WaitForService(t, "metrics")
+			return err
+		})
+
+		// then
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.Equal(t, wait.DefaultTimeout, elapsed)
+		assert.Less(t, time.Since(start), wait.DefaultTimeout)
+	})
+
+	t.Run("with backoff", func(t *testing.T) {
+		// given
+		h := waittest.NewHarness(t)
+		a := h.Awaitility.WithRetryOptions(wait.Backoff(time.Second, 2, 8*time.Second, 0), wait.TimeoutOption(30*time.Second))
+		var evaluations []time.Duration
+
+		// when
+		elapsed, err := h.Run(func() error {
+			_, err := a.WaitForService(t, "metrics")
+			return err
+		}, func(elapsed time.Duration) {
+			evaluations = append(evaluations, elapsed)
+		})
+
+		// then
+		require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.Equal(t, 30*time.Second, elapsed)
+		// the last delay is shortened to the remaining time, after which the wait times out without evaluating the criteria
+		assert.Equal(t, []time.Duration{1 * time.Second, 3 * time.Second, 7 * time.Second, 15 * time.Second, 23 * time.Second, 30 * time.Second}, evaluations)
+	})
+}
@@ -0,0 +1,163 @@
+package wait
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// watchResyncInterval the interval at which the criteria are re-evaluated when no watch event was received,
+// in case an event was missed
+const watchResyncInterval = 5 * time.Second
+
+// UseWatch returns a Strategy to re-evaluate the criteria of the waits on the watch events of the watched resources,
+// instead of polling the API server at every retry interval. Only the waits which support it use the watches: the others keep polling
+// with the Strategy configured before (eg: a Backoff), which is also used when a watch can't be established.
+func UseWatch() Strategy {
+	return useWatch(true)
+}
+
+type useWatch bool
+
+var _ Strategy = useWatch(false)
+
+func (o useWatch) apply(a *Awaitility) {
+	a.useWatch = bool(o)
+}
+
+// delays returns the fixed interval, since the watch events trigger the evaluations of the criteria. The Strategy configured on the
+// Awaitility is used instead of this one when a wait polls (see `pollOnObjectEvents`).
+func (o useWatch) delays(interval time.Duration) func() time.Duration {
+	return FixedInterval().delays(interval)
+}
+
+var (
+	// watchClients the clients used to watch the resources, by cluster config. The copies of an Awaitility (see WithRetryOptions
+	// and WithContext) share the config of the Awaitility they were created from, hence they also share its watch client
+	watchClients     = map[*rest.Config]client.WithWatch{}
+	watchClientsLock sync.Mutex
+)
+
+// watchClient returns the client to watch the resources of the cluster of the Awaitility, which is created the first time it is needed.
+// The client of the Awaitility is used if it supports watches (eg: a fake client)
+func (a *Awaitility) watchClient() (client.WithWatch, error) {
+	if cl, ok := a.Client.(client.WithWatch); ok {
+		return cl, nil
+	}
+	watchClientsLock.Lock()
+	defer watchClientsLock.Unlock()
+	if cl, found := watchClients[a.RestConfig]; found {
+		return cl, nil
+	}
+	cl, err := client.NewWithWatch(a.RestConfig, client.Options{Scheme: a.Client.Scheme()})
+	if err != nil {
+		return nil, err
+	}
+	watchClients[a.RestConfig] = cl
+	return cl, nil
+}
+
+// pollOnEvents is like `poll`, but if the Awaitility was configured with UseWatch, the condition is only re-evaluated when a watch event is received
+// for the object with the given name in the namespace of the Awaitility (or after the resync interval)
+func (a *Awaitility) pollOnEvents(t T, list client.ObjectList, name string, interval, timeout time.Duration, condition wait.ConditionFunc) error {
+	return a.pollOnObjectEvents(t, list, a.Namespace, name, interval, timeout, condition)
+}
+
+// pollOnObjectEvents is like `pollOnEvents`, for an object in the given namespace (or a cluster-scoped object if the namespace is empty).
+// The watch is re-established if it is closed (eg: by the API server), and the condition falls back to be polled if the watch can't be established.
+// As with `pollWithDelays`, the resync interval and the timeout are measured on the clock of the Awaitility (see UseClock), and the error
+// of the context of the Awaitility is returned if it is done before the condition is met (see WithContext). Returns an ErrTimeout
+// after the given timeout (wrapping ErrTestDeadlineImminent if the timeout was shortened to not outlive the given test).
+func (a *Awaitility) pollOnObjectEvents(t T, list client.ObjectList, namespace, name string, interval, timeout time.Duration, condition wait.ConditionFunc) (err error) {
+	if !a.useWatch {
+		return a.poll(t, interval, timeout, condition)
+	}
+	waitTimeout := a.timeoutOf(timeout)
+	timeout, shortened := testDeadlineTimeout(t, waitTimeout)
+	parent := a.getContext()
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+	clk := a.getClock()
+	watchClient, err := a.watchClient()
+	if err != nil {
+		a.logf(t, "unable to watch the %T in namespace '%s', polling instead: %v", list, namespace, err)
+		return a.pollWithin(t, interval, waitTimeout, condition)
+	}
+	startWatch := func() (watch.Interface, error) {
+		return watchClient.Watch(ctx, list, client.InNamespace(namespace), client.MatchingFieldsSelector{Selector: fields.OneTermEqualSelector("metadata.name", name)})
+	}
+	w, err := startWatch()
+	if err != nil {
+		a.logf(t, "unable to watch the %T in namespace '%s', polling instead: %v", list, namespace, err)
+		return a.pollWithin(t, interval, waitTimeout, condition)
+	}
+	defer func() {
+		w.Stop()
+	}()
+	stableCondition := a.stable(condition)
+	start := clk.Now()
+	deadline := start.Add(timeout)
+	attempts := 0
+	pollingInstead := false
+	defer func() {
+		err = typedWaitError(err, waitTimeout)
+		if !pollingInstead { // otherwise, the outcome is logged by the poll
+			a.logWaitOutcome(t, attempts, clk.Since(start), err)
+		}
+	}()
+	timedOut := func() error {
+		if shortened {
+			return a.testDeadlineImminent(t, waitTimeout)
+		}
+		return wait.ErrWaitTimeout
+	}
+	for {
+		attempts++
+		if done, err := stableCondition(); err != nil || done {
+			return err
+		}
+		remaining := deadline.Sub(clk.Now())
+		if remaining <= 0 {
+			return timedOut()
+		}
+		// a single timer is pending on the clock while waiting for the next event, for the resync or for the timeout
+		delay := watchResyncInterval
+		if delay > remaining {
+			delay = remaining
+		}
+		timer := clk.NewTimer(delay)
+		select {
+		case <-parent.Done():
+			timer.Stop()
+			return parent.Err()
+		case <-timer.C():
+			if delay == remaining {
+				return timedOut()
+			}
+		case _, ok := <-w.ResultChan():
+			timer.Stop()
+			if ok || ctx.Err() != nil {
+				continue
+			}
+			// the watch was closed (eg: by the API server): re-establish it, and re-evaluate the condition in case an event was missed
+			w.Stop()
+			restarted, err := startWatch()
+			if err != nil {
+				a.logf(t, "unable to re-establish the watch on the %T in namespace '%s', polling instead: %v", list, namespace, err)
+				remaining := deadline.Sub(clk.Now())
+				if remaining <= 0 {
+					return timedOut()
+				}
+				pollingInstead = true
+				return a.pollWithin(t, interval, remaining, condition)
+			}
+			w = restarted
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package wait_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8swait "k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeWatchClient a client whose watches are controlled by the tests
+type fakeWatchClient struct {
+	client.WithWatch
+	watchers chan *watch.FakeWatcher
+	err      error
+}
+
+func (c *fakeWatchClient) Watch(_ context.Context, _ client.ObjectList, _ ...client.ListOption) (watch.Interface, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	w := watch.NewFake()
+	c.watchers <- w
+	return w, nil
+}
+
+func TestWaitUntilObjectDeletedUsingWatch(t *testing.T) {
+
+	newConfigMap := func() *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "config",
+			},
+		}
+	}
+	newAwaitility := func(cl client.Client, timeout time.Duration) *wait.Awaitility {
+		a := &wait.Awaitility{
+			Client:        cl,
+			RetryInterval: 10 * time.Millisecond,
+			Timeout:       timeout,
+		}
+		return a.WithRetryOptions(wait.UseWatch())
+	}
+
+	t.Run("deleted while watching", func(t *testing.T) {
+		// given
+		cm := newConfigMap()
+		cl := fake.NewClientBuilder().WithObjects(cm).Build()
+		// the timeout is shorter than the resync interval, so the deletion can only be noticed via the watch event
+		a := newAwaitility(cl, 2*time.Second)
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			_ = cl.Delete(context.TODO(), cm.DeepCopy())
+		}()
+
+		// when
+		err := a.WaitUntilObjectDeleted(t, cm)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("watch closed and re-established", func(t *testing.T) {
+		// given
+		cm := newConfigMap()
+		cl := &fakeWatchClient{
+			WithWatch: fake.NewClientBuilder().WithObjects(cm).Build(),
+			watchers:  make(chan *watch.FakeWatcher, 10),
+		}
+		a := newAwaitility(cl, 2*time.Second)
+		go func() {
+			first := <-cl.watchers
+			first.Stop() // closed by the API server
+			second := <-cl.watchers
+			_ = cl.Delete(context.TODO(), cm.DeepCopy())
+			second.Delete(cm)
+		}()
+
+		// when
+		err := a.WaitUntilObjectDeleted(t, cm)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("falls back to polling when the watch fails", func(t *testing.T) {
+		// given
+		cm := newConfigMap()
+		cl := &fakeWatchClient{
+			WithWatch: fake.NewClientBuilder().WithObjects(cm).Build(),
+			err:       errors.New("mock error"),
+		}
+		a := newAwaitility(cl, 2*time.Second)
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			_ = cl.Delete(context.TODO(), cm.DeepCopy())
+		}()
+
+		// when
+		err := a.WaitUntilObjectDeleted(t, cm)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		// given
+		cm := newConfigMap()
+		a := newAwaitility(fake.NewClientBuilder().WithObjects(cm).Build(), 100*time.Millisecond)
+
+		// when
+		err := a.WaitUntilObjectDeleted(t, cm)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+	})
+
+	t.Run("timeout measured on the clock of the awaitility", func(t *testing.T) {
+		// given
+		cm := newConfigMap()
+		fakeClock := testingclock.NewFakeClock(time.Now())
+		a := newAwaitility(fake.NewClientBuilder().WithObjects(cm).Build(), time.Minute).WithRetryOptions(wait.UseClock(fakeClock))
+		go func() {
+			// steps over the resync intervals until the end of the timeout, without actually waiting for it
+			for elapsed := time.Duration(0); elapsed < time.Minute; elapsed += 5 * time.Second {
+				for !fakeClock.HasWaiters() {
+					time.Sleep(time.Millisecond)
+				}
+				fakeClock.Step(5 * time.Second)
+			}
+		}()
+		start := time.Now()
+
+		// when
+		err := a.WaitUntilObjectDeleted(t, cm)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+		assert.Less(t, time.Since(start), 10*time.Second)
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		// given
+		cm := newConfigMap()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		a := newAwaitility(fake.NewClientBuilder().WithObjects(cm).Build(), 2*time.Second).WithContext(ctx)
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			cancel()
+		}()
+
+		// when
+		err := a.WaitUntilObjectDeleted(t, cm)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.False(t, wait.IsTimeout(err))
+	})
+}
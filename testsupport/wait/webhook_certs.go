@@ -0,0 +1,122 @@
+package wait
+
+import (
+	"bytes"
+	"fmt"
+
+	admv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// WebhookCertsSecretName the name of the Secret with the serving certificate of the member webhook, and the CA which issued it
+	WebhookCertsSecretName = "webhook-certs"
+	// MutatingWebhookConfigurationName the name of the MutatingWebhookConfiguration of the member webhook
+	MutatingWebhookConfigurationName = "member-operator-webhook"
+	// ValidatingWebhookConfigurationName the name of the ValidatingWebhookConfiguration of the member webhook
+	ValidatingWebhookConfigurationName = "member-operator-validating-webhook"
+)
+
+// RotateWebhookCerts forces the rotation of the serving certificate of the member webhook: it deletes the Secret with the certificate,
+// waits until the member operator issued a new certificate (in a new Secret), and until the CA bundle of the webhook configurations
+// was updated with the CA of the new certificate. Returns the new Secret.
+// Note that the webhook server only serves the new certificate once the kubelet updated the content of the mounted Secret (see
+// VerifyWebhookAdmission in the `testsupport` package).
+func (a *MemberAwaitility) RotateWebhookCerts(t T) (*corev1.Secret, error) {
+	key := types.NamespacedName{Namespace: a.Namespace, Name: WebhookCertsSecretName}
+	previous := &corev1.Secret{}
+	if err := a.Client.Get(a.getContext(), key, previous); err != nil {
+		return nil, err
+	}
+	uid, err := DeleteSingleton[*corev1.Secret](t, a.Awaitility, key)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := WaitForRecreatedObject(t, a.Awaitility, key, uid, UntilWebhookCertsIssued(previous.Data["server-cert.pem"]))
+	if err != nil {
+		return nil, err
+	}
+	if err := a.WaitForWebhookCABundle(t, secret.Data["ca-cert.pem"]); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// WaitForWebhookCABundle waits until all the webhooks of the mutating and validating webhook configurations of the member webhook
+// have the given CA bundle
+func (a *MemberAwaitility) WaitForWebhookCABundle(t T, ca []byte) error {
+	if _, err := WaitForObject(t, a.Awaitility, types.NamespacedName{Name: MutatingWebhookConfigurationName},
+		UntilWebhookConfigurationHasCABundle[*admv1.MutatingWebhookConfiguration](ca)); err != nil {
+		return err
+	}
+	_, err := WaitForObject(t, a.Awaitility, types.NamespacedName{Name: ValidatingWebhookConfigurationName},
+		UntilWebhookConfigurationHasCABundle[*admv1.ValidatingWebhookConfiguration](ca))
+	return err
+}
+
+// UntilWebhookCertsIssued returns a `Criterion` which checks that the Secret contains a serving certificate along with its key
+// and the CA which issued it, and that the certificate is not the given previous one
+func UntilWebhookCertsIssued(previousCert []byte) Criterion[*corev1.Secret] {
+	return Criterion[*corev1.Secret]{
+		Match: func(actual *corev1.Secret) bool {
+			return len(actual.Data["server-key.pem"]) > 0 && len(actual.Data["server-cert.pem"]) > 0 && len(actual.Data["ca-cert.pem"]) > 0 &&
+				!bytes.Equal(actual.Data["server-cert.pem"], previousCert)
+		},
+		Diff: func(actual *corev1.Secret) string {
+			for _, k := range []string{"server-key.pem", "server-cert.pem", "ca-cert.pem"} {
+				if len(actual.Data[k]) == 0 {
+					return fmt.Sprintf("expected Secret '%s' to contain a non-empty '%s'", actual.Name, k)
+				}
+			}
+			return fmt.Sprintf("expected Secret '%s' to contain a new certificate, but it still contains the previous one", actual.Name)
+		},
+	}
+}
+
+// UntilWebhookConfigurationHasCABundle returns a `Criterion` which checks that all the webhooks of the MutatingWebhookConfiguration
+// or ValidatingWebhookConfiguration have the given CA bundle
+func UntilWebhookConfigurationHasCABundle[T client.Object](ca []byte) Criterion[T] {
+	return Criterion[T]{
+		Match: func(actual T) bool {
+			bundles := caBundlesOf(actual)
+			for _, b := range bundles {
+				if !bytes.Equal(b, ca) {
+					return false
+				}
+			}
+			return len(bundles) > 0
+		},
+		Diff: func(actual T) string {
+			bundles := caBundlesOf(actual)
+			if len(bundles) == 0 {
+				return fmt.Sprintf("expected %s '%s' to have webhooks, but it has none", objectKind[T](), actual.GetName())
+			}
+			outdated := 0
+			for _, b := range bundles {
+				if !bytes.Equal(b, ca) {
+					outdated++
+				}
+			}
+			return fmt.Sprintf("expected all the webhooks of %s '%s' to have the CA bundle of the new certificate, but %d out of %d have another one",
+				objectKind[T](), actual.GetName(), outdated, len(bundles))
+		},
+	}
+}
+
+// caBundlesOf returns the CA bundles of all the webhooks of the given MutatingWebhookConfiguration or ValidatingWebhookConfiguration
+func caBundlesOf(obj client.Object) [][]byte {
+	var bundles [][]byte
+	switch c := obj.(type) {
+	case *admv1.MutatingWebhookConfiguration:
+		for _, w := range c.Webhooks {
+			bundles = append(bundles, w.ClientConfig.CABundle)
+		}
+	case *admv1.ValidatingWebhookConfiguration:
+		for _, w := range c.Webhooks {
+			bundles = append(bundles, w.ClientConfig.CABundle)
+		}
+	}
+	return bundles
+}
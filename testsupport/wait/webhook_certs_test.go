@@ -0,0 +1,120 @@
+package wait_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUntilWebhookCertsIssued(t *testing.T) {
+	// given
+	newSecret := func(key, cert, ca string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: wait.WebhookCertsSecretName,
+			},
+			Data: map[string][]byte{
+				"server-key.pem":  []byte(key),
+				"server-cert.pem": []byte(cert),
+				"ca-cert.pem":     []byte(ca),
+			},
+		}
+	}
+	criterion := wait.UntilWebhookCertsIssued([]byte("old-cert"))
+
+	t.Run("new cert", func(t *testing.T) {
+		assert.True(t, criterion.Match(newSecret("key", "new-cert", "ca")))
+	})
+
+	t.Run("previous cert", func(t *testing.T) {
+		secret := newSecret("key", "old-cert", "ca")
+		assert.False(t, criterion.Match(secret))
+		assert.Equal(t, "expected Secret 'webhook-certs' to contain a new certificate, but it still contains the previous one", criterion.Diff(secret))
+	})
+
+	t.Run("missing CA", func(t *testing.T) {
+		secret := newSecret("key", "new-cert", "")
+		assert.False(t, criterion.Match(secret))
+		assert.Equal(t, "expected Secret 'webhook-certs' to contain a non-empty 'ca-cert.pem'", criterion.Diff(secret))
+	})
+}
+
+func TestWaitForWebhookCABundle(t *testing.T) {
+	// given
+	newMutatingConfig := func(bundles ...string) *admv1.MutatingWebhookConfiguration {
+		config := &admv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: wait.MutatingWebhookConfigurationName,
+			},
+		}
+		for _, b := range bundles {
+			config.Webhooks = append(config.Webhooks, admv1.MutatingWebhook{ClientConfig: admv1.WebhookClientConfig{CABundle: []byte(b)}})
+		}
+		return config
+	}
+	newValidatingConfig := func(bundles ...string) *admv1.ValidatingWebhookConfiguration {
+		config := &admv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: wait.ValidatingWebhookConfigurationName,
+			},
+		}
+		for _, b := range bundles {
+			config.Webhooks = append(config.Webhooks, admv1.ValidatingWebhook{ClientConfig: admv1.WebhookClientConfig{CABundle: []byte(b)}})
+		}
+		return config
+	}
+	newMemberAwaitility := func(t *testing.T, mutating *admv1.MutatingWebhookConfiguration, validating *admv1.ValidatingWebhookConfiguration) *wait.MemberAwaitility {
+		return &wait.MemberAwaitility{
+			Awaitility: &wait.Awaitility{
+				Client:        test.NewFakeClient(t, mutating, validating),
+				Namespace:     "toolchain-member-operator",
+				RetryInterval: 10 * time.Millisecond,
+				Timeout:       100 * time.Millisecond,
+			},
+		}
+	}
+
+	t.Run("all webhooks updated", func(t *testing.T) {
+		// given
+		a := newMemberAwaitility(t, newMutatingConfig("new-ca", "new-ca"), newValidatingConfig("new-ca", "new-ca", "new-ca"))
+
+		// when
+		err := a.WaitForWebhookCABundle(t, []byte("new-ca"))
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("validating webhook not updated yet", func(t *testing.T) {
+		// given
+		a := newMemberAwaitility(t, newMutatingConfig("new-ca", "new-ca"), newValidatingConfig("new-ca", "old-ca", "new-ca"))
+
+		// when
+		err := a.WaitForWebhookCABundle(t, []byte("new-ca"))
+
+		// then
+		require.Error(t, err)
+		assert.True(t, wait.IsTimeout(err))
+		assert.Contains(t, wait.LastState(err), "expected all the webhooks of ValidatingWebhookConfiguration 'member-operator-validating-webhook' to have the CA bundle of the new certificate, but 1 out of 3 have another one")
+	})
+
+	t.Run("no webhook", func(t *testing.T) {
+		// given
+		a := newMemberAwaitility(t, newMutatingConfig(), newValidatingConfig("new-ca"))
+
+		// when
+		err := a.WaitForWebhookCABundle(t, []byte("new-ca"))
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, wait.LastState(err), "expected MutatingWebhookConfiguration 'member-operator-webhook' to have webhooks, but it has none")
+	})
+}
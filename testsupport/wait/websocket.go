@@ -0,0 +1,147 @@
+package wait
+
+import (
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	routev1 "github.com/openshift/api/route/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// WebSocketOption an option to configure the handshake of the WebSocket connections established by WaitForWebSocket
+type WebSocketOption func(*webSocketConfig)
+
+type webSocketConfig struct {
+	subprotocols []string
+	header       http.Header
+	tlsConfig    *tls.Config
+}
+
+// WithSubprotocols an option to request the given subprotocols during the handshake (eg: `base64.binary.k8s.io`)
+func WithSubprotocols(subprotocols ...string) WebSocketOption {
+	return func(c *webSocketConfig) {
+		c.subprotocols = append(c.subprotocols, subprotocols...)
+	}
+}
+
+// WithWebSocketHeader an option to send the given header with the handshake request (eg: `Origin`)
+func WithWebSocketHeader(key, value string) WebSocketOption {
+	return func(c *webSocketConfig) {
+		c.header.Add(key, value)
+	}
+}
+
+// WithWebSocketBearerToken an option to send the given bearer token in the `Authorization` header of the handshake request
+func WithWebSocketBearerToken(token string) WebSocketOption {
+	return WithWebSocketHeader("Authorization", "Bearer "+token)
+}
+
+// WithBearerTokenSubprotocol an option to send the given bearer token in a `base64url.bearer.authorization.k8s.io.<token>` subprotocol,
+// which is how the browsers authenticate the WebSocket connections with the Kubernetes API server (and with the proxy), since they
+// can't set the `Authorization` header of the handshake request
+func WithBearerTokenSubprotocol(token string) WebSocketOption {
+	return WithSubprotocols("base64url.bearer.authorization.k8s.io." + base64.RawURLEncoding.EncodeToString([]byte(token)))
+}
+
+// WithWebSocketTLSConfig an option to use the given TLS config during the handshake, instead of skipping the verification of
+// the certificate of the server
+func WithWebSocketTLSConfig(tlsConfig *tls.Config) WebSocketOption {
+	return func(c *webSocketConfig) {
+		c.tlsConfig = tlsConfig
+	}
+}
+
+// WaitForWebSocket waits until a WebSocket connection can be established with the given URL (eg: `wss://<host>/apis/...?watch=true`),
+// ie: until the handshake succeeds. The handshake is retried when it fails (eg: while the route or its pods are not ready yet), and
+// the status and body of the last failed handshake are logged when the connection can't be established before the timeout.
+// The returned connection is closed when the test ends.
+func (a *Awaitility) WaitForWebSocket(t T, url string, opts ...WebSocketOption) (*websocket.Conn, error) {
+	recordWaiter(t)
+	config := &webSocketConfig{
+		header: http.Header{},
+		tlsConfig: &tls.Config{
+			InsecureSkipVerify: true, // nolint:gosec
+		},
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	transport := a.newTransport(config.tlsConfig)
+	dialer := &websocket.Dialer{
+		Proxy:            transport.Proxy,
+		Subprotocols:     config.subprotocols,
+		TLSClientConfig:  transport.TLSClientConfig,
+		NetDialContext:   transport.DialContext,
+		HandshakeTimeout: 5 * time.Second,
+	}
+	a.logf(t, "waiting for WebSocket connection to '%s'", url)
+	var conn *websocket.Conn
+	var lastFailure string
+	err := a.poll(t, a.RetryInterval, a.Timeout, func() (done bool, err error) {
+		c, resp, err := dialer.DialContext(a.getContext(), url, config.header)
+		if err != nil {
+			lastFailure = err.Error()
+			if errors.Is(err, websocket.ErrBadHandshake) && resp != nil {
+				body, _ := io.ReadAll(resp.Body)
+				_ = resp.Body.Close()
+				lastFailure = fmt.Sprintf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+			}
+			return false, nil
+		}
+		conn = c
+		return true, nil
+	})
+	if err != nil {
+		a.logf(t, "last WebSocket handshake with '%s' failed: %s", url, lastFailure)
+		return nil, err
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return conn, nil
+}
+
+// WaitForRouteWebSocket waits until a WebSocket connection can be established with the given path on the host of the route with
+// the given name (see WaitForWebSocket), using the `wss` scheme on the TLS routes and the `ws` scheme on the other ones
+func (a *Awaitility) WaitForRouteWebSocket(t T, ns, name, path string, opts ...WebSocketOption) (*websocket.Conn, error) {
+	route := routev1.Route{}
+	if err := a.Client.Get(a.getContext(), types.NamespacedName{Namespace: ns, Name: name}, &route); err != nil {
+		return nil, err
+	}
+	// `https://...` -> `wss://...` and `http://...` -> `ws://...`
+	return a.WaitForWebSocket(t, "ws"+strings.TrimPrefix(routeURL(route, path), "http"), opts...)
+}
+
+// WaitForWebSocketEcho sends the given text message on the given connection, and waits until the same message is received back
+// (eg: from an echo server behind a route), ignoring the other messages received in the meantime
+func (a *Awaitility) WaitForWebSocketEcho(t T, conn *websocket.Conn, message string) error {
+	recordWaiter(t)
+	a.logf(t, "waiting for the echo of '%s' on the WebSocket connection to '%s'", message, conn.RemoteAddr())
+	if err := conn.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+		return fmt.Errorf("unable to send the message on the WebSocket connection: %w", err)
+	}
+	if err := conn.SetReadDeadline(time.Now().Add(a.Timeout)); err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.SetReadDeadline(time.Time{})
+	}()
+	var received []string
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return fmt.Errorf("did not receive the echo of '%s' on the WebSocket connection (received: %v): %w", message, received, err)
+		}
+		if string(msg) == message {
+			return nil
+		}
+		received = append(received, string(msg))
+	}
+}
@@ -0,0 +1,131 @@
+package wait_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/gorilla/websocket"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWaitForWebSocket(t *testing.T) {
+	// given
+	upgrader := websocket.Upgrader{
+		Subprotocols: []string{"base64.binary.k8s.io"},
+	}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" &&
+			!strings.Contains(r.Header.Get("Sec-WebSocket-Protocol"), "base64url.bearer.authorization.k8s.io.dG9rZW4") {
+			http.Error(w, "missing token", http.StatusUnauthorized)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_ = conn.WriteMessage(websocket.TextMessage, []byte("welcome"))
+		for {
+			messageType, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if r.URL.Query().Get("echo") == "false" {
+				continue
+			}
+			if err := conn.WriteMessage(messageType, msg); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+	url := "wss" + strings.TrimPrefix(server.URL, "https") + "/apis/appstudio.redhat.com/v1alpha1/namespaces/john-tenant/applications?watch=true"
+	s := runtime.NewScheme()
+	require.NoError(t, routev1.AddToScheme(s))
+	route := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "toolchain-host-operator",
+			Name:      "api",
+		},
+		Spec: routev1.RouteSpec{
+			TLS: &routev1.TLSConfig{
+				Termination: routev1.TLSTerminationEdge,
+			},
+		},
+		Status: routev1.RouteStatus{
+			Ingress: []routev1.RouteIngress{
+				{
+					Host: strings.TrimPrefix(server.URL, "https://"),
+				},
+			},
+		},
+	}
+	a := &wait.Awaitility{
+		Client:        fake.NewClientBuilder().WithScheme(s).WithObjects(route).Build(),
+		Namespace:     "toolchain-host-operator",
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       100 * time.Millisecond,
+	}
+
+	t.Run("with bearer token", func(t *testing.T) {
+		// when
+		conn, err := a.WaitForWebSocket(t, url, wait.WithWebSocketBearerToken("token"))
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, conn.Subprotocol())
+
+		t.Run("echo", func(t *testing.T) {
+			require.NoError(t, a.WaitForWebSocketEcho(t, conn, "Hello from e2e tests!"))
+		})
+	})
+
+	t.Run("with bearer token subprotocol", func(t *testing.T) {
+		// when
+		conn, err := a.WaitForRouteWebSocket(t, route.Namespace, route.Name, "/apis/appstudio.redhat.com/v1alpha1/namespaces/john-tenant/applications?watch=true",
+			wait.WithBearerTokenSubprotocol("token"), wait.WithSubprotocols("base64.binary.k8s.io"))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "base64.binary.k8s.io", conn.Subprotocol())
+	})
+
+	t.Run("handshake failure", func(t *testing.T) {
+		// given
+		out := &strings.Builder{}
+
+		// when
+		ok := wait.RunStandalone(t.Name(), out, func(st wait.T) {
+			_, err := a.WaitForWebSocket(st, url)
+			require.Error(st, err)
+			assert.True(st, wait.IsTimeout(err))
+		})
+
+		// then
+		assert.True(t, ok)
+		assert.Contains(t, out.String(), "last WebSocket handshake with '"+url+"' failed: 401 Unauthorized: missing token")
+	})
+
+	t.Run("no echo", func(t *testing.T) {
+		// given
+		conn, err := a.WaitForWebSocket(t, url+"&echo=false", wait.WithWebSocketBearerToken("token"))
+		require.NoError(t, err)
+
+		// when
+		err = a.WaitForWebSocketEcho(t, conn, "Hello from e2e tests!")
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "did not receive the echo of 'Hello from e2e tests!' on the WebSocket connection (received: [welcome])")
+	})
+}
@@ -0,0 +1,233 @@
+package wait
+
+import (
+	"fmt"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+
+	"github.com/redhat-cop/operator-utils/pkg/util"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubectl/pkg/util/podutils"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StatefulSetCriteria a criterion which must be matched by a StatefulSet when waiting until it is ready
+type StatefulSetCriteria func(*appsv1.StatefulSet) bool
+
+// StatefulSetHasContainerWithImage returns `true` if the StatefulSet has a container with the given name and image
+func StatefulSetHasContainerWithImage(containerName, image string) StatefulSetCriteria {
+	return func(statefulSet *appsv1.StatefulSet) bool {
+		return podTemplateHasContainerWithImage(statefulSet.Spec.Template, containerName, image)
+	}
+}
+
+// StatefulSetHasContainerWithEnv returns `true` if the StatefulSet has a container with the given name, with the given env var
+func StatefulSetHasContainerWithEnv(containerName, envName, envValue string) StatefulSetCriteria {
+	return func(statefulSet *appsv1.StatefulSet) bool {
+		return podTemplateHasContainerWithEnv(statefulSet.Spec.Template, containerName, envName, envValue)
+	}
+}
+
+// DaemonSetCriteria a criterion which must be matched by a DaemonSet when waiting until it is ready
+type DaemonSetCriteria func(*appsv1.DaemonSet) bool
+
+// DaemonSetHasContainerWithImage returns `true` if the DaemonSet has a container with the given name and image
+func DaemonSetHasContainerWithImage(containerName, image string) DaemonSetCriteria {
+	return func(daemonSet *appsv1.DaemonSet) bool {
+		return podTemplateHasContainerWithImage(daemonSet.Spec.Template, containerName, image)
+	}
+}
+
+// DaemonSetHasContainerWithEnv returns `true` if the DaemonSet has a container with the given name, with the given env var
+func DaemonSetHasContainerWithEnv(containerName, envName, envValue string) DaemonSetCriteria {
+	return func(daemonSet *appsv1.DaemonSet) bool {
+		return podTemplateHasContainerWithEnv(daemonSet.Spec.Template, containerName, envName, envValue)
+	}
+}
+
+// DaemonSetHasScheduledPods returns `true` if the DaemonSet should run the given number of pods (ie, one per eligible node)
+func DaemonSetHasScheduledPods(count int) DaemonSetCriteria {
+	return func(daemonSet *appsv1.DaemonSet) bool {
+		return int(daemonSet.Status.DesiredNumberScheduled) == count
+	}
+}
+
+func podTemplateHasContainerWithImage(template corev1.PodTemplateSpec, containerName, image string) bool {
+	for _, container := range template.Spec.Containers {
+		if container.Name == containerName && container.Image == image {
+			return true
+		}
+	}
+	return false
+}
+
+func podTemplateContainer(template corev1.PodTemplateSpec, containerName string) (corev1.Container, bool) {
+	for _, container := range template.Spec.Containers {
+		if container.Name == containerName {
+			return container, true
+		}
+	}
+	return corev1.Container{}, false
+}
+
+// podTemplateHasMountedVolume returns `true` if the container with the given name mounts a volume which matches the given func
+func podTemplateHasMountedVolume(template corev1.PodTemplateSpec, containerName string, match func(corev1.Volume) bool) bool {
+	container, found := podTemplateContainer(template, containerName)
+	if !found {
+		return false
+	}
+	for _, volume := range template.Spec.Volumes {
+		if !match(volume) {
+			continue
+		}
+		for _, mount := range container.VolumeMounts {
+			if mount.Name == volume.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasQuantity(resources corev1.ResourceList, resourceName corev1.ResourceName, quantity string) bool {
+	expected, err := resource.ParseQuantity(quantity)
+	if err != nil {
+		return false
+	}
+	actual, found := resources[resourceName]
+	return found && actual.Cmp(expected) == 0
+}
+
+func podTemplateHasContainerWithEnv(template corev1.PodTemplateSpec, containerName, envName, envValue string) bool {
+	for _, container := range template.Spec.Containers {
+		if container.Name != containerName {
+			continue
+		}
+		for _, env := range container.Env {
+			if env.Name == envName && env.Value == envValue {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WaitForStatefulSetToGetReady waits until the StatefulSet with the given name is ready together with the given number of replicas,
+// ie: until all its replicas run the latest revision and are ready
+func (a *Awaitility) WaitForStatefulSetToGetReady(t T, name string, replicas int, criteria ...StatefulSetCriteria) *appsv1.StatefulSet {
+	statefulSet, err := a.TryWaitForStatefulSetToGetReady(t, name, replicas, criteria...)
+	require.NoError(t, err)
+	return statefulSet
+}
+
+// TryWaitForStatefulSetToGetReady is like WaitForStatefulSetToGetReady, but it returns an error instead of failing the test
+// if the StatefulSet does not get ready
+func (a *Awaitility) TryWaitForStatefulSetToGetReady(t T, name string, replicas int, criteria ...StatefulSetCriteria) (*appsv1.StatefulSet, error) {
+	recordWaiter(t)
+	a.logf(t, "waiting until statefulset '%s' in namespace '%s' is ready", name, a.Namespace)
+	statefulSet := &appsv1.StatefulSet{}
+	err := a.poll(t, a.RetryInterval, 6*a.Timeout, func() (done bool, err error) {
+		statefulSet = &appsv1.StatefulSet{}
+		if err := a.Client.Get(a.getContext(), test.NamespacedName(a.Namespace, name), statefulSet); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		s := statefulSet.Status
+		if s.ObservedGeneration < statefulSet.Generation || int(s.ReadyReplicas) != replicas {
+			return false, nil
+		}
+		// with the `OnDelete` strategy, the pods are only updated when they are deleted, so the revisions may legitimately differ
+		if statefulSet.Spec.UpdateStrategy.Type != appsv1.OnDeleteStatefulSetStrategyType &&
+			(int(s.UpdatedReplicas) != replicas || s.CurrentRevision != s.UpdateRevision) {
+			return false, nil
+		}
+		if ready, err := a.podsReady(statefulSet.Spec.Selector, replicas); err != nil || !ready {
+			return false, err
+		}
+		for _, criteriaMatch := range criteria {
+			if !criteriaMatch(statefulSet) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		s := statefulSet.Status
+		a.logf(t, "last status of statefulset '%s': ready=%d/%d updated=%d current-revision='%s' update-revision='%s'",
+			name, s.ReadyReplicas, replicas, s.UpdatedReplicas, s.CurrentRevision, s.UpdateRevision)
+	}
+	return statefulSet, err
+}
+
+// WaitForDaemonSetToGetReady waits until the DaemonSet with the given name is ready, ie: until it is scheduled on at least one node and
+// the pods of all the nodes on which it should run (see DaemonSetHasScheduledPods) run the latest revision and are ready
+func (a *Awaitility) WaitForDaemonSetToGetReady(t T, name string, criteria ...DaemonSetCriteria) *appsv1.DaemonSet {
+	daemonSet, err := a.TryWaitForDaemonSetToGetReady(t, name, criteria...)
+	require.NoError(t, err)
+	return daemonSet
+}
+
+// TryWaitForDaemonSetToGetReady is like WaitForDaemonSetToGetReady, but it returns an error instead of failing the test
+// if the DaemonSet does not get ready
+func (a *Awaitility) TryWaitForDaemonSetToGetReady(t T, name string, criteria ...DaemonSetCriteria) (*appsv1.DaemonSet, error) {
+	recordWaiter(t)
+	a.logf(t, "waiting until daemonset '%s' in namespace '%s' is ready", name, a.Namespace)
+	daemonSet := &appsv1.DaemonSet{}
+	err := a.poll(t, a.RetryInterval, 6*a.Timeout, func() (done bool, err error) {
+		daemonSet = &appsv1.DaemonSet{}
+		if err := a.Client.Get(a.getContext(), test.NamespacedName(a.Namespace, name), daemonSet); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		s := daemonSet.Status
+		if s.ObservedGeneration < daemonSet.Generation || s.DesiredNumberScheduled == 0 ||
+			s.NumberReady != s.DesiredNumberScheduled || s.UpdatedNumberScheduled != s.DesiredNumberScheduled || s.NumberUnavailable > 0 {
+			return false, nil
+		}
+		if ready, err := a.podsReady(daemonSet.Spec.Selector, int(s.DesiredNumberScheduled)); err != nil || !ready {
+			return false, err
+		}
+		for _, criteriaMatch := range criteria {
+			if !criteriaMatch(daemonSet) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		s := daemonSet.Status
+		a.logf(t, "last status of daemonset '%s': ready=%d/%d updated=%d unavailable=%d",
+			name, s.NumberReady, s.DesiredNumberScheduled, s.UpdatedNumberScheduled, s.NumberUnavailable)
+	}
+	return daemonSet, err
+}
+
+// podsReady returns `true` if there is the given number of pods matching the given selector in the current namespace, and if all
+// of them are ready and not being deleted
+func (a *Awaitility) podsReady(selector *metav1.LabelSelector, count int) (bool, error) {
+	if selector == nil {
+		return false, fmt.Errorf("missing selector")
+	}
+	pods := &corev1.PodList{}
+	if err := a.Client.List(a.getContext(), pods, client.InNamespace(a.Namespace), client.MatchingLabels(selector.MatchLabels)); err != nil {
+		return false, err
+	}
+	if len(pods.Items) != count {
+		return false, nil
+	}
+	for _, pod := range pods.Items { // nolint
+		if util.IsBeingDeleted(&pod) || !podutils.IsPodReady(&pod) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,234 @@
+package wait_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8swait "k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestWaitForStatefulSetToGetReady(t *testing.T) {
+	newStatefulSet := func(ready int32, currentRevision string) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "toolchain-host-operator", Name: "cache"},
+			Spec: appsv1.StatefulSetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "cache"}},
+				Template: newPodTemplate("cache:v2", "MODE", "cluster"),
+			},
+			Status: appsv1.StatefulSetStatus{
+				ReadyReplicas:   ready,
+				UpdatedReplicas: ready,
+				CurrentRevision: currentRevision,
+				UpdateRevision:  "cache-2",
+			},
+		}
+	}
+
+	t.Run("ready", func(t *testing.T) {
+		// given
+		a := newWorkloadsAwaitility(t, newStatefulSet(2, "cache-2"), newWorkloadPod("cache-0", "cache", true), newWorkloadPod("cache-1", "cache", true))
+
+		// when
+		statefulSet, err := a.TryWaitForStatefulSetToGetReady(t, "cache", 2,
+			wait.StatefulSetHasContainerWithImage("main", "cache:v2"),
+			wait.StatefulSetHasContainerWithEnv("main", "MODE", "cluster"))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "cache", statefulSet.Name)
+	})
+
+	t.Run("not ready", func(t *testing.T) {
+		for name, objs := range map[string][]runtime.Object{
+			"not found":            {},
+			"missing replica":      {newStatefulSet(1, "cache-2"), newWorkloadPod("cache-0", "cache", true)},
+			"rollout in progress":  {newStatefulSet(2, "cache-1"), newWorkloadPod("cache-0", "cache", true), newWorkloadPod("cache-1", "cache", true)},
+			"pod not ready":        {newStatefulSet(2, "cache-2"), newWorkloadPod("cache-0", "cache", true), newWorkloadPod("cache-1", "cache", false)},
+			"criteria not matched": {newStatefulSet(2, "cache-2"), newWorkloadPod("cache-0", "cache", true), newWorkloadPod("cache-1", "cache", true)},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// given
+				a := newWorkloadsAwaitility(t, objs...)
+
+				// when
+				_, err := a.TryWaitForStatefulSetToGetReady(t, "cache", 2, wait.StatefulSetHasContainerWithImage("main", "cache:v3"))
+
+				// then
+				require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+			})
+		}
+	})
+}
+
+func TestWaitForDaemonSetToGetReady(t *testing.T) {
+	newDaemonSet := func(desired, ready, unavailable int32) *appsv1.DaemonSet {
+		return &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "toolchain-host-operator", Name: "agent"},
+			Spec: appsv1.DaemonSetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "agent"}},
+				Template: newPodTemplate("agent:v1", "LOG_LEVEL", "debug"),
+			},
+			Status: appsv1.DaemonSetStatus{
+				DesiredNumberScheduled: desired,
+				NumberReady:            ready,
+				UpdatedNumberScheduled: desired,
+				NumberUnavailable:      unavailable,
+			},
+		}
+	}
+
+	t.Run("ready", func(t *testing.T) {
+		// given
+		a := newWorkloadsAwaitility(t, newDaemonSet(2, 2, 0), newWorkloadPod("agent-a", "agent", true), newWorkloadPod("agent-b", "agent", true))
+
+		// when
+		daemonSet, err := a.TryWaitForDaemonSetToGetReady(t, "agent",
+			wait.DaemonSetHasScheduledPods(2),
+			wait.DaemonSetHasContainerWithImage("main", "agent:v1"),
+			wait.DaemonSetHasContainerWithEnv("main", "LOG_LEVEL", "debug"))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "agent", daemonSet.Name)
+	})
+
+	t.Run("not ready", func(t *testing.T) {
+		for name, objs := range map[string][]runtime.Object{
+			"not found":           {},
+			"not scheduled":       {newDaemonSet(0, 0, 0)},
+			"unavailable pod":     {newDaemonSet(2, 1, 1), newWorkloadPod("agent-a", "agent", true), newWorkloadPod("agent-b", "agent", false)},
+			"pod not ready":       {newDaemonSet(2, 2, 0), newWorkloadPod("agent-a", "agent", true), newWorkloadPod("agent-b", "agent", false)},
+			"missing pod":         {newDaemonSet(2, 2, 0), newWorkloadPod("agent-a", "agent", true)},
+			"env var not matched": {newDaemonSet(2, 2, 0), newWorkloadPod("agent-a", "agent", true), newWorkloadPod("agent-b", "agent", true)},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// given
+				a := newWorkloadsAwaitility(t, objs...)
+
+				// when
+				_, err := a.TryWaitForDaemonSetToGetReady(t, "agent", wait.DaemonSetHasContainerWithEnv("main", "LOG_LEVEL", "info"))
+
+				// then
+				require.ErrorIs(t, err, k8swait.ErrWaitTimeout)
+			})
+		}
+	})
+}
+
+func newWorkloadsAwaitility(t *testing.T, objs ...runtime.Object) *wait.Awaitility {
+	return &wait.Awaitility{
+		Client:        test.NewFakeClient(t, objs...),
+		Namespace:     "toolchain-host-operator",
+		RetryInterval: 10 * time.Millisecond,
+		Timeout:       20 * time.Millisecond,
+	}
+}
+
+func newPodTemplate(image, envName, envValue string) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  "main",
+					Image: image,
+					Env:   []corev1.EnvVar{{Name: envName, Value: envValue}},
+				},
+			},
+		},
+	}
+}
+
+func newWorkloadPod(name, app string, ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "toolchain-host-operator",
+			Name:      name,
+			Labels:    map[string]string{"app": app},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+func TestDeploymentCriteria(t *testing.T) {
+	// given
+	replicas := int32(2)
+	deployment := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "registration-service",
+							Image: "registration-service:v1",
+							Env:   []corev1.EnvVar{{Name: "REGISTRATION_ENVIRONMENT", Value: "e2e-tests"}},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+								Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("0.5")},
+							},
+							VolumeMounts: []corev1.VolumeMount{{Name: "certs"}, {Name: "config"}},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{Name: "certs", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "registration-service-certs"}}},
+						{Name: "config", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{
+							Sources: []corev1.VolumeProjection{{ConfigMap: &corev1.ConfigMapProjection{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "registration-service-config"},
+							}}},
+						}}},
+						{Name: "unmounted", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "unmounted"}}},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		criteria wait.DeploymentCriteria
+		expected bool
+	}{
+		"image":                       {wait.DeploymentHasContainerWithImage("registration-service", "registration-service:v1"), true},
+		"other image":                 {wait.DeploymentHasContainerWithImage("registration-service", "registration-service:v2"), false},
+		"env":                         {wait.DeploymentHasContainerWithEnv("registration-service", "REGISTRATION_ENVIRONMENT", "e2e-tests"), true},
+		"other env value":             {wait.DeploymentHasContainerWithEnv("registration-service", "REGISTRATION_ENVIRONMENT", "prod"), false},
+		"env of unknown container":    {wait.DeploymentHasContainerWithEnv("unknown", "REGISTRATION_ENVIRONMENT", "e2e-tests"), false},
+		"without env":                 {wait.DeploymentHasContainerWithoutEnv("registration-service", "REGISTRATION_VERIFICATION_ENABLED"), true},
+		"without existing env":        {wait.DeploymentHasContainerWithoutEnv("registration-service", "REGISTRATION_ENVIRONMENT"), false},
+		"resource request":            {wait.DeploymentHasContainerWithResourceRequest("registration-service", corev1.ResourceMemory, "64Mi"), true},
+		"other resource request":      {wait.DeploymentHasContainerWithResourceRequest("registration-service", corev1.ResourceMemory, "128Mi"), false},
+		"missing resource request":    {wait.DeploymentHasContainerWithResourceRequest("registration-service", corev1.ResourceCPU, "500m"), false},
+		"resource limit":              {wait.DeploymentHasContainerWithResourceLimit("registration-service", corev1.ResourceCPU, "500m"), true},
+		"other resource limit":        {wait.DeploymentHasContainerWithResourceLimit("registration-service", corev1.ResourceCPU, "1"), false},
+		"mounted secret":              {wait.DeploymentHasMountedSecret("registration-service", "registration-service-certs"), true},
+		"unmounted secret":            {wait.DeploymentHasMountedSecret("registration-service", "unmounted"), false},
+		"projected configmap":         {wait.DeploymentHasMountedConfigMap("registration-service", "registration-service-config"), true},
+		"unknown configmap":           {wait.DeploymentHasMountedConfigMap("registration-service", "unknown"), false},
+		"replicas":                    {wait.DeploymentHasReplicas(2), true},
+		"other replicas":              {wait.DeploymentHasReplicas(3), false},
+		"secret of unknown container": {wait.DeploymentHasMountedSecret("unknown", "registration-service-certs"), false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			actual := tc.criteria(deployment)
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
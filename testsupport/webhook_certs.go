@@ -0,0 +1,73 @@
+package testsupport
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RotateMemberWebhookCerts forces the rotation of the serving certificate of the member webhook (see MemberAwaitility.RotateWebhookCerts),
+// and verifies that the pods created in the given user namespace are still admitted by the webhook afterwards (see VerifyWebhookAdmission)
+func RotateMemberWebhookCerts(t *testing.T, memberAwait *wait.MemberAwaitility, userNamespace string) {
+	secret, err := memberAwait.RotateWebhookCerts(t)
+	require.NoError(t, err)
+	t.Logf("the serving certificate of the member webhook was rotated (secret uid=%s)", secret.UID)
+	VerifyWebhookAdmission(t, memberAwait, userNamespace)
+}
+
+// VerifyWebhookAdmission verifies that the pods created in the given user namespace are mutated by the member webhook, ie: that
+// they are given the priority class of the user pods.
+// Since the failures of the webhook which mutates the pods are ignored by the API server, a pod which was created while the webhook
+// was still serving an outdated certificate (eg: until the kubelet updated the content of the mounted Secret after a rotation) is not
+// mutated: in that case, the pod is deleted and another one is created, until the timeout of the MemberAwaitility.
+func VerifyWebhookAdmission(t *testing.T, memberAwait *wait.MemberAwaitility, userNamespace string) {
+	zero := int64(0)
+	attempt := 0
+	var last *corev1.Pod
+	err := memberAwait.Poll(t, func() (done bool, err error) {
+		attempt++
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: userNamespace,
+				Name:      fmt.Sprintf("webhook-admission-%d", attempt),
+			},
+			Spec: corev1.PodSpec{
+				TerminationGracePeriodSeconds: &zero,
+				Containers: []corev1.Container{{
+					Name:    "sleep",
+					Image:   "busybox",
+					Command: []string{"sleep", "3600"},
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							"cpu":    resource.MustParse("1m"),
+							"memory": resource.MustParse("8Mi"),
+						},
+						Limits: corev1.ResourceList{
+							"cpu":    resource.MustParse("50m"),
+							"memory": resource.MustParse("80Mi"),
+						},
+					},
+				}},
+			},
+		}
+		if err := memberAwait.Client.Create(context.TODO(), pod); err != nil {
+			return false, err
+		}
+		defer func() {
+			_ = memberAwait.Client.Delete(context.TODO(), pod)
+		}()
+		last = pod
+		return wait.WithSandboxPriorityClass().Match(pod), nil
+	})
+	if err != nil && last != nil {
+		t.Log(wait.WithSandboxPriorityClass().Diff(last))
+	}
+	require.NoError(t, err, "the pods created in namespace '%s' are not mutated by the member webhook", userNamespace)
+}